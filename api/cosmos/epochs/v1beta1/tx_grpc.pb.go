@@ -0,0 +1,213 @@
+// Since: x/epochs 0.1.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package epochsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Msg_AddEpochInfo_FullMethodName    = "/cosmos.epochs.v1beta1.Msg/AddEpochInfo"
+	Msg_UpdateEpochInfo_FullMethodName = "/cosmos.epochs.v1beta1.Msg/UpdateEpochInfo"
+	Msg_RemoveEpochInfo_FullMethodName = "/cosmos.epochs.v1beta1.Msg/RemoveEpochInfo"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the epochs Msg service.
+type MsgClient interface {
+	// AddEpochInfo defines a governance operation to add a new epoch identifier.
+	AddEpochInfo(ctx context.Context, in *MsgAddEpochInfo, opts ...grpc.CallOption) (*MsgAddEpochInfoResponse, error)
+	// UpdateEpochInfo defines a governance operation to update the duration or
+	// start time of an existing epoch identifier.
+	UpdateEpochInfo(ctx context.Context, in *MsgUpdateEpochInfo, opts ...grpc.CallOption) (*MsgUpdateEpochInfoResponse, error)
+	// RemoveEpochInfo defines a governance operation to remove an epoch identifier.
+	// It fails if a registered epoch hook still relies on the identifier.
+	RemoveEpochInfo(ctx context.Context, in *MsgRemoveEpochInfo, opts ...grpc.CallOption) (*MsgRemoveEpochInfoResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddEpochInfo(ctx context.Context, in *MsgAddEpochInfo, opts ...grpc.CallOption) (*MsgAddEpochInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddEpochInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_AddEpochInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEpochInfo(ctx context.Context, in *MsgUpdateEpochInfo, opts ...grpc.CallOption) (*MsgUpdateEpochInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateEpochInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEpochInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEpochInfo(ctx context.Context, in *MsgRemoveEpochInfo, opts ...grpc.CallOption) (*MsgRemoveEpochInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveEpochInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveEpochInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+//
+// Msg defines the epochs Msg service.
+type MsgServer interface {
+	// AddEpochInfo defines a governance operation to add a new epoch identifier.
+	AddEpochInfo(context.Context, *MsgAddEpochInfo) (*MsgAddEpochInfoResponse, error)
+	// UpdateEpochInfo defines a governance operation to update the duration or
+	// start time of an existing epoch identifier.
+	UpdateEpochInfo(context.Context, *MsgUpdateEpochInfo) (*MsgUpdateEpochInfoResponse, error)
+	// RemoveEpochInfo defines a governance operation to remove an epoch identifier.
+	// It fails if a registered epoch hook still relies on the identifier.
+	RemoveEpochInfo(context.Context, *MsgRemoveEpochInfo) (*MsgRemoveEpochInfoResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) AddEpochInfo(context.Context, *MsgAddEpochInfo) (*MsgAddEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpochInfo not implemented")
+}
+func (UnimplementedMsgServer) UpdateEpochInfo(context.Context, *MsgUpdateEpochInfo) (*MsgUpdateEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochInfo not implemented")
+}
+func (UnimplementedMsgServer) RemoveEpochInfo(context.Context, *MsgRemoveEpochInfo) (*MsgRemoveEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEpochInfo not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_AddEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEpochInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpochInfo(ctx, req.(*MsgAddEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEpochInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochInfo(ctx, req.(*MsgUpdateEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveEpochInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEpochInfo(ctx, req.(*MsgRemoveEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddEpochInfo",
+			Handler:    _Msg_AddEpochInfo_Handler,
+		},
+		{
+			MethodName: "UpdateEpochInfo",
+			Handler:    _Msg_UpdateEpochInfo_Handler,
+		},
+		{
+			MethodName: "RemoveEpochInfo",
+			Handler:    _Msg_RemoveEpochInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
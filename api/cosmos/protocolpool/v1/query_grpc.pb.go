@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
-	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_CommunityPool_FullMethodName    = "/cosmos.protocolpool.v1.Query/CommunityPool"
+	Query_UnclaimedBudget_FullMethodName  = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_Budgets_FullMethodName          = "/cosmos.protocolpool.v1.Query/Budgets"
+	Query_MilestoneBudget_FullMethodName  = "/cosmos.protocolpool.v1.Query/MilestoneBudget"
+	Query_MilestoneBudgets_FullMethodName = "/cosmos.protocolpool.v1.Query/MilestoneBudgets"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +36,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// Budgets queries all the budget proposals.
+	Budgets(ctx context.Context, in *QueryBudgetsRequest, opts ...grpc.CallOption) (*QueryBudgetsResponse, error)
+	// MilestoneBudget queries a milestone budget by its id.
+	MilestoneBudget(ctx context.Context, in *QueryMilestoneBudgetRequest, opts ...grpc.CallOption) (*QueryMilestoneBudgetResponse, error)
+	// MilestoneBudgets queries all the milestone budgets.
+	MilestoneBudgets(ctx context.Context, in *QueryMilestoneBudgetsRequest, opts ...grpc.CallOption) (*QueryMilestoneBudgetsResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +72,36 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) Budgets(ctx context.Context, in *QueryBudgetsRequest, opts ...grpc.CallOption) (*QueryBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBudgetsResponse)
+	err := c.cc.Invoke(ctx, Query_Budgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MilestoneBudget(ctx context.Context, in *QueryMilestoneBudgetRequest, opts ...grpc.CallOption) (*QueryMilestoneBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMilestoneBudgetResponse)
+	err := c.cc.Invoke(ctx, Query_MilestoneBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MilestoneBudgets(ctx context.Context, in *QueryMilestoneBudgetsRequest, opts ...grpc.CallOption) (*QueryMilestoneBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMilestoneBudgetsResponse)
+	err := c.cc.Invoke(ctx, Query_MilestoneBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +112,12 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// Budgets queries all the budget proposals.
+	Budgets(context.Context, *QueryBudgetsRequest) (*QueryBudgetsResponse, error)
+	// MilestoneBudget queries a milestone budget by its id.
+	MilestoneBudget(context.Context, *QueryMilestoneBudgetRequest) (*QueryMilestoneBudgetResponse, error)
+	// MilestoneBudgets queries all the milestone budgets.
+	MilestoneBudgets(context.Context, *QueryMilestoneBudgetsRequest) (*QueryMilestoneBudgetsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +134,15 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) Budgets(context.Context, *QueryBudgetsRequest) (*QueryBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Budgets not implemented")
+}
+func (UnimplementedQueryServer) MilestoneBudget(context.Context, *QueryMilestoneBudgetRequest) (*QueryMilestoneBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MilestoneBudget not implemented")
+}
+func (UnimplementedQueryServer) MilestoneBudgets(context.Context, *QueryMilestoneBudgetsRequest) (*QueryMilestoneBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MilestoneBudgets not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +200,60 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Budgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Budgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Budgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Budgets(ctx, req.(*QueryBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MilestoneBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMilestoneBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MilestoneBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MilestoneBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MilestoneBudget(ctx, req.(*QueryMilestoneBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MilestoneBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMilestoneBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MilestoneBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MilestoneBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MilestoneBudgets(ctx, req.(*QueryMilestoneBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +269,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "Budgets",
+			Handler:    _Query_Budgets_Handler,
+		},
+		{
+			MethodName: "MilestoneBudget",
+			Handler:    _Query_MilestoneBudget_Handler,
+		},
+		{
+			MethodName: "MilestoneBudgets",
+			Handler:    _Query_MilestoneBudgets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -26,6 +26,9 @@ const (
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreateMilestoneBudget_FullMethodName  = "/cosmos.protocolpool.v1.Msg/CreateMilestoneBudget"
+	Msg_AttestMilestone_FullMethodName        = "/cosmos.protocolpool.v1.Msg/AttestMilestone"
+	Msg_CancelMilestoneBudget_FullMethodName  = "/cosmos.protocolpool.v1.Msg/CancelMilestoneBudget"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +55,12 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateMilestoneBudget defines a method to create a budget released milestone by milestone.
+	CreateMilestoneBudget(ctx context.Context, in *MsgCreateMilestoneBudget, opts ...grpc.CallOption) (*MsgCreateMilestoneBudgetResponse, error)
+	// AttestMilestone defines a method to attest a milestone and release its tranche.
+	AttestMilestone(ctx context.Context, in *MsgAttestMilestone, opts ...grpc.CallOption) (*MsgAttestMilestoneResponse, error)
+	// CancelMilestoneBudget defines a method to cancel the unreleased milestones of a budget.
+	CancelMilestoneBudget(ctx context.Context, in *MsgCancelMilestoneBudget, opts ...grpc.CallOption) (*MsgCancelMilestoneBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -132,6 +141,36 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateMilestoneBudget(ctx context.Context, in *MsgCreateMilestoneBudget, opts ...grpc.CallOption) (*MsgCreateMilestoneBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateMilestoneBudgetResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateMilestoneBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestMilestone(ctx context.Context, in *MsgAttestMilestone, opts ...grpc.CallOption) (*MsgAttestMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAttestMilestoneResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMilestoneBudget(ctx context.Context, in *MsgCancelMilestoneBudget, opts ...grpc.CallOption) (*MsgCancelMilestoneBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelMilestoneBudgetResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMilestoneBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -156,6 +195,12 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateMilestoneBudget defines a method to create a budget released milestone by milestone.
+	CreateMilestoneBudget(context.Context, *MsgCreateMilestoneBudget) (*MsgCreateMilestoneBudgetResponse, error)
+	// AttestMilestone defines a method to attest a milestone and release its tranche.
+	AttestMilestone(context.Context, *MsgAttestMilestone) (*MsgAttestMilestoneResponse, error)
+	// CancelMilestoneBudget defines a method to cancel the unreleased milestones of a budget.
+	CancelMilestoneBudget(context.Context, *MsgCancelMilestoneBudget) (*MsgCancelMilestoneBudgetResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -187,6 +232,15 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreateMilestoneBudget(context.Context, *MsgCreateMilestoneBudget) (*MsgCreateMilestoneBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMilestoneBudget not implemented")
+}
+func (UnimplementedMsgServer) AttestMilestone(context.Context, *MsgAttestMilestone) (*MsgAttestMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestMilestone not implemented")
+}
+func (UnimplementedMsgServer) CancelMilestoneBudget(context.Context, *MsgCancelMilestoneBudget) (*MsgCancelMilestoneBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMilestoneBudget not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -334,6 +388,60 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateMilestoneBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMilestoneBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMilestoneBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateMilestoneBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMilestoneBudget(ctx, req.(*MsgCreateMilestoneBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestMilestone(ctx, req.(*MsgAttestMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMilestoneBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMilestoneBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMilestoneBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMilestoneBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMilestoneBudget(ctx, req.(*MsgCancelMilestoneBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +477,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateMilestoneBudget",
+			Handler:    _Msg_CreateMilestoneBudget_Handler,
+		},
+		{
+			MethodName: "AttestMilestone",
+			Handler:    _Msg_AttestMilestone_Handler,
+		},
+		{
+			MethodName: "CancelMilestoneBudget",
+			Handler:    _Msg_CancelMilestoneBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName            = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_MissedBlockWindow_FullMethodName = "/cosmos.slashing.v1beta1.Query/MissedBlockWindow"
+	Query_JailHistory_FullMethodName       = "/cosmos.slashing.v1beta1.Query/JailHistory"
+	Query_Uptime_FullMethodName            = "/cosmos.slashing.v1beta1.Query/Uptime"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +39,14 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlockWindow queries the missed block bitmap of the signed blocks
+	// window of given cons address
+	MissedBlockWindow(ctx context.Context, in *QueryMissedBlockWindowRequest, opts ...grpc.CallOption) (*QueryMissedBlockWindowResponse, error)
+	// JailHistory queries the jail records of given cons address
+	JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error)
+	// Uptime queries the uptime of given cons address over the most recent
+	// blocks of the signed blocks window
+	Uptime(ctx context.Context, in *QueryUptimeRequest, opts ...grpc.CallOption) (*QueryUptimeResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +87,36 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlockWindow(ctx context.Context, in *QueryMissedBlockWindowRequest, opts ...grpc.CallOption) (*QueryMissedBlockWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMissedBlockWindowResponse)
+	err := c.cc.Invoke(ctx, Query_MissedBlockWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryJailHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_JailHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Uptime(ctx context.Context, in *QueryUptimeRequest, opts ...grpc.CallOption) (*QueryUptimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUptimeResponse)
+	err := c.cc.Invoke(ctx, Query_Uptime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +129,14 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlockWindow queries the missed block bitmap of the signed blocks
+	// window of given cons address
+	MissedBlockWindow(context.Context, *QueryMissedBlockWindowRequest) (*QueryMissedBlockWindowResponse, error)
+	// JailHistory queries the jail records of given cons address
+	JailHistory(context.Context, *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error)
+	// Uptime queries the uptime of given cons address over the most recent
+	// blocks of the signed blocks window
+	Uptime(context.Context, *QueryUptimeRequest) (*QueryUptimeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +156,15 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) MissedBlockWindow(context.Context, *QueryMissedBlockWindowRequest) (*QueryMissedBlockWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlockWindow not implemented")
+}
+func (UnimplementedQueryServer) JailHistory(context.Context, *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailHistory not implemented")
+}
+func (UnimplementedQueryServer) Uptime(context.Context, *QueryUptimeRequest) (*QueryUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uptime not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +240,60 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlockWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlockWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlockWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MissedBlockWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlockWindow(ctx, req.(*QueryMissedBlockWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_JailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailHistory(ctx, req.(*QueryJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Uptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Uptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Uptime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Uptime(ctx, req.(*QueryUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +313,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlockWindow",
+			Handler:    _Query_MissedBlockWindow_Handler,
+		},
+		{
+			MethodName: "JailHistory",
+			Handler:    _Query_JailHistory_Handler,
+		},
+		{
+			MethodName: "Uptime",
+			Handler:    _Query_Uptime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
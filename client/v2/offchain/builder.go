@@ -298,6 +298,31 @@ func (b *builder) signatureDataToModeInfoAndSig(data SignatureData) (*apitx.Mode
 				Single: &apitx.ModeInfo_Single{Mode: data.SignMode},
 			},
 		}, data.Signature, nil
+	case *MultiSignatureData:
+		modeInfos := make([]*apitx.ModeInfo, len(data.Signatures))
+		sigs := make([][]byte, len(data.Signatures))
+		for i, d := range data.Signatures {
+			var err error
+			modeInfos[i], sigs[i], err = b.signatureDataToModeInfoAndSig(d)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		multisig := cryptotypes.MultiSignature{Signatures: sigs}
+		sig, err := multisig.Marshal()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &apitx.ModeInfo{
+			Sum: &apitx.ModeInfo_Multi_{
+				Multi: &apitx.ModeInfo_Multi{
+					Bitarray:  data.BitArray,
+					ModeInfos: modeInfos,
+				},
+			},
+		}, sig, nil
 	default:
 		return nil, nil, fmt.Errorf("unexpected signature data type %T", data)
 	}
@@ -311,6 +336,28 @@ func modeInfoAndSigToSignatureData(modeInfo *apitx.ModeInfo, sig []byte) (Signat
 			SignMode:  modeInfoType.Single.Mode,
 			Signature: sig,
 		}, nil
+	case *apitx.ModeInfo_Multi_:
+		var multisig cryptotypes.MultiSignature
+		if err := multisig.Unmarshal(sig); err != nil {
+			return nil, err
+		}
+		if len(multisig.Signatures) != len(modeInfoType.Multi.ModeInfos) {
+			return nil, errors.New("mismatch between the number of multisig signatures and mode infos")
+		}
+
+		sigs := make([]SignatureData, len(multisig.Signatures))
+		for i, mi := range modeInfoType.Multi.ModeInfos {
+			var err error
+			sigs[i], err = modeInfoAndSigToSignatureData(mi, multisig.Signatures[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &MultiSignatureData{
+			BitArray:   modeInfoType.Multi.Bitarray,
+			Signatures: sigs,
+		}, nil
 
 	default:
 		return nil, fmt.Errorf("unexpected ModeInfo data type %T", modeInfo)
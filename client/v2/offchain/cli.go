@@ -30,6 +30,7 @@ func OffChain() *cobra.Command {
 	cmd.AddCommand(
 		SignFile(),
 		VerifyFile(),
+		Multisig(),
 	)
 
 	flags.AddKeyringFlags(cmd.PersistentFlags())
@@ -0,0 +1,587 @@
+package offchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	multisigv1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	apicrypto "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// EnvelopeKindLegacyMultisig is the kind of envelopes signed by the members of a
+	// LegacyAminoPubKey multisig key.
+	EnvelopeKindLegacyMultisig = "legacy-multisig"
+	// EnvelopeKindAccountsMultisig is the kind of envelopes creating, voting and executing
+	// a proposal of an x/accounts multisig account.
+	EnvelopeKindAccountsMultisig = "accounts-multisig"
+
+	// EnvelopeVersion is the version of the envelope format.
+	EnvelopeVersion = 1
+
+	// envelopeSignMode is the sign mode of the envelope signatures. Signers sign the
+	// transaction independently, the LEGACY_AMINO_JSON sign bytes do not depend on the
+	// signer infos of the other signers.
+	envelopeSignMode = apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+)
+
+// Envelope is a partially signed transaction exchanged between the co-signers of a multisig.
+// Each co-signer appends its signature offline, the envelopes are then combined into a
+// signed transaction once enough signatures have been collected.
+//
+// A legacy-multisig envelope holds a transaction sent by a LegacyAminoPubKey multisig
+// account, it is complete once the threshold of the multisig key is reached.
+//
+// An accounts-multisig envelope holds a transaction in which the listed members of an
+// x/accounts multisig create a proposal, vote yes on it and, when early execution is
+// enabled, execute it. It is complete once every listed member has signed.
+type Envelope struct {
+	Version int    `json:"version"`
+	Kind    string `json:"kind"`
+	ChainID string `json:"chain_id"`
+	// Multisig is the address of the multisig account.
+	Multisig string `json:"multisig"`
+	// MultisigPubKey is the LegacyAminoPubKey of a legacy-multisig envelope.
+	MultisigPubKey json.RawMessage `json:"multisig_pub_key,omitempty"`
+	// AccountNumber and Sequence are the ones of the legacy multisig account.
+	AccountNumber uint64 `json:"account_number,omitempty"`
+	Sequence      uint64 `json:"sequence,omitempty"`
+	// Threshold is the weight required by the multisig.
+	Threshold uint64 `json:"threshold"`
+	// Members are the co-signers of the envelope.
+	Members []EnvelopeMember `json:"members"`
+	// Tx is the protobuf encoded unsigned transaction.
+	Tx []byte `json:"tx"`
+	// Signatures are the signatures collected so far.
+	Signatures []EnvelopeSignature `json:"signatures"`
+}
+
+// EnvelopeMember is a co-signer of an envelope.
+type EnvelopeMember struct {
+	Address string `json:"address"`
+	Weight  uint64 `json:"weight"`
+}
+
+// EnvelopeSignature is the signature of a co-signer.
+type EnvelopeSignature struct {
+	Signer string          `json:"signer"`
+	PubKey json.RawMessage `json:"pub_key"`
+	// AccountNumber and Sequence are the ones of the co-signer account in an
+	// accounts-multisig envelope, they are unset in a legacy-multisig envelope.
+	AccountNumber uint64 `json:"account_number,omitempty"`
+	Sequence      uint64 `json:"sequence,omitempty"`
+	Signature     []byte `json:"signature"`
+}
+
+// EnvelopeStatus reports the signing progress of an envelope.
+type EnvelopeStatus struct {
+	Kind         string         `json:"kind"`
+	Multisig     string         `json:"multisig"`
+	Threshold    uint64         `json:"threshold"`
+	SignedWeight uint64         `json:"signed_weight"`
+	Complete     bool           `json:"complete"`
+	Signers      []SignerStatus `json:"signers"`
+}
+
+// SignerStatus reports whether a co-signer signed an envelope.
+type SignerStatus struct {
+	Address string `json:"address"`
+	Weight  uint64 `json:"weight"`
+	Signed  bool   `json:"signed"`
+}
+
+// AccountsMultisigOptions defines the proposal of an accounts-multisig envelope.
+type AccountsMultisigOptions struct {
+	// Signers are the members voting on the proposal, the first one creates the proposal
+	// and pays the fees of the transaction unless a fee payer is set.
+	Signers []EnvelopeMember
+	// Threshold is the weight required for the proposal to pass.
+	Threshold uint64
+	// ProposalID is the identifier the proposal gets once created.
+	ProposalID uint64
+	// Title and Summary describe the proposal.
+	Title   string
+	Summary string
+	// Execute adds the execution of the proposal to the transaction, it requires early
+	// execution to be enabled on the account.
+	Execute bool
+}
+
+// NewLegacyMultisigEnvelope returns an envelope for the transaction tx sent by the account of
+// the given LegacyAminoPubKey.
+func NewLegacyMultisigEnvelope(ctx client.Context, pubKey *multisig.LegacyAminoPubKey, chainID string, accNum, seq uint64, tx *apitx.Tx) (*Envelope, error) {
+	multisigAddr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyJSON, err := ctx.Codec.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	subKeys := pubKey.GetPubKeys()
+	members := make([]EnvelopeMember, len(subKeys))
+	for i, pk := range subKeys {
+		addr, err := ctx.AddressCodec.BytesToString(pk.Address())
+		if err != nil {
+			return nil, err
+		}
+		members[i] = EnvelopeMember{Address: addr, Weight: 1}
+	}
+
+	txBytes, err := marshalUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		Version:        EnvelopeVersion,
+		Kind:           EnvelopeKindLegacyMultisig,
+		ChainID:        chainID,
+		Multisig:       multisigAddr,
+		MultisigPubKey: pubKeyJSON,
+		AccountNumber:  accNum,
+		Sequence:       seq,
+		Threshold:      uint64(pubKey.Threshold),
+		Members:        members,
+		Tx:             txBytes,
+	}, nil
+}
+
+// NewAccountsMultisigEnvelope returns an envelope in which the signers create a proposal of
+// the x/accounts multisig account with the messages of tx, and vote yes on it. The fee,
+// memo and timeouts of tx are kept.
+func NewAccountsMultisigEnvelope(chainID, multisigAddr string, tx *apitx.Tx, opts AccountsMultisigOptions) (*Envelope, error) {
+	if len(opts.Signers) == 0 {
+		return nil, errors.New("at least one signer is required")
+	}
+	if len(tx.GetBody().GetMessages()) == 0 {
+		return nil, errors.New("the proposal must contain at least one message")
+	}
+
+	weight := uint64(0)
+	seen := map[string]bool{}
+	for _, s := range opts.Signers {
+		if seen[s.Address] {
+			return nil, fmt.Errorf("duplicate signer %s", s.Address)
+		}
+		seen[s.Address] = true
+		weight += s.Weight
+	}
+	if weight < opts.Threshold {
+		return nil, fmt.Errorf("signers weight %d is below the threshold of %d", weight, opts.Threshold)
+	}
+
+	execute := func(sender string, msg protov2.Message) (*anypb.Any, error) {
+		anyMsg, err := anyutil.New(msg)
+		if err != nil {
+			return nil, err
+		}
+		return anyutil.New(&accountsv1.MsgExecute{Sender: sender, Target: multisigAddr, Message: anyMsg})
+	}
+
+	proposer := opts.Signers[0].Address
+	createProposal, err := execute(proposer, &multisigv1.MsgCreateProposal{
+		Proposal: &multisigv1.Proposal{
+			Title:    opts.Title,
+			Summary:  opts.Summary,
+			Messages: tx.Body.Messages,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []*anypb.Any{createProposal}
+	for _, s := range opts.Signers {
+		vote, err := execute(s.Address, &multisigv1.MsgVote{ProposalId: opts.ProposalID, Vote: multisigv1.VoteOption_VOTE_OPTION_YES})
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, vote)
+	}
+	if opts.Execute {
+		executeProposal, err := execute(proposer, &multisigv1.MsgExecuteProposal{ProposalId: opts.ProposalID})
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, executeProposal)
+	}
+
+	envelopeTx := &apitx.Tx{
+		Body: &apitx.TxBody{
+			Messages:         msgs,
+			Memo:             tx.Body.Memo,
+			TimeoutHeight:    tx.Body.TimeoutHeight,
+			TimeoutTimestamp: tx.Body.TimeoutTimestamp,
+		},
+		AuthInfo: &apitx.AuthInfo{Fee: tx.GetAuthInfo().GetFee()},
+	}
+	txBytes, err := marshalUnsignedTx(envelopeTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		Version:   EnvelopeVersion,
+		Kind:      EnvelopeKindAccountsMultisig,
+		ChainID:   chainID,
+		Multisig:  multisigAddr,
+		Threshold: opts.Threshold,
+		Members:   slices.Clone(opts.Signers),
+		Tx:        txBytes,
+	}, nil
+}
+
+// ParseEnvelope parses a JSON encoded envelope.
+func ParseEnvelope(bz []byte) (*Envelope, error) {
+	var e Envelope
+	if err := json.Unmarshal(bz, &e); err != nil {
+		return nil, err
+	}
+	if e.Version != EnvelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version %d", e.Version)
+	}
+	if e.Kind != EnvelopeKindLegacyMultisig && e.Kind != EnvelopeKindAccountsMultisig {
+		return nil, fmt.Errorf("unsupported envelope kind %q", e.Kind)
+	}
+	return &e, nil
+}
+
+// GetTx returns the unsigned transaction of the envelope.
+func (e *Envelope) GetTx() (*apitx.Tx, error) {
+	tx := &apitx.Tx{}
+	if err := protov2.Unmarshal(e.Tx, tx); err != nil {
+		return nil, err
+	}
+	if tx.Body == nil || tx.AuthInfo == nil {
+		return nil, errors.New("envelope transaction is missing its body or auth info")
+	}
+	if tx.AuthInfo.Fee == nil {
+		tx.AuthInfo.Fee = &apitx.Fee{}
+	}
+	return tx, nil
+}
+
+// Sign appends the signature of the key fromName to the envelope. accNum and seq are the
+// account number and sequence of the signer, they are ignored by legacy-multisig envelopes
+// which are signed with the ones of the multisig account.
+func (e *Envelope) Sign(ctx client.Context, fromName string, accNum, seq uint64) error {
+	keybase, err := keyring.NewAutoCLIKeyring(ctx.Keyring, ctx.AddressCodec)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := keybase.GetPubKey(fromName)
+	if err != nil {
+		return err
+	}
+
+	addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return err
+	}
+	if e.memberIndex(addr) < 0 {
+		return fmt.Errorf("%s is not a signer of the envelope", addr)
+	}
+	if e.signatureIndex(addr) >= 0 {
+		return fmt.Errorf("%s already signed the envelope", addr)
+	}
+
+	pubKeyJSON, err := ctx.Codec.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return err
+	}
+
+	sig := EnvelopeSignature{Signer: addr, PubKey: pubKeyJSON}
+	if e.Kind == EnvelopeKindAccountsMultisig {
+		sig.AccountNumber, sig.Sequence = accNum, seq
+	}
+
+	signBytes, err := e.signBytes(ctx, sig, pubKey)
+	if err != nil {
+		return err
+	}
+
+	sig.Signature, err = keybase.Sign(fromName, signBytes, envelopeSignMode)
+	if err != nil {
+		return err
+	}
+
+	e.Signatures = append(e.Signatures, sig)
+	return nil
+}
+
+// Merge adds the signatures of other to the envelope. Both envelopes must hold the same
+// transaction, all signatures are verified.
+func (e *Envelope) Merge(ctx client.Context, other *Envelope) error {
+	if e.Kind != other.Kind || e.ChainID != other.ChainID || e.Multisig != other.Multisig ||
+		e.AccountNumber != other.AccountNumber || e.Sequence != other.Sequence || !bytes.Equal(e.Tx, other.Tx) {
+		return errors.New("envelopes do not hold the same transaction")
+	}
+
+	for _, sig := range other.Signatures {
+		if i := e.signatureIndex(sig.Signer); i >= 0 {
+			if !bytes.Equal(e.Signatures[i].Signature, sig.Signature) {
+				return fmt.Errorf("conflicting signatures of %s", sig.Signer)
+			}
+			continue
+		}
+		if err := e.verifySignature(ctx, sig); err != nil {
+			return err
+		}
+		e.Signatures = append(e.Signatures, sig)
+	}
+
+	return nil
+}
+
+// Status returns the signing progress of the envelope.
+func (e *Envelope) Status() EnvelopeStatus {
+	status := EnvelopeStatus{
+		Kind:      e.Kind,
+		Multisig:  e.Multisig,
+		Threshold: e.Threshold,
+		Signers:   make([]SignerStatus, len(e.Members)),
+	}
+
+	complete := true
+	for i, m := range e.Members {
+		signed := e.signatureIndex(m.Address) >= 0
+		if signed {
+			status.SignedWeight += m.Weight
+		}
+		complete = complete && signed
+		status.Signers[i] = SignerStatus{Address: m.Address, Weight: m.Weight, Signed: signed}
+	}
+
+	if e.Kind == EnvelopeKindLegacyMultisig {
+		status.Complete = status.SignedWeight >= e.Threshold
+	} else {
+		status.Complete = complete
+	}
+
+	return status
+}
+
+// Combine verifies the collected signatures and returns the signed transaction.
+func (e *Envelope) Combine(ctx client.Context) (*apitx.Tx, error) {
+	if status := e.Status(); !status.Complete {
+		return nil, fmt.Errorf("envelope is not complete, signed weight %d, threshold %d", status.SignedWeight, status.Threshold)
+	}
+
+	tx, err := e.GetTx()
+	if err != nil {
+		return nil, err
+	}
+	txBuilder := &builder{cdc: ctx.Codec, tx: tx}
+
+	sigs := make([]OffchainSignature, 0, len(e.Members))
+	switch e.Kind {
+	case EnvelopeKindLegacyMultisig:
+		multisigPubKey, err := e.multisigPubKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		subKeys := multisigPubKey.GetPubKeys()
+		bitArray := cryptotypes.NewCompactBitArray(len(subKeys))
+		data := &MultiSignatureData{}
+		for i, m := range e.Members {
+			j := e.signatureIndex(m.Address)
+			if j < 0 || len(data.Signatures) == int(e.Threshold) {
+				continue
+			}
+			if err := e.verifySignature(ctx, e.Signatures[j]); err != nil {
+				return nil, err
+			}
+			bitArray.SetIndex(i, true)
+			data.Signatures = append(data.Signatures, &SingleSignatureData{SignMode: envelopeSignMode, Signature: e.Signatures[j].Signature})
+		}
+		data.BitArray = &apicrypto.CompactBitArray{ExtraBitsStored: bitArray.ExtraBitsStored, Elems: bitArray.Elems}
+
+		sigs = append(sigs, OffchainSignature{PubKey: multisigPubKey, Data: data, Sequence: e.Sequence})
+	case EnvelopeKindAccountsMultisig:
+		// signers are ordered by their first message, the proposer comes first.
+		for _, m := range e.Members {
+			sig := e.Signatures[e.signatureIndex(m.Address)]
+			if err := e.verifySignature(ctx, sig); err != nil {
+				return nil, err
+			}
+			pubKey, err := e.signerPubKey(ctx, sig)
+			if err != nil {
+				return nil, err
+			}
+			sigs = append(sigs, OffchainSignature{
+				PubKey:   pubKey,
+				Data:     &SingleSignatureData{SignMode: envelopeSignMode, Signature: sig.Signature},
+				Sequence: sig.Sequence,
+			})
+		}
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+// EncodeTx returns the protobuf encoding of a signed transaction, ready to be broadcast.
+func EncodeTx(tx *apitx.Tx) ([]byte, error) {
+	bodyBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+	authInfoBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(tx.AuthInfo)
+	if err != nil {
+		return nil, err
+	}
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(&apitx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    tx.Signatures,
+	})
+}
+
+// verifySignature verifies the signature of a co-signer of the envelope.
+func (e *Envelope) verifySignature(ctx client.Context, sig EnvelopeSignature) error {
+	if e.memberIndex(sig.Signer) < 0 {
+		return fmt.Errorf("%s is not a signer of the envelope", sig.Signer)
+	}
+
+	pubKey, err := e.signerPubKey(ctx, sig)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := e.signBytes(ctx, sig, pubKey)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, sig.Signature) {
+		return fmt.Errorf("invalid signature of %s", sig.Signer)
+	}
+	return nil
+}
+
+// signBytes returns the bytes signed by a co-signer of the envelope.
+func (e *Envelope) signBytes(ctx client.Context, sig EnvelopeSignature, pubKey cryptotypes.PubKey) ([]byte, error) {
+	tx, err := e.GetTx()
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := (&builder{cdc: ctx.Codec, tx: tx}).GetSigningTxData()
+	if err != nil {
+		return nil, err
+	}
+
+	signerData := txsigning.SignerData{
+		ChainID:       e.ChainID,
+		AccountNumber: sig.AccountNumber,
+		Sequence:      sig.Sequence,
+		Address:       sig.Signer,
+	}
+	if e.Kind == EnvelopeKindLegacyMultisig {
+		// members of a legacy multisig sign with the data of the multisig account.
+		multisigPubKey, err := e.multisigPubKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		signerData.AccountNumber, signerData.Sequence, signerData.Address = e.AccountNumber, e.Sequence, e.Multisig
+		pubKey = multisigPubKey
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	signerData.PubKey = &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value}
+
+	return ctx.TxConfig.SignModeHandler().GetSignBytes(context.Background(), envelopeSignMode, signerData, txData)
+}
+
+// signerPubKey decodes the public key of a signature and checks it matches the signer.
+func (e *Envelope) signerPubKey(ctx client.Context, sig EnvelopeSignature) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := ctx.Codec.UnmarshalInterfaceJSON(sig.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+	if addr != sig.Signer {
+		return nil, fmt.Errorf("public key does not match signer %s", sig.Signer)
+	}
+	return pubKey, nil
+}
+
+// multisigPubKey decodes the LegacyAminoPubKey of a legacy-multisig envelope.
+func (e *Envelope) multisigPubKey(ctx client.Context) (*multisig.LegacyAminoPubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := ctx.Codec.UnmarshalInterfaceJSON(e.MultisigPubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected %T multisig public key, got %T", &multisig.LegacyAminoPubKey{}, pubKey)
+	}
+	return multisigPubKey, nil
+}
+
+func (e *Envelope) memberIndex(addr string) int {
+	return slices.IndexFunc(e.Members, func(m EnvelopeMember) bool { return m.Address == addr })
+}
+
+func (e *Envelope) signatureIndex(addr string) int {
+	return slices.IndexFunc(e.Signatures, func(s EnvelopeSignature) bool { return s.Signer == addr })
+}
+
+// marshalUnsignedTx encodes tx without its signatures.
+func marshalUnsignedTx(tx *apitx.Tx) ([]byte, error) {
+	if tx.GetBody() == nil {
+		return nil, errors.New("transaction is missing its body")
+	}
+
+	authInfo := &apitx.AuthInfo{Fee: tx.GetAuthInfo().GetFee()}
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(&apitx.Tx{Body: tx.Body, AuthInfo: authInfo})
+}
+
+// decodeTx decodes a protobuf encoded transaction.
+func decodeTx(txBytes []byte) (*apitx.Tx, error) {
+	var raw apitx.TxRaw
+	if err := protov2.Unmarshal(txBytes, &raw); err != nil {
+		return nil, err
+	}
+
+	tx := &apitx.Tx{Body: &apitx.TxBody{}, AuthInfo: &apitx.AuthInfo{}, Signatures: raw.Signatures}
+	if err := protov2.Unmarshal(raw.BodyBytes, tx.Body); err != nil {
+		return nil, err
+	}
+	if err := protov2.Unmarshal(raw.AuthInfoBytes, tx.AuthInfo); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,443 @@
+package offchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/spf13/cobra"
+	protov2 "google.golang.org/protobuf/proto"
+
+	multisigv1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/internal/account"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+)
+
+const (
+	flagSigners    = "signers"
+	flagTitle      = "title"
+	flagSummary    = "summary"
+	flagProposalID = "proposal-id"
+	flagNoExecute  = "no-execute"
+	flagBroadcast  = "broadcast"
+)
+
+// Multisig returns the commands coordinating the signature of a multisig transaction.
+func Multisig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Multisig transaction signing workflow.",
+		Long: `Coordinate the signature of a multisig transaction through a partially signed envelope.
+An envelope is created for a legacy multisig key or an x/accounts multisig account, every co-signer then
+appends its signature offline and the envelopes are finally combined into a signed transaction.`,
+	}
+
+	cmd.AddCommand(
+		CreateLegacyMultisigEnvelope(),
+		CreateAccountsMultisigEnvelope(),
+		SignEnvelope(),
+		EnvelopeStatusCmd(),
+		CombineEnvelopes(),
+	)
+
+	return cmd
+}
+
+// CreateLegacyMultisigEnvelope creates an envelope for a transaction sent by a legacy multisig key.
+func CreateLegacyMultisigEnvelope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-legacy <multisigKeyName> <txFile>",
+		Short: "Create an envelope for a transaction of a legacy multisig key.",
+		Long: `Create an envelope for an unsigned transaction sent by a legacy multisig key stored in the keyring.
+The account number and sequence of the multisig account are queried unless --offline is set.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[0])
+			}
+
+			tx, err := readTxFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			accNum, seq, err := accountNumberSequence(cmd, clientCtx, pubKey.Address())
+			if err != nil {
+				return err
+			}
+
+			envelope, err := NewLegacyMultisigEnvelope(clientCtx, multisigPubKey, clientCtx.ChainID, accNum, seq, tx)
+			if err != nil {
+				return err
+			}
+
+			return writeEnvelope(cmd, envelope)
+		},
+	}
+
+	addEnvelopeAccountFlags(cmd)
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The envelope will be written to the given file instead of STDOUT")
+	return cmd
+}
+
+// CreateAccountsMultisigEnvelope creates an envelope submitting a proposal to an x/accounts multisig account.
+func CreateAccountsMultisigEnvelope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-accounts <multisigAddress> <txFile> --signers <address>,...",
+		Short: "Create an envelope for a proposal of an x/accounts multisig account.",
+		Long: `Create an envelope in which the given members of an x/accounts multisig account create a proposal
+with the messages of the unsigned transaction, vote yes on it and execute it when early execution is enabled.
+The fee, memo and timeouts of the transaction are kept. The first signer creates the proposal and pays the fees.
+Every signer must sign the envelope.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := readTxFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			signerAddrs, _ := cmd.Flags().GetStringSlice(flagSigners)
+			title, _ := cmd.Flags().GetString(flagTitle)
+			summary, _ := cmd.Flags().GetString(flagSummary)
+			noExecute, _ := cmd.Flags().GetBool(flagNoExecute)
+
+			config, err := queryAccountsMultisig[*multisigv1.QueryConfigResponse](cmd.Context(), clientCtx, args[0], &multisigv1.QueryConfig{}, &multisigv1.QueryConfigResponse{})
+			if err != nil {
+				return fmt.Errorf("failed to query multisig config: %w", err)
+			}
+
+			proposalID, _ := cmd.Flags().GetUint64(flagProposalID)
+			if !cmd.Flags().Changed(flagProposalID) {
+				seq, err := queryAccountsMultisig[*multisigv1.QuerySequenceResponse](cmd.Context(), clientCtx, args[0], &multisigv1.QuerySequence{}, &multisigv1.QuerySequenceResponse{})
+				if err != nil {
+					return fmt.Errorf("failed to query multisig sequence: %w", err)
+				}
+				proposalID = seq.Sequence
+			}
+
+			weights := make(map[string]uint64, len(config.Members))
+			for _, m := range config.Members {
+				weights[m.Address] = m.Weight
+			}
+			signers := make([]EnvelopeMember, len(signerAddrs))
+			for i, addr := range signerAddrs {
+				weight, ok := weights[addr]
+				if !ok {
+					return fmt.Errorf("%s is not a member of %s", addr, args[0])
+				}
+				signers[i] = EnvelopeMember{Address: addr, Weight: weight}
+			}
+
+			envelope, err := NewAccountsMultisigEnvelope(clientCtx.ChainID, args[0], tx, AccountsMultisigOptions{
+				Signers:    signers,
+				Threshold:  uint64(max(config.Config.GetThreshold(), config.Config.GetQuorum())),
+				ProposalID: proposalID,
+				Title:      title,
+				Summary:    summary,
+				Execute:    config.Config.GetEarlyExecution() && !noExecute,
+			})
+			if err != nil {
+				return err
+			}
+
+			return writeEnvelope(cmd, envelope)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagSigners, nil, "The members voting on the proposal, the first one creates it")
+	cmd.Flags().String(flagTitle, "", "The title of the proposal")
+	cmd.Flags().String(flagSummary, "", "The summary of the proposal")
+	cmd.Flags().Uint64(flagProposalID, 0, "The identifier of the proposal, queried when not set")
+	cmd.Flags().Bool(flagNoExecute, false, "Do not execute the proposal, even if early execution is enabled")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The envelope will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flagSigners)
+	return cmd
+}
+
+// SignEnvelope appends a signature to an envelope.
+func SignEnvelope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <envelopeFile> <keyName>",
+		Short: "Append a signature to an envelope.",
+		Long: `Sign an envelope with a key of the keyring and append the signature to it.
+For x/accounts multisig envelopes, the account number and sequence of the signer are queried unless --offline is set.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			envelope, err := readEnvelope(args[0])
+			if err != nil {
+				return err
+			}
+
+			var accNum, seq uint64
+			if envelope.Kind == EnvelopeKindAccountsMultisig {
+				record, err := clientCtx.Keyring.Key(args[1])
+				if err != nil {
+					return err
+				}
+				addr, err := record.GetAddress()
+				if err != nil {
+					return err
+				}
+				accNum, seq, err = accountNumberSequence(cmd, clientCtx, addr)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := envelope.Sign(clientCtx, args[1], accNum, seq); err != nil {
+				return err
+			}
+
+			return writeEnvelope(cmd, envelope)
+		},
+	}
+
+	addEnvelopeAccountFlags(cmd)
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The envelope will be written to the given file instead of STDOUT")
+	return cmd
+}
+
+// EnvelopeStatusCmd prints the signing progress of envelopes as JSON.
+func EnvelopeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status <envelopeFile>...",
+		Short: "Print the signing progress of an envelope.",
+		Long:  "Merge the signatures of the given envelopes and print the signing progress as JSON.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			envelope, err := readEnvelopes(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(envelope.Status())
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// CombineEnvelopes combines envelopes into a signed transaction.
+func CombineEnvelopes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine <envelopeFile>...",
+		Short: "Combine envelopes into a signed transaction.",
+		Long: `Merge the signatures of the given envelopes and, once complete, output the signed transaction
+as JSON or broadcast it with --broadcast.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			envelope, err := readEnvelopes(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			tx, err := envelope.Combine(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			if broadcast, _ := cmd.Flags().GetBool(flagBroadcast); broadcast {
+				txBytes, err := EncodeTx(tx)
+				if err != nil {
+					return err
+				}
+
+				res, err := clientCtx.BroadcastTx(txBytes)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			txMarshaller, err := getMarshaller("json", "  ", false)
+			if err != nil {
+				return err
+			}
+			signedTx, err := marshalOffChainTx(tx, txMarshaller)
+			if err != nil {
+				return err
+			}
+
+			if err := setOutputDocument(cmd); err != nil {
+				return err
+			}
+			cmd.Println(signedTx)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagBroadcast, false, "Broadcast the signed transaction")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addEnvelopeAccountFlags adds the flags used to set the account number and sequence of a signer.
+func addEnvelopeAccountFlags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.Uint64P(flags.FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only)")
+	f.Uint64P(flags.FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
+	f.Bool(flags.FlagOffline, false, "Offline mode (does not allow any online functionality)")
+	f.String(flags.FlagChainID, "", "The network chain ID")
+	f.String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	f.String(flags.FlagGRPC, "", "the gRPC endpoint to use for this chain")
+	f.Bool(flags.FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
+}
+
+// accountNumberSequence returns the account number and sequence of addr, from the flags in
+// offline mode or when both are set, otherwise from the chain.
+func accountNumberSequence(cmd *cobra.Command, clientCtx client.Context, addr []byte) (accNum, seq uint64, err error) {
+	accNum, _ = cmd.Flags().GetUint64(flags.FlagAccountNumber)
+	seq, _ = cmd.Flags().GetUint64(flags.FlagSequence)
+	if clientCtx.Offline || (cmd.Flags().Changed(flags.FlagAccountNumber) && cmd.Flags().Changed(flags.FlagSequence)) {
+		if !cmd.Flags().Changed(flags.FlagAccountNumber) || !cmd.Flags().Changed(flags.FlagSequence) {
+			return 0, 0, errors.New("account-number and sequence must be set in offline mode")
+		}
+		return accNum, seq, nil
+	}
+
+	retriever := account.NewAccountRetriever(clientCtx.AddressCodec, clientCtx, clientCtx.InterfaceRegistry)
+	return retriever.GetAccountNumberSequence(cmd.Context(), addr)
+}
+
+// queryAccountsMultisig sends a query to an x/accounts multisig account.
+func queryAccountsMultisig[T protov2.Message](ctx context.Context, clientCtx client.Context, target string, req protov2.Message, res T) (T, error) {
+	anyReq, err := anyutil.New(req)
+	if err != nil {
+		return res, err
+	}
+
+	queryRes, err := accountsv1.NewQueryClient(clientCtx).AccountQuery(ctx, &accountsv1.AccountQueryRequest{
+		Target:  target,
+		Request: anyReq,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	return res, queryRes.Response.UnmarshalTo(res)
+}
+
+// readTxFile reads an unsigned transaction from a JSON file.
+func readTxFile(clientCtx client.Context, path string) (*apitx.Tx, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTx(txBytes)
+}
+
+// readEnvelope reads an envelope from a file.
+func readEnvelope(path string) (*Envelope, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnvelope(bz)
+}
+
+// readEnvelopes reads envelopes from files and merges them into the first one.
+func readEnvelopes(clientCtx client.Context, paths []string) (*Envelope, error) {
+	envelope, err := readEnvelope(paths[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths[1:] {
+		other, err := readEnvelope(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := envelope.Merge(clientCtx, other); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return envelope, nil
+}
+
+// writeEnvelope writes an envelope to the output document or STDOUT.
+func writeEnvelope(cmd *cobra.Command, envelope *Envelope) error {
+	bz, err := json.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := setOutputDocument(cmd); err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
+
+// setOutputDocument redirects the command output to the output document when set.
+func setOutputDocument(cmd *cobra.Command) error {
+	outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputFile == "" {
+		return nil
+	}
+
+	fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	cmd.SetOut(fp)
+	return nil
+}
@@ -0,0 +1,210 @@
+package offchain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const multisigChainID = "multisig-test"
+
+func newMultisigTestContext(t *testing.T, names ...string) (client.Context, []cryptotypes.PubKey, []string) {
+	t.Helper()
+	ctx := client.Context{
+		Keyring:      keyring.NewInMemory(getCodec()),
+		Codec:        getCodec(),
+		TxConfig:     newTestConfig(t),
+		AddressCodec: address.NewBech32Codec(addressCodecPrefix),
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	addrs := make([]string, len(names))
+	for i, name := range names {
+		record, _, err := ctx.Keyring.NewMnemonic(name, keyring.English, "m/44'/118'/0'/0/0", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = record.GetPubKey()
+		require.NoError(t, err)
+		addrs[i], err = ctx.AddressCodec.BytesToString(pubKeys[i].Address())
+		require.NoError(t, err)
+	}
+	return ctx, pubKeys, addrs
+}
+
+func newSendTx(t *testing.T, from, to string) *apitx.Tx {
+	t.Helper()
+	msg, err := anyutil.New(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	})
+	require.NoError(t, err)
+	return &apitx.Tx{
+		Body: &apitx.TxBody{Messages: []*anypb.Any{msg}, Memo: "multisig"},
+		AuthInfo: &apitx.AuthInfo{Fee: &apitx.Fee{
+			Amount:   []*basev1beta1.Coin{{Denom: "stake", Amount: "100"}},
+			GasLimit: 200000,
+		}},
+	}
+}
+
+// roundTrip simulates exchanging the envelope as a file between co-signers.
+func roundTrip(t *testing.T, e *Envelope) *Envelope {
+	t.Helper()
+	bz, err := json.Marshal(e)
+	require.NoError(t, err)
+	parsed, err := ParseEnvelope(bz)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestLegacyMultisigEnvelope(t *testing.T) {
+	ctx, pubKeys, addrs := newMultisigTestContext(t, "alice", "bob", "carol", "eve")
+	multisigPubKey := multisig.NewLegacyAminoPubKey(2, pubKeys[:3])
+	multisigAddr, err := ctx.AddressCodec.BytesToString(multisigPubKey.Address())
+	require.NoError(t, err)
+
+	envelope, err := NewLegacyMultisigEnvelope(ctx, multisigPubKey, multisigChainID, 7, 3, newSendTx(t, multisigAddr, addrs[3]))
+	require.NoError(t, err)
+	require.Equal(t, multisigAddr, envelope.Multisig)
+
+	_, err = envelope.Combine(ctx)
+	require.ErrorContains(t, err, "not complete")
+
+	// co-signers sign their own copy of the envelope offline.
+	aliceEnvelope := roundTrip(t, envelope)
+	require.NoError(t, aliceEnvelope.Sign(ctx, "alice", 0, 0))
+	require.ErrorContains(t, aliceEnvelope.Sign(ctx, "alice", 0, 0), "already signed")
+	require.ErrorContains(t, aliceEnvelope.Sign(ctx, "eve", 0, 0), "not a signer")
+
+	carolEnvelope := roundTrip(t, envelope)
+	require.NoError(t, carolEnvelope.Sign(ctx, "carol", 0, 0))
+
+	envelope = roundTrip(t, aliceEnvelope)
+	require.NoError(t, envelope.Merge(ctx, carolEnvelope))
+	require.Equal(t, EnvelopeStatus{
+		Kind:         EnvelopeKindLegacyMultisig,
+		Multisig:     multisigAddr,
+		Threshold:    2,
+		SignedWeight: 2,
+		Complete:     true,
+		Signers: []SignerStatus{
+			{Address: addrs[0], Weight: 1, Signed: true},
+			{Address: addrs[1], Weight: 1, Signed: false},
+			{Address: addrs[2], Weight: 1, Signed: true},
+		},
+	}, envelope.Status())
+
+	tx, err := envelope.Combine(ctx)
+	require.NoError(t, err)
+	require.Len(t, tx.AuthInfo.SignerInfos, 1)
+	require.Equal(t, uint64(3), tx.AuthInfo.SignerInfos[0].Sequence)
+
+	// the combined multisig signature verifies against the multisig account.
+	txBuilder := &builder{cdc: ctx.Codec, tx: tx}
+	sigs, err := txBuilder.GetSignatures()
+	require.NoError(t, err)
+	txData, err := txBuilder.GetSigningTxData()
+	require.NoError(t, err)
+	anyPk, err := codectypes.NewAnyWithValue(multisigPubKey)
+	require.NoError(t, err)
+	signerData := txsigning.SignerData{
+		ChainID:       multisigChainID,
+		AccountNumber: 7,
+		Sequence:      3,
+		Address:       multisigAddr,
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	require.NoError(t, verifySignature(context.Background(), sigs[0].PubKey, signerData, sigs[0].Data, ctx.TxConfig.SignModeHandler(), txData))
+
+	signerData.Sequence = 4
+	require.Error(t, verifySignature(context.Background(), sigs[0].PubKey, signerData, sigs[0].Data, ctx.TxConfig.SignModeHandler(), txData))
+
+	txBytes, err := EncodeTx(tx)
+	require.NoError(t, err)
+	decoded, err := decodeTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, tx.Signatures, decoded.Signatures)
+
+	// tampered signatures are rejected.
+	tampered := roundTrip(t, envelope)
+	tampered.Signatures = tampered.Signatures[:1]
+	bobEnvelope := roundTrip(t, tampered)
+	require.NoError(t, bobEnvelope.Sign(ctx, "bob", 0, 0))
+	bobEnvelope.Signatures[1].Signature[0] ^= 0xff
+	require.ErrorContains(t, tampered.Merge(ctx, bobEnvelope), "invalid signature")
+
+	// envelopes of different transactions cannot be merged.
+	other, err := NewLegacyMultisigEnvelope(ctx, multisigPubKey, multisigChainID, 7, 4, newSendTx(t, multisigAddr, addrs[3]))
+	require.NoError(t, err)
+	require.ErrorContains(t, envelope.Merge(ctx, other), "same transaction")
+}
+
+func TestAccountsMultisigEnvelope(t *testing.T) {
+	ctx, _, addrs := newMultisigTestContext(t, "alice", "bob", "carol")
+	multisigAddr := addrs[2]
+
+	_, err := NewAccountsMultisigEnvelope(multisigChainID, multisigAddr, newSendTx(t, multisigAddr, addrs[0]), AccountsMultisigOptions{
+		Signers:   []EnvelopeMember{{Address: addrs[0], Weight: 1}},
+		Threshold: 2,
+	})
+	require.ErrorContains(t, err, "below the threshold")
+
+	envelope, err := NewAccountsMultisigEnvelope(multisigChainID, multisigAddr, newSendTx(t, multisigAddr, addrs[0]), AccountsMultisigOptions{
+		Signers:    []EnvelopeMember{{Address: addrs[1], Weight: 1}, {Address: addrs[0], Weight: 2}},
+		Threshold:  3,
+		ProposalID: 5,
+		Title:      "pay alice",
+		Execute:    true,
+	})
+	require.NoError(t, err)
+
+	tx, err := envelope.GetTx()
+	require.NoError(t, err)
+	// create proposal, two votes and execute.
+	require.Len(t, tx.Body.Messages, 4)
+	require.Equal(t, "multisig", tx.Body.Memo)
+
+	require.NoError(t, envelope.Sign(ctx, "alice", 1, 10))
+	status := envelope.Status()
+	require.False(t, status.Complete)
+	require.Equal(t, uint64(2), status.SignedWeight)
+	_, err = envelope.Combine(ctx)
+	require.ErrorContains(t, err, "not complete")
+
+	bobEnvelope := roundTrip(t, envelope)
+	bobEnvelope.Signatures = nil
+	require.NoError(t, bobEnvelope.Sign(ctx, "bob", 2, 20))
+	require.NoError(t, envelope.Merge(ctx, bobEnvelope))
+	require.True(t, envelope.Status().Complete)
+
+	signedTx, err := envelope.Combine(ctx)
+	require.NoError(t, err)
+	require.Len(t, signedTx.AuthInfo.SignerInfos, 2)
+	// signatures are ordered by signer, the proposer first.
+	require.Equal(t, uint64(20), signedTx.AuthInfo.SignerInfos[0].Sequence)
+	require.Equal(t, uint64(10), signedTx.AuthInfo.SignerInfos[1].Sequence)
+
+	// a signature made with a wrong sequence does not verify once merged.
+	wrong := roundTrip(t, envelope)
+	wrong.Signatures[1].Sequence = 21
+	fresh := roundTrip(t, envelope)
+	fresh.Signatures = nil
+	require.ErrorContains(t, fresh.Merge(ctx, wrong), "invalid signature")
+}
@@ -1,6 +1,7 @@
 package offchain
 
 import (
+	apicrypto "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -12,6 +13,8 @@ type SignatureData interface {
 
 func (m *SingleSignatureData) isSignatureData() {}
 
+func (m *MultiSignatureData) isSignatureData() {}
+
 type SingleSignatureData struct {
 	// SignMode represents the SignMode of the signature
 	SignMode apitxsigning.SignMode
@@ -20,6 +23,16 @@ type SingleSignatureData struct {
 	Signature []byte
 }
 
+// MultiSignatureData represents the nested SignatureData of a multisig signature.
+type MultiSignatureData struct {
+	// BitArray is a compact way of indicating which signers from the multisig key
+	// have signed.
+	BitArray *apicrypto.CompactBitArray
+
+	// Signatures is the nested SignatureData's for each signer.
+	Signatures []SignatureData
+}
+
 type OffchainSignature struct {
 	// PubKey is the public key to use for verifying the signature
 	PubKey cryptotypes.PubKey
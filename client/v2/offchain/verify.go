@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -126,6 +127,35 @@ func verifySignature(
 			return errors.New("unable to verify single signer signature")
 		}
 		return nil
+	case *MultiSignatureData:
+		multiPK, ok := pubKey.(*multisig.LegacyAminoPubKey)
+		if !ok {
+			return fmt.Errorf("expected %T public key for a multisig signature, got %T", &multisig.LegacyAminoPubKey{}, pubKey)
+		}
+		if data.BitArray == nil {
+			return errors.New("multisig signature is missing its bit array")
+		}
+
+		pubKeys := multiPK.GetPubKeys()
+		bitArray := &cryptotypes.CompactBitArray{ExtraBitsStored: data.BitArray.ExtraBitsStored, Elems: data.BitArray.Elems}
+		if bitArray.Count() != len(pubKeys) {
+			return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+		}
+		if n := bitArray.NumTrueBitsBefore(len(pubKeys)); n != len(data.Signatures) || n < int(multiPK.Threshold) {
+			return fmt.Errorf("invalid number of signatures, have %d, expected at least %d", len(data.Signatures), multiPK.Threshold)
+		}
+
+		sigIndex := 0
+		for i, pk := range pubKeys {
+			if !bitArray.GetIndex(i) {
+				continue
+			}
+			if err := verifySignature(ctx, pk, signerData, data.Signatures[sigIndex], handler, txData); err != nil {
+				return err
+			}
+			sigIndex++
+		}
+		return nil
 	default:
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
@@ -0,0 +1,416 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"cosmossdk.io/client/v2/internal/account"
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultMaxResyncAttempts is the number of times a transaction is signed again after an
+// account sequence mismatch when BatchConfig.MaxResyncAttempts is not set.
+const defaultMaxResyncAttempts = 3
+
+// sequenceMismatchRegex matches the error returned by the auth ante handler when a
+// transaction is signed with a wrong sequence, both in CheckTx and in DeliverTx.
+var sequenceMismatchRegex = regexp.MustCompile(`account sequence mismatch[,:] expected (?:higher than or equal to )?(\d+)`)
+
+// Broadcaster broadcasts encoded transactions to a node, client.Context implements it.
+type Broadcaster interface {
+	BroadcastTx(txBytes []byte) (*sdk.TxResponse, error)
+}
+
+// SequenceManager keeps a local cursor over the account number and sequence of a signer.
+// It allows signing several transactions from the same account without waiting for them
+// to be committed, the account is only queried on the first use and after a Resync.
+// It is safe for concurrent use.
+type SequenceManager struct {
+	accountRetriever account.AccountRetriever
+	address          []byte
+
+	mu            sync.Mutex
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewSequenceManager returns a SequenceManager for the account with the given address.
+func NewSequenceManager(accRetriever account.AccountRetriever, address []byte) *SequenceManager {
+	return &SequenceManager{
+		accountRetriever: accRetriever,
+		address:          address,
+	}
+}
+
+// Next reserves the next sequence of the account and returns it along with the account number.
+func (m *SequenceManager) Next(ctx context.Context) (accNum, seq uint64, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(ctx); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	seq = m.sequence
+	m.sequence++
+	return m.accountNumber, seq, nil
+}
+
+// Peek returns the account number and the sequence the next call to Next would reserve.
+func (m *SequenceManager) Peek(ctx context.Context) (accNum, seq uint64, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(ctx); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return m.accountNumber, m.sequence, nil
+}
+
+// Set moves the cursor to the given sequence, e.g. the sequence expected by the chain.
+func (m *SequenceManager) Set(accNum, seq uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accountNumber = accNum
+	m.sequence = seq
+	m.synced = true
+}
+
+// Resync queries the account number and sequence of the account from the chain.
+func (m *SequenceManager) Resync(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sync(ctx)
+}
+
+// Invalidate marks the cursor as out of sync, the account is queried on the next call to Next.
+func (m *SequenceManager) Invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.synced = false
+}
+
+func (m *SequenceManager) sync(ctx context.Context) error {
+	if m.accountRetriever == nil {
+		return errors.New("account retriever must be set to sync the account sequence")
+	}
+
+	num, seq, err := m.accountRetriever.GetAccountNumberSequence(ctx, m.address)
+	if err != nil {
+		return err
+	}
+
+	m.accountNumber, m.sequence, m.synced = num, seq, true
+	return nil
+}
+
+// BatchConfig defines how a BatchBuilder groups messages into transactions.
+type BatchConfig struct {
+	// MaxGasPerTx is the maximum gas limit of a transaction. When set, messages are grouped
+	// into transactions whose simulated and adjusted gas does not exceed it.
+	MaxGasPerTx uint64
+	// MaxMsgsPerTx is the maximum number of messages of a transaction, zero means no limit.
+	MaxMsgsPerTx int
+	// MaxResyncAttempts is the number of times a transaction is signed again after an
+	// account sequence mismatch. It defaults to 3.
+	MaxResyncAttempts int
+}
+
+// Batch is a group of messages sent in a single transaction.
+type Batch struct {
+	Msgs []transaction.Msg
+	// Gas is the gas limit of the transaction.
+	Gas uint64
+}
+
+// BatchBuilder builds and broadcasts many transactions from the same signer.
+// It keeps a local sequence cursor so that signed transactions can be pipelined without
+// waiting for the previous ones to be committed, re-syncs the cursor when the chain
+// reports an account sequence mismatch and splits large lists of messages into
+// gas-bounded transactions.
+//
+// A BatchBuilder must not be used concurrently.
+type BatchBuilder struct {
+	factory   Factory
+	sequences *SequenceManager
+	cfg       BatchConfig
+}
+
+// NewBatchBuilder returns a BatchBuilder signing transactions with the parameters of txf.
+// The account number and sequence of txf seed the sequence cursor, as in prepareTxParams
+// a zero sequence is considered unset and is queried on first use.
+func NewBatchBuilder(txf Factory, cfg BatchConfig) *BatchBuilder {
+	sequences := NewSequenceManager(txf.accountRetriever, txf.txParams.address)
+	if txf.txParams.sequence != 0 {
+		sequences.Set(txf.txParams.accountNumber, txf.txParams.sequence)
+	}
+
+	if cfg.MaxResyncAttempts <= 0 {
+		cfg.MaxResyncAttempts = defaultMaxResyncAttempts
+	}
+
+	return &BatchBuilder{
+		factory:   txf,
+		sequences: sequences,
+		cfg:       cfg,
+	}
+}
+
+// Sequences returns the sequence cursor of the builder.
+func (b *BatchBuilder) Sequences() *SequenceManager {
+	return b.sequences
+}
+
+// SplitMsgs groups msgs, in order, into batches respecting the configured gas and message
+// limits. When a gas limit is configured or the factory simulates transactions, the gas of
+// each batch is simulated, otherwise the gas of the factory is used.
+func (b *BatchBuilder) SplitMsgs(ctx context.Context, msgs ...transaction.Msg) ([]Batch, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no messages to batch")
+	}
+
+	simulate := b.cfg.MaxGasPerTx > 0 || b.factory.simulateAndExecute()
+	if !simulate {
+		return b.splitByCount(msgs), nil
+	}
+
+	// simulations are run against the committed state, which expects the on-chain sequence
+	// and not the one of the local cursor.
+	txf := b.factory
+	if txf.accountRetriever != nil && len(txf.txParams.address) > 0 {
+		_, seq, err := txf.accountRetriever.GetAccountNumberSequence(ctx, txf.txParams.address)
+		if err != nil {
+			return nil, err
+		}
+		txf.WithSequence(seq)
+	}
+
+	var batches []Batch
+	for start := 0; start < len(msgs); {
+		maxSize := len(msgs) - start
+		if b.cfg.MaxMsgsPerTx > 0 {
+			maxSize = min(maxSize, b.cfg.MaxMsgsPerTx)
+		}
+
+		batch, err := b.nextBatch(txf, msgs, start, maxSize)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+		start += len(batch.Msgs)
+	}
+
+	return batches, nil
+}
+
+// nextBatch returns the largest batch of at most maxSize messages starting at msgs[start]
+// whose simulated gas does not exceed MaxGasPerTx. Assuming the gas grows with the number
+// of messages, the batch size is found by doubling it until the gas limit is exceeded and
+// then binary searching it, so that the number of simulations is logarithmic in the size
+// of the batch.
+func (b *BatchBuilder) nextBatch(txf Factory, msgs []transaction.Msg, start, maxSize int) (Batch, error) {
+	simulate := func(size int) (uint64, error) {
+		_, gas, err := txf.Simulate(msgs[start : start+size]...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to simulate messages %d to %d: %w", start, start+size-1, err)
+		}
+		return gas, nil
+	}
+
+	if b.cfg.MaxGasPerTx == 0 {
+		gas, err := simulate(maxSize)
+		if err != nil {
+			return Batch{}, err
+		}
+		return Batch{Msgs: msgs[start : start+maxSize], Gas: gas}, nil
+	}
+
+	gas, err := simulate(1)
+	if err != nil {
+		return Batch{}, err
+	}
+	if gas > b.cfg.MaxGasPerTx {
+		return Batch{}, fmt.Errorf("message %d requires %d gas, exceeding the maximum of %d gas per transaction", start, gas, b.cfg.MaxGasPerTx)
+	}
+
+	// fits is the largest known size within the gas limit, exceeds the smallest known size above it.
+	fits, fitsGas, exceeds := 1, gas, maxSize+1
+	for fits < maxSize {
+		size := min(2*fits, maxSize)
+		gas, err := simulate(size)
+		if err != nil {
+			return Batch{}, err
+		}
+		if gas > b.cfg.MaxGasPerTx {
+			exceeds = size
+			break
+		}
+		fits, fitsGas = size, gas
+	}
+
+	for exceeds-fits > 1 {
+		size := fits + (exceeds-fits)/2
+		gas, err := simulate(size)
+		if err != nil {
+			return Batch{}, err
+		}
+		if gas > b.cfg.MaxGasPerTx {
+			exceeds = size
+		} else {
+			fits, fitsGas = size, gas
+		}
+	}
+
+	return Batch{Msgs: msgs[start : start+fits], Gas: fitsGas}, nil
+}
+
+// splitByCount groups msgs into batches of at most MaxMsgsPerTx messages using the gas of the factory.
+func (b *BatchBuilder) splitByCount(msgs []transaction.Msg) []Batch {
+	size := len(msgs)
+	if b.cfg.MaxMsgsPerTx > 0 {
+		size = b.cfg.MaxMsgsPerTx
+	}
+
+	var batches []Batch
+	for start := 0; start < len(msgs); start += size {
+		end := min(start+size, len(msgs))
+		batches = append(batches, Batch{Msgs: msgs[start:end], Gas: b.factory.txParams.gas})
+	}
+	return batches
+}
+
+// SignBatches signs a transaction for each batch with consecutive sequences taken from the
+// local cursor. The transactions can be broadcast one after the other without waiting for
+// them to be included in a block.
+func (b *BatchBuilder) SignBatches(ctx context.Context, batches ...Batch) ([]Tx, error) {
+	txs := make([]Tx, len(batches))
+	for i, batch := range batches {
+		tx, _, err := b.signNext(ctx, batch)
+		if err != nil {
+			// the remaining sequences were reserved but will never be used.
+			b.sequences.Invalidate()
+			return nil, err
+		}
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
+// BroadcastMsgs splits msgs into batches, then signs and broadcasts a transaction for each
+// of them without waiting for the previous ones to be committed.
+// When the node reports an account sequence mismatch, the cursor is moved to the expected
+// sequence and the transaction is signed again. It returns the responses of the
+// transactions accepted so far along with the first error.
+func (b *BatchBuilder) BroadcastMsgs(ctx context.Context, broadcaster Broadcaster, msgs ...transaction.Msg) ([]*sdk.TxResponse, error) {
+	if err := validateMessages(msgs...); err != nil {
+		return nil, err
+	}
+
+	batches, err := b.SplitMsgs(ctx, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*sdk.TxResponse, 0, len(batches))
+	for i, batch := range batches {
+		res, err := b.broadcast(ctx, broadcaster, batch)
+		if err != nil {
+			return responses, fmt.Errorf("transaction %d of %d: %w", i+1, len(batches), err)
+		}
+		responses = append(responses, res)
+	}
+
+	return responses, nil
+}
+
+// broadcast signs and broadcasts a transaction for batch, signing it again after a sequence mismatch.
+func (b *BatchBuilder) broadcast(ctx context.Context, broadcaster Broadcaster, batch Batch) (*sdk.TxResponse, error) {
+	encoder := b.factory.txConfig.TxEncoder()
+	if encoder == nil {
+		return nil, errors.New("failed to encode transaction: tx encoder is nil")
+	}
+
+	for attempt := 0; ; attempt++ {
+		tx, accNum, err := b.signNext(ctx, batch)
+		if err != nil {
+			b.sequences.Invalidate()
+			return nil, err
+		}
+
+		txBytes, err := encoder(tx)
+		if err != nil {
+			b.sequences.Invalidate()
+			return nil, err
+		}
+
+		res, err := broadcaster.BroadcastTx(txBytes)
+		if err == nil && res.Code == 0 {
+			return res, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+
+		expected, ok := parseSequenceMismatch(err)
+		if !ok || attempt >= b.cfg.MaxResyncAttempts {
+			// the transaction may or may not have consumed its sequence.
+			b.sequences.Invalidate()
+			return res, err
+		}
+		b.sequences.Set(accNum, expected)
+	}
+}
+
+// signNext signs a transaction for batch with the next sequence of the cursor.
+func (b *BatchBuilder) signNext(ctx context.Context, batch Batch) (Tx, uint64, error) {
+	accNum, seq, err := b.sequences.Next(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txf := b.factory
+	txf.WithAccountNumber(accNum)
+	txf.WithSequence(seq)
+	txf.WithGas(batch.Gas)
+
+	tx, err := txf.BuildsSignedTx(ctx, batch.Msgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tx, accNum, nil
+}
+
+// parseSequenceMismatch returns the sequence expected by the chain if err reports an
+// account sequence mismatch.
+func parseSequenceMismatch(err error) (uint64, bool) {
+	if err == nil {
+		return 0, false
+	}
+
+	matches := sequenceMismatchRegex.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return seq, true
+}
@@ -0,0 +1,268 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/internal/account"
+	"cosmossdk.io/core/transaction"
+
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gasPerMsg is the gas used by every message simulated by msgGasClientConn.
+const gasPerMsg = 1000
+
+// msgGasClientConn simulates transactions using gasPerMsg gas per message.
+type msgGasClientConn struct {
+	mockClientConn
+	// simulations, if set, counts the simulated transactions.
+	simulations *int
+}
+
+func (m msgGasClientConn) Invoke(_ context.Context, _ string, args, reply interface{}, _ ...grpc.CallOption) error {
+	body, _, err := decodeTestTx(args.(*apitx.SimulateRequest).TxBytes)
+	if err != nil {
+		return err
+	}
+	if m.simulations != nil {
+		*m.simulations++
+	}
+	gasUsed := uint64(gasPerMsg * len(body.Messages))
+	reply.(*apitx.SimulateResponse).GasInfo = &abciv1beta1.GasInfo{GasWanted: gasUsed, GasUsed: gasUsed}
+	return nil
+}
+
+// sequenceAccountRetriever returns the on-chain sequence of the account.
+type sequenceAccountRetriever struct {
+	mockAccountRetriever
+	sequence *uint64
+}
+
+func (m sequenceAccountRetriever) GetAccountNumberSequence(_ context.Context, _ []byte) (accNum, accSeq uint64, err error) {
+	return 1, *m.sequence, nil
+}
+
+var _ account.AccountRetriever = sequenceAccountRetriever{}
+
+// mockBroadcaster accepts transactions signed with the expected sequence.
+type mockBroadcaster struct {
+	sequence  *uint64
+	sequences []uint64
+	msgs      []int
+	failAt    int
+}
+
+func (m *mockBroadcaster) BroadcastTx(txBytes []byte) (*sdk.TxResponse, error) {
+	body, authInfo, err := decodeTestTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if m.failAt > 0 && len(m.sequences) == m.failAt {
+		return nil, errors.New("connection refused")
+	}
+
+	seq := authInfo.SignerInfos[0].Sequence
+	if seq != *m.sequence {
+		return &sdk.TxResponse{
+			Code:   32,
+			RawLog: fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", *m.sequence, seq),
+		}, nil
+	}
+
+	*m.sequence++
+	m.sequences = append(m.sequences, seq)
+	m.msgs = append(m.msgs, len(body.Messages))
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("%d", seq)}, nil
+}
+
+func decodeTestTx(txBytes []byte) (*apitx.TxBody, *apitx.AuthInfo, error) {
+	var raw apitx.TxRaw
+	if err := proto.Unmarshal(txBytes, &raw); err != nil {
+		return nil, nil, err
+	}
+	var body apitx.TxBody
+	if err := proto.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, nil, err
+	}
+	var authInfo apitx.AuthInfo
+	if err := proto.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, nil, err
+	}
+	return &body, &authInfo, nil
+}
+
+func newTestBatchBuilder(t *testing.T, chainSequence *uint64, localSequence uint64, cfg BatchConfig) *BatchBuilder {
+	t.Helper()
+	f, err := NewFactory(setKeyring(), cdc, sequenceAccountRetriever{sequence: chainSequence}, txConf, ac, msgGasClientConn{}, TxParameters{
+		chainID: "demo",
+		AccountConfig: AccountConfig{
+			fromName:      "alice",
+			address:       addr,
+			accountNumber: 1,
+			sequence:      localSequence,
+		},
+		GasConfig: GasConfig{gas: 200000, gasAdjustment: 1},
+	})
+	require.NoError(t, err)
+	return NewBatchBuilder(f, cfg)
+}
+
+func testMsgs(n int) []transaction.Msg {
+	msgs := make([]transaction.Msg, n)
+	for i := range msgs {
+		msgs[i] = &countertypes.MsgIncreaseCounter{Signer: signer, Count: int64(i + 1)}
+	}
+	return msgs
+}
+
+func TestBatchBuilder_SplitMsgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     BatchConfig
+		msgs    int
+		want    []int
+		wantGas []uint64
+		wantErr string
+	}{
+		{
+			name:    "gas bounded",
+			cfg:     BatchConfig{MaxGasPerTx: 3500},
+			msgs:    10,
+			want:    []int{3, 3, 3, 1},
+			wantGas: []uint64{3000, 3000, 3000, 1000},
+		},
+		{
+			name:    "gas and message bounded",
+			cfg:     BatchConfig{MaxGasPerTx: 3500, MaxMsgsPerTx: 2},
+			msgs:    5,
+			want:    []int{2, 2, 1},
+			wantGas: []uint64{2000, 2000, 1000},
+		},
+		{
+			name:    "message bounded",
+			cfg:     BatchConfig{MaxMsgsPerTx: 4},
+			msgs:    5,
+			want:    []int{4, 1},
+			wantGas: []uint64{200000, 200000},
+		},
+		{
+			name:    "message exceeding the gas limit",
+			cfg:     BatchConfig{MaxGasPerTx: 500},
+			msgs:    2,
+			wantErr: "message 0 requires 1000 gas",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq := uint64(0)
+			b := newTestBatchBuilder(t, &seq, 0, tt.cfg)
+			batches, err := b.SplitMsgs(context.Background(), testMsgs(tt.msgs)...)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var (
+				sizes []int
+				gas   []uint64
+			)
+			for _, batch := range batches {
+				sizes = append(sizes, len(batch.Msgs))
+				gas = append(gas, batch.Gas)
+			}
+			require.Equal(t, tt.want, sizes)
+			require.Equal(t, tt.wantGas, gas)
+		})
+	}
+}
+
+func TestBatchBuilder_SplitMsgsSimulations(t *testing.T) {
+	seq := uint64(0)
+	b := newTestBatchBuilder(t, &seq, 0, BatchConfig{MaxGasPerTx: 500 * gasPerMsg})
+	simulations := 0
+	b.factory.conn = msgGasClientConn{simulations: &simulations}
+
+	batches, err := b.SplitMsgs(context.Background(), testMsgs(1000)...)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0].Msgs, 500)
+	require.Len(t, batches[1].Msgs, 500)
+
+	// the size of each batch is searched in a logarithmic number of simulations
+	require.LessOrEqual(t, simulations, 40)
+}
+
+func TestBatchBuilder_SignBatches(t *testing.T) {
+	chainSeq := uint64(4)
+	b := newTestBatchBuilder(t, &chainSeq, 0, BatchConfig{MaxGasPerTx: 2000})
+
+	batches, err := b.SplitMsgs(context.Background(), testMsgs(5)...)
+	require.NoError(t, err)
+	txs, err := b.SignBatches(context.Background(), batches...)
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+
+	// the cursor is synced from the chain and signed transactions are pipelined.
+	for i, tx := range txs {
+		sigs, err := tx.GetSignatures()
+		require.NoError(t, err)
+		require.Equal(t, chainSeq+uint64(i), sigs[0].Sequence)
+	}
+	_, next, err := b.Sequences().Peek(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), next)
+}
+
+func TestBatchBuilder_BroadcastMsgs(t *testing.T) {
+	chainSeq := uint64(5)
+	// the local cursor is behind the chain.
+	b := newTestBatchBuilder(t, &chainSeq, 2, BatchConfig{MaxGasPerTx: 2000})
+	broadcaster := &mockBroadcaster{sequence: &chainSeq}
+
+	responses, err := b.BroadcastMsgs(context.Background(), broadcaster, testMsgs(7)...)
+	require.NoError(t, err)
+	require.Len(t, responses, 4)
+	require.Equal(t, []uint64{5, 6, 7, 8}, broadcaster.sequences)
+	require.Equal(t, []int{2, 2, 2, 1}, broadcaster.msgs)
+
+	// a failed broadcast invalidates the cursor, which is synced again on the next use.
+	broadcaster.failAt = 5
+	responses, err = b.BroadcastMsgs(context.Background(), broadcaster, testMsgs(4)...)
+	require.ErrorContains(t, err, "transaction 2 of 2: connection refused")
+	require.Len(t, responses, 1)
+
+	chainSeq = 20
+	_, next, err := b.Sequences().Peek(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), next)
+}
+
+func TestParseSequenceMismatch(t *testing.T) {
+	tests := []struct {
+		err    error
+		want   uint64
+		wantOk bool
+	}{
+		{errors.New("account sequence mismatch, expected 10, got 9: incorrect account sequence"), 10, true},
+		{errors.New("account sequence mismatch: expected 11, got 12: incorrect account sequence"), 11, true},
+		{errors.New("account sequence mismatch, expected higher than or equal to 7, got 3"), 7, true},
+		{errors.New("insufficient funds"), 0, false},
+		{nil, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseSequenceMismatch(tt.err)
+		require.Equal(t, tt.wantOk, ok, tt.err)
+		require.Equal(t, tt.want, got)
+	}
+}
@@ -82,6 +82,8 @@ type ConfigOptions struct {
 	EnablesSignModes           []apitxsigning.SignMode
 	CustomSignModes            []signing.SignModeHandler
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	TextualMessageRenderers    map[protoreflect.FullName]textual.ValueRenderer
+	TextualFieldRenderers      map[protoreflect.FullName]textual.ValueRenderer
 }
 
 // validate checks the ConfigOptions for required fields and sets default values where necessary.
@@ -310,6 +312,8 @@ func newHandlerMap(opts ConfigOptions, signingCtx *signing.Context) (*signing.Ha
 				CoinMetadataQuerier: opts.TextualCoinMetadataQueryFn,
 				FileResolver:        signingCtx.FileResolver(),
 				TypeResolver:        signingCtx.TypeResolver(),
+				MessageRenderers:    opts.TextualMessageRenderers,
+				FieldRenderers:      opts.TextualFieldRenderers,
 			})
 			if err != nil {
 				return nil, err
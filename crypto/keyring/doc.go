@@ -39,4 +39,8 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend holds no key. Keys are listed from and messages are signed by a remote
+//		signer over gRPC authenticated with mutual TLS, see the RemoteSigner service. The
+//		connection is configured with the RemoteSigner option or with the
+//		keyring-remote/remote_signer.toml file of the keyring directory.
 package keyring
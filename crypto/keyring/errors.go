@@ -31,6 +31,9 @@ var (
 	ErrNotLedgerObj = errors.New("not a ledger object")
 	// ErrLedgerInvalidSignature is raised when ledger generates an invalid signature.
 	ErrLedgerInvalidSignature = errors.New("ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
+	// ErrRemoteInvalidSignature is raised when the remote signer returns a signature which is
+	// not made by the requested key.
+	ErrRemoteInvalidSignature = errors.New("remote signer returned an invalid signature")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
@@ -26,6 +26,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// RemoteSigner configures the remote signer used by the "remote" backend
+	RemoteSigner *RemoteSignerConfig
 }
 
 func New(
@@ -0,0 +1,432 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/99designs/keyring"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	keyringRemoteDirName           = "keyring-remote"
+	remoteSignerConfigFileName     = "remote_signer.toml"
+	defaultRemoteSignerTimeout     = 30 * time.Second
+	remoteSignerUnsupportedMessage = "not supported by the remote keyring backend, keys are managed by the remote signer"
+)
+
+// ErrRemoteUnsupported is raised when an operation requiring access to the private
+// keys or mutating the key set is performed on a remote keyring.
+var ErrRemoteUnsupported = errors.New(remoteSignerUnsupportedMessage)
+
+var _ Keyring = &remoteKeystore{}
+
+// RemoteSignerConfig defines how to connect to a remote signer.
+// Connections to the remote signer are always authenticated with mutual TLS.
+type RemoteSignerConfig struct {
+	// Address is the <host>:<port> of the remote signer gRPC server.
+	Address string `mapstructure:"address"`
+	// ServerName overrides the name used to verify the remote signer certificate.
+	// It defaults to the host of Address.
+	ServerName string `mapstructure:"server-name"`
+	// CACertFile is the PEM encoded certificate authority used to verify the remote signer.
+	CACertFile string `mapstructure:"ca-cert-file"`
+	// CertFile is the PEM encoded client certificate presented to the remote signer.
+	CertFile string `mapstructure:"cert-file"`
+	// KeyFile is the PEM encoded private key of the client certificate.
+	KeyFile string `mapstructure:"key-file"`
+	// Timeout bounds every request made to the remote signer, defaults to 30s.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// RemoteSigner sets the remote signer used by the "remote" backend.
+// When not set, the configuration is read from keyring-remote/remote_signer.toml
+// in the keyring directory.
+func RemoteSigner(cfg RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = &cfg
+	}
+}
+
+// LoadRemoteSignerConfig reads a remote signer configuration file.
+// Relative certificate paths are resolved against the directory of the file.
+func LoadRemoteSignerConfig(path string) (RemoteSignerConfig, error) {
+	var cfg RemoteSignerConfig
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return cfg, fmt.Errorf("couldn't read remote signer config: %w", err)
+	}
+	if err := v.Unmarshal(&cfg); err != nil {
+		return cfg, fmt.Errorf("couldn't parse remote signer config: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	for _, file := range []*string{&cfg.CACertFile, &cfg.CertFile, &cfg.KeyFile} {
+		if *file != "" && !filepath.IsAbs(*file) {
+			*file = filepath.Join(dir, *file)
+		}
+	}
+
+	return cfg, nil
+}
+
+// TLSConfig returns the mutual TLS client configuration used to connect to the remote signer.
+func (cfg RemoteSignerConfig) TLSConfig() (*tls.Config, error) {
+	if cfg.CACertFile == "" || cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("remote signer requires mutual TLS: ca-cert-file, cert-file and key-file must be set")
+	}
+
+	caCert, err := os.ReadFile(cfg.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read remote signer CA certificate: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificate found in %s", cfg.CACertFile)
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load remote signer client certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		ServerName:   cfg.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewRemote creates a keyring forwarding key lookups and signing requests to
+// the remote signer described by cfg.
+func NewRemote(cfg RemoteSignerConfig, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("remote signer address is empty")
+	}
+
+	tlsConfig, err := cfg.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to remote signer %s: %w", cfg.Address, err)
+	}
+
+	return newRemoteKeystore(NewRemoteSignerClient(conn), cdc, cfg.Timeout, opts...), nil
+}
+
+// NewRemoteWithClient creates a keyring using the given remote signer client.
+// It is useful to plug a remote signer using a custom transport or an in-process one.
+func NewRemoteWithClient(client RemoteSignerClient, cdc codec.Codec, opts ...Option) Keyring {
+	return newRemoteKeystore(client, cdc, 0, opts...)
+}
+
+// newRemoteKeyring creates the keyring of the "remote" backend.
+func newRemoteKeyring(rootDir string, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSigner != nil {
+		return NewRemote(*options.RemoteSigner, cdc, opts...)
+	}
+
+	cfg, err := LoadRemoteSignerConfig(filepath.Join(rootDir, keyringRemoteDirName, remoteSignerConfigFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemote(cfg, cdc, opts...)
+}
+
+// remoteKeystore is a keyring holding no key: keys are listed from and
+// messages are signed by a remote signer.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	timeout time.Duration
+	options Options
+}
+
+func newRemoteKeystore(client RemoteSignerClient, cdc codec.Codec, timeout time.Duration, opts ...Option) remoteKeystore {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if timeout == 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+
+	return remoteKeystore{
+		client:  client,
+		cdc:     cdc,
+		timeout: timeout,
+		options: options,
+	}
+}
+
+// Backend returns the keyring backend option used in the config
+func (rs remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// DB returns nil, the remote keyring has no local storage.
+func (rs remoteKeystore) DB() keyring.Keyring {
+	return nil
+}
+
+func (rs remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := rs.context()
+	defer cancel()
+
+	res, err := rs.client.ListKeys(ctx, &ListKeysRequest{})
+	if err != nil {
+		return nil, wrapRemoteSignerError(err)
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		record, err := rs.record(key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (rs remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return rs.options.SupportedAlgos, rs.options.SupportedAlgosLedger
+}
+
+func (rs remoteKeystore) Key(uid string) (*Record, error) {
+	return rs.key(&GetKeyRequest{Name: uid})
+}
+
+func (rs remoteKeystore) KeyByAddress(address []byte) (*Record, error) {
+	return rs.key(&GetKeyRequest{Address: address})
+}
+
+func (rs remoteKeystore) key(req *GetKeyRequest) (*Record, error) {
+	ctx, cancel := rs.context()
+	defer cancel()
+
+	res, err := rs.client.GetKey(ctx, req)
+	if err != nil {
+		return nil, wrapRemoteSignerError(err)
+	}
+
+	return rs.record(res.Key)
+}
+
+func (rs remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return rs.sign(&SignRequest{Name: uid, Msg: msg, SignMode: signMode})
+}
+
+func (rs remoteKeystore) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return rs.sign(&SignRequest{Address: address, Msg: msg, SignMode: signMode})
+}
+
+// sign requests a signature from the remote signer and checks that it is a valid
+// signature of msg made by the key the request refers to.
+func (rs remoteKeystore) sign(req *SignRequest) ([]byte, types.PubKey, error) {
+	record, err := rs.key(&GetKeyRequest{Name: req.Name, Address: req.Address})
+	if err != nil {
+		return nil, nil, err
+	}
+	expected, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := rs.context()
+	defer cancel()
+
+	res, err := rs.client.Sign(ctx, req)
+	if err != nil {
+		return nil, nil, wrapRemoteSignerError(err)
+	}
+
+	pubKey, err := rs.pubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pubKey.Equals(expected) {
+		return nil, nil, errorsmod.Wrapf(ErrRemoteInvalidSignature, "signed with %s instead of the public key of %s", pubKey.Address(), record.Name)
+	}
+	if !pubKey.VerifySignature(req.Msg, res.Signature) {
+		return nil, nil, ErrRemoteInvalidSignature
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+func (rs remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := rs.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.armorPubKey(k)
+}
+
+func (rs remoteKeystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := rs.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.armorPubKey(k)
+}
+
+func (rs remoteKeystore) armorPubKey(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := rs.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (rs remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (rs remoteKeystore) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (rs remoteKeystore) Delete(string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete key")
+}
+
+func (rs remoteKeystore) DeleteByAddress([]byte) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete key")
+}
+
+func (rs remoteKeystore) Rename(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename key")
+}
+
+func (rs remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (rs remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (rs remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save ledger key")
+}
+
+func (rs remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save offline key")
+}
+
+func (rs remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save multisig key")
+}
+
+func (rs remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rs remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rs remoteKeystore) ImportPubKey(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import public key")
+}
+
+// MigrateAll returns the keys of the remote signer, there is nothing to migrate.
+func (rs remoteKeystore) MigrateAll() ([]*Record, error) {
+	return rs.List()
+}
+
+func (rs remoteKeystore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rs.timeout)
+}
+
+func (rs remoteKeystore) record(key *RemoteKey) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned an empty key")
+	}
+
+	pubKey, err := rs.pubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteRecord(key.Name, pubKey)
+}
+
+func (rs remoteKeystore) pubKey(any *codectypes.Any) (types.PubKey, error) {
+	if any == nil {
+		return nil, errors.New("remote signer returned an empty public key")
+	}
+
+	var pubKey types.PubKey
+	if err := rs.cdc.UnpackAny(any, &pubKey); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid public key returned by the remote signer")
+	}
+
+	return pubKey, nil
+}
+
+// wrapRemoteSignerError maps the gRPC errors returned by the remote signer to keyring errors.
+func wrapRemoteSignerError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, st.Message())
+	case codes.FailedPrecondition:
+		return errorsmod.Wrap(ErrOfflineSign, st.Message())
+	default:
+		return fmt.Errorf("remote signer: %w", err)
+	}
+}
@@ -0,0 +1,113 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = &keyringRemoteSigner{}
+
+// NewKeyringRemoteSigner returns a RemoteSignerServer serving the keys of kr.
+// It is the reference implementation of the remote signer protocol and is
+// meant to be used in tests or to expose a local keyring to other processes.
+// When names is not empty, only the keys with the given names are served.
+func NewKeyringRemoteSigner(kr Keyring, names ...string) RemoteSignerServer {
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		allowed[name] = true
+	}
+
+	return &keyringRemoteSigner{kr: kr, allowed: allowed}
+}
+
+type keyringRemoteSigner struct {
+	UnimplementedRemoteSignerServer
+
+	kr      Keyring
+	allowed map[string]bool
+}
+
+func (s *keyringRemoteSigner) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keys := make([]*RemoteKey, 0, len(records))
+	for _, record := range records {
+		if !s.isAllowed(record) {
+			continue
+		}
+		keys = append(keys, &RemoteKey{Name: record.Name, PubKey: record.PubKey})
+	}
+
+	return &ListKeysResponse{Keys: keys}, nil
+}
+
+func (s *keyringRemoteSigner) GetKey(_ context.Context, req *GetKeyRequest) (*GetKeyResponse, error) {
+	record, err := s.key(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetKeyResponse{Key: &RemoteKey{Name: record.Name, PubKey: record.PubKey}}, nil
+}
+
+func (s *keyringRemoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	record, err := s.key(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pubKey, err := s.kr.Sign(record.Name, req.Msg, req.SignMode)
+	if err != nil {
+		if errors.Is(err, ErrOfflineSign) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: anyPubKey}, nil
+}
+
+func (s *keyringRemoteSigner) key(name string, address []byte) (*Record, error) {
+	if (name == "") == (len(address) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of name or address must be set")
+	}
+
+	var (
+		record *Record
+		err    error
+	)
+	if name != "" {
+		record, err = s.kr.Key(name)
+	} else {
+		record, err = s.kr.KeyByAddress(address)
+	}
+
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	case !s.isAllowed(record):
+		return nil, status.Error(codes.NotFound, "key not found")
+	}
+
+	return record, nil
+}
+
+func (s *keyringRemoteSigner) isAllowed(record *Record) bool {
+	return len(s.allowed) == 0 || s.allowed[record.Name]
+}
@@ -0,0 +1,334 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testPKI holds a certificate authority and certificates signed by it, written as PEM files.
+type testPKI struct {
+	dir    string
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caPool *x509.CertPool
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pki := &testPKI{dir: t.TempDir(), caCert: cert, caKey: key, caPool: x509.NewCertPool()}
+	pki.caPool.AddCert(cert)
+	pki.write(t, "ca.pem", "CERTIFICATE", der)
+	return pki
+}
+
+// issue creates a certificate for name and returns it along with the paths of its PEM files.
+func (p *testPKI) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (tls.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.caCert, &key.PublicKey, p.caKey)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := p.write(t, name+".pem", "CERTIFICATE", der)
+	keyFile := p.write(t, name+"-key.pem", "EC PRIVATE KEY", keyDer)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	return cert, certFile, keyFile
+}
+
+func (p *testPKI) write(t *testing.T, name, typ string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(p.dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return path
+}
+
+// startRemoteSigner serves the keys of kr over mutual TLS and returns the client configuration.
+func startRemoteSigner(t *testing.T, kr Keyring, names ...string) RemoteSignerConfig {
+	t.Helper()
+	pki := newTestPKI(t)
+	serverCert, _, _ := pki.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	_, certFile, keyFile := pki.issue(t, "client", x509.ExtKeyUsageClientAuth)
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    pki.caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})))
+	RegisterRemoteSignerServer(server, NewKeyringRemoteSigner(kr, names...))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return RemoteSignerConfig{
+		Address:    lis.Addr().String(),
+		ServerName: "localhost",
+		CACertFile: filepath.Join(pki.dir, "ca.pem"),
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+	}
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	signer := NewInMemory(cdc)
+	alice, _, err := signer.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = signer.NewMnemonic("bob", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signer.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	cfg := startRemoteSigner(t, signer, "alice", "offline")
+	kr, err := NewRemote(cfg, cdc)
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		require.Equal(t, TypeRemote, record.GetType())
+	}
+
+	record, err := kr.Key("alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", record.Name)
+	aliceAddr, err := alice.GetAddress()
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, aliceAddr, addr)
+
+	record, err = kr.KeyByAddress(aliceAddr)
+	require.NoError(t, err)
+	require.Equal(t, "alice", record.Name)
+
+	// bob is not exposed by the remote signer.
+	_, err = kr.Key("bob")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("message to sign")
+	sig, pubKey, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	sig, pubKey, err = kr.SignByAddress(aliceAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+
+	armor, err := kr.ExportPubKeyArmor("alice")
+	require.NoError(t, err)
+	expected, err := signer.ExportPubKeyArmor("alice")
+	require.NoError(t, err)
+	require.Equal(t, expected, armor)
+
+	// keys are managed by the remote signer.
+	_, err = kr.ExportPrivKeyArmor("alice", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("alice"), ErrRemoteUnsupported)
+	_, _, err = kr.NewMnemonic("carol", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+}
+
+func TestRemoteKeyringBackend(t *testing.T) {
+	cdc := getCodec()
+	signer := NewInMemory(cdc)
+	_, _, err := signer.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	cfg := startRemoteSigner(t, signer)
+
+	// the configuration is read from the keyring directory.
+	dir := t.TempDir()
+	_, err = New("remote-test", BackendRemote, dir, nil, cdc)
+	require.ErrorContains(t, err, "couldn't read remote signer config")
+
+	configDir := filepath.Join(dir, keyringRemoteDirName)
+	require.NoError(t, os.MkdirAll(configDir, 0o700))
+	// relative certificate paths are resolved against the configuration directory.
+	for _, file := range []string{cfg.CACertFile, cfg.CertFile, cfg.KeyFile} {
+		bz, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, filepath.Base(file)), bz, 0o600))
+	}
+	config := fmt.Sprintf(`address = %q
+server-name = "localhost"
+ca-cert-file = %q
+cert-file = %q
+key-file = %q
+timeout = "5s"
+`, cfg.Address, filepath.Base(cfg.CACertFile), filepath.Base(cfg.CertFile), filepath.Base(cfg.KeyFile))
+	configFile := filepath.Join(configDir, remoteSignerConfigFileName)
+	require.NoError(t, os.WriteFile(configFile, []byte(config), 0o600))
+
+	loaded, err := LoadRemoteSignerConfig(configFile)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, loaded.Timeout)
+	require.Equal(t, filepath.Join(configDir, filepath.Base(cfg.CertFile)), loaded.CertFile)
+
+	kr, err := New("remote-test", BackendRemote, dir, nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("alice", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the option takes precedence over the configuration file.
+	kr, err = New("remote-test", BackendRemote, t.TempDir(), nil, cdc, RemoteSigner(cfg))
+	require.NoError(t, err)
+	_, err = kr.Key("alice")
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringRequiresMutualTLS(t *testing.T) {
+	cdc := getCodec()
+	cfg := startRemoteSigner(t, NewInMemory(cdc))
+
+	noClientCert := cfg
+	noClientCert.CertFile, noClientCert.KeyFile = "", ""
+	_, err := NewRemote(noClientCert, cdc)
+	require.ErrorContains(t, err, "requires mutual TLS")
+
+	// a client certificate issued by another authority is rejected.
+	otherPKI := newTestPKI(t)
+	_, certFile, keyFile := otherPKI.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	untrusted := cfg
+	untrusted.CertFile, untrusted.KeyFile = certFile, keyFile
+	kr, err := NewRemote(untrusted, cdc)
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.ErrorContains(t, err, "remote signer")
+}
+
+// inProcessRemoteSigner calls a remote signer server in process, letting tests tamper
+// with its signatures.
+type inProcessRemoteSigner struct {
+	server RemoteSignerServer
+	tamper func(res *SignResponse)
+}
+
+func (c inProcessRemoteSigner) ListKeys(ctx context.Context, in *ListKeysRequest, _ ...grpc.CallOption) (*ListKeysResponse, error) {
+	return c.server.ListKeys(ctx, in)
+}
+
+func (c inProcessRemoteSigner) GetKey(ctx context.Context, in *GetKeyRequest, _ ...grpc.CallOption) (*GetKeyResponse, error) {
+	return c.server.GetKey(ctx, in)
+}
+
+func (c inProcessRemoteSigner) Sign(ctx context.Context, in *SignRequest, _ ...grpc.CallOption) (*SignResponse, error) {
+	res, err := c.server.Sign(ctx, in)
+	if err == nil && c.tamper != nil {
+		c.tamper(res)
+	}
+	return res, err
+}
+
+func TestRemoteKeyringVerifiesSignatures(t *testing.T) {
+	cdc := getCodec()
+	signer := NewInMemory(cdc)
+	_, _, err := signer.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	server := NewKeyringRemoteSigner(signer)
+	msg := []byte("message to sign")
+
+	otherKey := secp256k1.GenPrivKey()
+	otherPubKey, err := codectypes.NewAnyWithValue(otherKey.PubKey())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		tamper func(res *SignResponse)
+		expErr string
+	}{
+		{
+			name: "valid signature",
+		},
+		{
+			name: "signed by another key",
+			tamper: func(res *SignResponse) {
+				sig, err := otherKey.Sign(msg)
+				require.NoError(t, err)
+				res.Signature, res.PubKey = sig, otherPubKey
+			},
+			expErr: "instead of the public key of alice",
+		},
+		{
+			name: "invalid signature",
+			tamper: func(res *SignResponse) {
+				res.Signature[0] ^= 0xff
+			},
+			expErr: ErrRemoteInvalidSignature.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := NewRemoteWithClient(inProcessRemoteSigner{server: server, tamper: tc.tamper}, cdc)
+			sig, pubKey, err := kr.Sign("alice", msg, signing.SignMode_SIGN_MODE_DIRECT)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, ErrRemoteInvalidSignature)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, pubKey.VerifySignature(msg, sig))
+		})
+	}
+}
@@ -6,6 +6,12 @@ CREATE OR REPLACE FUNCTION nanos_to_timestamptz(nanos bigint) RETURNS timestampt
     SELECT to_timestamp(nanos / 1000000000) + (nanos / 1000000000) * INTERVAL '1 microsecond'
 $$ LANGUAGE SQL IMMUTABLE;
 
+CREATE TABLE IF NOT EXISTS module_schema
+(
+    module_name TEXT  NOT NULL PRIMARY KEY,
+    schema      JSONB NOT NULL
+);
+
 CREATE TABLE IF NOT EXISTS block
 (
     number BIGINT NOT NULL PRIMARY KEY,
@@ -19,7 +19,19 @@ func (i *indexerImpl) listener() appdata.Listener {
 			mm := newModuleIndexer(moduleName, modSchema, i.opts)
 			i.modules[moduleName] = mm
 
-			return mm.initializeSchema(i.ctx, i.tx)
+			err := mm.initializeSchema(i.ctx, i.tx)
+			if err != nil {
+				return err
+			}
+
+			// commit the schema changes as enum values added by migrations can only be used once committed
+			err = i.tx.Commit()
+			if err != nil {
+				return err
+			}
+
+			i.tx, err = i.db.BeginTx(i.ctx, nil)
+			return err
 		},
 		StartBlock: func(data appdata.StartBlockData) error {
 			_, err := i.tx.Exec("INSERT INTO block (number) VALUES ($1)", data.Height)
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
+)
+
+// loadAppliedSchema loads the module schema which was last applied to the database.
+func (m *moduleIndexer) loadAppliedSchema(ctx context.Context, conn dbConn) (modSchema schema.ModuleSchema, found bool, err error) {
+	var bz []byte
+	row := conn.QueryRowContext(ctx, "SELECT schema FROM module_schema WHERE module_name = $1", m.moduleName)
+	if err := row.Scan(&bz); err != nil {
+		if err == sql.ErrNoRows {
+			return schema.ModuleSchema{}, false, nil
+		}
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to load the applied schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	err = json.Unmarshal(bz, &modSchema)
+	if err != nil {
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to decode the applied schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	return modSchema, true, nil
+}
+
+// checkNoExistingTables returns an error if a table of the module already exists. It is called when no
+// schema was applied for the module, for instance because its tables were created before the applied schema
+// was persisted, in which case the tables can't be diffed with the current schema.
+func (m *moduleIndexer) checkNoExistingTables(ctx context.Context, conn dbConn) error {
+	var err error
+	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		tableName := newObjectIndexer(m.moduleName, typ, m.options).tableName()
+		row := conn.QueryRowContext(ctx,
+			"SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1", tableName)
+		var res interface{}
+		if err = row.Scan(&res); err != nil {
+			if err == sql.ErrNoRows {
+				err = nil
+				return true
+			}
+			err = fmt.Errorf("failed to check if table %q exists: %v", tableName, err) //nolint:errorlint // using %v for go 1.12 compat
+			return false
+		}
+
+		err = fmt.Errorf("table %q of module %s already exists but no schema was applied for the module, its indexer tables must be rebuilt",
+			tableName, m.moduleName)
+		return false
+	})
+	return err
+}
+
+// saveAppliedSchema persists the module schema so that it can be diffed on the next start-up.
+func (m *moduleIndexer) saveAppliedSchema(ctx context.Context, conn dbConn) error {
+	bz, err := json.Marshal(m.schema)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx,
+		"INSERT INTO module_schema (module_name, schema) VALUES ($1, $2) ON CONFLICT (module_name) DO UPDATE SET schema = EXCLUDED.schema",
+		m.moduleName, string(bz),
+	)
+	return err
+}
+
+// migrateSchema applies the compatible changes between the old and the current module schema
+// to the existing tables and enum types. New tables and enum types are created by initializeSchema.
+// It returns an error if the schemas have incompatible changes.
+func (m *moduleIndexer) migrateSchema(ctx context.Context, conn dbConn, oldSchema schema.ModuleSchema) error {
+	schemaDiff := diff.CompareModuleSchemas(oldSchema, m.schema)
+	if !schemaDiff.HasCompatibleChanges() {
+		return fmt.Errorf("module %s has incompatible schema changes (%s), its indexer tables must be rebuilt",
+			m.moduleName, strings.Join(incompatibleChanges(schemaDiff), "; "))
+	}
+
+	for _, enumDiff := range schemaDiff.ChangedEnumTypes {
+		for _, value := range enumDiff.AddedValues {
+			buf := new(strings.Builder)
+			err := addEnumValueSql(buf, m.moduleName, enumDiff.Name, value)
+			if err != nil {
+				return err
+			}
+
+			err = m.execMigration(ctx, conn, buf.String())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		oldTyp, found := oldSchema.LookupStateObjectType(typ.Name)
+		if !found {
+			return true
+		}
+
+		tm := newObjectIndexer(m.moduleName, typ, m.options)
+		buf := new(strings.Builder)
+		err = tm.alterTableSql(buf, oldTyp)
+		if err == nil && buf.Len() != 0 {
+			err = m.execMigration(ctx, conn, buf.String())
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to migrate table for %s in module %s: %v", typ.Name, m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		return err == nil
+	})
+
+	return err
+}
+
+func (m *moduleIndexer) execMigration(ctx context.Context, conn dbConn, sqlStr string) error {
+	if m.options.logger != nil {
+		m.options.logger.Info("Migrating schema", "module", m.moduleName, "sql", sqlStr)
+	}
+	_, err := conn.ExecContext(ctx, sqlStr)
+	return err
+}
+
+// alterTableSql generates the ALTER TABLE statements adding the columns of the value fields
+// added since the old object type, and the _deleted column if retain deletions got enabled.
+func (tm *objectIndexer) alterTableSql(writer io.Writer, oldTyp schema.StateObjectType) error {
+	oldFields := map[string]bool{}
+	for _, field := range oldTyp.ValueFields {
+		oldFields[field.Name] = true
+	}
+
+	for _, field := range tm.typ.ValueFields {
+		if oldFields[field.Name] {
+			continue
+		}
+
+		err := tm.addColumnSql(writer, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions && !oldTyp.RetainDeletions {
+		_, err := fmt.Fprintf(writer, "ALTER TABLE %q ADD COLUMN IF NOT EXISTS _deleted BOOLEAN NOT NULL DEFAULT FALSE;\n", tm.tableName())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addColumnSql generates the ALTER TABLE statements adding the column(s) of the field.
+func (tm *objectIndexer) addColumnSql(writer io.Writer, field schema.Field) error {
+	nullability := "NOT NULL"
+	if field.Nullable {
+		nullability = "NULL"
+	}
+
+	switch field.Kind {
+	case schema.EnumKind:
+		_, err := fmt.Fprintf(writer, "ALTER TABLE %q ADD COLUMN IF NOT EXISTS %q %q %s;\n",
+			tm.tableName(), field.Name, enumTypeName(tm.moduleName, field.ReferencedType), nullability)
+		return err
+	case schema.TimeKind:
+		// the nanos column must exist before the generated column referencing it, see createColumnDefinition
+		nanosColName := fmt.Sprintf("%s_nanos", field.Name)
+		_, err := fmt.Fprintf(writer, "ALTER TABLE %q ADD COLUMN IF NOT EXISTS %q BIGINT %s;\n",
+			tm.tableName(), nanosColName, nullability)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(writer, "ALTER TABLE %q ADD COLUMN IF NOT EXISTS %q TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz(%q)) STORED;\n",
+			tm.tableName(), field.Name, nanosColName)
+		return err
+	default:
+		simple := simpleColumnType(field.Kind)
+		if simple == "" {
+			return fmt.Errorf("unexpected kind: %v, this should have been handled earlier", field.Kind)
+		}
+
+		_, err := fmt.Fprintf(writer, "ALTER TABLE %q ADD COLUMN IF NOT EXISTS %q %s %s;\n",
+			tm.tableName(), field.Name, simple, nullability)
+		return err
+	}
+}
+
+// addEnumValueSql generates an ALTER TYPE statement adding a value to an enum type.
+func addEnumValueSql(writer io.Writer, moduleName, enumName string, value schema.EnumValueDefinition) error {
+	_, err := fmt.Fprintf(writer, "ALTER TYPE %q ADD VALUE IF NOT EXISTS '%s';", enumTypeName(moduleName, enumName), value.Name)
+	return err
+}
+
+// incompatibleChanges describes the changes of the diff which can't be applied automatically.
+func incompatibleChanges(schemaDiff diff.ModuleSchemaDiff) []string {
+	var changes []string
+	for _, typ := range schemaDiff.RemovedStateObjectTypes {
+		changes = append(changes, fmt.Sprintf("object type %s removed", typ.Name))
+	}
+
+	for _, typ := range schemaDiff.RemovedEnumTypes {
+		changes = append(changes, fmt.Sprintf("enum type %s removed", typ.Name))
+	}
+
+	for _, typDiff := range schemaDiff.ChangedStateObjectTypes {
+		if !typDiff.HasCompatibleChanges() {
+			changes = append(changes, fmt.Sprintf("object type %s changed: only nullable value fields can be added", typDiff.Name))
+		}
+	}
+
+	for _, enumDiff := range schemaDiff.ChangedEnumTypes {
+		if !enumDiff.HasCompatibleChanges() {
+			changes = append(changes, fmt.Sprintf("enum type %s changed: only values can be added", enumDiff.Name))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_alterTableSql() {
+	newSingleton := testdata.SingletonObject
+	newSingleton.ValueFields = append(append([]schema.Field{}, newSingleton.ValueFields...),
+		schema.Field{Name: "baz", Kind: schema.StringKind, Nullable: true},
+		schema.Field{Name: "at", Kind: schema.TimeKind, Nullable: true},
+		schema.Field{Name: "kind", Kind: schema.EnumKind, ReferencedType: testdata.MyEnum.Name, Nullable: true},
+	)
+	newSingleton.RetainDeletions = true
+
+	tm := newObjectIndexer("test", newSingleton, options{logger: logutil.NoopLogger{}})
+	err := tm.alterTableSql(os.Stdout, testdata.SingletonObject)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "baz" TEXT NULL;
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "at_nanos" BIGINT NULL;
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "at" TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz("at_nanos")) STORED;
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "kind" "test_my_enum" NULL;
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS _deleted BOOLEAN NOT NULL DEFAULT FALSE;
+}
+
+func Example_addEnumValueSql() {
+	err := addEnumValueSql(os.Stdout, "test", testdata.MyEnum.Name, schema.EnumValueDefinition{Name: "d", Value: 4})
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// ALTER TYPE "test_my_enum" ADD VALUE IF NOT EXISTS 'd';
+}
+
+func Example_incompatibleChanges() {
+	newVote := testdata.VoteObject
+	newVote.ValueFields = append(append([]schema.Field{}, newVote.ValueFields...),
+		schema.Field{Name: "weight", Kind: schema.StringKind},
+	)
+
+	newSchema := schema.MustCompileModuleSchema(testdata.AllKindsObject, newVote, testdata.MyEnum, testdata.VoteType)
+	schemaDiff := diff.CompareModuleSchemas(testdata.ExampleSchema, newSchema)
+	fmt.Println(schemaDiff.HasCompatibleChanges())
+	fmt.Println(strings.Join(incompatibleChanges(schemaDiff), "\n"))
+	// Output:
+	// false
+	// object type singleton removed
+	// object type vote changed: only nullable value fields can be added
+}
@@ -28,9 +28,23 @@ func newModuleIndexer(moduleName string, modSchema schema.ModuleSchema, options
 }
 
 // initializeSchema creates tables for all object types in the module schema and creates enum types.
+// If a schema was previously applied for the module, the compatible changes since that schema are
+// migrated and an error is returned for incompatible changes. If no schema was applied but tables of
+// the module already exist, an error is returned as they can't be migrated. The applied schema is then persisted.
 func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error {
+	oldSchema, found, err := m.loadAppliedSchema(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		err = m.checkNoExistingTables(ctx, conn)
+		if err != nil {
+			return err
+		}
+	}
+
 	// create enum types
-	var err error
 	m.schema.EnumTypes(func(enumType schema.EnumType) bool {
 		err = m.createEnumType(ctx, conn, enumType)
 		return err == nil
@@ -39,6 +53,13 @@ func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error
 		return err
 	}
 
+	if found {
+		err = m.migrateSchema(ctx, conn, oldSchema)
+		if err != nil {
+			return err
+		}
+	}
+
 	// create tables for all object types
 	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
 		tm := newObjectIndexer(m.moduleName, typ, m.options)
@@ -49,6 +70,9 @@ func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error
 		}
 		return err == nil
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	return m.saveAppliedSchema(ctx, conn)
 }
@@ -96,6 +96,51 @@ func (tm *objectIndexer) selectAllSql(w io.Writer) error {
 	return err
 }
 
+// selectAllAfterSqlAndParams generates a SELECT statement of the objects sorted by key, starting after the
+// provided key if it isn't nil, and returns the parameters.
+func (tm *objectIndexer) selectAllAfterSqlAndParams(w io.Writer, after interface{}) ([]interface{}, error) {
+	err := tm.selectAllClause(w)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols := make([]string, 0, len(tm.typ.KeyFields))
+	for _, field := range tm.typ.KeyFields {
+		colName, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+		keyCols = append(keyCols, colName)
+	}
+
+	var params []interface{}
+	if after != nil && len(keyCols) > 0 {
+		params, _, err = tm.bindKeyParams(after)
+		if err != nil {
+			return nil, err
+		}
+
+		placeholders := make([]string, len(params))
+		for i := range params {
+			placeholders[i] = fmt.Sprintf("$%d", i+1)
+		}
+		_, err = fmt.Fprintf(w, " WHERE (%s) > (%s)", strings.Join(keyCols, ", "), strings.Join(placeholders, ", "))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(keyCols) > 0 {
+		_, err = fmt.Fprintf(w, " ORDER BY %s", strings.Join(keyCols, ", "))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
 func (tm *objectIndexer) getSqlAndParams(w io.Writer, key interface{}) ([]interface{}, error) {
 	err := tm.selectAllClause(w)
 	if err != nil {
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+func TestMigrateSchema(t *testing.T) {
+	connectionUrl := createTestDB(t)
+
+	initModule := func(modSchema schema.ModuleSchema) error {
+		res, err := indexer.StartIndexing(indexer.IndexingOptions{
+			Config: indexer.IndexingConfig{
+				Target: map[string]indexer.Config{
+					"postgres": {
+						Type:   "postgres",
+						Config: postgres.Config{DatabaseURL: connectionUrl},
+					},
+				},
+			},
+			Context: context.Background(),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, res.Listener.InitializeModuleData(appdata.ModuleInitializationData{
+			ModuleName: "test",
+			Schema:     modSchema,
+		}))
+
+		// the listener is asynchronous, errors are only returned once the commit completes
+		commit, err := res.Listener.Commit(appdata.CommitData{})
+		require.NoError(t, err)
+		return commit()
+	}
+
+	require.NoError(t, initModule(testdata.ExampleSchema))
+
+	// restarting with the same schema is a no-op
+	require.NoError(t, initModule(testdata.ExampleSchema))
+
+	// compatible changes: a new nullable field, enum value and object type
+	newEnum := testdata.MyEnum
+	newEnum.Values = append(append([]schema.EnumValueDefinition{}, newEnum.Values...), schema.EnumValueDefinition{Name: "d", Value: 4})
+	newSingleton := testdata.SingletonObject
+	newSingleton.ValueFields = append(append([]schema.Field{}, newSingleton.ValueFields...),
+		schema.Field{Name: "baz", Kind: schema.EnumKind, ReferencedType: newEnum.Name, Nullable: true},
+	)
+	newObject := schema.StateObjectType{
+		Name:        "params",
+		ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+	}
+	v2 := schema.MustCompileModuleSchema(testdata.AllKindsObject, newSingleton, testdata.VoteObject, newObject, newEnum, testdata.VoteType)
+	require.NoError(t, initModule(v2))
+
+	// incompatible changes fail
+	err := initModule(schema.MustCompileModuleSchema(testdata.AllKindsObject, testdata.VoteObject, newEnum, testdata.VoteType))
+	require.ErrorContains(t, err, "object type params removed")
+	require.ErrorContains(t, err, "incompatible schema changes")
+
+	// existing tables without an applied schema can't be migrated
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+	_, err = db.Exec("DELETE FROM module_schema WHERE module_name = 'test'")
+	require.NoError(t, err)
+	err = initModule(v2)
+	require.ErrorContains(t, err, "no schema was applied for the module")
+}
@@ -142,6 +142,47 @@ func (tm *objectView) AllState(f func(schema.StateObjectUpdate, error) bool) {
 	}
 }
 
+// AllStateAfter iterates over the objects sorted by key, starting after the given key or from the first
+// object if it is nil.
+func (tm *objectView) AllStateAfter(after interface{}, f func(schema.StateObjectUpdate, error) bool) {
+	buf := new(strings.Builder)
+	params, err := tm.selectAllAfterSqlAndParams(buf, after)
+	if err != nil {
+		f(schema.StateObjectUpdate{}, err)
+		return
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Select", "sql", sqlStr, "params", params)
+	}
+
+	rows, err := tm.conn.QueryContext(tm.ctx, sqlStr, params...)
+	if err != nil {
+		f(schema.StateObjectUpdate{}, err)
+		return
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(rows)
+
+	for rows.Next() {
+		update, found, err := tm.readRow(rows)
+		if err == nil && !found {
+			err = sql.ErrNoRows
+		}
+		if !f(update, err) {
+			return
+		}
+	}
+	if err := rows.Err(); err != nil {
+		f(schema.StateObjectUpdate{}, err)
+	}
+}
+
 func (tm *objectView) Len() (int, error) {
 	n, err := tm.count(tm.ctx, tm.conn)
 	if err != nil {
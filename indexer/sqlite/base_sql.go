@@ -0,0 +1,35 @@
+package sqlite
+
+// baseSQL is the base SQL that is always included in the schema.
+const baseSQL = `
+CREATE TABLE IF NOT EXISTS module_schema
+(
+    module_name TEXT NOT NULL PRIMARY KEY,
+    schema      TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS block
+(
+    number INTEGER NOT NULL PRIMARY KEY,
+    header TEXT    NULL
+);
+
+CREATE TABLE IF NOT EXISTS tx
+(
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number   INTEGER NOT NULL REFERENCES block (number),
+    index_in_block INTEGER NOT NULL,
+    data           TEXT    NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS event
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number INTEGER NOT NULL REFERENCES block (number),
+    tx_id        INTEGER NULL REFERENCES tx (id),
+    msg_index    INTEGER NULL,
+    event_index  INTEGER NULL,
+    type         TEXT    NOT NULL,
+    data         TEXT    NOT NULL
+);
+`
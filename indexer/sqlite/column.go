@@ -0,0 +1,143 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// createColumnDefinition writes a column definition within a CREATE TABLE statement for the field.
+func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.Field) error {
+	_, err := fmt.Fprintf(writer, "%q ", field.Name)
+	if err != nil {
+		return err
+	}
+
+	simple := simpleColumnType(field.Kind)
+	if simple != "" {
+		_, err = fmt.Fprintf(writer, "%s", simple)
+		if err != nil {
+			return err
+		}
+
+		return writeNullability(writer, field.Nullable)
+	} else {
+		switch field.Kind {
+		case schema.EnumKind:
+			// SQLite has no enum types so enum values are stored as TEXT restricted by a CHECK constraint
+			enumType, ok := tm.modSchema.LookupEnumType(field.ReferencedType)
+			if !ok {
+				return fmt.Errorf("enum type %q not found in module %s", field.ReferencedType, tm.moduleName)
+			}
+
+			_, err = fmt.Fprintf(writer, "TEXT CHECK (%q IN (%s))", field.Name, enumValuesList(enumType))
+			if err != nil {
+				return err
+			}
+		case schema.TimeKind:
+			// for time fields, we generate two columns:
+			// - one with nanoseconds precision for lossless storage, suffixed with _nanos
+			// - one as an ISO 8601 string (millisecond precision) for ease of use, that is GENERATED
+			nanosColName := fmt.Sprintf("%s_nanos", field.Name)
+			_, err = fmt.Fprintf(writer, "TEXT GENERATED ALWAYS AS (%s) VIRTUAL,\n\t", nanosToTimestamp(nanosColName))
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(writer, `%q INTEGER`, nanosColName)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected kind: %v, this should have been handled earlier", field.Kind)
+		}
+
+		return writeNullability(writer, field.Nullable)
+	}
+}
+
+// writeNullability writes column nullability.
+func writeNullability(writer io.Writer, nullable bool) error {
+	if nullable {
+		_, err := fmt.Fprintf(writer, " NULL,\n\t")
+		return err
+	} else {
+		_, err := fmt.Fprintf(writer, " NOT NULL,\n\t")
+		return err
+	}
+}
+
+// simpleColumnType returns the SQLite column type for the kind for simple types.
+// Uint64, integer and decimal values are stored as TEXT because they don't fit into
+// SQLite's 64-bit signed INTEGER and REAL types without loss of precision.
+func simpleColumnType(kind schema.Kind) string {
+	//nolint:goconst // adding constants for these sqlite type names would impede readability
+	switch kind {
+	case schema.StringKind:
+		return "TEXT"
+	case schema.BoolKind:
+		return "BOOLEAN"
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind:
+		return "INTEGER"
+	case schema.Int16Kind:
+		return "INTEGER"
+	case schema.Int32Kind:
+		return "INTEGER"
+	case schema.Int64Kind:
+		return "INTEGER"
+	case schema.Uint8Kind:
+		return "INTEGER"
+	case schema.Uint16Kind:
+		return "INTEGER"
+	case schema.Uint32Kind:
+		return "INTEGER"
+	case schema.Uint64Kind:
+		return "TEXT"
+	case schema.IntegerKind:
+		return "TEXT"
+	case schema.DecimalKind:
+		return "TEXT"
+	case schema.Float32Kind:
+		return "REAL"
+	case schema.Float64Kind:
+		return "REAL"
+	case schema.JSONKind:
+		return "TEXT"
+	case schema.DurationKind:
+		return "INTEGER"
+	case schema.AddressKind:
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// nanosToTimestamp returns the SQL expression converting the nanoseconds column to an ISO 8601 string.
+func nanosToTimestamp(nanosColName string) string {
+	return fmt.Sprintf("strftime('%%Y-%%m-%%dT%%H:%%M:%%fZ', %q / 1000000000.0, 'unixepoch')", nanosColName)
+}
+
+// enumValuesList returns the comma separated list of the quoted enum value names.
+func enumValuesList(enumType schema.EnumType) string {
+	values := make([]string, 0, len(enumType.Values))
+	for _, value := range enumType.Values {
+		values = append(values, fmt.Sprintf("'%s'", value.Name))
+	}
+	return strings.Join(values, ", ")
+}
+
+// updatableColumnName is the name of the insertable/updatable column name for the field.
+// This is the field name in most cases, except for time columns which are stored as nanos
+// and then converted to timestamp generated columns.
+func (tm *objectIndexer) updatableColumnName(field schema.Field) (name string, err error) {
+	name = field.Name
+	if field.Kind == schema.TimeKind {
+		name = fmt.Sprintf("%s_nanos", name)
+	}
+	name = fmt.Sprintf("%q", name)
+	return
+}
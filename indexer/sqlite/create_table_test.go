@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_createTableSql_allKinds() {
+	exampleCreateTable(testdata.AllKindsObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_all_kinds" (
+	// 	"id" INTEGER NOT NULL,
+	// 	"ts" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "ts_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"ts_nanos" INTEGER NOT NULL,
+	// 	"string" TEXT NOT NULL,
+	// 	"bytes" BLOB NOT NULL,
+	// 	"int8" INTEGER NOT NULL,
+	// 	"uint8" INTEGER NOT NULL,
+	// 	"int16" INTEGER NOT NULL,
+	// 	"uint16" INTEGER NOT NULL,
+	// 	"int32" INTEGER NOT NULL,
+	// 	"uint32" INTEGER NOT NULL,
+	// 	"int64" INTEGER NOT NULL,
+	// 	"uint64" TEXT NOT NULL,
+	// 	"integer" TEXT NOT NULL,
+	// 	"decimal" TEXT NOT NULL,
+	// 	"bool" BOOLEAN NOT NULL,
+	// 	"time" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "time_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"time_nanos" INTEGER NOT NULL,
+	// 	"duration" INTEGER NOT NULL,
+	// 	"float32" REAL NOT NULL,
+	// 	"float64" REAL NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"enum" TEXT CHECK ("enum" IN ('a', 'b', 'c')) NOT NULL,
+	// 	"json" TEXT NOT NULL,
+	// 	PRIMARY KEY ("id", "ts_nanos")
+	// ) WITHOUT ROWID;
+}
+
+func Example_objectIndexer_createTableSql_singleton() {
+	exampleCreateTable(testdata.SingletonObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_singleton" (
+	// 	_id INTEGER NOT NULL CHECK (_id = 1),
+	// 	"foo" TEXT NOT NULL,
+	// 	"bar" INTEGER NULL,
+	// 	"an_enum" TEXT CHECK ("an_enum" IN ('a', 'b', 'c')) NOT NULL,
+	// 	PRIMARY KEY (_id)
+	// ) WITHOUT ROWID;
+}
+
+func Example_objectIndexer_createTableSql_vote() {
+	exampleCreateTable(testdata.VoteObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" TEXT CHECK ("vote" IN ('yes', 'no', 'abstain')) NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	PRIMARY KEY ("proposal", "address")
+	// ) WITHOUT ROWID;
+}
+
+func Example_objectIndexer_createTableSql_vote_no_retain_delete() {
+	exampleCreateTableOpt(testdata.VoteObject, true)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" TEXT CHECK ("vote" IN ('yes', 'no', 'abstain')) NOT NULL,
+	// 	PRIMARY KEY ("proposal", "address")
+	// ) WITHOUT ROWID;
+}
+
+func exampleCreateTable(objectType schema.StateObjectType) {
+	exampleCreateTableOpt(objectType, false)
+}
+
+func exampleCreateTableOpt(objectType schema.StateObjectType, noRetainDelete bool) {
+	tm := newObjectIndexer("test", testdata.ExampleSchema, objectType, options{
+		logger:                 logutil.NoopLogger{},
+		disableRetainDeletions: noRetainDelete,
+	})
+	err := tm.createTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,93 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
+)
+
+// moduleIndexer manages the tables for a module.
+type moduleIndexer struct {
+	moduleName string
+	schema     schema.ModuleSchema
+	tables     map[string]*objectIndexer
+	options    options
+}
+
+// newModuleIndexer creates a new moduleIndexer for the given module schema.
+func newModuleIndexer(moduleName string, modSchema schema.ModuleSchema, options options) *moduleIndexer {
+	return &moduleIndexer{
+		moduleName: moduleName,
+		schema:     modSchema,
+		tables:     map[string]*objectIndexer{},
+		options:    options,
+	}
+}
+
+// initializeSchema creates tables for all object types in the module schema.
+// If a different schema was previously applied for the module an error is returned,
+// as SQLite can't alter the CHECK constraints of enum columns so the tables must be rebuilt.
+func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error {
+	oldSchema, found, err := m.loadAppliedSchema(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	if found && !diff.CompareModuleSchemas(oldSchema, m.schema).Empty() {
+		return fmt.Errorf("the schema of module %s changed since it was last indexed, its indexer tables must be rebuilt", m.moduleName)
+	}
+
+	// create tables for all object types
+	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		tm := newObjectIndexer(m.moduleName, m.schema, typ, m.options)
+		m.tables[typ.Name] = tm
+		err = tm.createTable(ctx, conn)
+		if err != nil {
+			err = fmt.Errorf("failed to create table for %s in module %s: %v", typ.Name, m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return nil
+	}
+
+	return m.saveAppliedSchema(ctx, conn)
+}
+
+// loadAppliedSchema loads the module schema which was last applied to the database.
+func (m *moduleIndexer) loadAppliedSchema(ctx context.Context, conn dbConn) (modSchema schema.ModuleSchema, found bool, err error) {
+	var bz []byte
+	row := conn.QueryRowContext(ctx, "SELECT schema FROM module_schema WHERE module_name = ?", m.moduleName)
+	if err := row.Scan(&bz); err != nil {
+		if err == sql.ErrNoRows {
+			return schema.ModuleSchema{}, false, nil
+		}
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to load the applied schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	err = json.Unmarshal(bz, &modSchema)
+	if err != nil {
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to decode the applied schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	return modSchema, true, nil
+}
+
+// saveAppliedSchema persists the module schema so that it can be compared on the next start-up.
+func (m *moduleIndexer) saveAppliedSchema(ctx context.Context, conn dbConn) error {
+	bz, err := json.Marshal(m.schema)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "INSERT INTO module_schema (module_name, schema) VALUES (?, ?)", m.moduleName, string(bz))
+	return err
+}
@@ -0,0 +1,26 @@
+module cosmossdk.io/indexer/sqlite/testing
+
+go 1.23
+
+require (
+	cosmossdk.io/indexer/sqlite v0.0.0-00010101000000-000000000000
+	cosmossdk.io/schema v0.3.0
+	cosmossdk.io/schema/testing v0.0.0
+	github.com/mattn/go-sqlite3 v1.14.22
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/cockroachdb/apd/v3 v3.2.1 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/tidwall/btree v1.7.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+	pgregory.net/rapid v1.1.0 // indirect
+)
+
+replace cosmossdk.io/indexer/sqlite => ../.
+
+replace cosmossdk.io/schema => ../../../schema
+
+replace cosmossdk.io/schema/testing => ../../../schema/testing
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/view"
+)
+
+func TestInitSchema(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "indexer.db")
+
+	objectType := schema.StateObjectType{
+		Name:        "params",
+		ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+	}
+	v1 := schema.MustCompileModuleSchema(objectType)
+
+	start := func(modSchema schema.ModuleSchema, updates ...schema.StateObjectUpdate) (view.AppData, error) {
+		res, err := indexer.StartIndexing(indexer.IndexingOptions{
+			Config: indexer.IndexingConfig{
+				Target: map[string]indexer.Config{
+					"sqlite": {
+						Type:   "sqlite",
+						Config: sqlite.Config{DatabaseURL: dbPath},
+					},
+				},
+			},
+			Context: context.Background(),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, res.Listener.InitializeModuleData(appdata.ModuleInitializationData{
+			ModuleName: "test",
+			Schema:     modSchema,
+		}))
+		require.NoError(t, res.Listener.OnObjectUpdate(appdata.ObjectUpdateData{
+			ModuleName: "test",
+			Updates:    updates,
+		}))
+
+		// the listener is asynchronous, errors are only returned once the commit completes
+		commit, err := res.Listener.Commit(appdata.CommitData{})
+		require.NoError(t, err)
+		return res.IndexerInfos["sqlite"].View, commit()
+	}
+
+	_, err := start(v1, schema.StateObjectUpdate{TypeName: "params", Value: "foo"})
+	require.NoError(t, err)
+
+	// restarting with the same schema keeps the indexed state
+	appView, err := start(v1)
+	require.NoError(t, err)
+	mod, err := appView.AppState().GetModule("test")
+	require.NoError(t, err)
+	coll, err := mod.GetObjectCollection("params")
+	require.NoError(t, err)
+	update, found, err := coll.GetObject(nil)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "foo", update.Value)
+
+	// schema changes require the tables to be rebuilt
+	objectType.ValueFields = append(objectType.ValueFields, schema.Field{Name: "other", Kind: schema.StringKind, Nullable: true})
+	_, err = start(schema.MustCompileModuleSchema(objectType))
+	require.ErrorContains(t, err, "its indexer tables must be rebuilt")
+}
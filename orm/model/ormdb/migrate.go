@@ -0,0 +1,81 @@
+package ormdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// Diff detects the changes between the tables of an old (from) and a new (to) version of a
+// module schema. Tables are matched by message name and only tables with changes are returned.
+// The old ModuleDB is generally built with pinned file descriptors and a matching TypeResolver.
+func Diff(from, to ModuleDB) ([]ormtable.TableDiff, error) {
+	fromDB, toDB, err := getModuleDBs(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []ormtable.TableDiff
+	for _, name := range commonTableNames(fromDB, toDB) {
+		diff, err := ormtable.DiffTables(fromDB.tablesByName[name], toDB.tablesByName[name])
+		if err != nil {
+			return nil, err
+		}
+
+		if !diff.IsEmpty() {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs, nil
+}
+
+// Migrate migrates the data of every table of the old (from) module schema which changed in
+// the new (to) module schema, see ormtable.MigrateTable. Tables are migrated in name order.
+// Tables which only exist in one of the versions are left untouched.
+func Migrate(ctx context.Context, from, to ModuleDB, options ormtable.MigrateOptions) error {
+	fromDB, toDB, err := getModuleDBs(from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range commonTableNames(fromDB, toDB) {
+		err := ormtable.MigrateTable(ctx, fromDB.tablesByName[name], toDB.tablesByName[name], options)
+		if err != nil {
+			return fmt.Errorf("migrating table %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func getModuleDBs(from, to ModuleDB) (*moduleDB, *moduleDB, error) {
+	fromDB, ok := from.(*moduleDB)
+	if !ok {
+		return nil, nil, ormerrors.UnsupportedOperation.Wrapf("can't migrate module db of type %T", from)
+	}
+
+	toDB, ok := to.(*moduleDB)
+	if !ok {
+		return nil, nil, ormerrors.UnsupportedOperation.Wrapf("can't migrate module db of type %T", to)
+	}
+
+	return fromDB, toDB, nil
+}
+
+func commonTableNames(from, to *moduleDB) []protoreflect.FullName {
+	var names []protoreflect.FullName
+	for name := range to.tablesByName {
+		if _, ok := from.tablesByName[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
@@ -0,0 +1,101 @@
+package ormdb_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gotest.tools/v3/assert"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/testing/ormtest"
+)
+
+// pinnedBankV1 returns the module db of a previous version of the test bank schema,
+// whose balance table has no denom index, built from a pinned file descriptor.
+func pinnedBankV1(t *testing.T) ormdb.ModuleDB {
+	t.Helper()
+
+	fdp := protodesc.ToFileDescriptorProto(testpb.File_testpb_bank_proto)
+	for _, msg := range fdp.MessageType {
+		if msg.GetName() == "Balance" {
+			proto.SetExtension(msg.Options, ormv1.E_Table, &ormv1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "address,denom"},
+			})
+		}
+	}
+
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	assert.NilError(t, err)
+	files := &protoregistry.Files{}
+	assert.NilError(t, files.RegisterFile(fd))
+
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{
+		FileResolver: files,
+		TypeResolver: dynamicpb.NewTypes(files),
+	})
+	assert.NilError(t, err)
+	return db
+}
+
+func TestMigrate(t *testing.T) {
+	v1 := pinnedBankV1(t)
+	v2, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	diffs, err := ormdb.Diff(v1, v2)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, "testpb.Balance: added indexes [1]", diffs[0].String())
+
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+
+	balanceV1 := v1.GetTable(&testpb.Balance{})
+	desc := balanceV1.MessageType().Descriptor().Fields()
+	for _, b := range []*testpb.Balance{
+		{Address: "alice", Denom: "foo", Amount: 10},
+		{Address: "bob", Denom: "foo", Amount: 20},
+		{Address: "bob", Denom: "bar", Amount: 30},
+	} {
+		msg := balanceV1.MessageType().New()
+		msg.Set(desc.ByName("address"), protoreflect.ValueOfString(b.Address))
+		msg.Set(desc.ByName("denom"), protoreflect.ValueOfString(b.Denom))
+		msg.Set(desc.ByName("amount"), protoreflect.ValueOfUint64(b.Amount))
+		assert.NilError(t, balanceV1.Insert(ctx, msg.Interface()))
+	}
+
+	var progress []ormtable.MigrationProgress
+	assert.NilError(t, ormdb.Migrate(ctx, v1, v2, ormtable.MigrateOptions{
+		OnProgress: func(p ormtable.MigrationProgress) { progress = append(progress, p) },
+	}))
+	assert.Equal(t, 2, len(progress))
+	assert.Equal(t, ormtable.MigrationStepBackfillIndex, progress[1].Step)
+	assert.Equal(t, uint64(3), progress[1].Processed)
+
+	store, err := testpb.NewBankStore(v2)
+	assert.NilError(t, err)
+
+	it, err := store.BalanceTable().List(ctx, testpb.BalanceDenomIndexKey{}.WithDenom("foo"))
+	assert.NilError(t, err)
+	var owners []string
+	for it.Next() {
+		balance, err := it.Value()
+		assert.NilError(t, err)
+		owners = append(owners, balance.Address)
+	}
+	it.Close()
+	assert.DeepEqual(t, []string{"alice", "bob"}, owners)
+
+	// migrating again is a no-op
+	diffs, err = ormdb.Diff(v2, v2)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(diffs))
+	assert.NilError(t, ormdb.Migrate(ctx, v2, v2, ormtable.MigrateOptions{}))
+}
@@ -0,0 +1,617 @@
+package ormtable
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/orm/encoding/encodeutil"
+	"cosmossdk.io/orm/encoding/ormkv"
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/kv"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// stagingID is the ID under the prefix of a table where primary key entries
+// are staged while they are re-encoded. It is never used by table definitions.
+const stagingID = seqID + 1
+
+// defaultMigrationBatchSize is the default number of entries processed at once by MigrateTable.
+const defaultMigrationBatchSize = 1000
+
+// TableDiff describes the changes between two versions of a table definition
+// which require the table data to be migrated.
+type TableDiff struct {
+	// TableName is the name of the table message in the new definition.
+	TableName protoreflect.FullName
+
+	// PrefixChanged is true when the table ID or the prefix of the table changed,
+	// in which case all the entries must be moved.
+	PrefixChanged bool
+
+	// PrimaryKeyChanged is true when the primary key fields changed, in which
+	// case all the entries must be re-encoded.
+	PrimaryKeyChanged bool
+
+	// AutoIncrementChanged is true when the primary key was made
+	// auto-incrementing or stopped being auto-incrementing.
+	AutoIncrementChanged bool
+
+	// AddedIndexes are the IDs of the indexes of the new definition which must be backfilled.
+	// An index whose fields or uniqueness changed is both removed and added.
+	AddedIndexes []uint32
+
+	// RemovedIndexes are the IDs of the indexes of the old definition which must be pruned.
+	RemovedIndexes []uint32
+}
+
+// IsEmpty returns true if the table data doesn't need to be migrated.
+func (d TableDiff) IsEmpty() bool {
+	return !d.PrefixChanged && !d.PrimaryKeyChanged && !d.AutoIncrementChanged &&
+		len(d.AddedIndexes) == 0 && len(d.RemovedIndexes) == 0
+}
+
+// String returns a human-readable description of the changes.
+func (d TableDiff) String() string {
+	if d.IsEmpty() {
+		return fmt.Sprintf("%s: no changes", d.TableName)
+	}
+
+	var changes []string
+	if d.PrefixChanged {
+		changes = append(changes, "prefix changed")
+	}
+	if d.PrimaryKeyChanged {
+		changes = append(changes, "primary key changed")
+	}
+	if d.AutoIncrementChanged {
+		changes = append(changes, "auto-increment changed")
+	}
+	if len(d.RemovedIndexes) != 0 {
+		changes = append(changes, fmt.Sprintf("removed indexes %v", d.RemovedIndexes))
+	}
+	if len(d.AddedIndexes) != 0 {
+		changes = append(changes, fmt.Sprintf("added indexes %v", d.AddedIndexes))
+	}
+
+	return fmt.Sprintf("%s: %s", d.TableName, strings.Join(changes, ", "))
+}
+
+// reencode returns true if all the entries of the table must be re-encoded.
+func (d TableDiff) reencode() bool {
+	return d.PrefixChanged || d.PrimaryKeyChanged
+}
+
+// DiffTables detects the changes between the old (from) and the new (to) definition of a table.
+// The two tables may use different message types, for instance when the old table is built
+// from a pinned version of the .proto file, as long as they are wire compatible.
+func DiffTables(from, to Table) (TableDiff, error) {
+	fromImpl, err := getTableImpl(from)
+	if err != nil {
+		return TableDiff{}, err
+	}
+
+	toImpl, err := getTableImpl(to)
+	if err != nil {
+		return TableDiff{}, err
+	}
+
+	tableName := to.MessageType().Descriptor().FullName()
+	if isSingleton(from) != isSingleton(to) {
+		return TableDiff{}, ormerrors.UnsupportedOperation.Wrapf("can't migrate %s between a table and a singleton", tableName)
+	}
+
+	diff := TableDiff{
+		TableName:            tableName,
+		PrefixChanged:        !bytes.Equal(fromImpl.tablePrefix, toImpl.tablePrefix),
+		PrimaryKeyChanged:    fromImpl.primaryKeyIndex.fields != toImpl.primaryKeyIndex.fields,
+		AutoIncrementChanged: isAutoIncrement(from) != isAutoIncrement(to),
+	}
+
+	for id, idx := range fromImpl.indexesByID {
+		if id == primaryKeyID {
+			continue
+		}
+
+		if diff.reencode() || !sameIndex(idx, toImpl.indexesByID[id]) {
+			diff.RemovedIndexes = append(diff.RemovedIndexes, id)
+		}
+	}
+
+	for id, idx := range toImpl.indexesByID {
+		if id == primaryKeyID {
+			continue
+		}
+
+		if diff.reencode() || !sameIndex(fromImpl.indexesByID[id], idx) {
+			diff.AddedIndexes = append(diff.AddedIndexes, id)
+		}
+	}
+
+	sort.Slice(diff.RemovedIndexes, func(i, j int) bool { return diff.RemovedIndexes[i] < diff.RemovedIndexes[j] })
+	sort.Slice(diff.AddedIndexes, func(i, j int) bool { return diff.AddedIndexes[i] < diff.AddedIndexes[j] })
+
+	return diff, nil
+}
+
+// MigrationStep is a step of a table migration.
+type MigrationStep string
+
+const (
+	// MigrationStepStage moves the entries of the old primary key to a staging area.
+	MigrationStepStage MigrationStep = "stage"
+
+	// MigrationStepReencode inserts the staged entries using the new table definition.
+	MigrationStepReencode MigrationStep = "reencode"
+
+	// MigrationStepPruneIndex deletes the entries of a removed index.
+	MigrationStepPruneIndex MigrationStep = "prune-index"
+
+	// MigrationStepBackfillIndex writes the entries of an added index.
+	MigrationStepBackfillIndex MigrationStep = "backfill-index"
+)
+
+// MigrationProgress reports the progress of a table migration.
+type MigrationProgress struct {
+	// TableName is the name of the migrated table.
+	TableName protoreflect.FullName
+
+	// Step is the current migration step.
+	Step MigrationStep
+
+	// IndexID is the ID of the index being pruned or backfilled.
+	IndexID uint32
+
+	// Processed is the number of entries processed so far in this step.
+	Processed uint64
+
+	// Done is true when the step is complete.
+	Done bool
+}
+
+// MigrateOptions are options for MigrateTable.
+type MigrateOptions struct {
+	// BatchSize is the number of entries read at once from the store. It defaults to 1000.
+	BatchSize int
+
+	// OnProgress is an optional callback called after each batch of entries is processed
+	// and when each step completes.
+	OnProgress func(MigrationProgress)
+}
+
+// MigrateTable migrates the data of a table from its old definition (from) to its new
+// definition (to), as detected by DiffTables. It is meant to be called from the
+// migration handlers registered in a module's Migrator.
+//
+// When only indexes changed, the entries of removed indexes are pruned and the
+// added indexes are backfilled. When the primary key or the table prefix changed, all
+// the entries are re-encoded and all the indexes rebuilt. The sequence of auto-increment
+// tables is preserved, or initialized from the greatest primary key.
+//
+// Validate and write hooks are not called for migrated entries. MigrateTable is not atomic,
+// as it is assumed to be called in the context of a larger transaction. If the new definition
+// introduces a unique constraint violated by existing entries, an error is returned.
+func MigrateTable(ctx context.Context, from, to Table, options MigrateOptions) error {
+	diff, err := DiffTables(from, to)
+	if err != nil {
+		return err
+	}
+
+	if diff.IsEmpty() {
+		return nil
+	}
+
+	fromImpl, _ := getTableImpl(from)
+	toImpl, _ := getTableImpl(to)
+
+	fromBackend, err := fromImpl.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	toBackend, err := toImpl.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultMigrationBatchSize
+	}
+
+	m := &tableMigrator{
+		ctx:         ctx,
+		diff:        diff,
+		options:     options,
+		from:        fromImpl,
+		to:          toImpl,
+		fromBackend: fromBackend,
+		toBackend:   toBackend.WithValidateHooks(nil).WithWriteHooks(nil),
+	}
+
+	switch {
+	case isSingleton(to):
+		err = m.migrateSingleton()
+	case diff.reencode():
+		err = m.reencode()
+	default:
+		err = m.migrateIndexes(to)
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.migrateSequence(from, to)
+}
+
+type tableMigrator struct {
+	ctx         context.Context
+	diff        TableDiff
+	options     MigrateOptions
+	from, to    *tableImpl
+	fromBackend Backend
+	toBackend   Backend
+}
+
+func (m *tableMigrator) progress(step MigrationStep, indexID uint32, processed uint64, done bool) {
+	if m.options.OnProgress == nil {
+		return
+	}
+
+	m.options.OnProgress(MigrationProgress{
+		TableName: m.diff.TableName,
+		Step:      step,
+		IndexID:   indexID,
+		Processed: processed,
+		Done:      done,
+	})
+}
+
+// migrateSingleton moves a singleton to its new prefix.
+func (m *tableMigrator) migrateSingleton() error {
+	bz, err := m.fromBackend.CommitmentStoreReader().Get(m.from.tablePrefix)
+	if err != nil || bz == nil {
+		return err
+	}
+
+	msg := m.from.MessageType().New().Interface()
+	if err := (proto.UnmarshalOptions{Resolver: m.from.typeResolver}).Unmarshal(bz, msg); err != nil {
+		return err
+	}
+
+	msg, err = m.convert(msg)
+	if err != nil {
+		return err
+	}
+
+	bz, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := m.fromBackend.CommitmentStore().Delete(m.from.tablePrefix); err != nil {
+		return err
+	}
+
+	return m.toBackend.CommitmentStore().Set(m.to.tablePrefix, bz)
+}
+
+// reencode moves all the entries of the old primary key to a staging area, prunes the
+// old indexes and inserts the staged entries using the new table definition. Staging
+// avoids iterating over entries encoded with the new definition when the prefix is unchanged.
+func (m *tableMigrator) reencode() error {
+	pkPrefix := m.from.PrimaryKeyCodec.Prefix()
+	stagingPrefix := encodeutil.AppendVarUInt32(m.from.tablePrefix, stagingID)
+	commitment := m.fromBackend.CommitmentStore()
+
+	var staged uint64
+	for {
+		batch, err := m.readBatch(commitment, pkPrefix)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, pair := range batch {
+			if err := commitment.Set(concat(stagingPrefix, pair.key[len(pkPrefix):]), pair.value); err != nil {
+				return err
+			}
+			if err := commitment.Delete(pair.key); err != nil {
+				return err
+			}
+		}
+
+		staged += uint64(len(batch))
+		m.progress(MigrationStepStage, primaryKeyID, staged, false)
+	}
+	m.progress(MigrationStepStage, primaryKeyID, staged, true)
+
+	for _, id := range m.diff.RemovedIndexes {
+		if err := m.pruneIndex(id); err != nil {
+			return err
+		}
+	}
+
+	var reencoded uint64
+	for {
+		batch, err := m.readBatch(commitment, stagingPrefix)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, pair := range batch {
+			entry, err := m.from.PrimaryKeyCodec.DecodeEntry(concat(pkPrefix, pair.key[len(stagingPrefix):]), pair.value)
+			if err != nil {
+				return err
+			}
+
+			msg, err := m.convert(entry.(*ormkv.PrimaryKeyEntry).Value)
+			if err != nil {
+				return err
+			}
+
+			if err := m.insert(msg); err != nil {
+				return err
+			}
+
+			if err := commitment.Delete(pair.key); err != nil {
+				return err
+			}
+		}
+
+		reencoded += uint64(len(batch))
+		m.progress(MigrationStepReencode, primaryKeyID, reencoded, false)
+	}
+	m.progress(MigrationStepReencode, primaryKeyID, reencoded, true)
+
+	return nil
+}
+
+// insert saves a new entry with the new table definition, checking primary and unique key constraints.
+func (m *tableMigrator) insert(msg proto.Message) error {
+	writer := newBatchIndexCommitmentWriter(m.toBackend)
+	defer writer.Close()
+	return m.to.doSave(m.ctx, writer, msg, saveModeInsert)
+}
+
+// migrateIndexes prunes the removed indexes and backfills the added indexes of a table
+// whose primary key encoding is unchanged.
+func (m *tableMigrator) migrateIndexes(to Table) error {
+	for _, id := range m.diff.RemovedIndexes {
+		if err := m.pruneIndex(id); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range m.diff.AddedIndexes {
+		if err := m.backfillIndex(to, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *tableMigrator) pruneIndex(id uint32) error {
+	prefix := encodeutil.AppendVarUInt32(m.from.tablePrefix, id)
+	store := m.fromBackend.IndexStore()
+
+	var pruned uint64
+	for {
+		batch, err := m.readBatch(store, prefix)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, pair := range batch {
+			if err := store.Delete(pair.key); err != nil {
+				return err
+			}
+		}
+
+		pruned += uint64(len(batch))
+		m.progress(MigrationStepPruneIndex, id, pruned, false)
+	}
+	m.progress(MigrationStepPruneIndex, id, pruned, true)
+
+	return nil
+}
+
+func (m *tableMigrator) backfillIndex(to Table, id uint32) error {
+	idx, ok := m.to.indexesByID[id].(indexer)
+	if !ok {
+		return ormerrors.CantFindIndex.Wrapf("id %d on table %s", id, m.diff.TableName)
+	}
+
+	var (
+		cursor     ormlist.CursorT
+		backfilled uint64
+	)
+	for {
+		// entries are read before index entries are written, as no writes may
+		// happen while iterating the store.
+		msgs, next, err := m.readMessages(to, cursor)
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			break
+		}
+
+		for _, msg := range msgs {
+			if err := idx.onInsert(m.toBackend.IndexStore(), msg.ProtoReflect()); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		backfilled += uint64(len(msgs))
+		m.progress(MigrationStepBackfillIndex, id, backfilled, false)
+	}
+	m.progress(MigrationStepBackfillIndex, id, backfilled, true)
+
+	return nil
+}
+
+// migrateSequence initializes the sequence of the new table if it is auto-incrementing,
+// so that it is never lower than the old sequence or the greatest primary key, and deletes
+// the sequence of the old table if it isn't used anymore.
+func (m *tableMigrator) migrateSequence(from, to Table) error {
+	fromAutoInc, fromOk := from.(*autoIncrementTable)
+	toAutoInc, toOk := to.(*autoIncrementTable)
+
+	if toOk {
+		curSeq, err := toAutoInc.curSeqValue(m.toBackend.IndexStoreReader())
+		if err != nil {
+			return err
+		}
+
+		seq := curSeq
+
+		if fromOk {
+			fromSeq, err := fromAutoInc.curSeqValue(m.fromBackend.IndexStoreReader())
+			if err != nil {
+				return err
+			}
+			seq = max(seq, fromSeq)
+		}
+
+		it, err := to.List(m.ctx, nil, ormlist.Reverse())
+		if err != nil {
+			return err
+		}
+		if it.Next() {
+			msg, err := it.GetMessage()
+			if err != nil {
+				it.Close()
+				return err
+			}
+			seq = max(seq, msg.ProtoReflect().Get(toAutoInc.autoIncField).Uint())
+		}
+		it.Close()
+
+		if seq != curSeq {
+			if err := toAutoInc.setSeqValue(m.toBackend.IndexStore(), seq); err != nil {
+				return err
+			}
+		}
+	}
+
+	if fromOk && (!toOk || m.diff.PrefixChanged) {
+		return m.fromBackend.IndexStore().Delete(fromAutoInc.seqCodec.Prefix())
+	}
+
+	return nil
+}
+
+type kvPair struct {
+	key, value []byte
+}
+
+// readBatch reads the first batch of key-value pairs with the given prefix.
+// Callers are expected to delete or move the returned keys before reading the next batch.
+func (m *tableMigrator) readBatch(store kv.ReadonlyStore, prefix []byte) ([]kvPair, error) {
+	it, err := store.Iterator(prefix, prefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batch []kvPair
+	for ; it.Valid() && len(batch) < m.options.BatchSize; it.Next() {
+		batch = append(batch, kvPair{
+			key:   bytes.Clone(it.Key()),
+			value: bytes.Clone(it.Value()),
+		})
+	}
+
+	return batch, it.Error()
+}
+
+// readMessages reads the batch of entries following the cursor and returns the cursor of the last entry.
+func (m *tableMigrator) readMessages(table Table, cursor ormlist.CursorT) ([]proto.Message, ormlist.CursorT, error) {
+	it, err := table.List(m.ctx, nil, ormlist.Cursor(cursor))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var msgs []proto.Message
+	for len(msgs) < m.options.BatchSize && it.Next() {
+		msg, err := it.GetMessage()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		msgs = append(msgs, msg)
+		cursor = it.Cursor()
+	}
+
+	return msgs, cursor, nil
+}
+
+// convert converts a message of the old table type to the new table type.
+func (m *tableMigrator) convert(msg proto.Message) (proto.Message, error) {
+	if m.from.MessageType() == m.to.MessageType() {
+		return msg, nil
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	newMsg := m.to.MessageType().New().Interface()
+	return newMsg, proto.UnmarshalOptions{Resolver: m.to.typeResolver}.Unmarshal(bz, newMsg)
+}
+
+func getTableImpl(table Table) (*tableImpl, error) {
+	switch t := table.(type) {
+	case *tableImpl:
+		return t, nil
+	case *autoIncrementTable:
+		return t.tableImpl, nil
+	case *singleton:
+		return t.tableImpl, nil
+	default:
+		return nil, ormerrors.UnsupportedOperation.Wrapf("can't migrate table of type %T", table)
+	}
+}
+
+func isSingleton(table Table) bool {
+	_, ok := table.(*singleton)
+	return ok
+}
+
+func isAutoIncrement(table Table) bool {
+	_, ok := table.(*autoIncrementTable)
+	return ok
+}
+
+// sameIndex returns true if both indexes have the same fields and uniqueness.
+func sameIndex(a, b Index) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	_, aUnique := a.(UniqueIndex)
+	_, bUnique := b.(UniqueIndex)
+	return a.Fields() == b.Fields() && aUnique == bUnique
+}
+
+func concat(prefix, suffix []byte) []byte {
+	res := make([]byte, 0, len(prefix)+len(suffix))
+	return append(append(res, prefix...), suffix...)
+}
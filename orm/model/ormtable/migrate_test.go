@@ -0,0 +1,284 @@
+package ormtable_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func buildTable(t *testing.T, options ormtable.Options) ormtable.Table {
+	t.Helper()
+	table, err := ormtable.Build(options)
+	assert.NilError(t, err)
+	return table
+}
+
+func migrationBackends() map[string]func() ormtable.Backend {
+	return map[string]func() ormtable.Backend{
+		"split":  testkv.NewSplitMemBackend,
+		"shared": testkv.NewSharedMemBackend,
+	}
+}
+
+func countEntries(t *testing.T, ctx context.Context, table ormtable.Table) int {
+	t.Helper()
+	it, err := table.List(ctx, nil)
+	assert.NilError(t, err)
+	defer it.Close()
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+	return n
+}
+
+func TestDiffTables(t *testing.T) {
+	simpleType := (&testpb.SimpleExample{}).ProtoReflect().Type()
+	current := buildTable(t, ormtable.Options{MessageType: simpleType})
+
+	diff, err := ormtable.DiffTables(current, current)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.IsEmpty())
+
+	noIndex := buildTable(t, ormtable.Options{
+		MessageType: simpleType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         5,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "name"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 2, Fields: "not_unique"}},
+		},
+	})
+
+	diff, err = ormtable.DiffTables(noIndex, current)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint32{1}, diff.AddedIndexes)
+	assert.DeepEqual(t, []uint32{2}, diff.RemovedIndexes)
+	assert.Equal(t, "testpb.SimpleExample: removed indexes [2], added indexes [1]", diff.String())
+
+	nonUnique := buildTable(t, ormtable.Options{
+		MessageType: simpleType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         5,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "name"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "unique"}},
+		},
+	})
+
+	diff, err = ormtable.DiffTables(nonUnique, current)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint32{1}, diff.AddedIndexes)
+	assert.DeepEqual(t, []uint32{1}, diff.RemovedIndexes)
+
+	newPK := buildTable(t, ormtable.Options{
+		MessageType: simpleType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         7,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "unique"},
+		},
+	})
+
+	diff, err = ormtable.DiffTables(current, newPK)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.PrefixChanged)
+	assert.Assert(t, diff.PrimaryKeyChanged)
+	assert.DeepEqual(t, []uint32{1}, diff.RemovedIndexes)
+
+	singleton := buildTable(t, ormtable.Options{MessageType: (&testpb.ExampleSingleton{}).ProtoReflect().Type()})
+	_, err = ormtable.DiffTables(singleton, current)
+	assert.ErrorIs(t, err, ormerrors.UnsupportedOperation)
+}
+
+func TestMigrateTableIndexes(t *testing.T) {
+	simpleType := (&testpb.SimpleExample{}).ProtoReflect().Type()
+	from := buildTable(t, ormtable.Options{
+		MessageType: simpleType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         5,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "name"},
+		},
+	})
+	to := buildTable(t, ormtable.Options{MessageType: simpleType})
+
+	for name, newBackend := range migrationBackends() {
+		t.Run(name, func(t *testing.T) {
+			ctx := ormtable.WrapContextDefault(newBackend())
+			for i := 0; i < 5; i++ {
+				assert.NilError(t, from.Insert(ctx, &testpb.SimpleExample{
+					Name:   fmt.Sprintf("name%d", i),
+					Unique: fmt.Sprintf("unique%d", i),
+				}))
+			}
+
+			var progress []ormtable.MigrationProgress
+			assert.NilError(t, ormtable.MigrateTable(ctx, from, to, ormtable.MigrateOptions{
+				BatchSize:  2,
+				OnProgress: func(p ormtable.MigrationProgress) { progress = append(progress, p) },
+			}))
+
+			assert.DeepEqual(t, []uint64{2, 4, 5, 5}, processed(progress))
+			last := progress[len(progress)-1]
+			assert.Equal(t, ormtable.MigrationStepBackfillIndex, last.Step)
+			assert.Equal(t, uint32(1), last.IndexID)
+			assert.Assert(t, last.Done)
+
+			var msg testpb.SimpleExample
+			found, err := to.GetUniqueIndex("unique").Get(ctx, &msg, "unique3")
+			assert.NilError(t, err)
+			assert.Assert(t, found)
+			assert.Equal(t, "name3", msg.Name)
+
+			// the unique constraint is now enforced
+			err = to.Insert(ctx, &testpb.SimpleExample{Name: "name5", Unique: "unique3"})
+			assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+
+			// pruning the index and backfilling it again succeeds
+			assert.NilError(t, ormtable.MigrateTable(ctx, to, from, ormtable.MigrateOptions{}))
+			assert.NilError(t, from.Insert(ctx, &testpb.SimpleExample{Name: "name5", Unique: "unique3"}))
+			err = ormtable.MigrateTable(ctx, from, to, ormtable.MigrateOptions{})
+			assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+		})
+	}
+}
+
+func TestMigrateTablePrimaryKey(t *testing.T) {
+	simpleType := (&testpb.SimpleExample{}).ProtoReflect().Type()
+	from := buildTable(t, ormtable.Options{MessageType: simpleType})
+	to := buildTable(t, ormtable.Options{
+		MessageType: simpleType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         5,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "unique"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "name", Unique: true}},
+		},
+	})
+
+	for name, newBackend := range migrationBackends() {
+		t.Run(name, func(t *testing.T) {
+			ctx := ormtable.WrapContextDefault(newBackend())
+			for i := 0; i < 7; i++ {
+				assert.NilError(t, from.Insert(ctx, &testpb.SimpleExample{
+					Name:      fmt.Sprintf("name%d", i),
+					Unique:    fmt.Sprintf("unique%d", 6-i),
+					NotUnique: "x",
+				}))
+			}
+
+			var steps []ormtable.MigrationStep
+			assert.NilError(t, ormtable.MigrateTable(ctx, from, to, ormtable.MigrateOptions{
+				BatchSize: 3,
+				OnProgress: func(p ormtable.MigrationProgress) {
+					if p.Done {
+						steps = append(steps, p.Step)
+					}
+				},
+			}))
+			assert.DeepEqual(t, []ormtable.MigrationStep{
+				ormtable.MigrationStepStage,
+				ormtable.MigrationStepPruneIndex,
+				ormtable.MigrationStepReencode,
+			}, steps)
+
+			assert.Equal(t, 7, countEntries(t, ctx, to))
+
+			msg := &testpb.SimpleExample{Unique: "unique0"}
+			found, err := to.Get(ctx, msg)
+			assert.NilError(t, err)
+			assert.Assert(t, found)
+			assert.Equal(t, "name6", msg.Name)
+			assert.Equal(t, "x", msg.NotUnique)
+
+			found, err = to.GetUniqueIndex("name").Get(ctx, msg, "name2")
+			assert.NilError(t, err)
+			assert.Assert(t, found)
+			assert.Equal(t, "unique4", msg.Unique)
+		})
+	}
+}
+
+func TestMigrateTableAutoIncrement(t *testing.T) {
+	autoIncType := (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type()
+	manual := buildTable(t, ormtable.Options{
+		MessageType: autoIncType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         3,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "id"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "x", Unique: true}},
+		},
+	})
+	autoInc := buildTable(t, ormtable.Options{MessageType: autoIncType})
+	moved := buildTable(t, ormtable.Options{
+		MessageType: autoIncType,
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         9,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "id", AutoIncrement: true},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "x", Unique: true}},
+		},
+	})
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.NilError(t, manual.Insert(ctx, &testpb.ExampleAutoIncrementTable{Id: 5, X: "a"}))
+	assert.NilError(t, manual.Insert(ctx, &testpb.ExampleAutoIncrementTable{Id: 9, X: "b"}))
+
+	// the sequence is initialized from the greatest primary key
+	assert.NilError(t, ormtable.MigrateTable(ctx, manual, autoInc, ormtable.MigrateOptions{}))
+	seq, err := autoInc.(ormtable.AutoIncrementTable).LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(9), seq)
+
+	pk, err := autoInc.(ormtable.AutoIncrementTable).InsertReturningPKey(ctx, &testpb.ExampleAutoIncrementTable{X: "c"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(10), pk)
+
+	// the sequence moves with the table
+	assert.NilError(t, autoInc.Delete(ctx, &testpb.ExampleAutoIncrementTable{Id: 10}))
+	assert.NilError(t, ormtable.MigrateTable(ctx, autoInc, moved, ormtable.MigrateOptions{}))
+	assert.Equal(t, 0, countEntries(t, ctx, autoInc))
+	assert.Equal(t, 2, countEntries(t, ctx, moved))
+
+	seq, err = autoInc.(ormtable.AutoIncrementTable).LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(0), seq)
+	seq, err = moved.(ormtable.AutoIncrementTable).LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(10), seq)
+}
+
+func TestMigrateSingleton(t *testing.T) {
+	singletonType := (&testpb.ExampleSingleton{}).ProtoReflect().Type()
+	from := buildTable(t, ormtable.Options{MessageType: singletonType})
+	to := buildTable(t, ormtable.Options{
+		MessageType:         singletonType,
+		SingletonDescriptor: &ormv1.SingletonDescriptor{Id: 10},
+	})
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.NilError(t, from.Save(ctx, &testpb.ExampleSingleton{Foo: "foo", Bar: 3}))
+	assert.NilError(t, ormtable.MigrateTable(ctx, from, to, ormtable.MigrateOptions{}))
+
+	var msg testpb.ExampleSingleton
+	found, err := to.Get(ctx, &msg)
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+	assert.Equal(t, "foo", msg.Foo)
+
+	found, err = from.Has(ctx, &msg)
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+}
+
+func processed(progress []ormtable.MigrationProgress) []uint64 {
+	res := make([]uint64, len(progress))
+	for i, p := range progress {
+		res[i] = p.Processed
+	}
+	return res
+}
@@ -0,0 +1,338 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+// moduleSchema is the schema of a module registered in the app data.
+type moduleSchema struct {
+	name   string
+	schema schema.ModuleSchema
+}
+
+// schemaBuilder generates the GraphQL schema of the module schemas.
+type schemaBuilder struct {
+	s            *schemaDef
+	addressCodec addressutil.AddressCodec
+	pageSizes    pageSizes
+}
+
+type pageSizes struct {
+	defaultSize int
+	maxSize     int
+}
+
+// buildSchema generates a GraphQL schema with a query field for every module. Each module type has
+// a field returning a page of the objects of each object type matching a filter, a field getting an
+// object by its key and a field getting the value of each singleton object type.
+func buildSchema(modules []moduleSchema, addressCodec addressutil.AddressCodec, sizes pageSizes) (*schemaDef, error) {
+	b := &schemaBuilder{
+		s:            newSchemaDef(),
+		addressCodec: addressCodec,
+		pageSizes:    sizes,
+	}
+
+	query := &typeDef{kind: objectKind, name: "Query"}
+	b.s.query = query
+	if err := b.s.addType(query); err != nil {
+		return nil, err
+	}
+
+	// the introspection types reference these scalars
+	b.scalar(stringScalar)
+	b.scalar(booleanScalar)
+
+	err := query.addField(&fieldDef{
+		name:        "blockNum",
+		description: "The last block persisted in the indexed state.",
+		typ:         nonNullType(namedType(uint64Scalar)),
+		resolve: func(_ context.Context, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			blockNum, err := source.(view.AppData).BlockNum()
+			if err != nil {
+				return nil, err
+			}
+			return encodeValue(schema.Uint64Kind, blockNum, nil)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.scalar(uint64Scalar)
+
+	for _, mod := range modules {
+		if err := b.addModule(query, mod); err != nil {
+			return nil, fmt.Errorf("module %s: %w", mod.name, err)
+		}
+	}
+
+	b.s.introspection = introspectSchema(b.s)
+	return b.s, nil
+}
+
+// scalar adds the named scalar type to the schema if it wasn't already.
+func (b *schemaBuilder) scalar(name string) {
+	if _, ok := b.s.types[name]; !ok {
+		b.s.types[name] = newScalarType(name, b.addressCodec)
+	}
+}
+
+func (b *schemaBuilder) addModule(query *typeDef, mod moduleSchema) error {
+	modType := &typeDef{
+		kind:        objectKind,
+		name:        fmt.Sprintf("%s_Module", mod.name),
+		description: fmt.Sprintf("The state of the %s module.", mod.name),
+	}
+	if err := b.s.addType(modType); err != nil {
+		return err
+	}
+
+	moduleName := mod.name
+	err := query.addField(&fieldDef{
+		name: mod.name,
+		typ:  nonNullType(namedType(modType.name)),
+		resolve: func(_ context.Context, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			appState := source.(view.AppData).AppState()
+			if appState == nil {
+				return nil, fmt.Errorf("the app data has no state")
+			}
+			modState, err := appState.GetModule(moduleName)
+			if err != nil {
+				return nil, err
+			}
+			if modState == nil {
+				return nil, fmt.Errorf("module %s not found", moduleName)
+			}
+			return modState, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	mod.schema.EnumTypes(func(enumType schema.EnumType) bool {
+		err = b.addEnum(mod.name, enumType)
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	mod.schema.StateObjectTypes(func(objType schema.StateObjectType) bool {
+		err = b.addObjectType(modType, mod.name, objType)
+		if err != nil {
+			err = fmt.Errorf("object type %s: %w", objType.Name, err)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func enumTypeName(moduleName, enumName string) string {
+	return fmt.Sprintf("%s_%s", moduleName, enumName)
+}
+
+func (b *schemaBuilder) addEnum(moduleName string, enumType schema.EnumType) error {
+	t := &typeDef{kind: enumKind, name: enumTypeName(moduleName, enumType.Name)}
+	for _, v := range enumType.Values {
+		t.enumValues = append(t.enumValues, v.Name)
+	}
+	if err := b.s.addType(t); err != nil {
+		return err
+	}
+
+	return b.s.addType(&typeDef{
+		kind:        inputObjectKind,
+		name:        t.name + "_Filter",
+		description: fmt.Sprintf("Filters %s values.", t.name),
+		inputFields: []*inputValueDef{
+			{name: "eq", typ: namedType(t.name)},
+			{name: "ne", typ: namedType(t.name)},
+			{name: "in", typ: listType(nonNullType(namedType(t.name)))},
+			{name: "isNull", typ: namedType(booleanScalar)},
+		},
+	})
+}
+
+// fieldType returns the name of the GraphQL type of the field, adding scalar types as needed.
+func (b *schemaBuilder) fieldType(moduleName string, field schema.Field) string {
+	if field.Kind == schema.EnumKind {
+		return enumTypeName(moduleName, field.ReferencedType)
+	}
+
+	name := scalarForKind(field.Kind)
+	b.scalar(name)
+	return name
+}
+
+// scalarFilterType returns the name of the filter input type of the scalar, adding it as needed.
+func (b *schemaBuilder) scalarFilterType(scalar string) string {
+	name := scalar + "Filter"
+	if _, ok := b.s.types[name]; ok {
+		return name
+	}
+
+	t := &typeDef{
+		kind:        inputObjectKind,
+		name:        name,
+		description: fmt.Sprintf("Filters %s values. Only the values matching all the conditions set are selected.", scalar),
+		inputFields: []*inputValueDef{
+			{name: "eq", typ: namedType(scalar)},
+			{name: "ne", typ: namedType(scalar)},
+		},
+	}
+	if scalar != booleanScalar {
+		t.inputFields = append(t.inputFields,
+			&inputValueDef{name: "in", typ: listType(nonNullType(namedType(scalar)))},
+			&inputValueDef{name: "gt", typ: namedType(scalar)},
+			&inputValueDef{name: "gte", typ: namedType(scalar)},
+			&inputValueDef{name: "lt", typ: namedType(scalar)},
+			&inputValueDef{name: "lte", typ: namedType(scalar)},
+		)
+	}
+	t.inputFields = append(t.inputFields, &inputValueDef{name: "isNull", typ: namedType(booleanScalar)})
+	b.s.types[name] = t
+	return name
+}
+
+func (b *schemaBuilder) addObjectType(modType *typeDef, moduleName string, objType schema.StateObjectType) error {
+	t := &typeDef{kind: objectKind, name: fmt.Sprintf("%s_%s", moduleName, objType.Name)}
+	if err := b.s.addType(t); err != nil {
+		return err
+	}
+
+	allFields := append(append([]schema.Field{}, objType.KeyFields...), objType.ValueFields...)
+	for _, field := range allFields {
+		typ := namedType(b.fieldType(moduleName, field))
+		if !field.Nullable {
+			typ = nonNullType(typ)
+		}
+		if err := t.addField(&fieldDef{name: field.Name, typ: typ}); err != nil {
+			return err
+		}
+	}
+
+	if objType.RetainDeletions {
+		err := t.addField(&fieldDef{
+			name:        "_deleted",
+			description: "Whether the object was deleted, deleted objects are retained for this object type.",
+			typ:         nonNullType(namedType(booleanScalar)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	objects := &objectResolver{
+		objectType:   objType,
+		addressCodec: b.addressCodec,
+		pageSizes:    b.pageSizes,
+	}
+
+	var includeDeletedArgs []*inputValueDef
+	if objType.RetainDeletions {
+		includeDeletedArgs = append(includeDeletedArgs, &inputValueDef{
+			name:         "includeDeleted",
+			description:  "Whether to include the deleted objects retained for this object type.",
+			typ:          namedType(booleanScalar),
+			defaultValue: &value{kind: booleanValue, raw: "false"},
+		})
+	}
+
+	if len(objType.KeyFields) == 0 {
+		return modType.addField(&fieldDef{
+			name:        objType.Name,
+			description: fmt.Sprintf("The %s singleton, null if it isn't set.", objType.Name),
+			args:        includeDeletedArgs,
+			typ:         namedType(t.name),
+			resolve:     objects.resolveGet,
+		})
+	}
+
+	// the filter input type has a field for each field of the object type, except JSON fields which can't be compared
+	filter := &typeDef{
+		kind:        inputObjectKind,
+		name:        t.name + "_Filter",
+		description: fmt.Sprintf("Filters %s objects. Only the objects matching all the field filters set are selected.", t.name),
+	}
+	for _, field := range allFields {
+		if field.Kind == schema.JSONKind {
+			continue
+		}
+
+		filterType := b.fieldType(moduleName, field) + "_Filter"
+		if field.Kind != schema.EnumKind {
+			filterType = b.scalarFilterType(b.fieldType(moduleName, field))
+		}
+		filter.inputFields = append(filter.inputFields, &inputValueDef{name: field.Name, typ: namedType(filterType)})
+	}
+	filter.inputFields = append(filter.inputFields,
+		&inputValueDef{name: "_and", description: "Selects the objects matching all the filters.", typ: listType(nonNullType(namedType(filter.name)))},
+		&inputValueDef{name: "_or", description: "Selects the objects matching any of the filters.", typ: listType(nonNullType(namedType(filter.name)))},
+		&inputValueDef{name: "_not", description: "Selects the objects not matching the filter.", typ: namedType(filter.name)},
+	)
+	if err := b.s.addType(filter); err != nil {
+		return err
+	}
+
+	page := &typeDef{
+		kind:        objectKind,
+		name:        t.name + "_Page",
+		description: fmt.Sprintf("A page of %s objects sorted by key.", t.name),
+	}
+	for _, f := range []*fieldDef{
+		{name: "nodes", typ: nonNullType(listType(nonNullType(namedType(t.name))))},
+		{name: "totalCount", description: "The number of objects matching the filter across all pages.", typ: nonNullType(namedType("Int")), resolve: resolveTotalCount},
+		{name: "hasNextPage", typ: nonNullType(namedType(booleanScalar))},
+		{name: "endCursor", description: "The cursor to pass as the after argument to get the next page.", typ: namedType(stringScalar)},
+	} {
+		if err := page.addField(f); err != nil {
+			return err
+		}
+	}
+	b.scalar(intScalar)
+	if err := b.s.addType(page); err != nil {
+		return err
+	}
+
+	listArgs := []*inputValueDef{
+		{name: "where", typ: namedType(filter.name)},
+		{
+			name:         "first",
+			description:  fmt.Sprintf("The maximum number of objects to return, at most %d.", b.pageSizes.maxSize),
+			typ:          namedType("Int"),
+			defaultValue: &value{kind: intValue, raw: fmt.Sprint(b.pageSizes.defaultSize)},
+		},
+		{name: "after", description: "Returns the objects after this cursor.", typ: namedType(stringScalar)},
+	}
+	err := modType.addField(&fieldDef{
+		name:        objType.Name,
+		description: fmt.Sprintf("A page of the %s objects matching the filter.", objType.Name),
+		args:        append(listArgs, includeDeletedArgs...),
+		typ:         nonNullType(namedType(page.name)),
+		resolve:     objects.resolvePage,
+	})
+	if err != nil {
+		return err
+	}
+
+	var keyArgs []*inputValueDef
+	for _, field := range objType.KeyFields {
+		typ := namedType(b.fieldType(moduleName, field))
+		if !field.Nullable {
+			typ = nonNullType(typ)
+		}
+		keyArgs = append(keyArgs, &inputValueDef{name: field.Name, typ: typ})
+	}
+	return modType.addField(&fieldDef{
+		name:        objType.Name + "_by_key",
+		description: fmt.Sprintf("The %s object with the given key, null if it doesn't exist.", objType.Name),
+		args:        append(keyArgs, includeDeletedArgs...),
+		typ:         namedType(t.name),
+		resolve:     objects.resolveGet,
+	})
+}
@@ -0,0 +1,44 @@
+package graphql
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:          true,
+		Address:         "localhost:8081",
+		DefaultPageSize: 100,
+		MaxPageSize:     1000,
+		MaxQueryDepth:   15,
+		MaxQueryCost:    50000,
+	}
+}
+
+type CfgOption func(*Config)
+
+// Config defines configuration for the GraphQL server.
+type Config struct {
+	// Enable defines if the GraphQL server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the GraphQL server should be enabled."`
+	// Address defines the GraphQL server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the GraphQL server address to bind to."`
+	// DefaultPageSize defines the default number of objects returned by list queries.
+	DefaultPageSize int `mapstructure:"default-page-size" toml:"default-page-size" comment:"DefaultPageSize defines the default number of objects returned by list queries."`
+	// MaxPageSize defines the maximum number of objects returned by list queries.
+	MaxPageSize int `mapstructure:"max-page-size" toml:"max-page-size" comment:"MaxPageSize defines the maximum number of objects returned by list queries."`
+	// MaxQueryDepth defines the maximum nesting level of the fields of a query.
+	MaxQueryDepth int `mapstructure:"max-query-depth" toml:"max-query-depth" comment:"MaxQueryDepth defines the maximum nesting level of the fields of a query."`
+	// MaxQueryCost defines the maximum number of fields a query can resolve, the fields of the objects of a list query counting once per requested object.
+	MaxQueryCost int `mapstructure:"max-query-cost" toml:"max-query-cost" comment:"MaxQueryCost defines the maximum number of fields a query can resolve, the fields of the objects of a list query counting once per requested object."`
+}
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Disable the GraphQL server by default (default enabled).
+func Disable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = false
+	}
+}
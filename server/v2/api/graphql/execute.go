@@ -0,0 +1,600 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Request is a GraphQL request as described in https://graphql.github.io/graphql-over-http/.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is a GraphQL response. Data is omitted if the request failed before execution.
+type Response struct {
+	Data   interface{} `json:"data,omitempty"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error with the location of the field in the document and its path in the response.
+type Error struct {
+	Message   string        `json:"message"`
+	Locations []Location    `json:"locations,omitempty"`
+	Path      []interface{} `json:"path,omitempty"`
+}
+
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// orderedMap is a JSON object which preserves the order of its keys, as the fields
+// of a response are ordered like the fields of the query.
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{values: map[string]interface{}{}}
+}
+
+func (m *orderedMap) set(key string, v interface{}) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = v
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyBz, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyBz)
+		buf.WriteByte(':')
+		valueBz, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(valueBz)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// execute executes the query of the request against the schema, resolving the root fields from the root value.
+// The query is rejected if it exceeds the limits.
+func execute(ctx context.Context, s *schemaDef, root interface{}, req Request, limits queryLimits) *Response {
+	doc, err := parseDocument(req.Query)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	e := &executor{ctx: ctx, schema: s, doc: doc}
+	e.variables, err = e.coerceVariables(op, req.Variables)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	if err := e.checkLimits(op, limits); err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	data, ok := e.executeSelectionSet(s.query, root, op.selectionSet, nil)
+	res := &Response{Errors: e.errors}
+	if ok {
+		res.Data = data
+	} else {
+		res.Data = json.RawMessage("null")
+	}
+	return res
+}
+
+func selectOperation(doc *document, name string) (*operation, error) {
+	var op *operation
+	if name == "" {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("operationName is required for documents with multiple operations")
+		}
+		op = doc.operations[0]
+	} else {
+		for _, o := range doc.operations {
+			if o.name == name {
+				op = o
+			}
+		}
+		if op == nil {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+	}
+
+	if op.kind != "query" {
+		return nil, fmt.Errorf("only query operations are supported, got %s", op.kind)
+	}
+	return op, nil
+}
+
+type executor struct {
+	ctx       context.Context
+	schema    *schemaDef
+	doc       *document
+	variables map[string]interface{}
+	errors    []*Error
+}
+
+func (e *executor) addError(f *field, path []interface{}, err error) {
+	e.errors = append(e.errors, &Error{
+		Message:   err.Error(),
+		Locations: []Location{{Line: f.line, Column: f.column}},
+		Path:      append([]interface{}{}, path...),
+	})
+}
+
+func (e *executor) coerceVariables(op *operation, values map[string]interface{}) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	for _, def := range op.variables {
+		t, ok := e.schema.types[def.typ.namedTypeName()]
+		if !ok || (t.kind != scalarKind && t.kind != enumKind && t.kind != inputObjectKind) {
+			return nil, fmt.Errorf("variable $%s has invalid input type %s", def.name, def.typ)
+		}
+
+		v, provided := values[def.name]
+		if !provided && def.defaultValue != nil {
+			// default values are coerced with the arguments they are used in
+			var err error
+			v, err = e.literalValue(def.defaultValue)
+			if err != nil {
+				return nil, err
+			}
+			provided = true
+		}
+
+		if !provided {
+			if def.typ.nonNull {
+				return nil, fmt.Errorf("variable $%s of required type %s was not provided", def.name, def.typ)
+			}
+			continue
+		}
+
+		coerced, err := e.coerceInput(def.typ, normalizeJSON(v))
+		if err != nil {
+			return nil, fmt.Errorf("variable $%s: %w", def.name, err)
+		}
+		res[def.name] = coerced
+	}
+	return res, nil
+}
+
+// normalizeJSON converts the numbers of a value decoded from JSON without UseNumber to json.Number.
+func normalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case float64:
+		return json.Number(fmt.Sprint(v))
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			res[i] = normalizeJSON(item)
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			res[k] = normalizeJSON(item)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// coercedValue is the value of a variable, which was already coerced to the variable type.
+type coercedValue struct {
+	value interface{}
+}
+
+// literalValue converts a document value to a raw input value, substituting variables with their coerced value.
+func (e *executor) literalValue(v *value) (interface{}, error) {
+	switch v.kind {
+	case variableValue:
+		return coercedValue{e.variables[v.raw]}, nil
+	case intValue, floatValue:
+		return json.Number(v.raw), nil
+	case stringValue:
+		return v.raw, nil
+	case booleanValue:
+		return v.raw == "true", nil
+	case nullValue:
+		return nil, nil
+	case enumValue:
+		return enumLiteral(v.raw), nil
+	case listValue:
+		res := make([]interface{}, 0, len(v.list))
+		for _, item := range v.list {
+			x, err := e.literalValue(item)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, x)
+		}
+		return res, nil
+	case objectValue:
+		res := make(map[string]interface{}, len(v.fields))
+		for _, f := range v.fields {
+			x, err := e.literalValue(f.value)
+			if err != nil {
+				return nil, err
+			}
+			res[f.name] = x
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unexpected value %s", v)
+	}
+}
+
+// coerceInput coerces a raw input value to the input type as described in
+// https://spec.graphql.org/October2021/#sec-Input-Values.
+// Scalars are coerced with their parseValue function and enums to the name of their value.
+func (e *executor) coerceInput(t *typeRef, v interface{}) (interface{}, error) {
+	if v, ok := v.(coercedValue); ok {
+		if v.value == nil && t.nonNull {
+			return nil, fmt.Errorf("expected non-null %s", t.elem)
+		}
+		return v.value, nil
+	}
+
+	if t.nonNull {
+		if v == nil {
+			return nil, fmt.Errorf("expected non-null %s", t.elem)
+		}
+		return e.coerceInput(t.elem, v)
+	}
+
+	if v == nil {
+		return nil, nil
+	}
+
+	if t.elem != nil {
+		items, ok := v.([]interface{})
+		if !ok {
+			// a single value is coerced to a list of one item
+			items = []interface{}{v}
+		}
+
+		res := make([]interface{}, len(items))
+		for i, item := range items {
+			var err error
+			res[i], err = e.coerceInput(t.elem, item)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return res, nil
+	}
+
+	def, ok := e.schema.types[t.name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", t.name)
+	}
+
+	switch def.kind {
+	case scalarKind:
+		if _, ok := v.(enumLiteral); ok {
+			return nil, fmt.Errorf("expected %s, got enum value %s", t.name, v)
+		}
+		return def.parseValue(v)
+	case enumKind:
+		var name string
+		switch v := v.(type) {
+		case enumLiteral:
+			name = string(v)
+		case string:
+			name = v
+		default:
+			return nil, fmt.Errorf("expected %s, got %v", t.name, v)
+		}
+		for _, ev := range def.enumValues {
+			if ev == name {
+				return name, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a value of enum %s", name, t.name)
+	case inputObjectKind:
+		fields, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected input object %s, got %v", t.name, v)
+		}
+		for name := range fields {
+			if def.lookupInputField(name) == nil {
+				return nil, fmt.Errorf("unknown field %s of input object %s", name, t.name)
+			}
+		}
+		return e.coerceFields(def.inputFields, fields)
+	default:
+		return nil, fmt.Errorf("%s is not an input type", t.name)
+	}
+}
+
+// coerceFields coerces the raw values of arguments or input object fields, applying default values.
+func (e *executor) coerceFields(defs []*inputValueDef, values map[string]interface{}) (map[string]interface{}, error) {
+	res := make(map[string]interface{}, len(defs))
+	for _, def := range defs {
+		v, provided := values[def.name]
+		if !provided && def.defaultValue != nil {
+			var err error
+			v, err = e.literalValue(def.defaultValue)
+			if err != nil {
+				return nil, err
+			}
+			provided = true
+		}
+
+		if !provided {
+			if def.typ.nonNull {
+				return nil, fmt.Errorf("missing required field %s of type %s", def.name, def.typ)
+			}
+			continue
+		}
+
+		coerced, err := e.coerceInput(def.typ, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", def.name, err)
+		}
+		res[def.name] = coerced
+	}
+	return res, nil
+}
+
+func (e *executor) coerceArguments(defs []*inputValueDef, args []*argument) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(args))
+	for _, arg := range args {
+		found := false
+		for _, def := range defs {
+			found = found || def.name == arg.name
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown argument %s", arg.name)
+		}
+
+		// variables which were not provided are treated as absent arguments
+		if arg.value.kind == variableValue {
+			if _, ok := e.variables[arg.value.raw]; !ok {
+				continue
+			}
+		}
+
+		v, err := e.literalValue(arg.value)
+		if err != nil {
+			return nil, err
+		}
+		values[arg.name] = v
+	}
+	return e.coerceFields(defs, values)
+}
+
+// collectFields collects the fields of the selection set grouped by response key, as described
+// in https://spec.graphql.org/October2021/#CollectFields().
+func (e *executor) collectFields(t *typeDef, sels []selection, keys *[]string, groups map[string][]*field, visited map[string]bool) error {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			include, err := e.shouldInclude(sel.directives)
+			if err != nil || !include {
+				return err
+			}
+			key := sel.responseKey()
+			if _, ok := groups[key]; !ok {
+				*keys = append(*keys, key)
+			}
+			groups[key] = append(groups[key], sel)
+		case *fragmentSpread:
+			include, err := e.shouldInclude(sel.directives)
+			if err != nil {
+				return err
+			}
+			if !include || visited[sel.name] {
+				continue
+			}
+			visited[sel.name] = true
+
+			frag, ok := e.doc.fragments[sel.name]
+			if !ok {
+				return fmt.Errorf("unknown fragment %s", sel.name)
+			}
+			if frag.typeCondition != t.name {
+				continue
+			}
+			if err := e.collectFields(t, frag.selectionSet, keys, groups, visited); err != nil {
+				return err
+			}
+		case *inlineFragment:
+			include, err := e.shouldInclude(sel.directives)
+			if err != nil {
+				return err
+			}
+			if !include || (sel.typeCondition != "" && sel.typeCondition != t.name) {
+				continue
+			}
+			if err := e.collectFields(t, sel.selectionSet, keys, groups, visited); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// shouldInclude evaluates the @skip and @include directives.
+func (e *executor) shouldInclude(dirs []*directive) (bool, error) {
+	for _, dir := range dirs {
+		if dir.name != "skip" && dir.name != "include" {
+			return false, fmt.Errorf("unknown directive @%s", dir.name)
+		}
+
+		args, err := e.coerceArguments([]*inputValueDef{{name: "if", typ: nonNullType(namedType(booleanScalar))}}, dir.arguments)
+		if err != nil {
+			return false, fmt.Errorf("@%s: %w", dir.name, err)
+		}
+		if args["if"].(bool) == (dir.name == "skip") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// executeSelectionSet executes the selection set on the source value of the object type.
+// It returns false if a non-null field is null, which makes the object itself null.
+func (e *executor) executeSelectionSet(t *typeDef, source interface{}, sels []selection, path []interface{}) (*orderedMap, bool) {
+	var keys []string
+	groups := map[string][]*field{}
+	err := e.collectFields(t, sels, &keys, groups, map[string]bool{})
+	if err != nil {
+		e.errors = append(e.errors, &Error{Message: err.Error(), Path: append([]interface{}{}, path...)})
+		return nil, false
+	}
+
+	res := newOrderedMap()
+	for _, key := range keys {
+		if err := e.ctx.Err(); err != nil {
+			e.addError(groups[key][0], append(path, key), err)
+			return nil, false
+		}
+
+		v, nonNull := e.executeField(t, source, groups[key], append(path, key))
+		if v == nil && nonNull {
+			return nil, false
+		}
+		res.set(key, v)
+	}
+	return res, true
+}
+
+// executeField resolves and completes the value of the fields sharing a response key. It returns
+// the value and whether the field is non-null.
+func (e *executor) executeField(t *typeDef, source interface{}, fields []*field, path []interface{}) (interface{}, bool) {
+	f := fields[0]
+	if f.name == "__typename" {
+		return t.name, true
+	}
+
+	def, ok := t.fieldMap[f.name]
+	if !ok && t == e.schema.query {
+		def, ok = introspectionFields[f.name]
+	}
+	if !ok {
+		e.addError(f, path, fmt.Errorf("cannot query field %s on type %s", f.name, t.name))
+		return nil, false
+	}
+
+	args, err := e.coerceArguments(def.args, f.arguments)
+	if err != nil {
+		e.addError(f, path, fmt.Errorf("invalid arguments of field %s: %w", f.name, err))
+		return nil, def.typ.nonNull
+	}
+
+	var v interface{}
+	if def.resolve != nil {
+		if t == e.schema.query && introspectionFields[f.name] == def {
+			source = e.schema
+		}
+		v, err = def.resolve(e.ctx, source, args)
+	} else if m, ok := source.(map[string]interface{}); ok {
+		v = m[f.name]
+	}
+	if err != nil {
+		e.addError(f, path, err)
+		return nil, def.typ.nonNull
+	}
+
+	return e.completeValue(def.typ, fields, v, path, false), def.typ.nonNull
+}
+
+// completeValue completes the resolved value of the fields according to their type. It returns nil
+// if the value is null or if a non-null value is null, in which case the error is recorded once.
+func (e *executor) completeValue(t *typeRef, fields []*field, v interface{}, path []interface{}, nonNull bool) interface{} {
+	if t.nonNull {
+		res := e.completeValue(t.elem, fields, v, path, true)
+		return res
+	}
+
+	if isNil(v) {
+		if nonNull {
+			e.addError(fields[0], path, fmt.Errorf("cannot return null for non-null field %s", fields[0].name))
+		}
+		return nil
+	}
+
+	if t.elem != nil {
+		items, ok := v.([]interface{})
+		if !ok {
+			e.addError(fields[0], path, fmt.Errorf("expected a list for field %s, got %T", fields[0].name, v))
+			return nil
+		}
+
+		res := make([]interface{}, len(items))
+		for i, item := range items {
+			res[i] = e.completeValue(t.elem, fields, item, append(path, i), false)
+			if res[i] == nil && t.elem.nonNull {
+				return nil
+			}
+		}
+		return res
+	}
+
+	def := e.schema.types[t.name]
+	switch def.kind {
+	case objectKind:
+		var sels []selection
+		for _, f := range fields {
+			sels = append(sels, f.selectionSet...)
+		}
+		if len(sels) == 0 {
+			e.addError(fields[0], path, fmt.Errorf("field %s of type %s must have a selection of subfields", fields[0].name, t))
+			return nil
+		}
+
+		res, ok := e.executeSelectionSet(def, v, sels, path)
+		if !ok {
+			return nil
+		}
+		return res
+	default:
+		if len(fields[0].selectionSet) > 0 {
+			e.addError(fields[0], path, fmt.Errorf("field %s of type %s can't have a selection of subfields", fields[0].name, t))
+			return nil
+		}
+		return v
+	}
+}
+
+func isNil(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return v == nil
+	case []interface{}:
+		return v == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,184 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+const (
+	ContentTypeJSON = "application/json"
+	MaxBodySize     = 1 << 20 // 1 MB
+)
+
+// HandlerOptions are the options of the GraphQL handler.
+type HandlerOptions struct {
+	// AddressCodec encodes the address fields, it defaults to a hex address codec.
+	AddressCodec addressutil.AddressCodec
+	// DefaultPageSize is the default number of objects returned by list fields.
+	DefaultPageSize int
+	// MaxPageSize is the maximum number of objects returned by list fields.
+	MaxPageSize int
+	// MaxQueryDepth is the maximum nesting level of the fields of a query.
+	MaxQueryDepth int
+	// MaxQueryCost is the maximum number of fields a query can resolve, the fields of the objects
+	// of a list field counting once per requested object.
+	MaxQueryCost int
+}
+
+// Handler serves GraphQL queries of the indexed app data. Its schema is generated from the schemas
+// of the modules of the app data and is regenerated when the modules change.
+type Handler struct {
+	appData view.AppData
+	opts    HandlerOptions
+
+	mu        sync.Mutex
+	schema    *schemaDef
+	schemaKey string
+}
+
+// NewHandler returns a GraphQL handler querying the app data.
+func NewHandler(appData view.AppData, opts HandlerOptions) *Handler {
+	if opts.AddressCodec == nil {
+		opts.AddressCodec = addressutil.HexAddressCodec{}
+	}
+	if opts.MaxPageSize <= 0 {
+		opts.MaxPageSize = DefaultConfig().MaxPageSize
+	}
+	if opts.DefaultPageSize <= 0 || opts.DefaultPageSize > opts.MaxPageSize {
+		opts.DefaultPageSize = min(DefaultConfig().DefaultPageSize, opts.MaxPageSize)
+	}
+	if opts.MaxQueryDepth <= 0 {
+		opts.MaxQueryDepth = DefaultConfig().MaxQueryDepth
+	}
+	if opts.MaxQueryCost <= 0 {
+		opts.MaxQueryCost = DefaultConfig().MaxQueryCost
+	}
+	return &Handler{appData: appData, opts: opts}
+}
+
+// loadSchema returns the GraphQL schema of the modules of the app data, regenerating it if the modules changed.
+func (h *Handler) loadSchema() (*schemaDef, error) {
+	appState := h.appData.AppState()
+	if appState == nil {
+		return nil, fmt.Errorf("the app data has no state")
+	}
+
+	var (
+		modules []moduleSchema
+		err     error
+	)
+	appState.Modules(func(modState view.ModuleState, modErr error) bool {
+		if modErr != nil {
+			err = modErr
+			return false
+		}
+		modules = append(modules, moduleSchema{name: modState.ModuleName(), schema: modState.ModuleSchema()})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i].name < modules[j].name })
+
+	names := make([]string, len(modules))
+	for i, mod := range modules {
+		names[i] = mod.name
+	}
+	key := strings.Join(names, ",")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.schema != nil && h.schemaKey == key {
+		return h.schema, nil
+	}
+
+	s, err := buildSchema(modules, h.opts.AddressCodec, pageSizes{defaultSize: h.opts.DefaultPageSize, maxSize: h.opts.MaxPageSize})
+	if err != nil {
+		return nil, err
+	}
+	h.schema, h.schemaKey = s, key
+	return s, nil
+}
+
+// Execute executes the GraphQL request. Errors are returned in the response.
+func (h *Handler) Execute(ctx context.Context, req Request) *Response {
+	s, err := h.loadSchema()
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: fmt.Sprintf("failed to load schema: %v", err)}}}
+	}
+	return execute(ctx, s, h.appData, req, queryLimits{maxDepth: h.opts.MaxQueryDepth, maxCost: h.opts.MaxQueryCost})
+}
+
+// WriteSchema writes the GraphQL schema in the schema definition language.
+func (h *Handler) WriteSchema(w io.Writer) error {
+	s, err := h.loadSchema()
+	if err != nil {
+		return err
+	}
+	return s.writeSDL(w)
+}
+
+// ServeHTTP serves GraphQL requests sent as a JSON POST body, or in the query parameters of a GET request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := decodeJSON(strings.NewReader(variables), &req.Variables); err != nil {
+				http.Error(w, fmt.Sprintf("invalid variables: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if contentType := r.Header.Get("Content-Type"); !strings.HasPrefix(contentType, ContentTypeJSON) {
+			http.Error(w, fmt.Sprintf("unsupported content type, expected %s", ContentTypeJSON), http.StatusUnsupportedMediaType)
+			return
+		}
+		if err := decodeJSON(io.LimitReader(r.Body, MaxBodySize), &req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Query == "" {
+		http.Error(w, "missing query", http.StatusBadRequest)
+		return
+	}
+
+	res := h.Execute(r.Context(), req)
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		http.Error(w, fmt.Sprintf("Error encoding response: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// SchemaHandler returns a handler serving the GraphQL schema in the schema definition language.
+func (h *Handler) SchemaHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := h.WriteSchema(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// decodeJSON decodes JSON keeping numbers as json.Number so that 64-bit integers aren't truncated.
+func decodeJSON(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
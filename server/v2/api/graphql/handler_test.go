@@ -0,0 +1,443 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+var testModuleSchema = schema.MustCompileModuleSchema(
+	schema.EnumType{
+		Name: "status",
+		Values: []schema.EnumValueDefinition{
+			{Name: "active", Value: 1},
+			{Name: "inactive", Value: 2},
+		},
+	},
+	schema.StateObjectType{
+		Name: "balances",
+		KeyFields: []schema.Field{
+			{Name: "address", Kind: schema.AddressKind},
+			{Name: "denom", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "amount", Kind: schema.Int64Kind},
+		},
+	},
+	schema.StateObjectType{
+		Name: "params",
+		ValueFields: []schema.Field{
+			{Name: "enabled", Kind: schema.BoolKind},
+			{Name: "status", Kind: schema.EnumKind, ReferencedType: "status"},
+		},
+	},
+	schema.StateObjectType{
+		Name: "proposals",
+		KeyFields: []schema.Field{
+			{Name: "id", Kind: schema.Uint64Kind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "submitTime", Kind: schema.TimeKind},
+			{Name: "metadata", Kind: schema.JSONKind, Nullable: true},
+		},
+		RetainDeletions: true,
+	},
+)
+
+func testAppData() *fakeAppData {
+	addr := func(b byte) []byte { return []byte{b, b} }
+	return &fakeAppData{
+		blockNum: 7,
+		modules: []*fakeModule{{
+			name:   "bank",
+			schema: testModuleSchema,
+			objects: map[string][]schema.StateObjectUpdate{
+				"balances": {
+					{TypeName: "balances", Key: []interface{}{addr(1), "atom"}, Value: int64(10)},
+					{TypeName: "balances", Key: []interface{}{addr(1), "stake"}, Value: int64(20)},
+					{TypeName: "balances", Key: []interface{}{addr(2), "atom"}, Value: int64(30)},
+					{TypeName: "balances", Key: []interface{}{addr(3), "stake"}, Value: int64(40)},
+				},
+				"params": {
+					{TypeName: "params", Value: []interface{}{true, "active"}},
+				},
+				"proposals": {
+					{TypeName: "proposals", Key: uint64(1), Value: []interface{}{time.Unix(1, 5).UTC(), json.RawMessage(`{"title":"a"}`)}},
+					{TypeName: "proposals", Key: uint64(2), Value: []interface{}{time.Unix(2, 0).UTC(), nil}, Delete: true},
+				},
+			},
+		}},
+	}
+}
+
+func query(t *testing.T, h *Handler, q string, variables map[string]interface{}) (map[string]interface{}, []*Error) {
+	t.Helper()
+	res := h.Execute(context.Background(), Request{Query: q, Variables: variables})
+	bz, err := json.Marshal(res)
+	require.NoError(t, err)
+
+	var decoded struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []*Error               `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	return decoded.Data, decoded.Errors
+}
+
+func TestQueryObjects(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{DefaultPageSize: 2, MaxPageSize: 3})
+
+	data, errs := query(t, h, `{
+		blockNum
+		bank {
+			params { enabled status }
+			balances(where: {denom: {eq: "atom"}}) { nodes { address denom amount } totalCount hasNextPage }
+		}
+	}`, nil)
+	require.Empty(t, errs)
+	require.Equal(t, "7", data["blockNum"])
+	bank := data["bank"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{"enabled": true, "status": "active"}, bank["params"])
+	require.Equal(t, map[string]interface{}{
+		"nodes": []interface{}{
+			map[string]interface{}{"address": "0x0101", "denom": "atom", "amount": "10"},
+			map[string]interface{}{"address": "0x0202", "denom": "atom", "amount": "30"},
+		},
+		"totalCount":  float64(2),
+		"hasNextPage": false,
+	}, bank["balances"])
+
+	// pages follow the cursor of the previous page
+	q := `query Page($after: String) {
+		bank { balances(after: $after) { nodes { denom amount } hasNextPage endCursor } }
+	}`
+	data, errs = query(t, h, q, nil)
+	require.Empty(t, errs)
+	page := data["bank"].(map[string]interface{})["balances"].(map[string]interface{})
+	require.Len(t, page["nodes"], 2)
+	require.Equal(t, true, page["hasNextPage"])
+
+	data, errs = query(t, h, q, map[string]interface{}{"after": page["endCursor"]})
+	require.Empty(t, errs)
+	page = data["bank"].(map[string]interface{})["balances"].(map[string]interface{})
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"denom": "atom", "amount": "30"},
+		map[string]interface{}{"denom": "stake", "amount": "40"},
+	}, page["nodes"])
+	require.Equal(t, false, page["hasNextPage"])
+
+	_, errs = query(t, h, `{ bank { balances(first: 4) { totalCount } } }`, nil)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Message, "first must be between 0 and 3")
+}
+
+func TestQueryKeyRangeCollection(t *testing.T) {
+	appData := testAppData()
+	reads := 0
+	appData.modules[0].reads = &reads
+	h := NewHandler(appData, HandlerOptions{})
+
+	// only the objects of the page and the next one are read
+	q := `query Page($after: String) {
+		bank { balances(first: 1, after: $after, where: {denom: {eq: "atom"}}) { nodes { amount } hasNextPage endCursor } }
+	}`
+	data, errs := query(t, h, q, nil)
+	require.Empty(t, errs)
+	page := data["bank"].(map[string]interface{})["balances"].(map[string]interface{})
+	require.Equal(t, []interface{}{map[string]interface{}{"amount": "10"}}, page["nodes"])
+	require.Equal(t, true, page["hasNextPage"])
+	require.Equal(t, 3, reads)
+
+	reads = 0
+	data, errs = query(t, h, q, map[string]interface{}{"after": page["endCursor"]})
+	require.Empty(t, errs)
+	page = data["bank"].(map[string]interface{})["balances"].(map[string]interface{})
+	require.Equal(t, []interface{}{map[string]interface{}{"amount": "30"}}, page["nodes"])
+	require.Equal(t, false, page["hasNextPage"])
+	require.Equal(t, 3, reads)
+
+	// the total count scans the collection
+	data, errs = query(t, h, `{ bank { balances(first: 1, where: {denom: {eq: "atom"}}) { totalCount } } }`, nil)
+	require.Empty(t, errs)
+	require.Equal(t, map[string]interface{}{"totalCount": float64(2)}, data["bank"].(map[string]interface{})["balances"])
+}
+
+func TestQueryLimits(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{MaxQueryDepth: 4, MaxQueryCost: 20})
+
+	_, errs := query(t, h, `{ bank { balances(first: 5) { nodes { address amount } } } }`, nil)
+	require.Empty(t, errs)
+
+	testCases := []struct {
+		query string
+		error string
+	}{
+		{`{ bank { balances(first: 5) { nodes { address amount denom } } } }`, "query cost exceeds the maximum of 20"},
+		{`query ($n: Int) { bank { balances(first: $n) { totalCount } } }`, "query cost exceeds the maximum of 20"},
+		{`{ __schema { types { fields { type { name } } } } }`, "query depth exceeds the maximum of 4"},
+		{`{ bank { ...f } } fragment f on bank_Module { balances { nodes { address } } }`, "query cost exceeds the maximum of 20"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			_, errs := query(t, h, tc.query, map[string]interface{}{"n": 100})
+			require.Len(t, errs, 1)
+			require.Contains(t, errs[0].Message, tc.error)
+		})
+	}
+}
+
+func TestQueryFilters(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	testCases := []struct {
+		where   string
+		amounts []interface{}
+	}{
+		{`{amount: {gt: "10", lte: "30"}}`, []interface{}{"20", "30"}},
+		{`{amount: {in: ["10", "40"]}}`, []interface{}{"10", "40"}},
+		{`{address: {eq: "0x0101"}, denom: {ne: "atom"}}`, []interface{}{"20"}},
+		{`{_or: [{amount: {eq: "10"}}, {denom: {eq: "stake"}, amount: {gte: "40"}}]}`, []interface{}{"10", "40"}},
+		{`{_not: {denom: {eq: "atom"}}}`, []interface{}{"20", "40"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.where, func(t *testing.T) {
+			data, errs := query(t, h, fmt.Sprintf(`{ bank { balances(where: %s) { nodes { amount } } } }`, tc.where), nil)
+			require.Empty(t, errs)
+
+			var amounts []interface{}
+			for _, node := range data["bank"].(map[string]interface{})["balances"].(map[string]interface{})["nodes"].([]interface{}) {
+				amounts = append(amounts, node.(map[string]interface{})["amount"])
+			}
+			require.Equal(t, tc.amounts, amounts)
+		})
+	}
+}
+
+func TestQueryByKeyAndDeletions(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	data, errs := query(t, h, `query ($addr: Address!) {
+		bank {
+			balance: balances_by_key(address: $addr, denom: "stake") { amount }
+			missing: balances_by_key(address: "0x0909", denom: "stake") { amount }
+			proposals { totalCount }
+			all: proposals(includeDeleted: true) { nodes { id submitTime metadata _deleted } }
+			deleted: proposals_by_key(id: "2") { id }
+		}
+	}`, map[string]interface{}{"addr": "0x0101"})
+	require.Empty(t, errs)
+	bank := data["bank"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{"amount": "20"}, bank["balance"])
+	require.Nil(t, bank["missing"])
+	require.Nil(t, bank["deleted"])
+	require.Equal(t, map[string]interface{}{"totalCount": float64(1)}, bank["proposals"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"id": "1", "submitTime": "1970-01-01T00:00:01.000000005Z", "metadata": map[string]interface{}{"title": "a"}, "_deleted": false},
+		map[string]interface{}{"id": "2", "submitTime": "1970-01-01T00:00:02Z", "metadata": nil, "_deleted": true},
+	}, bank["all"].(map[string]interface{})["nodes"])
+}
+
+func TestQueryErrors(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	testCases := []struct {
+		query string
+		error string
+	}{
+		{`{ bank { unknown } }`, "cannot query field unknown on type bank_Module"},
+		{`{ bank { balances(where: {amount: {eq: "x"}}) { totalCount } } }`, "invalid arguments of field balances"},
+		{`{ bank { params { status(x: 1) } } }`, "unknown argument x"},
+		{`{ bank { params } }`, "must have a selection of subfields"},
+		{`mutation { bank }`, "only query operations are supported"},
+		{`{ bank {`, "syntax error"},
+		{`query ($id: Uint64!) { bank { proposals_by_key(id: $id) { id } } }`, "variable $id of required type Uint64! was not provided"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			_, errs := query(t, h, tc.query, nil)
+			require.NotEmpty(t, errs)
+			require.Contains(t, errs[0].Message, tc.error)
+		})
+	}
+}
+
+func TestFragmentsAndDirectives(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	data, errs := query(t, h, `query ($skip: Boolean!) {
+		bank {
+			__typename
+			params { ...Params enabled @skip(if: $skip) }
+			... on bank_Module { p: params { status } }
+		}
+	}
+	fragment Params on bank_params { s: status }`, map[string]interface{}{"skip": true})
+	require.Empty(t, errs)
+	require.Equal(t, map[string]interface{}{
+		"__typename": "bank_Module",
+		"params":     map[string]interface{}{"s": "active"},
+		"p":          map[string]interface{}{"status": "active"},
+	}, data["bank"])
+}
+
+func TestIntrospection(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	data, errs := query(t, h, `{
+		__schema { queryType { name } }
+		__type(name: "bank_balances_Page") {
+			kind
+			fields { name type { kind ofType { name } } }
+		}
+	}`, nil)
+	require.Empty(t, errs)
+	require.Equal(t, map[string]interface{}{"queryType": map[string]interface{}{"name": "Query"}}, data["__schema"])
+	typ := data["__type"].(map[string]interface{})
+	require.Equal(t, "OBJECT", typ["kind"])
+	require.Equal(t, map[string]interface{}{
+		"name": "totalCount",
+		"type": map[string]interface{}{"kind": "NON_NULL", "ofType": map[string]interface{}{"name": "Int"}},
+	}, typ["fields"].([]interface{})[1])
+
+	var sdl strings.Builder
+	require.NoError(t, h.WriteSchema(&sdl))
+	require.Contains(t, sdl.String(), "balances(where: bank_balances_Filter, first: Int = 100, after: String): bank_balances_Page!")
+	require.Contains(t, sdl.String(), "proposals_by_key(id: Uint64!, includeDeleted: Boolean = false): bank_proposals")
+	require.NotContains(t, sdl.String(), "__Type")
+}
+
+func TestServeHTTP(t *testing.T) {
+	h := NewHandler(testAppData(), HandlerOptions{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "query ($id: Uint64) { bank { proposals_by_key(id: $id) { id } } }", "variables": {"id": 1}}`)))
+	require.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "query ($id: Uint64) { bank { proposals_by_key(id: $id) { id } } }", "variables": {"id": 1}}`))
+	req.Header.Set("Content-Type", ContentTypeJSON)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data": {"bank": {"proposals_by_key": {"id": "1"}}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query={blockNum}", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data": {"blockNum": "7"}}`, rec.Body.String())
+}
+
+type fakeAppData struct {
+	blockNum uint64
+	modules  []*fakeModule
+}
+
+func (a *fakeAppData) BlockNum() (uint64, error) { return a.blockNum, nil }
+
+func (a *fakeAppData) AppState() view.AppState { return a }
+
+func (a *fakeAppData) GetModule(moduleName string) (view.ModuleState, error) {
+	for _, mod := range a.modules {
+		if mod.name == moduleName {
+			return mod, nil
+		}
+	}
+	return nil, nil
+}
+
+func (a *fakeAppData) Modules(f func(modState view.ModuleState, err error) bool) {
+	for _, mod := range a.modules {
+		if !f(mod, nil) {
+			return
+		}
+	}
+}
+
+func (a *fakeAppData) NumModules() (int, error) { return len(a.modules), nil }
+
+type fakeModule struct {
+	name    string
+	schema  schema.ModuleSchema
+	objects map[string][]schema.StateObjectUpdate
+	// reads, if not nil, makes the collections KeyRangeCollection's counting the objects they read
+	reads *int
+}
+
+func (m *fakeModule) ModuleName() string { return m.name }
+
+func (m *fakeModule) ModuleSchema() schema.ModuleSchema { return m.schema }
+
+func (m *fakeModule) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	typ, ok := m.schema.LookupStateObjectType(objectType)
+	if !ok {
+		return nil, nil
+	}
+	coll := &fakeCollection{typ: typ, objects: m.objects[objectType]}
+	if m.reads != nil {
+		return &fakeRangeCollection{fakeCollection: coll, reads: m.reads}, nil
+	}
+	return coll, nil
+}
+
+func (m *fakeModule) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		return f(&fakeCollection{typ: typ, objects: m.objects[typ.Name]}, nil)
+	})
+}
+
+func (m *fakeModule) NumObjectCollections() (int, error) { return len(m.objects), nil }
+
+type fakeCollection struct {
+	typ     schema.StateObjectType
+	objects []schema.StateObjectUpdate
+}
+
+func (c *fakeCollection) ObjectType() schema.StateObjectType { return c.typ }
+
+func (c *fakeCollection) GetObject(key interface{}) (schema.StateObjectUpdate, bool, error) {
+	for _, obj := range c.objects {
+		if len(c.typ.KeyFields) == 0 || fmt.Sprint(obj.Key) == fmt.Sprint(key) {
+			return obj, true, nil
+		}
+	}
+	return schema.StateObjectUpdate{}, false, nil
+}
+
+func (c *fakeCollection) AllState(f func(schema.StateObjectUpdate, error) bool) {
+	for _, obj := range c.objects {
+		if !f(obj, nil) {
+			return
+		}
+	}
+}
+
+func (c *fakeCollection) Len() (int, error) { return len(c.objects), nil }
+
+// fakeRangeCollection is a fakeCollection whose objects are sorted by key.
+type fakeRangeCollection struct {
+	*fakeCollection
+	reads *int
+}
+
+func (c *fakeRangeCollection) AllStateAfter(after interface{}, f func(schema.StateObjectUpdate, error) bool) {
+	found := after == nil
+	for _, obj := range c.objects {
+		if !found {
+			found = fmt.Sprint(obj.Key) == fmt.Sprint(after)
+			continue
+		}
+		*c.reads++
+		if !f(obj, nil) {
+			return
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package graphql
+
+import (
+	"context"
+)
+
+// introspectionFields are the meta fields of the query type described in
+// https://spec.graphql.org/October2021/#sec-Schema-Introspection. Their resolvers get the schema as source.
+var introspectionFields = map[string]*fieldDef{
+	"__schema": {
+		name: "__schema",
+		typ:  nonNullType(namedType("__Schema")),
+		resolve: func(_ context.Context, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return source.(*schemaDef).introspection, nil
+		},
+	},
+	"__type": {
+		name: "__type",
+		args: []*inputValueDef{{name: "name", typ: nonNullType(namedType(stringScalar))}},
+		typ:  namedType("__Type"),
+		resolve: func(_ context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+			for _, t := range source.(*schemaDef).introspection["types"].([]interface{}) {
+				if t := t.(map[string]interface{}); t["name"] == args["name"] {
+					return t, nil
+				}
+			}
+			return nil, nil
+		},
+	},
+}
+
+// introspectionTypes returns the types of the introspection system. All their fields are
+// resolved from the maps built by introspectSchema.
+func introspectionTypes() []*typeDef {
+	includeDeprecated := []*inputValueDef{{
+		name:         "includeDeprecated",
+		typ:          namedType(booleanScalar),
+		defaultValue: &value{kind: booleanValue, raw: "false"},
+	}}
+	str := namedType(stringScalar)
+	nonNullBool := nonNullType(namedType(booleanScalar))
+	listOf := func(name string) *typeRef {
+		return listType(nonNullType(namedType(name)))
+	}
+
+	return []*typeDef{
+		{
+			kind: objectKind,
+			name: "__Schema",
+			fields: []*fieldDef{
+				{name: "description", typ: str},
+				{name: "types", typ: nonNullType(listOf("__Type"))},
+				{name: "queryType", typ: nonNullType(namedType("__Type"))},
+				{name: "mutationType", typ: namedType("__Type")},
+				{name: "subscriptionType", typ: namedType("__Type")},
+				{name: "directives", typ: nonNullType(listOf("__Directive"))},
+			},
+		},
+		{
+			kind: objectKind,
+			name: "__Type",
+			fields: []*fieldDef{
+				{name: "kind", typ: nonNullType(namedType("__TypeKind"))},
+				{name: "name", typ: str},
+				{name: "description", typ: str},
+				{name: "specifiedByURL", typ: str},
+				{name: "fields", args: includeDeprecated, typ: listOf("__Field")},
+				{name: "interfaces", typ: listOf("__Type")},
+				{name: "possibleTypes", typ: listOf("__Type")},
+				{name: "enumValues", args: includeDeprecated, typ: listOf("__EnumValue")},
+				{name: "inputFields", args: includeDeprecated, typ: listOf("__InputValue")},
+				{name: "ofType", typ: namedType("__Type")},
+			},
+		},
+		{
+			kind: objectKind,
+			name: "__Field",
+			fields: []*fieldDef{
+				{name: "name", typ: nonNullType(str)},
+				{name: "description", typ: str},
+				{name: "args", args: includeDeprecated, typ: nonNullType(listOf("__InputValue"))},
+				{name: "type", typ: nonNullType(namedType("__Type"))},
+				{name: "isDeprecated", typ: nonNullBool},
+				{name: "deprecationReason", typ: str},
+			},
+		},
+		{
+			kind: objectKind,
+			name: "__InputValue",
+			fields: []*fieldDef{
+				{name: "name", typ: nonNullType(str)},
+				{name: "description", typ: str},
+				{name: "type", typ: nonNullType(namedType("__Type"))},
+				{name: "defaultValue", typ: str},
+				{name: "isDeprecated", typ: nonNullBool},
+				{name: "deprecationReason", typ: str},
+			},
+		},
+		{
+			kind: objectKind,
+			name: "__EnumValue",
+			fields: []*fieldDef{
+				{name: "name", typ: nonNullType(str)},
+				{name: "description", typ: str},
+				{name: "isDeprecated", typ: nonNullBool},
+				{name: "deprecationReason", typ: str},
+			},
+		},
+		{
+			kind: objectKind,
+			name: "__Directive",
+			fields: []*fieldDef{
+				{name: "name", typ: nonNullType(str)},
+				{name: "description", typ: str},
+				{name: "locations", typ: nonNullType(listOf("__DirectiveLocation"))},
+				{name: "args", args: includeDeprecated, typ: nonNullType(listOf("__InputValue"))},
+				{name: "isRepeatable", typ: nonNullBool},
+			},
+		},
+		{
+			kind:       enumKind,
+			name:       "__TypeKind",
+			enumValues: []string{"SCALAR", "OBJECT", "INTERFACE", "UNION", "ENUM", "INPUT_OBJECT", "LIST", "NON_NULL"},
+		},
+		{
+			kind: enumKind,
+			name: "__DirectiveLocation",
+			enumValues: []string{
+				"QUERY", "MUTATION", "SUBSCRIPTION", "FIELD", "FRAGMENT_DEFINITION", "FRAGMENT_SPREAD",
+				"INLINE_FRAGMENT", "VARIABLE_DEFINITION",
+			},
+		},
+	}
+}
+
+// introspectSchema adds the introspection types to the schema and returns the value of the __schema meta field.
+func introspectSchema(s *schemaDef) map[string]interface{} {
+	for _, t := range introspectionTypes() {
+		t.fieldMap = map[string]*fieldDef{}
+		for _, f := range t.fields {
+			t.fieldMap[f.name] = f
+		}
+		s.types[t.name] = t
+	}
+
+	sorted := s.sortedTypes()
+	typeMaps := make(map[string]map[string]interface{}, len(sorted))
+	for _, t := range sorted {
+		typeMaps[t.name] = map[string]interface{}{
+			"kind":        string(t.kind),
+			"name":        t.name,
+			"description": optionalString(t.description),
+		}
+	}
+
+	var typeOf func(t *typeRef) map[string]interface{}
+	typeOf = func(t *typeRef) map[string]interface{} {
+		switch {
+		case t.nonNull:
+			return map[string]interface{}{"kind": "NON_NULL", "ofType": typeOf(t.elem)}
+		case t.elem != nil:
+			return map[string]interface{}{"kind": "LIST", "ofType": typeOf(t.elem)}
+		default:
+			return typeMaps[t.name]
+		}
+	}
+
+	inputValues := func(defs []*inputValueDef) []interface{} {
+		res := make([]interface{}, 0, len(defs))
+		for _, def := range defs {
+			var defaultValue interface{}
+			if def.defaultValue != nil {
+				defaultValue = def.defaultValue.String()
+			}
+			res = append(res, map[string]interface{}{
+				"name":         def.name,
+				"description":  optionalString(def.description),
+				"type":         typeOf(def.typ),
+				"defaultValue": defaultValue,
+				"isDeprecated": false,
+			})
+		}
+		return res
+	}
+
+	types := make([]interface{}, 0, len(sorted))
+	for _, t := range sorted {
+		m := typeMaps[t.name]
+		switch t.kind {
+		case objectKind:
+			fields := make([]interface{}, 0, len(t.fields))
+			for _, f := range t.fields {
+				fields = append(fields, map[string]interface{}{
+					"name":         f.name,
+					"description":  optionalString(f.description),
+					"args":         inputValues(f.args),
+					"type":         typeOf(f.typ),
+					"isDeprecated": false,
+				})
+			}
+			m["fields"] = fields
+			m["interfaces"] = []interface{}{}
+		case enumKind:
+			values := make([]interface{}, 0, len(t.enumValues))
+			for _, v := range t.enumValues {
+				values = append(values, map[string]interface{}{"name": v, "isDeprecated": false})
+			}
+			m["enumValues"] = values
+		case inputObjectKind:
+			m["inputFields"] = inputValues(t.inputFields)
+		}
+		types = append(types, m)
+	}
+
+	ifArg := []*inputValueDef{{name: "if", typ: nonNullType(namedType(booleanScalar))}}
+	directive := func(name, description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":         name,
+			"description":  description,
+			"locations":    []interface{}{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+			"args":         inputValues(ifArg),
+			"isRepeatable": false,
+		}
+	}
+
+	return map[string]interface{}{
+		"types":     types,
+		"queryType": typeMaps[s.query.name],
+		"directives": []interface{}{
+			directive("include", "Directs the executor to include this field or fragment only when the `if` argument is true."),
+			directive("skip", "Directs the executor to skip this field or fragment when the `if` argument is true."),
+		},
+	}
+}
+
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
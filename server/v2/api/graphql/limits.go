@@ -0,0 +1,96 @@
+package graphql
+
+import "fmt"
+
+// queryLimits bounds the work done to execute a query, they are checked before the execution.
+type queryLimits struct {
+	// maxDepth is the maximum nesting level of the fields.
+	maxDepth int
+	// maxCost is the maximum number of fields which can be resolved.
+	maxCost int
+}
+
+// checkLimits checks that the depth and the cost of the operation don't exceed the limits.
+// The cost is the number of fields which can be resolved, the subfields of a field with a first
+// argument counting once per requested object.
+func (e *executor) checkLimits(op *operation, limits queryLimits) error {
+	_, err := e.selectionCost(e.schema.query, op.selectionSet, 1, limits, map[string]bool{})
+	return err
+}
+
+func (e *executor) selectionCost(t *typeDef, sels []selection, depth int, limits queryLimits, spreads map[string]bool) (int, error) {
+	if depth > limits.maxDepth {
+		return 0, fmt.Errorf("query depth exceeds the maximum of %d", limits.maxDepth)
+	}
+
+	cost := 0
+	for _, sel := range sels {
+		var (
+			selCost int
+			err     error
+		)
+		switch sel := sel.(type) {
+		case *field:
+			selCost, err = e.fieldCost(t, sel, depth, limits, spreads)
+		case *fragmentSpread:
+			// fragment cycles are reported by the execution
+			frag, ok := e.doc.fragments[sel.name]
+			if !ok || spreads[sel.name] {
+				continue
+			}
+			spreads[sel.name] = true
+			selCost, err = e.selectionCost(e.conditionType(t, frag.typeCondition), frag.selectionSet, depth, limits, spreads)
+			delete(spreads, sel.name)
+		case *inlineFragment:
+			selCost, err = e.selectionCost(e.conditionType(t, sel.typeCondition), sel.selectionSet, depth, limits, spreads)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		cost += selCost
+		if cost > limits.maxCost {
+			return 0, fmt.Errorf("query cost exceeds the maximum of %d", limits.maxCost)
+		}
+	}
+	return cost, nil
+}
+
+func (e *executor) fieldCost(t *typeDef, f *field, depth int, limits queryLimits, spreads map[string]bool) (int, error) {
+	def, ok := t.fieldMap[f.name]
+	if !ok && t == e.schema.query {
+		def, ok = introspectionFields[f.name]
+	}
+	if !ok || len(f.selectionSet) == 0 {
+		return 1, nil
+	}
+
+	fieldType, ok := e.schema.types[def.typ.namedTypeName()]
+	if !ok {
+		return 1, nil
+	}
+
+	subCost, err := e.selectionCost(fieldType, f.selectionSet, depth+1, limits, spreads)
+	if err != nil {
+		return 0, err
+	}
+
+	// invalid arguments are reported by the execution
+	if args, err := e.coerceArguments(def.args, f.arguments); err == nil {
+		if first, ok := args["first"].(int64); ok && first > 1 {
+			if int64(subCost) > int64(limits.maxCost)/first {
+				return 0, fmt.Errorf("query cost exceeds the maximum of %d", limits.maxCost)
+			}
+			subCost *= int(first)
+		}
+	}
+	return 1 + subCost, nil
+}
+
+// conditionType returns the type of a fragment type condition, or the parent type if there is none.
+func (e *executor) conditionType(t *typeDef, typeCondition string) *typeDef {
+	if def, ok := e.schema.types[typeCondition]; ok {
+		return def
+	}
+	return t
+}
@@ -0,0 +1,548 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+// objectResolver resolves the objects of an object type from the object collection of its module.
+type objectResolver struct {
+	objectType   schema.StateObjectType
+	addressCodec addressutil.AddressCodec
+	pageSizes    pageSizes
+}
+
+// objectEntry is an object of a collection with its key and all its field values in their Go encoding.
+type objectEntry struct {
+	key     []interface{}
+	values  map[string]interface{}
+	deleted bool
+}
+
+// predicate returns whether the field values of an object match a filter.
+type predicate func(values map[string]interface{}) bool
+
+func (r *objectResolver) collection(source interface{}) (view.ObjectCollection, error) {
+	coll, err := source.(view.ModuleState).GetObjectCollection(r.objectType.Name)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, fmt.Errorf("object collection %s not found", r.objectType.Name)
+	}
+	return coll, nil
+}
+
+// resolveGet resolves the object with the key passed as arguments, or the singleton object if the object type has no key.
+func (r *objectResolver) resolveGet(_ context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+	coll, err := r.collection(source)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]interface{}, len(r.objectType.KeyFields))
+	for i, field := range r.objectType.KeyFields {
+		if args[field.Name] == nil {
+			continue
+		}
+		keys[i], err = toKindValue(field.Kind, args[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key field %s: %w", field.Name, err)
+		}
+	}
+
+	var key interface{}
+	switch len(keys) {
+	case 0:
+	case 1:
+		key = keys[0]
+	default:
+		key = keys
+	}
+
+	update, found, err := coll.GetObject(key)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	entry, err := r.entry(update)
+	if err != nil {
+		return nil, err
+	}
+	if entry.deleted && args["includeDeleted"] != true {
+		return nil, nil
+	}
+	return r.encode(entry)
+}
+
+// KeyRangeCollection is an optional interface of view.ObjectCollection for collections which can iterate
+// over their objects sorted by key, such as the ones of the SQL indexer targets. Pages of these collections
+// only read the objects they return instead of scanning the whole collection.
+type KeyRangeCollection interface {
+	// AllStateAfter iterates over the objects sorted by key, starting after the given key or from the first
+	// object if it is nil. Keys have the format of view.ObjectCollection.GetObject.
+	AllStateAfter(after interface{}, f func(schema.StateObjectUpdate, error) bool)
+}
+
+// resolvePage resolves a page of the objects matching the filter sorted by key.
+// The cursor, filter and page size are applied while iterating over the collection, which is only scanned
+// in full if it isn't a KeyRangeCollection or if the total count is queried.
+func (r *objectResolver) resolvePage(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+	first, _ := args["first"].(int64)
+	if first < 0 || first > int64(r.pageSizes.maxSize) {
+		return nil, fmt.Errorf("first must be between 0 and %d", r.pageSizes.maxSize)
+	}
+	limit := int(first)
+
+	match := func(map[string]interface{}) bool { return true }
+	where, hasFilter := args["where"].(map[string]interface{})
+	if hasFilter {
+		var err error
+		match, err = r.compileFilter(where)
+		if err != nil {
+			return nil, err
+		}
+	}
+	includeDeleted := args["includeDeleted"] == true
+	selected := func(entry *objectEntry) bool {
+		return (!entry.deleted || includeDeleted) && match(entry.values)
+	}
+
+	var after []interface{}
+	if cursor, ok := args["after"].(string); ok {
+		var err error
+		after, err = r.decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	coll, err := r.collection(source)
+	if err != nil {
+		return nil, err
+	}
+
+	// entries holds the first limit+1 selected objects after the cursor, the last one only tells if there is a next page
+	var entries []*objectEntry
+	rangeColl, sorted := coll.(KeyRangeCollection)
+	if sorted {
+		var afterKey interface{}
+		if after != nil {
+			afterKey = r.collectionKey(after)
+		}
+		err = r.iterate(ctx, func(f func(schema.StateObjectUpdate, error) bool) { rangeColl.AllStateAfter(afterKey, f) }, func(entry *objectEntry) bool {
+			if selected(entry) {
+				entries = append(entries, entry)
+			}
+			return len(entries) <= limit
+		})
+	} else {
+		err = r.iterate(ctx, coll.AllState, func(entry *objectEntry) bool {
+			if !selected(entry) || (after != nil && r.compareKeys(entry.key, after) <= 0) {
+				return true
+			}
+
+			// insert the entry in the sorted entries if it is among the limit+1 first ones
+			i := sort.Search(len(entries), func(i int) bool {
+				return r.compareKeys(entries[i].key, entry.key) > 0
+			})
+			if i <= limit {
+				entries = append(entries, nil)
+				copy(entries[i+1:], entries[i:])
+				entries[i] = entry
+				entries = entries[:min(len(entries), limit+1)]
+			}
+			return true
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(entries) > limit
+	entries = entries[:min(len(entries), limit)]
+
+	nodes := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		node, err := r.encode(entry)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	var endCursor interface{}
+	if len(entries) > 0 {
+		endCursor, err = r.encodeCursor(entries[len(entries)-1].key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the total count is only computed if it is queried, see resolveTotalCount
+	totalCount := func(ctx context.Context) (int, error) {
+		if !hasFilter && (includeDeleted || !r.objectType.RetainDeletions) {
+			return coll.Len()
+		}
+
+		n := 0
+		err := r.iterate(ctx, coll.AllState, func(entry *objectEntry) bool {
+			if selected(entry) {
+				n++
+			}
+			return true
+		})
+		return n, err
+	}
+
+	return map[string]interface{}{
+		"nodes":       nodes,
+		"totalCount":  totalCount,
+		"hasNextPage": hasNextPage,
+		"endCursor":   endCursor,
+	}, nil
+}
+
+// resolveTotalCount resolves the total count of a page returned by resolvePage, which requires a scan of
+// the collection unless it is the number of objects of the collection.
+func resolveTotalCount(ctx context.Context, source interface{}, _ map[string]interface{}) (interface{}, error) {
+	totalCount, ok := source.(map[string]interface{})["totalCount"].(func(context.Context) (int, error))
+	if !ok {
+		return nil, fmt.Errorf("unexpected page source %T", source)
+	}
+	return totalCount(ctx)
+}
+
+// iterate decodes the objects of the iteration and calls f with each of them until it returns false.
+func (r *objectResolver) iterate(ctx context.Context, iter func(func(schema.StateObjectUpdate, error) bool), f func(*objectEntry) bool) error {
+	var err error
+	iter(func(update schema.StateObjectUpdate, iterErr error) bool {
+		if err = iterErr; err != nil {
+			return false
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+
+		var entry *objectEntry
+		entry, err = r.entry(update)
+		if err != nil {
+			return false
+		}
+		return f(entry)
+	})
+	return err
+}
+
+// collectionKey converts the key fields of an object key to the key format of view.ObjectCollection.
+func (r *objectResolver) collectionKey(key []interface{}) interface{} {
+	if len(key) == 1 {
+		return key[0]
+	}
+	return key
+}
+
+// entry decodes the key and value fields of the object update.
+func (r *objectResolver) entry(update schema.StateObjectUpdate) (*objectEntry, error) {
+	entry := &objectEntry{values: map[string]interface{}{}, deleted: update.Delete}
+
+	keyFields := r.objectType.KeyFields
+	switch len(keyFields) {
+	case 0:
+	case 1:
+		entry.key = []interface{}{update.Key}
+	default:
+		keys, ok := update.Key.([]interface{})
+		if !ok || len(keys) != len(keyFields) {
+			return nil, fmt.Errorf("expected key of %s to be a slice of %d values, got %v", r.objectType.Name, len(keyFields), update.Key)
+		}
+		entry.key = keys
+	}
+	for i, field := range keyFields {
+		entry.values[field.Name] = entry.key[i]
+	}
+
+	valueFields := r.objectType.ValueFields
+	switch value := update.Value.(type) {
+	case nil:
+	case schema.ValueUpdates:
+		err := value.Iterate(func(name string, v interface{}) bool {
+			entry.values[name] = v
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		if len(valueFields) == 1 {
+			entry.values[valueFields[0].Name] = value
+			break
+		}
+
+		values, ok := value.([]interface{})
+		if !ok || len(values) != len(valueFields) {
+			return nil, fmt.Errorf("expected value of %s to be a slice of %d values, got %v", r.objectType.Name, len(valueFields), update.Value)
+		}
+		for i, field := range valueFields {
+			entry.values[field.Name] = values[i]
+		}
+	}
+
+	return entry, nil
+}
+
+// encode encodes the field values of the object entry to the JSON encoding of their GraphQL types.
+func (r *objectResolver) encode(entry *objectEntry) (map[string]interface{}, error) {
+	res := make(map[string]interface{}, len(entry.values)+1)
+	for _, fields := range [][]schema.Field{r.objectType.KeyFields, r.objectType.ValueFields} {
+		for _, field := range fields {
+			v := entry.values[field.Name]
+			if v == nil {
+				continue
+			}
+
+			var err error
+			res[field.Name], err = encodeValue(field.Kind, v, r.addressCodec)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+		}
+	}
+
+	if r.objectType.RetainDeletions {
+		res["_deleted"] = entry.deleted
+	}
+	return res, nil
+}
+
+// compareKeys compares object keys field by field, null values sort first.
+func (r *objectResolver) compareKeys(a, b []interface{}) int {
+	for i, field := range r.objectType.KeyFields {
+		x, y := a[i], b[i]
+		var c int
+		switch {
+		case x == nil && y == nil:
+		case x == nil:
+			c = -1
+		case y == nil:
+			c = 1
+		default:
+			c = compareValues(field.Kind, x, y)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// encodeCursor encodes the object key as an opaque cursor.
+func (r *objectResolver) encodeCursor(key []interface{}) (string, error) {
+	encoded := make([]interface{}, len(key))
+	for i, field := range r.objectType.KeyFields {
+		if key[i] == nil {
+			continue
+		}
+
+		var err error
+		encoded[i], err = encodeValue(field.Kind, key[i], r.addressCodec)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	bz, err := json.Marshal(encoded)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bz), nil
+}
+
+// decodeCursor decodes the object key of a cursor returned by encodeCursor.
+func (r *objectResolver) decodeCursor(cursor string) ([]interface{}, error) {
+	invalid := fmt.Errorf("invalid cursor %q", cursor)
+	bz, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var encoded []interface{}
+	dec := json.NewDecoder(strings.NewReader(string(bz)))
+	dec.UseNumber()
+	if err := dec.Decode(&encoded); err != nil || len(encoded) != len(r.objectType.KeyFields) {
+		return nil, invalid
+	}
+
+	key := make([]interface{}, len(encoded))
+	for i, field := range r.objectType.KeyFields {
+		if encoded[i] == nil {
+			continue
+		}
+
+		key[i], err = r.parseFieldValue(field, encoded[i])
+		if err != nil {
+			return nil, invalid
+		}
+	}
+	return key, nil
+}
+
+// parseFieldValue parses the JSON encoding of the field value to its Go encoding.
+func (r *objectResolver) parseFieldValue(field schema.Field, v interface{}) (interface{}, error) {
+	if field.Kind != schema.EnumKind {
+		var err error
+		v, err = newScalarType(scalarForKind(field.Kind), r.addressCodec).parseValue(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toKindValue(field.Kind, v)
+}
+
+// compileFilter compiles the coerced value of the filter input type of the object type to a predicate.
+func (r *objectResolver) compileFilter(filter map[string]interface{}) (predicate, error) {
+	var preds []predicate
+	for name, arg := range filter {
+		if arg == nil {
+			continue
+		}
+
+		switch name {
+		case "_and", "_or":
+			var subPreds []predicate
+			for _, sub := range arg.([]interface{}) {
+				pred, err := r.compileFilter(sub.(map[string]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				subPreds = append(subPreds, pred)
+			}
+
+			all := name == "_and"
+			preds = append(preds, func(values map[string]interface{}) bool {
+				for _, pred := range subPreds {
+					if pred(values) != all {
+						return !all
+					}
+				}
+				return all
+			})
+		case "_not":
+			pred, err := r.compileFilter(arg.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			preds = append(preds, func(values map[string]interface{}) bool {
+				return !pred(values)
+			})
+		default:
+			pred, err := r.compileFieldFilter(name, arg.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			preds = append(preds, pred)
+		}
+	}
+
+	return func(values map[string]interface{}) bool {
+		for _, pred := range preds {
+			if !pred(values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (r *objectResolver) compileFieldFilter(name string, filter map[string]interface{}) (predicate, error) {
+	var field schema.Field
+	for _, fields := range [][]schema.Field{r.objectType.KeyFields, r.objectType.ValueFields} {
+		for _, f := range fields {
+			if f.Name == name {
+				field = f
+			}
+		}
+	}
+
+	var preds []predicate
+	for op, arg := range filter {
+		if arg == nil {
+			continue
+		}
+
+		if op == "isNull" {
+			isNull := arg.(bool)
+			preds = append(preds, func(values map[string]interface{}) bool {
+				return (values[name] == nil) == isNull
+			})
+			continue
+		}
+
+		if op == "in" {
+			var set []interface{}
+			for _, item := range arg.([]interface{}) {
+				v, err := toKindValue(field.Kind, item)
+				if err != nil {
+					return nil, fmt.Errorf("filter %s.in: %w", name, err)
+				}
+				set = append(set, v)
+			}
+			preds = append(preds, func(values map[string]interface{}) bool {
+				v := values[name]
+				for _, x := range set {
+					if v != nil && compareValues(field.Kind, v, x) == 0 {
+						return true
+					}
+				}
+				return false
+			})
+			continue
+		}
+
+		operand, err := toKindValue(field.Kind, arg)
+		if err != nil {
+			return nil, fmt.Errorf("filter %s.%s: %w", name, op, err)
+		}
+
+		var test func(c int) bool
+		switch op {
+		case "eq":
+			test = func(c int) bool { return c == 0 }
+		case "ne":
+			test = func(c int) bool { return c != 0 }
+		case "gt":
+			test = func(c int) bool { return c > 0 }
+		case "gte":
+			test = func(c int) bool { return c >= 0 }
+		case "lt":
+			test = func(c int) bool { return c < 0 }
+		case "lte":
+			test = func(c int) bool { return c <= 0 }
+		default:
+			return nil, fmt.Errorf("unknown filter operator %s", op)
+		}
+
+		// null values never match comparisons, use isNull to select them
+		preds = append(preds, func(values map[string]interface{}) bool {
+			v := values[name]
+			return v != nil && test(compareValues(field.Kind, v, operand))
+		})
+	}
+
+	return func(values map[string]interface{}) bool {
+		for _, pred := range preds {
+			if !pred(values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
@@ -0,0 +1,611 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// document is a parsed GraphQL executable document.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind         string // query, mutation or subscription
+	name         string
+	variables    []*variableDefinition
+	directives   []*directive
+	selectionSet []selection
+}
+
+type variableDefinition struct {
+	name         string
+	typ          *typeRef
+	defaultValue *value
+}
+
+type fragment struct {
+	name          string
+	typeCondition string
+	directives    []*directive
+	selectionSet  []selection
+}
+
+// selection is either a *field, a *fragmentSpread or an *inlineFragment.
+type selection interface {
+	isSelection()
+}
+
+type field struct {
+	alias        string
+	name         string
+	arguments    []*argument
+	directives   []*directive
+	selectionSet []selection
+	line, column int
+}
+
+type fragmentSpread struct {
+	name       string
+	directives []*directive
+}
+
+type inlineFragment struct {
+	typeCondition string
+	directives    []*directive
+	selectionSet  []selection
+}
+
+func (*field) isSelection()          {}
+func (*fragmentSpread) isSelection() {}
+func (*inlineFragment) isSelection() {}
+
+// responseKey is the key of the field in the response, i.e. its alias if any.
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+type argument struct {
+	name  string
+	value *value
+}
+
+type directive struct {
+	name      string
+	arguments []*argument
+}
+
+type valueKind int
+
+const (
+	variableValue valueKind = iota
+	intValue
+	floatValue
+	stringValue
+	booleanValue
+	nullValue
+	enumValue
+	listValue
+	objectValue
+)
+
+// value is a literal or variable value in a document.
+type value struct {
+	kind   valueKind
+	raw    string // the variable name, scalar literal or enum value name
+	list   []*value
+	fields []*objectField
+}
+
+type objectField struct {
+	name  string
+	value *value
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind         tokenKind
+	value        string
+	line, column int
+}
+
+// parser is a recursive descent parser of GraphQL executable documents
+// as described in https://spec.graphql.org/October2021/#sec-Language.
+type parser struct {
+	src       string
+	pos       int
+	line      int
+	lineStart int
+	tok       token
+}
+
+// parseDocument parses a GraphQL executable document. Type system definitions are not supported as the
+// schema is generated from the module schemas, see the README for why no GraphQL library is used.
+func parseDocument(src string) (doc *document, err error) {
+	p := &parser{src: src, line: 1}
+	defer func() {
+		if r := recover(); r != nil {
+			perr, ok := r.(parseError)
+			if !ok {
+				panic(r)
+			}
+			err = perr
+		}
+	}()
+
+	p.next()
+	doc = &document{fragments: map[string]*fragment{}}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.peek("{"):
+			doc.operations = append(doc.operations, &operation{kind: "query", selectionSet: p.parseSelectionSet()})
+		case p.peekName("query"), p.peekName("mutation"), p.peekName("subscription"):
+			doc.operations = append(doc.operations, p.parseOperation())
+		case p.peekName("fragment"):
+			frag := p.parseFragment()
+			if _, ok := doc.fragments[frag.name]; ok {
+				p.errorf("duplicate fragment %q", frag.name)
+			}
+			doc.fragments[frag.name] = frag
+		default:
+			p.unexpected()
+		}
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("document has no operations")
+	}
+
+	return doc, nil
+}
+
+type parseError struct {
+	msg string
+}
+
+func (e parseError) Error() string {
+	return e.msg
+}
+
+func (p *parser) errorf(format string, args ...interface{}) {
+	panic(parseError{fmt.Sprintf("syntax error at %d:%d: %s", p.tok.line, p.tok.column, fmt.Sprintf(format, args...))})
+}
+
+func (p *parser) unexpected() {
+	if p.tok.kind == tokenEOF {
+		p.errorf("unexpected end of document")
+	}
+	p.errorf("unexpected %q", p.tok.value)
+}
+
+func (p *parser) peek(punctuator string) bool {
+	return p.tok.kind == tokenPunctuator && p.tok.value == punctuator
+}
+
+func (p *parser) peekName(name string) bool {
+	return p.tok.kind == tokenName && p.tok.value == name
+}
+
+func (p *parser) skip(punctuator string) bool {
+	if p.peek(punctuator) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(punctuator string) {
+	if !p.skip(punctuator) {
+		p.errorf("expected %q, got %q", punctuator, p.tok.value)
+	}
+}
+
+func (p *parser) expectName() string {
+	if p.tok.kind != tokenName {
+		p.errorf("expected name, got %q", p.tok.value)
+	}
+	name := p.tok.value
+	p.next()
+	return name
+}
+
+func (p *parser) parseOperation() *operation {
+	op := &operation{kind: p.expectName()}
+	if p.tok.kind == tokenName {
+		op.name = p.expectName()
+	}
+
+	if p.skip("(") {
+		for !p.skip(")") {
+			p.expect("$")
+			def := &variableDefinition{name: p.expectName()}
+			p.expect(":")
+			def.typ = p.parseType()
+			if p.skip("=") {
+				def.defaultValue = p.parseValue(true)
+			}
+			op.variables = append(op.variables, def)
+		}
+	}
+
+	op.directives = p.parseDirectives()
+	op.selectionSet = p.parseSelectionSet()
+	return op
+}
+
+func (p *parser) parseFragment() *fragment {
+	p.next()
+	frag := &fragment{name: p.expectName()}
+	if frag.name == "on" {
+		p.errorf("fragment can't be named \"on\"")
+	}
+	if !p.peekName("on") {
+		p.errorf("expected \"on\", got %q", p.tok.value)
+	}
+	p.next()
+	frag.typeCondition = p.expectName()
+	frag.directives = p.parseDirectives()
+	frag.selectionSet = p.parseSelectionSet()
+	return frag
+}
+
+func (p *parser) parseType() *typeRef {
+	var typ *typeRef
+	if p.skip("[") {
+		typ = &typeRef{elem: p.parseType()}
+		p.expect("]")
+	} else {
+		typ = &typeRef{name: p.expectName()}
+	}
+
+	if p.skip("!") {
+		typ = &typeRef{elem: typ, nonNull: true}
+	}
+	return typ
+}
+
+func (p *parser) parseSelectionSet() []selection {
+	p.expect("{")
+	var sels []selection
+	for !p.skip("}") {
+		sels = append(sels, p.parseSelection())
+	}
+	if len(sels) == 0 {
+		p.errorf("empty selection set")
+	}
+	return sels
+}
+
+func (p *parser) parseSelection() selection {
+	if p.skip("...") {
+		if p.peekName("on") {
+			p.next()
+			typeCondition := p.expectName()
+			return &inlineFragment{
+				typeCondition: typeCondition,
+				directives:    p.parseDirectives(),
+				selectionSet:  p.parseSelectionSet(),
+			}
+		}
+
+		if p.tok.kind == tokenName {
+			return &fragmentSpread{name: p.expectName(), directives: p.parseDirectives()}
+		}
+
+		return &inlineFragment{
+			directives:   p.parseDirectives(),
+			selectionSet: p.parseSelectionSet(),
+		}
+	}
+
+	f := &field{line: p.tok.line, column: p.tok.column}
+	f.name = p.expectName()
+	if p.skip(":") {
+		f.alias = f.name
+		f.name = p.expectName()
+	}
+	f.arguments = p.parseArguments()
+	f.directives = p.parseDirectives()
+	if p.peek("{") {
+		f.selectionSet = p.parseSelectionSet()
+	}
+	return f
+}
+
+func (p *parser) parseArguments() []*argument {
+	if !p.skip("(") {
+		return nil
+	}
+
+	var args []*argument
+	for !p.skip(")") {
+		arg := &argument{name: p.expectName()}
+		p.expect(":")
+		arg.value = p.parseValue(false)
+		args = append(args, arg)
+	}
+	return args
+}
+
+func (p *parser) parseDirectives() []*directive {
+	var dirs []*directive
+	for p.skip("@") {
+		dirs = append(dirs, &directive{name: p.expectName(), arguments: p.parseArguments()})
+	}
+	return dirs
+}
+
+func (p *parser) parseValue(constant bool) *value {
+	tok := p.tok
+	switch tok.kind {
+	case tokenPunctuator:
+		switch tok.value {
+		case "$":
+			if constant {
+				p.errorf("variables are not allowed in constant values")
+			}
+			p.next()
+			return &value{kind: variableValue, raw: p.expectName()}
+		case "[":
+			p.next()
+			v := &value{kind: listValue}
+			for !p.skip("]") {
+				v.list = append(v.list, p.parseValue(constant))
+			}
+			return v
+		case "{":
+			p.next()
+			v := &value{kind: objectValue}
+			for !p.skip("}") {
+				f := &objectField{name: p.expectName()}
+				p.expect(":")
+				f.value = p.parseValue(constant)
+				v.fields = append(v.fields, f)
+			}
+			return v
+		}
+	case tokenInt:
+		p.next()
+		return &value{kind: intValue, raw: tok.value}
+	case tokenFloat:
+		p.next()
+		return &value{kind: floatValue, raw: tok.value}
+	case tokenString:
+		p.next()
+		return &value{kind: stringValue, raw: tok.value}
+	case tokenName:
+		p.next()
+		switch tok.value {
+		case "true", "false":
+			return &value{kind: booleanValue, raw: tok.value}
+		case "null":
+			return &value{kind: nullValue}
+		default:
+			return &value{kind: enumValue, raw: tok.value}
+		}
+	default:
+	}
+
+	p.unexpected()
+	return nil
+}
+
+// next reads the next token, skipping ignored tokens (whitespace, commas, comments and the BOM).
+func (p *parser) next() {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\n':
+			p.pos++
+			p.line++
+			p.lineStart = p.pos
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "\uFEFF"):
+			p.pos += len("\uFEFF")
+		default:
+			p.tok = p.readToken()
+			return
+		}
+	}
+
+	p.tok = token{kind: tokenEOF, line: p.line, column: p.pos - p.lineStart + 1}
+}
+
+func (p *parser) readToken() token {
+	start := p.pos
+	tok := token{line: p.line, column: start - p.lineStart + 1}
+	c := p.src[start]
+	switch {
+	case strings.HasPrefix(p.src[start:], "..."):
+		p.pos += 3
+		tok.kind, tok.value = tokenPunctuator, "..."
+	case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+		p.pos++
+		tok.kind, tok.value = tokenPunctuator, string(c)
+	case c == '_' || isLetter(c):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		tok.kind, tok.value = tokenName, p.src[start:p.pos]
+	case c == '-' || isDigit(c):
+		tok.kind, tok.value = p.readNumber()
+	case strings.HasPrefix(p.src[start:], `"""`):
+		tok.kind, tok.value = tokenString, p.readBlockString()
+	case c == '"':
+		tok.kind, tok.value = tokenString, p.readString()
+	default:
+		p.tok = tok
+		p.errorf("unexpected character %q", c)
+	}
+	return tok
+}
+
+func (p *parser) readNumber() (tokenKind, string) {
+	start := p.pos
+	kind := tokenInt
+	if p.src[p.pos] == '-' {
+		p.pos++
+	}
+	p.readDigits()
+	if p.pos < len(p.src) && p.src[p.pos] == '.' {
+		kind = tokenFloat
+		p.pos++
+		p.readDigits()
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		kind = tokenFloat
+		p.pos++
+		if p.pos < len(p.src) && (p.src[p.pos] == '+' || p.src[p.pos] == '-') {
+			p.pos++
+		}
+		p.readDigits()
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == '_' || p.src[p.pos] == '.' || isLetter(p.src[p.pos])) {
+		p.errorf("invalid number %q", p.src[start:p.pos+1])
+	}
+	return kind, p.src[start:p.pos]
+}
+
+func (p *parser) readDigits() {
+	start := p.pos
+	for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		p.errorf("invalid number, expected digit")
+	}
+}
+
+func (p *parser) readString() string {
+	p.pos++
+	var sb strings.Builder
+	for {
+		if p.pos >= len(p.src) || p.src[p.pos] == '\n' {
+			p.errorf("unterminated string")
+		}
+
+		c := p.src[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return sb.String()
+		case '\\':
+			if p.pos+1 >= len(p.src) {
+				p.errorf("unterminated string")
+			}
+			esc := p.src[p.pos+1]
+			p.pos += 2
+			switch esc {
+			case '"', '\\', '/':
+				sb.WriteByte(esc)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					p.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					p.errorf("invalid unicode escape %q", p.src[p.pos:p.pos+4])
+				}
+				p.pos += 4
+				sb.WriteRune(rune(r))
+			default:
+				p.errorf("invalid escape sequence \\%c", esc)
+			}
+		default:
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			sb.WriteRune(r)
+			p.pos += size
+		}
+	}
+}
+
+func (p *parser) readBlockString() string {
+	p.pos += 3
+	start := p.pos
+	for {
+		if p.pos >= len(p.src) {
+			p.errorf("unterminated block string")
+		}
+		if strings.HasPrefix(p.src[p.pos:], `\"""`) {
+			p.pos += 4
+			continue
+		}
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			raw := strings.ReplaceAll(p.src[start:p.pos], `\"""`, `"""`)
+			p.pos += 3
+			p.line += strings.Count(raw, "\n")
+			return blockStringValue(raw)
+		}
+		p.pos++
+	}
+}
+
+// blockStringValue removes the common indentation and the leading and trailing blank lines of a block string.
+func blockStringValue(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
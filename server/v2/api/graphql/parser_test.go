@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDocument(t *testing.T) {
+	doc, err := parseDocument(`
+		# a comment
+		query Balances($denom: String = "atom", $first: Int!) @dir {
+			bank {
+				b: balances(where: {denom: {eq: $denom}, amount: {in: [1, -2.5e3]}}, first: $first) {
+					nodes { ...Node }
+				}
+				... on bank_Module @include(if: true) { params { status } }
+			}
+		}
+
+		fragment Node on bank_balances {
+			denom
+			description: amount(text: """
+				block "string"
+			""")
+		}
+	`)
+	require.NoError(t, err)
+	require.Len(t, doc.operations, 1)
+
+	op := doc.operations[0]
+	require.Equal(t, "query", op.kind)
+	require.Equal(t, "Balances", op.name)
+	require.Len(t, op.variables, 2)
+	require.Equal(t, "String", op.variables[0].typ.String())
+	require.Equal(t, `"atom"`, op.variables[0].defaultValue.String())
+	require.Equal(t, "Int!", op.variables[1].typ.String())
+
+	bank := op.selectionSet[0].(*field)
+	balances := bank.selectionSet[0].(*field)
+	require.Equal(t, "b", balances.responseKey())
+	require.Equal(t, `{denom: {eq: $denom}, amount: {in: [1, -2.5e3]}}`, balances.arguments[0].value.String())
+	require.Equal(t, 5, balances.line)
+
+	inline := bank.selectionSet[1].(*inlineFragment)
+	require.Equal(t, "bank_Module", inline.typeCondition)
+	require.Equal(t, "include", inline.directives[0].name)
+
+	frag := doc.fragments["Node"]
+	require.Equal(t, "bank_balances", frag.typeCondition)
+	require.Equal(t, `block "string"`, frag.selectionSet[1].(*field).arguments[0].value.raw)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`{ a`,
+		`{ a(b: ) }`,
+		`query ($a) { a }`,
+		`{ a } fragment F on T { b } fragment F on T { c }`,
+		`{ a(b: "unterminated) }`,
+		`subscription { a } }`,
+	} {
+		_, err := parseDocument(src)
+		require.Error(t, err, src)
+	}
+}
@@ -0,0 +1,246 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type typeKind string
+
+const (
+	scalarKind      typeKind = "SCALAR"
+	objectKind      typeKind = "OBJECT"
+	enumKind        typeKind = "ENUM"
+	inputObjectKind typeKind = "INPUT_OBJECT"
+)
+
+// typeRef references a named type, or wraps another type reference as a list or non-null type.
+type typeRef struct {
+	name    string
+	elem    *typeRef
+	nonNull bool
+}
+
+func namedType(name string) *typeRef {
+	return &typeRef{name: name}
+}
+
+func nonNullType(t *typeRef) *typeRef {
+	return &typeRef{elem: t, nonNull: true}
+}
+
+func listType(t *typeRef) *typeRef {
+	return &typeRef{elem: t}
+}
+
+// namedTypeName returns the name of the named type wrapped by the type reference.
+func (t *typeRef) namedTypeName() string {
+	for t.elem != nil {
+		t = t.elem
+	}
+	return t.name
+}
+
+func (t *typeRef) String() string {
+	switch {
+	case t.nonNull:
+		return t.elem.String() + "!"
+	case t.elem != nil:
+		return "[" + t.elem.String() + "]"
+	default:
+		return t.name
+	}
+}
+
+// resolver resolves the value of a field of its parent (source) value. Objects are resolved
+// to the source value of their fields, and scalars and enums to their JSON serialized value.
+type resolver func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error)
+
+// typeDef defines a named scalar, object, enum or input object type.
+type typeDef struct {
+	kind        typeKind
+	name        string
+	description string
+
+	// fields are the fields of object types.
+	fields   []*fieldDef
+	fieldMap map[string]*fieldDef
+
+	// inputFields are the fields of input object types.
+	inputFields []*inputValueDef
+
+	// enumValues are the values of enum types.
+	enumValues []string
+
+	// parseValue coerces the input values of scalar types to their Go representation.
+	parseValue func(value interface{}) (interface{}, error)
+}
+
+type fieldDef struct {
+	name        string
+	description string
+	args        []*inputValueDef
+	typ         *typeRef
+
+	// resolve resolves the field value, if it is nil the field is looked up in a map[string]interface{} source.
+	resolve resolver
+}
+
+type inputValueDef struct {
+	name         string
+	description  string
+	typ          *typeRef
+	defaultValue *value
+}
+
+func (t *typeDef) addField(f *fieldDef) error {
+	if _, ok := t.fieldMap[f.name]; ok {
+		return fmt.Errorf("duplicate field %s in type %s", f.name, t.name)
+	}
+	if t.fieldMap == nil {
+		t.fieldMap = map[string]*fieldDef{}
+	}
+	t.fields = append(t.fields, f)
+	t.fieldMap[f.name] = f
+	return nil
+}
+
+func (t *typeDef) lookupInputField(name string) *inputValueDef {
+	for _, f := range t.inputFields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// schemaDef is a GraphQL schema with a query root type.
+type schemaDef struct {
+	types map[string]*typeDef
+	query *typeDef
+
+	// introspection is the value of the __schema meta field.
+	introspection map[string]interface{}
+}
+
+func newSchemaDef() *schemaDef {
+	return &schemaDef{types: map[string]*typeDef{}}
+}
+
+func (s *schemaDef) addType(t *typeDef) error {
+	if _, ok := s.types[t.name]; ok {
+		return fmt.Errorf("duplicate type %s", t.name)
+	}
+	s.types[t.name] = t
+	return nil
+}
+
+// sortedTypes returns the types of the schema sorted by name.
+func (s *schemaDef) sortedTypes() []*typeDef {
+	types := make([]*typeDef, 0, len(s.types))
+	for _, t := range s.types {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].name < types[j].name })
+	return types
+}
+
+// writeSDL writes the schema in the GraphQL schema definition language, omitting the
+// built-in scalar and introspection types.
+func (s *schemaDef) writeSDL(w io.Writer) error {
+	var sb strings.Builder
+	for _, t := range s.sortedTypes() {
+		if isBuiltinType(t.name) {
+			continue
+		}
+
+		writeDescription(&sb, "", t.description)
+		switch t.kind {
+		case scalarKind:
+			fmt.Fprintf(&sb, "scalar %s\n\n", t.name)
+		case enumKind:
+			fmt.Fprintf(&sb, "enum %s {\n", t.name)
+			for _, v := range t.enumValues {
+				fmt.Fprintf(&sb, "  %s\n", v)
+			}
+			sb.WriteString("}\n\n")
+		case inputObjectKind:
+			fmt.Fprintf(&sb, "input %s {\n", t.name)
+			for _, f := range t.inputFields {
+				writeDescription(&sb, "  ", f.description)
+				fmt.Fprintf(&sb, "  %s\n", inputValueSDL(f))
+			}
+			sb.WriteString("}\n\n")
+		case objectKind:
+			fmt.Fprintf(&sb, "type %s {\n", t.name)
+			for _, f := range t.fields {
+				writeDescription(&sb, "  ", f.description)
+				fmt.Fprintf(&sb, "  %s", f.name)
+				if len(f.args) > 0 {
+					args := make([]string, 0, len(f.args))
+					for _, arg := range f.args {
+						args = append(args, inputValueSDL(arg))
+					}
+					fmt.Fprintf(&sb, "(%s)", strings.Join(args, ", "))
+				}
+				fmt.Fprintf(&sb, ": %s\n", f.typ)
+			}
+			sb.WriteString("}\n\n")
+		}
+	}
+
+	_, err := io.WriteString(w, strings.TrimSuffix(sb.String(), "\n"))
+	return err
+}
+
+func inputValueSDL(v *inputValueDef) string {
+	if v.defaultValue != nil {
+		return fmt.Sprintf("%s: %s = %s", v.name, v.typ, v.defaultValue)
+	}
+	return fmt.Sprintf("%s: %s", v.name, v.typ)
+}
+
+func writeDescription(sb *strings.Builder, indent, description string) {
+	if description != "" {
+		fmt.Fprintf(sb, "%s%s\n", indent, strconv.Quote(description))
+	}
+}
+
+func isBuiltinType(name string) bool {
+	switch name {
+	case "String", "Int", "Float", "Boolean", "ID":
+		return true
+	default:
+		return strings.HasPrefix(name, "__")
+	}
+}
+
+// String formats the value as a GraphQL literal.
+func (v *value) String() string {
+	switch v.kind {
+	case variableValue:
+		return "$" + v.raw
+	case stringValue:
+		return strconv.Quote(v.raw)
+	case nullValue:
+		return "null"
+	case listValue:
+		items := make([]string, 0, len(v.list))
+		for _, item := range v.list {
+			items = append(items, item.String())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case objectValue:
+		fields := make([]string, 0, len(v.fields))
+		for _, f := range v.fields {
+			fields = append(fields, f.name+": "+f.value.String())
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	default:
+		return v.raw
+	}
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+const (
+	ServerName = "graphql"
+
+	// QueryPath is the path of the GraphQL endpoint.
+	QueryPath = "/graphql"
+	// SchemaPath is the path serving the GraphQL schema in the schema definition language.
+	SchemaPath = "/graphql/schema"
+)
+
+// Server serves GraphQL queries of the state indexed by an indexer exposing its data as a view.AppData.
+type Server[T transaction.Tx] struct {
+	logger log.Logger
+	router *http.ServeMux
+
+	appData      view.AppData
+	addressCodec addressutil.AddressCodec
+
+	httpServer *http.Server
+	config     *Config
+	cfgOptions []CfgOption
+}
+
+// New returns a GraphQL server querying the app data. Address fields are encoded with the address
+// codec, or as hex strings if it is nil.
+func New[T transaction.Tx](appData view.AppData, addressCodec addressutil.AddressCodec, cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		appData:      appData,
+		addressCodec: addressCodec,
+		cfgOptions:   cfgOptions,
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.Logger) error {
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	serverCfg := s.Config().(*Config)
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, s.Name(), &serverCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	handler := NewHandler(s.appData, HandlerOptions{
+		AddressCodec:    s.addressCodec,
+		DefaultPageSize: serverCfg.DefaultPageSize,
+		MaxPageSize:     serverCfg.MaxPageSize,
+		MaxQueryDepth:   serverCfg.MaxQueryDepth,
+		MaxQueryCost:    serverCfg.MaxQueryCost,
+	})
+	s.router = http.NewServeMux()
+	s.router.Handle(QueryPath, handler)
+	s.router.Handle(SchemaPath, handler.SchemaHandler())
+	s.config = serverCfg
+
+	return nil
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
+		return nil
+	}
+
+	s.httpServer = &http.Server{
+		Addr:    s.config.Address,
+		Handler: s.router,
+	}
+
+	s.logger.Info("starting GraphQL server", "address", s.config.Address)
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start GraphQL server", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping GraphQL server")
+
+	return s.httpServer.Shutdown(ctx)
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config.Address == "" {
+		cfg := DefaultConfig()
+
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
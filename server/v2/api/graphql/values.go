@@ -0,0 +1,441 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// Names of the scalar types fields are mapped to. Custom scalars follow the JSON encoding of their schema.Kind.
+const (
+	stringScalar   = "String"
+	intScalar      = "Int"
+	floatScalar    = "Float"
+	booleanScalar  = "Boolean"
+	uint32Scalar   = "Uint32"
+	int64Scalar    = "Int64"
+	uint64Scalar   = "Uint64"
+	integerScalar  = "Integer"
+	decimalScalar  = "Decimal"
+	bytesScalar    = "Bytes"
+	addressScalar  = "Address"
+	timeScalar     = "Time"
+	durationScalar = "Duration"
+	jsonScalar     = "JSON"
+)
+
+var scalarDescriptions = map[string]string{
+	uint32Scalar:   "An unsigned 32-bit integer.",
+	int64Scalar:    "A signed 64-bit integer encoded as a base10 string.",
+	uint64Scalar:   "An unsigned 64-bit integer encoded as a base10 string.",
+	integerScalar:  "An arbitrary precision integer encoded as a base10 string.",
+	decimalScalar:  "An arbitrary precision decimal number encoded as a string.",
+	bytesScalar:    "Bytes encoded as a base64 string with padding.",
+	addressScalar:  "An address encoded as a string with the address codec of the chain.",
+	timeScalar:     "A nanosecond precision ISO 8601 UTC timestamp.",
+	durationScalar: "A nanosecond precision duration encoded as a number of seconds followed by 's', i.e. \"1.5s\".",
+	jsonScalar:     "An arbitrary JSON value.",
+}
+
+// enumLiteral is an enum value literal of a document, as opposed to a string.
+type enumLiteral string
+
+// scalarForKind returns the name of the scalar type the kind is mapped to.
+func scalarForKind(kind schema.Kind) string {
+	switch kind {
+	case schema.StringKind:
+		return stringScalar
+	case schema.BytesKind:
+		return bytesScalar
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind:
+		return intScalar
+	case schema.Uint32Kind:
+		return uint32Scalar
+	case schema.Int64Kind:
+		return int64Scalar
+	case schema.Uint64Kind:
+		return uint64Scalar
+	case schema.IntegerKind:
+		return integerScalar
+	case schema.DecimalKind:
+		return decimalScalar
+	case schema.BoolKind:
+		return booleanScalar
+	case schema.TimeKind:
+		return timeScalar
+	case schema.DurationKind:
+		return durationScalar
+	case schema.Float32Kind, schema.Float64Kind:
+		return floatScalar
+	case schema.AddressKind:
+		return addressScalar
+	default:
+		return jsonScalar
+	}
+}
+
+// newScalarType returns the definition of the named scalar type.
+func newScalarType(name string, addressCodec addressutil.AddressCodec) *typeDef {
+	t := &typeDef{kind: scalarKind, name: name, description: scalarDescriptions[name]}
+	switch name {
+	case stringScalar:
+		t.parseValue = parseString
+	case intScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			return parseInt(v, math.MinInt32, math.MaxInt32)
+		}
+	case floatScalar:
+		t.parseValue = parseFloat
+	case booleanScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("expected boolean, got %v", v)
+			}
+			return b, nil
+		}
+	case uint32Scalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			i, err := parseInt(v, 0, math.MaxUint32)
+			if err != nil {
+				return nil, err
+			}
+			return uint32(i), nil
+		}
+	case int64Scalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseIntegerString(v)
+			if err != nil {
+				return nil, err
+			}
+			return strconv.ParseInt(str, 10, 64)
+		}
+	case uint64Scalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseIntegerString(v)
+			if err != nil {
+				return nil, err
+			}
+			return strconv.ParseUint(str, 10, 64)
+		}
+	case integerScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseIntegerString(v)
+			if err != nil {
+				return nil, err
+			}
+			return str, schema.IntegerKind.ValidateValue(str)
+		}
+	case decimalScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseString(v)
+			if err != nil {
+				return nil, err
+			}
+			return str, schema.DecimalKind.ValidateValue(str)
+		}
+	case bytesScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseString(v)
+			if err != nil {
+				return nil, err
+			}
+			return base64.StdEncoding.DecodeString(str.(string))
+		}
+	case addressScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseString(v)
+			if err != nil {
+				return nil, err
+			}
+			return addressCodec.StringToBytes(str.(string))
+		}
+	case timeScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseString(v)
+			if err != nil {
+				return nil, err
+			}
+			return time.Parse(time.RFC3339Nano, str.(string))
+		}
+	case durationScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			str, err := parseString(v)
+			if err != nil {
+				return nil, err
+			}
+			return parseDuration(str.(string))
+		}
+	case jsonScalar:
+		t.parseValue = func(v interface{}) (interface{}, error) {
+			bz, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			return json.RawMessage(bz), nil
+		}
+	default:
+		panic(fmt.Sprintf("unknown scalar %s", name))
+	}
+	return t
+}
+
+func parseString(v interface{}) (interface{}, error) {
+	str, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected string, got %v", v)
+	}
+	return str, nil
+}
+
+// parseIntegerString parses an integer encoded as a string or as an integer number.
+func parseIntegerString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return "", fmt.Errorf("expected integer, got %s", v)
+		}
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("expected integer string, got %v", v)
+	}
+}
+
+func parseInt(v interface{}, min, max int64) (int64, error) {
+	var i int64
+	switch v := v.(type) {
+	case json.Number:
+		var err error
+		i, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected integer, got %s", v)
+		}
+	case int64:
+		i = v
+	case int:
+		i = int64(v)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v > math.MaxInt64 {
+			return 0, fmt.Errorf("expected integer, got %v", v)
+		}
+		i = int64(v)
+	default:
+		return 0, fmt.Errorf("expected integer, got %v", v)
+	}
+
+	if i < min || i > max {
+		return 0, fmt.Errorf("integer %d out of range [%d, %d]", i, min, max)
+	}
+	return i, nil
+}
+
+func parseFloat(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("expected number, got %s", v)
+		}
+		return f, nil
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	default:
+		return nil, fmt.Errorf("expected number, got %v", v)
+	}
+}
+
+// parseDuration parses a duration encoded as a decimal number of seconds followed by 's'.
+func parseDuration(str string) (time.Duration, error) {
+	secs, ok := new(big.Rat).SetString(strings.TrimSuffix(str, "s"))
+	if !ok || !strings.HasSuffix(str, "s") {
+		return 0, fmt.Errorf("expected duration in seconds such as \"1.5s\", got %q", str)
+	}
+
+	nanos := secs.Mul(secs, big.NewRat(int64(time.Second), 1))
+	if !nanos.IsInt() || !nanos.Num().IsInt64() {
+		return 0, fmt.Errorf("duration %q out of range or with more than nanosecond precision", str)
+	}
+	return time.Duration(nanos.Num().Int64()), nil
+}
+
+// formatDuration formats the duration as a decimal number of seconds with no trailing zeros followed by 's'.
+func formatDuration(d time.Duration) string {
+	sign := ""
+	n := uint64(d)
+	if d < 0 {
+		sign = "-"
+		n = uint64(-d)
+	}
+
+	secs, nanos := n/uint64(time.Second), n%uint64(time.Second)
+	if nanos == 0 {
+		return fmt.Sprintf("%s%ds", sign, secs)
+	}
+	return fmt.Sprintf("%s%d.%ss", sign, secs, strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+}
+
+// toKindValue converts a value parsed by the scalar type of the kind to the Go encoding of the kind.
+func toKindValue(kind schema.Kind, v interface{}) (interface{}, error) {
+	var res interface{}
+	switch kind {
+	case schema.Int8Kind:
+		if i := v.(int64); i >= math.MinInt8 && i <= math.MaxInt8 {
+			res = int8(i)
+		} else {
+			return nil, fmt.Errorf("value %d out of range for %s", i, kind)
+		}
+	case schema.Uint8Kind:
+		if i := v.(int64); i >= 0 && i <= math.MaxUint8 {
+			res = uint8(i)
+		} else {
+			return nil, fmt.Errorf("value %d out of range for %s", i, kind)
+		}
+	case schema.Int16Kind:
+		if i := v.(int64); i >= math.MinInt16 && i <= math.MaxInt16 {
+			res = int16(i)
+		} else {
+			return nil, fmt.Errorf("value %d out of range for %s", i, kind)
+		}
+	case schema.Uint16Kind:
+		if i := v.(int64); i >= 0 && i <= math.MaxUint16 {
+			res = uint16(i)
+		} else {
+			return nil, fmt.Errorf("value %d out of range for %s", i, kind)
+		}
+	case schema.Int32Kind:
+		res = int32(v.(int64))
+	case schema.Float32Kind:
+		res = float32(v.(float64))
+	default:
+		res = v
+	}
+
+	return res, kind.ValidateValue(res)
+}
+
+// encodeValue encodes the Go encoding of a value of the kind to the JSON encoding of its scalar type.
+func encodeValue(kind schema.Kind, v interface{}, addressCodec addressutil.AddressCodec) (interface{}, error) {
+	switch kind {
+	case schema.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.([]byte)), nil
+	case schema.Int64Kind:
+		return strconv.FormatInt(v.(int64), 10), nil
+	case schema.Uint64Kind:
+		return strconv.FormatUint(v.(uint64), 10), nil
+	case schema.Float32Kind, schema.Float64Kind:
+		if f := toFloat64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("can't encode %v as a JSON number", v)
+		}
+		return v, nil
+	case schema.TimeKind:
+		return v.(time.Time).UTC().Format(time.RFC3339Nano), nil
+	case schema.DurationKind:
+		return formatDuration(v.(time.Duration)), nil
+	case schema.AddressKind:
+		return addressCodec.BytesToString(v.([]byte))
+	default:
+		return v, nil
+	}
+}
+
+func toFloat64(v interface{}) float64 {
+	switch v := v.(type) {
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	default:
+		return math.NaN()
+	}
+}
+
+// compareValues compares two non-nil values of the kind in their Go encoding.
+func compareValues(kind schema.Kind, a, b interface{}) int {
+	switch kind {
+	case schema.BytesKind, schema.AddressKind:
+		return bytes.Compare(a.([]byte), b.([]byte))
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Int64Kind:
+		return compareOrdered(toInt64(a), toInt64(b))
+	case schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind, schema.Uint64Kind:
+		return compareOrdered(toUint64(a), toUint64(b))
+	case schema.Float32Kind, schema.Float64Kind:
+		return compareOrdered(toFloat64(a), toFloat64(b))
+	case schema.IntegerKind, schema.DecimalKind:
+		x, okX := new(big.Rat).SetString(a.(string))
+		y, okY := new(big.Rat).SetString(b.(string))
+		if okX && okY {
+			return x.Cmp(y)
+		}
+		return strings.Compare(a.(string), b.(string))
+	case schema.BoolKind:
+		x, y := a.(bool), b.(bool)
+		switch {
+		case x == y:
+			return 0
+		case !x:
+			return -1
+		default:
+			return 1
+		}
+	case schema.TimeKind:
+		return a.(time.Time).Compare(b.(time.Time))
+	case schema.DurationKind:
+		return compareOrdered(a.(time.Duration), b.(time.Duration))
+	case schema.JSONKind:
+		return bytes.Compare(a.(json.RawMessage), b.(json.RawMessage))
+	default:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+}
+
+func compareOrdered[T int64 | uint64 | float64 | time.Duration](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	switch v := v.(type) {
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	default:
+		return v.(int64)
+	}
+}
+
+func toUint64(v interface{}) uint64 {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	default:
+		return v.(uint64)
+	}
+}
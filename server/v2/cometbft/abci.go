@@ -66,6 +66,7 @@ type Consensus[T transaction.Tx] struct {
 	verifyVoteExt          handlers.VerifyVoteExtensionhandler
 	extendVote             handlers.ExtendVoteHandler
 	checkTxHandler         handlers.CheckTxHandler[T]
+	txInjector             handlers.TxInjector
 
 	addrPeerFilter types.PeerFilter // filter peers by address and port
 	idPeerFilter   types.PeerFilter // filter peers by node ID
@@ -389,14 +390,38 @@ func (c *Consensus[T]) PrepareProposal(
 		LastCommit:      toCoreExtendedCommitInfo(req.LocalLastCommit),
 	})
 
+	injectTx, err := c.injectsTx(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var injectedTx []byte
+	if injectTx {
+		injectedTx, err = c.txInjector.InjectTx(ciCtx, c.chainID, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inject tx: %w", err)
+		}
+		if int64(len(injectedTx)) > req.MaxTxBytes {
+			return nil, fmt.Errorf("injected tx size %d exceeds max tx bytes %d", len(injectedTx), req.MaxTxBytes)
+		}
+
+		// leave room for the injected tx in the proposal.
+		reqCopy := *req
+		reqCopy.MaxTxBytes -= int64(len(injectedTx))
+		req = &reqCopy
+	}
+
 	txs, err := c.prepareProposalHandler(ciCtx, c.app, c.txCodec, req)
 	if err != nil {
 		return nil, err
 	}
 
-	encodedTxs := make([][]byte, len(txs))
-	for i, tx := range txs {
-		encodedTxs[i] = tx.Bytes()
+	encodedTxs := make([][]byte, 0, len(txs)+1)
+	if injectTx {
+		encodedTxs = append(encodedTxs, injectedTx)
+	}
+	for _, tx := range txs {
+		encodedTxs = append(encodedTxs, tx.Bytes())
 	}
 
 	return &abciproto.PrepareProposalResponse{
@@ -425,7 +450,30 @@ func (c *Consensus[T]) ProcessProposal(
 		LastCommit:      toCoreCommitInfo(req.ProposedLastCommit),
 	})
 
-	err := c.processProposalHandler(ciCtx, c.app, c.txCodec, req)
+	injectTx, err := c.injectsTx(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if injectTx {
+		if len(req.Txs) == 0 {
+			err = errors.New("proposal is missing its injected tx")
+		} else {
+			err = c.txInjector.VerifyInjectedTx(ciCtx, c.chainID, req.Txs[0], req)
+		}
+		if err != nil {
+			c.logger.Error("failed to verify injected tx", "height", req.Height, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
+			return &abciproto.ProcessProposalResponse{
+				Status: abciproto.PROCESS_PROPOSAL_STATUS_REJECT,
+			}, nil
+		}
+
+		// the injected tx is not a transaction, do not pass it to the process handler.
+		reqCopy := *req
+		reqCopy.Txs = req.Txs[1:]
+		req = &reqCopy
+	}
+
+	err = c.processProposalHandler(ciCtx, c.app, c.txCodec, req)
 	if err != nil {
 		c.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
 		return &abciproto.ProcessProposalResponse{
@@ -464,10 +512,24 @@ func (c *Consensus[T]) FinalizeBlock(
 		}, nil
 	}
 
+	// the injected tx, if any, is removed from the block and exposed to modules through the context.
+	rawTxs := req.Txs
+	injectTx, err := c.injectsTx(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if injectTx {
+		if len(rawTxs) == 0 {
+			return nil, errors.New("block is missing its injected tx")
+		}
+		ctx = handlers.ContextWithInjectedTx(ctx, rawTxs[0])
+		rawTxs = rawTxs[1:]
+	}
+
 	// TODO(tip): can we expect some txs to not decode? if so, what we do in this case? this does not seem to be the case,
 	// considering that prepare and process always decode txs, assuming they're the ones providing txs we should never
 	// have a tx that fails decoding.
-	decodedTxs, err := decodeTxs(req.Txs, c.txCodec)
+	decodedTxs, err := decodeTxs(rawTxs, c.txCodec)
 	if err != nil {
 		return nil, err
 	}
@@ -518,7 +580,7 @@ func (c *Consensus[T]) FinalizeBlock(
 	events = append(events, resp.EndBlockEvents...)
 
 	// listen to state streaming changes in accordance with the block
-	err = c.streamDeliverBlockChanges(ctx, req.Height, req.Txs, resp.TxResults, events, stateChanges)
+	err = c.streamDeliverBlockChanges(ctx, req.Height, rawTxs, resp.TxResults, events, stateChanges)
 	if err != nil {
 		return nil, err
 	}
@@ -537,7 +599,16 @@ func (c *Consensus[T]) FinalizeBlock(
 		return nil, err
 	}
 
-	return finalizeBlockResponse(resp, cp, appHash, c.indexedEvents, c.cfg.AppTomlConfig.Trace)
+	res, err := finalizeBlockResponse(resp, cp, appHash, c.indexedEvents, c.cfg.AppTomlConfig.Trace)
+	if err != nil {
+		return nil, err
+	}
+	if injectTx {
+		// cometbft expects a result for every tx of the block.
+		res.TxResults = append([]*abciproto.ExecTxResult{{}}, res.TxResults...)
+	}
+
+	return res, nil
 }
 
 // Commit implements types.Application.
@@ -642,6 +713,30 @@ func (c *Consensus[T]) ExtendVote(ctx context.Context, req *abciproto.ExtendVote
 	return resp, err
 }
 
+// injectsTx returns whether blocks at the given height start with a tx injected by the TxInjector.
+// That is the case when the vote extensions of the previous height are available.
+func (c *Consensus[T]) injectsTx(ctx context.Context, height int64) (bool, error) {
+	if c.txInjector == nil {
+		return false, nil
+	}
+
+	cp, err := c.GetConsensusParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	enableHeight := int64(0)
+	if cp.Feature != nil && cp.Feature.VoteExtensionsEnableHeight != nil {
+		enableHeight = cp.Feature.VoteExtensionsEnableHeight.Value
+	}
+	// Since Abci was deprecated, should check both Feature & Abci
+	if enableHeight == 0 && cp.Abci != nil {
+		enableHeight = cp.Abci.VoteExtensionsEnableHeight
+	}
+
+	return enableHeight != 0 && height > enableHeight, nil
+}
+
 func decodeTxs[T transaction.Tx](rawTxs [][]byte, codec transaction.Codec[T]) ([]T, error) {
 	txs := make([]T, len(rawTxs))
 	for i, rawTx := range rawTxs {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"io"
 	"strings"
 	"testing"
@@ -535,6 +536,88 @@ func TestConsensus_ProcessProposal_With_Handler(t *testing.T) {
 	require.Equal(t, res.Status, abciproto.PROCESS_PROPOSAL_STATUS_REJECT)
 }
 
+// mockTxInjector injects a fixed tx and only accepts it back.
+type mockTxInjector struct{}
+
+var injectedTx = []byte("injected")
+
+func (mockTxInjector) InjectTx(context.Context, string, *abciproto.PrepareProposalRequest) ([]byte, error) {
+	return injectedTx, nil
+}
+
+func (mockTxInjector) VerifyInjectedTx(_ context.Context, _ string, tx []byte, _ *abciproto.ProcessProposalRequest) error {
+	if string(tx) != string(injectedTx) {
+		return errors.New("unexpected injected tx")
+	}
+	return nil
+}
+
+func TestConsensus_TxInjector(t *testing.T) {
+	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
+	c.prepareProposalHandler = DefaultServerOptions[mock.Tx]().PrepareProposalHandler
+	c.processProposalHandler = handlers.NewDefaultProposalHandler(c.mempool).ProcessHandler()
+	c.txInjector = mockTxInjector{}
+
+	_, err := c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	// vote extensions are enabled at height 2, no tx is injected until height 3
+	res, err := c.PrepareProposal(context.Background(), &abciproto.PrepareProposalRequest{
+		Height:     2,
+		MaxTxBytes: 1000,
+		Txs:        [][]byte{mockTx.Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{mockTx.Bytes()}, res.Txs)
+
+	res, err = c.PrepareProposal(context.Background(), &abciproto.PrepareProposalRequest{
+		Height:     3,
+		MaxTxBytes: 1000,
+		Txs:        [][]byte{mockTx.Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{injectedTx, mockTx.Bytes()}, res.Txs)
+
+	processRes, err := c.ProcessProposal(context.Background(), &abciproto.ProcessProposalRequest{
+		Height: 3,
+		Txs:    res.Txs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abciproto.PROCESS_PROPOSAL_STATUS_ACCEPT, processRes.Status)
+
+	// missing or invalid injected tx
+	for _, txs := range [][][]byte{nil, {mockTx.Bytes()}, {[]byte("bad"), mockTx.Bytes()}} {
+		processRes, err = c.ProcessProposal(context.Background(), &abciproto.ProcessProposalRequest{
+			Height: 3,
+			Txs:    txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abciproto.PROCESS_PROPOSAL_STATUS_REJECT, processRes.Status)
+	}
+
+	for i := int64(1); i <= 3; i++ {
+		txs := [][]byte{mockTx.Bytes()}
+		if i == 3 {
+			txs = res.Txs
+		}
+		finalizeRes, err := c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+			Time:   time.Now(),
+			Height: i,
+			Hash:   sum[:],
+			Txs:    txs,
+		})
+		require.NoError(t, err)
+		if i > 1 {
+			require.Len(t, finalizeRes.TxResults, len(txs))
+		}
+	}
+	require.Equal(t, int64(3), c.lastCommittedHeight.Load())
+}
+
 func TestConsensus_Info(t *testing.T) {
 	c := setUpConsensus(t, 100_000, cometmock.MockMempool[mock.Tx]{})
 
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/streaming"
 )
 
 // Config is the configuration for the CometBFT application
@@ -28,6 +29,9 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 			Target:            make(map[string]indexer.Config),
 			ChannelBufferSize: 1024,
 		},
+		Streaming: streaming.StreamingConfig{
+			ListenerConfig: streaming.DefaultListenerConfig(),
+		},
 	}
 }
 
@@ -42,8 +46,9 @@ type AppTomlConfig struct {
 	Standalone      bool     `mapstructure:"standalone" toml:"standalone" comment:"standalone starts the application without the CometBFT node. The node should be started separately."`
 
 	// Sub configs
-	Mempool mempool.Config         `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
-	Indexer indexer.IndexingConfig `mapstructure:"indexer" toml:"indexer" comment:"indexer defines the configuration for the SDK built-in indexer implementation."`
+	Mempool   mempool.Config            `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
+	Indexer   indexer.IndexingConfig    `mapstructure:"indexer" toml:"indexer" comment:"indexer defines the configuration for the SDK built-in indexer implementation."`
+	Streaming streaming.StreamingConfig `mapstructure:"streaming" toml:"streaming" comment:"streaming defines the configuration for the state streaming plugins."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -31,4 +31,17 @@ type (
 
 	// CheckTxHandler is a function type that handles the execution of a transaction.
 	CheckTxHandler[T transaction.Tx] func(func(ctx context.Context, tx T) (server.TxResult, error)) (*abci.CheckTxResponse, error)
+
+	// TxInjector injects data which is not a transaction, typically aggregated from the vote
+	// extensions of the previous height, as the first transaction of block proposals.
+	// Injection happens at every height following the vote extensions enable height.
+	// The injected transaction is neither decoded nor executed: it is removed from the block
+	// before delivery and exposed to modules, e.g. in PreBlock, through InjectedTxFromContext.
+	TxInjector interface {
+		// InjectTx returns the transaction to inject at the top of the proposal.
+		InjectTx(ctx context.Context, chainID string, req *abci.PrepareProposalRequest) ([]byte, error)
+		// VerifyInjectedTx verifies the transaction injected at the top of a proposal.
+		// An error rejects the proposal.
+		VerifyInjectedTx(ctx context.Context, chainID string, tx []byte, req *abci.ProcessProposalRequest) error
+	}
 )
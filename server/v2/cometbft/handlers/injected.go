@@ -0,0 +1,17 @@
+package handlers
+
+import "context"
+
+type injectedTxKey struct{}
+
+// ContextWithInjectedTx returns a new context carrying the transaction injected by a TxInjector.
+func ContextWithInjectedTx(ctx context.Context, tx []byte) context.Context {
+	return context.WithValue(ctx, injectedTxKey{}, tx)
+}
+
+// InjectedTxFromContext returns the transaction injected at the top of the block being
+// finalized, if any.
+func InjectedTxFromContext(ctx context.Context) ([]byte, bool) {
+	tx, ok := ctx.Value(injectedTxKey{}).([]byte)
+	return tx, ok
+}
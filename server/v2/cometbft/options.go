@@ -21,6 +21,7 @@ type ServerOptions[T transaction.Tx] struct {
 	CheckTxHandler             handlers.CheckTxHandler[T]
 	VerifyVoteExtensionHandler handlers.VerifyVoteExtensionhandler
 	ExtendVoteHandler          handlers.ExtendVoteHandler
+	TxInjector                 handlers.TxInjector // optional, injects a tx at the top of proposals when vote extensions are enabled
 	KeygenF                    keyGenF
 
 	Mempool         func(cfg map[string]any) mempool.Mempool[T]
@@ -39,6 +40,7 @@ func DefaultServerOptions[T transaction.Tx]() ServerOptions[T] {
 		CheckTxHandler:             nil,
 		VerifyVoteExtensionHandler: handlers.NoOpVerifyVoteExtensionHandler(),
 		ExtendVoteHandler:          handlers.NoOpExtendVote(),
+		TxInjector:                 nil,
 		Mempool:                    func(cfg map[string]any) mempool.Mempool[T] { return mempool.NoOpMempool[T]{} },
 		SnapshotOptions:            func(cfg map[string]any) snapshots.SnapshotOptions { return snapshots.NewSnapshotOptions(0, 0) },
 		AddrPeerFilter:             nil,
@@ -0,0 +1,70 @@
+package oracle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// voteExtensionSignBytes returns the bytes signed by a validator when extending its vote.
+func voteExtensionSignBytes(chainID string, height int64, round int32, extension []byte) ([]byte, error) {
+	if height < 1 {
+		return nil, fmt.Errorf("invalid vote extension height %d", height)
+	}
+
+	return cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+		Height:    height,
+		Round:     round,
+		Extension: extension,
+	}), nil
+}
+
+// validateExtendedCommitAgainstLastCommit checks that an injected extended commit is consistent
+// with the last commit of the proposal: same round, and the same votes, sorted by descending
+// voting power then by address, as done by cometbft.
+func validateExtendedCommitAgainstLastCommit(ec abci.ExtendedCommitInfo, lc abci.CommitInfo) error {
+	if ec.Round != lc.Round {
+		return fmt.Errorf("extended commit round %d does not match last commit round %d", ec.Round, lc.Round)
+	}
+
+	if len(ec.Votes) != len(lc.Votes) {
+		return fmt.Errorf("extended commit votes length %d does not match last commit votes length %d", len(ec.Votes), len(lc.Votes))
+	}
+
+	if !slices.IsSortedFunc(ec.Votes, func(vote1, vote2 abci.ExtendedVoteInfo) int {
+		if vote1.Validator.Power == vote2.Validator.Power {
+			return bytes.Compare(vote1.Validator.Address, vote2.Validator.Address)
+		}
+		if vote1.Validator.Power > vote2.Validator.Power {
+			return -1
+		}
+		return 1
+	}) {
+		return errors.New("extended commit votes are not sorted by voting power")
+	}
+
+	seen := make(map[string]struct{}, len(ec.Votes))
+	for i, vote := range ec.Votes {
+		if _, ok := seen[string(vote.Validator.Address)]; ok {
+			return fmt.Errorf("extended commit vote address %X is duplicated", vote.Validator.Address)
+		}
+		seen[string(vote.Validator.Address)] = struct{}{}
+
+		if !bytes.Equal(vote.Validator.Address, lc.Votes[i].Validator.Address) {
+			return fmt.Errorf("extended commit vote address %X does not match last commit vote address %X", vote.Validator.Address, lc.Votes[i].Validator.Address)
+		}
+		if vote.Validator.Power != lc.Votes[i].Validator.Power {
+			return fmt.Errorf("extended commit vote power %d does not match last commit vote power %d", vote.Validator.Power, lc.Votes[i].Validator.Power)
+		}
+		if vote.BlockIdFlag != lc.Votes[i].BlockIdFlag {
+			return fmt.Errorf("extended commit vote flag %s does not match last commit vote flag %s", vote.BlockIdFlag, lc.Votes[i].BlockIdFlag)
+		}
+	}
+
+	return nil
+}
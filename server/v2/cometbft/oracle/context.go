@@ -0,0 +1,35 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/handlers"
+)
+
+// ObservationsFromContext returns the aggregated observations injected at the top of the block
+// being finalized, keyed by feed. It is meant to be called by modules in PreBlock and returns an
+// empty map when the block carries no observation.
+func ObservationsFromContext(ctx context.Context) (map[string]math.LegacyDec, error) {
+	tx, ok := handlers.InjectedTxFromContext(ctx)
+	if !ok {
+		return map[string]math.LegacyDec{}, nil
+	}
+
+	var data InjectedData
+	if err := data.Unmarshal(tx); err != nil {
+		return nil, fmt.Errorf("failed to decode injected oracle data: %w", err)
+	}
+
+	observations := make(map[string]math.LegacyDec, len(data.Observations))
+	for _, obs := range data.Observations {
+		value, err := math.LegacyNewDecFromStr(obs.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid injected observation %s: %w", obs.Feed, err)
+		}
+		observations[obs.Feed] = value
+	}
+
+	return observations, nil
+}
@@ -0,0 +1,387 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/handlers"
+)
+
+var _ handlers.TxInjector = (*Oracle)(nil)
+
+// ValidatorStore returns the consensus public keys of the validators, used to verify the
+// signatures of their vote extensions. It is typically backed by x/staking.
+type ValidatorStore interface {
+	GetPubKeyByConsAddr(ctx context.Context, consAddr []byte) (cmtcrypto.PubKey, error)
+}
+
+// Config defines the parameters of the oracle.
+type Config struct {
+	// Threshold is the minimum share of the voting power of the last commit which must report
+	// a feed for the feed to be aggregated.
+	Threshold math.LegacyDec
+	// ObserveTimeout bounds the time spent querying the providers when extending a vote.
+	ObserveTimeout time.Duration
+	// MaxFeeds is the maximum number of observations in a vote extension.
+	MaxFeeds int
+}
+
+// DefaultConfig returns the default oracle configuration.
+func DefaultConfig() Config {
+	return Config{
+		Threshold:      math.LegacyNewDecWithPrec(5, 1),
+		ObserveTimeout: 500 * time.Millisecond,
+		MaxFeeds:       128,
+	}
+}
+
+// Oracle is a vote extension based oracle.
+//
+// Validators attach the observations of the registered providers to their precommit votes
+// through vote extensions. The proposer of the next block aggregates them into stake-weighted
+// medians, and injects the result along with the extended commit proving it as the first
+// transaction of the proposal. Modules consume the aggregated observations in PreBlock through
+// ObservationsFromContext.
+//
+// The Oracle handlers are set in the cometbft server options as ExtendVoteHandler,
+// VerifyVoteExtensionHandler and TxInjector.
+type Oracle struct {
+	cfg            Config
+	validatorStore ValidatorStore
+	providers      []Provider
+	logger         log.Logger
+}
+
+// New creates an Oracle observing the given providers.
+func New(cfg Config, validatorStore ValidatorStore, logger log.Logger, providers ...Provider) (*Oracle, error) {
+	if cfg.Threshold.IsNil() || !cfg.Threshold.IsPositive() || cfg.Threshold.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("threshold must be in (0, 1], got %s", cfg.Threshold)
+	}
+	if cfg.MaxFeeds <= 0 {
+		return nil, fmt.Errorf("max feeds must be positive, got %d", cfg.MaxFeeds)
+	}
+	if validatorStore == nil {
+		return nil, errors.New("validator store must be set")
+	}
+
+	names := make(map[string]struct{}, len(providers))
+	for _, p := range providers {
+		if p.Name() == "" {
+			return nil, errors.New("provider name cannot be empty")
+		}
+		if _, ok := names[p.Name()]; ok {
+			return nil, fmt.Errorf("duplicate provider %s", p.Name())
+		}
+		names[p.Name()] = struct{}{}
+	}
+
+	return &Oracle{
+		cfg:            cfg,
+		validatorStore: validatorStore,
+		providers:      providers,
+		logger:         logger,
+	}, nil
+}
+
+// ExtendVoteHandler returns a handler attaching the observations of the providers to the vote.
+func (o *Oracle) ExtendVoteHandler() handlers.ExtendVoteHandler {
+	return func(ctx context.Context, _ store.ReaderMap, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		ve := VoteExtension{
+			Height:       req.Height,
+			Observations: o.observe(ctx),
+		}
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a handler rejecting malformed vote extensions.
+// Empty vote extensions are accepted, they carry no observation.
+func (o *Oracle) VerifyVoteExtensionHandler() handlers.VerifyVoteExtensionhandler {
+	return func(_ context.Context, _ store.ReaderMap, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		if len(req.VoteExtension) > 0 {
+			var ve VoteExtension
+			if err := ve.Unmarshal(req.VoteExtension); err != nil {
+				return nil, fmt.Errorf("failed to decode vote extension: %w", err)
+			}
+			if err := o.validateVoteExtension(ve, req.Height); err != nil {
+				return nil, err
+			}
+		}
+
+		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+	}
+}
+
+// InjectTx implements handlers.TxInjector. It aggregates the vote extensions of the local last
+// commit. If they cannot be verified, no observation is injected.
+func (o *Oracle) InjectTx(ctx context.Context, chainID string, req *abci.PrepareProposalRequest) ([]byte, error) {
+	data := InjectedData{Height: req.Height - 1}
+	if err := o.validateExtendedCommit(ctx, chainID, req.Height, req.LocalLastCommit); err != nil {
+		o.logger.Error("failed to validate vote extensions, injecting no observation", "height", req.Height, "err", err)
+	} else {
+		data.ExtendedCommitInfo = req.LocalLastCommit
+		data.Observations = o.aggregate(req.Height, req.LocalLastCommit)
+	}
+
+	return data.Marshal()
+}
+
+// VerifyInjectedTx implements handlers.TxInjector. It checks the extended commit carried by the
+// injected transaction and that the injected observations are its aggregation.
+func (o *Oracle) VerifyInjectedTx(ctx context.Context, chainID string, tx []byte, req *abci.ProcessProposalRequest) error {
+	var data InjectedData
+	if err := data.Unmarshal(tx); err != nil {
+		return fmt.Errorf("failed to decode injected data: %w", err)
+	}
+	if data.Height != req.Height-1 {
+		return fmt.Errorf("injected data height %d does not match the previous height %d", data.Height, req.Height-1)
+	}
+
+	// the proposer could not aggregate the vote extensions.
+	if len(data.ExtendedCommitInfo.Votes) == 0 {
+		if len(data.Observations) > 0 {
+			return errors.New("injected observations without extended commit")
+		}
+		return nil
+	}
+
+	if err := validateExtendedCommitAgainstLastCommit(data.ExtendedCommitInfo, req.ProposedLastCommit); err != nil {
+		return err
+	}
+	if err := o.validateExtendedCommit(ctx, chainID, req.Height, data.ExtendedCommitInfo); err != nil {
+		return err
+	}
+
+	expected := o.aggregate(req.Height, data.ExtendedCommitInfo)
+	if len(expected) != len(data.Observations) {
+		return fmt.Errorf("expected %d injected observations, got %d", len(expected), len(data.Observations))
+	}
+	for i, obs := range expected {
+		if obs != data.Observations[i] {
+			return fmt.Errorf("injected observation %s=%s does not match the aggregated value %s", data.Observations[i].Feed, data.Observations[i].Value, obs.Value)
+		}
+	}
+
+	return nil
+}
+
+// observe queries all providers concurrently and combines their values, per feed, into their median.
+func (o *Oracle) observe(ctx context.Context) []Observation {
+	ctx, cancel := context.WithTimeout(ctx, o.cfg.ObserveTimeout)
+	defer cancel()
+
+	results := make([]map[string]math.LegacyDec, len(o.providers))
+	var wg sync.WaitGroup
+	for i, p := range o.providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values, err := p.Observe(ctx)
+			if err != nil {
+				o.logger.Error("failed to query oracle provider", "provider", p.Name(), "err", err)
+				return
+			}
+			results[i] = values
+		}()
+	}
+	wg.Wait()
+
+	values := make(map[string][]math.LegacyDec)
+	for i, result := range results {
+		for feed, value := range result {
+			if feed == "" || value.IsNil() || !value.IsPositive() {
+				o.logger.Error("ignoring invalid oracle observation", "provider", o.providers[i].Name(), "feed", feed, "value", value)
+				continue
+			}
+			values[feed] = append(values[feed], value)
+		}
+	}
+
+	observations := make([]Observation, 0, len(values))
+	for feed, vs := range values {
+		observations = append(observations, Observation{Feed: feed, Value: median(vs).String()})
+	}
+	sort.Slice(observations, func(i, j int) bool { return observations[i].Feed < observations[j].Feed })
+
+	if len(observations) > o.cfg.MaxFeeds {
+		o.logger.Error("too many oracle feeds, dropping the last ones", "feeds", len(observations), "max", o.cfg.MaxFeeds)
+		observations = observations[:o.cfg.MaxFeeds]
+	}
+
+	return observations
+}
+
+// validateVoteExtension checks that ve is a well-formed vote extension for the given height.
+func (o *Oracle) validateVoteExtension(ve VoteExtension, height int64) error {
+	if ve.Height != height {
+		return fmt.Errorf("vote extension height %d does not match vote height %d", ve.Height, height)
+	}
+	if len(ve.Observations) > o.cfg.MaxFeeds {
+		return fmt.Errorf("vote extension has %d observations, max is %d", len(ve.Observations), o.cfg.MaxFeeds)
+	}
+
+	for i, obs := range ve.Observations {
+		if obs.Feed == "" {
+			return errors.New("observation feed cannot be empty")
+		}
+		if i > 0 && obs.Feed <= ve.Observations[i-1].Feed {
+			return fmt.Errorf("observations must be sorted by feed without duplicates, got %s after %s", obs.Feed, ve.Observations[i-1].Feed)
+		}
+		value, err := math.LegacyNewDecFromStr(obs.Value)
+		if err != nil {
+			return fmt.Errorf("invalid observation %s value: %w", obs.Feed, err)
+		}
+		if !value.IsPositive() {
+			return fmt.Errorf("observation %s value must be positive, got %s", obs.Feed, value)
+		}
+	}
+
+	return nil
+}
+
+// validateExtendedCommit verifies the vote extension signatures of the extended commit of the
+// height preceding height, and that validators holding more than 2/3 of the voting power signed.
+func (o *Oracle) validateExtendedCommit(ctx context.Context, chainID string, height int64, extCommit abci.ExtendedCommitInfo) error {
+	var totalVP, sumVP int64
+	for _, vote := range extCommit.Votes {
+		totalVP += vote.Validator.Power
+
+		// only commit votes carry vote extensions.
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		if len(vote.ExtensionSignature) == 0 {
+			return fmt.Errorf("empty vote extension signature from validator %X at height %d", vote.Validator.Address, height)
+		}
+
+		pubKey, err := o.validatorStore.GetPubKeyByConsAddr(ctx, vote.Validator.Address)
+		if err != nil {
+			return fmt.Errorf("failed to get validator %X public key: %w", vote.Validator.Address, err)
+		}
+
+		signBytes, err := voteExtensionSignBytes(chainID, height-1, extCommit.Round, vote.VoteExtension)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, vote.ExtensionSignature) {
+			return fmt.Errorf("failed to verify validator %X vote extension signature", vote.Validator.Address)
+		}
+
+		sumVP += vote.Validator.Power
+	}
+
+	if totalVP <= 0 {
+		return fmt.Errorf("total voting power must be positive, got: %d", totalVP)
+	}
+	if requiredVP := ((totalVP * 2) / 3) + 1; sumVP < requiredVP {
+		return fmt.Errorf("insufficient cumulative voting power received to verify vote extensions; got: %d, expected: >=%d", sumVP, requiredVP)
+	}
+
+	return nil
+}
+
+// aggregate computes, for every feed reported by validators holding at least the threshold of the
+// voting power, the stake-weighted median of the observations of the extended commit preceding
+// height. Malformed vote extensions are ignored.
+func (o *Oracle) aggregate(height int64, extCommit abci.ExtendedCommitInfo) []Observation {
+	var totalVP int64
+	reports := make(map[string][]weightedValue)
+	for _, vote := range extCommit.Votes {
+		totalVP += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var ve VoteExtension
+		if err := ve.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+		if err := o.validateVoteExtension(ve, height-1); err != nil {
+			continue
+		}
+
+		for _, obs := range ve.Observations {
+			reports[obs.Feed] = append(reports[obs.Feed], weightedValue{
+				value: math.LegacyMustNewDecFromStr(obs.Value),
+				power: vote.Validator.Power,
+			})
+		}
+	}
+
+	feeds := make([]string, 0, len(reports))
+	for feed := range reports {
+		feeds = append(feeds, feed)
+	}
+	sort.Strings(feeds)
+
+	threshold := o.cfg.Threshold.MulInt64(totalVP)
+	observations := make([]Observation, 0, len(feeds))
+	for _, feed := range feeds {
+		var reportedVP int64
+		for _, r := range reports[feed] {
+			reportedVP += r.power
+		}
+		if math.LegacyNewDec(reportedVP).LT(threshold) {
+			continue
+		}
+
+		observations = append(observations, Observation{Feed: feed, Value: weightedMedian(reports[feed]).String()})
+	}
+
+	return observations
+}
+
+// weightedValue is a value reported by a validator, weighted by its voting power.
+type weightedValue struct {
+	value math.LegacyDec
+	power int64
+}
+
+// weightedMedian returns the smallest value such that the values lower or equal to it
+// hold at least half of the total power.
+func weightedMedian(values []weightedValue) math.LegacyDec {
+	sort.SliceStable(values, func(i, j int) bool { return values[i].value.LT(values[j].value) })
+
+	var total int64
+	for _, v := range values {
+		total += v.power
+	}
+
+	var cumulative int64
+	for _, v := range values {
+		cumulative += v.power
+		if 2*cumulative >= total {
+			return v.value
+		}
+	}
+
+	return values[len(values)-1].value
+}
+
+// median returns the median of values, averaging the two middle values of an even count.
+func median(values []math.LegacyDec) math.LegacyDec {
+	sort.Slice(values, func(i, j int) bool { return values[i].LT(values[j]) })
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+	return values[mid-1].Add(values[mid]).QuoInt64(2)
+}
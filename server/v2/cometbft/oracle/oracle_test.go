@@ -0,0 +1,219 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/handlers"
+)
+
+const chainID = "oracle-test"
+
+type testValidator struct {
+	key    ed25519.PrivKey
+	power  int64
+	oracle *Oracle
+}
+
+type mockValidatorStore map[string]cmtcrypto.PubKey
+
+func (m mockValidatorStore) GetPubKeyByConsAddr(_ context.Context, consAddr []byte) (cmtcrypto.PubKey, error) {
+	pk, ok := m[string(consAddr)]
+	if !ok {
+		return nil, errors.New("validator not found")
+	}
+	return pk, nil
+}
+
+func dec(s string) math.LegacyDec { return math.LegacyMustNewDecFromStr(s) }
+
+// setupValidators creates validators, sorted by descending power, each observing the given prices.
+func setupValidators(t *testing.T, powers []int64, prices []map[string]math.LegacyDec) ([]testValidator, mockValidatorStore) {
+	t.Helper()
+	valStore := mockValidatorStore{}
+	validators := make([]testValidator, len(powers))
+	for i, power := range powers {
+		key := ed25519.GenPrivKey()
+		valStore[string(key.PubKey().Address())] = key.PubKey()
+		validators[i] = testValidator{key: key, power: power}
+	}
+	for i := range validators {
+		o, err := New(DefaultConfig(), valStore, log.NewNopLogger(), StaticProvider{ProviderName: fmt.Sprintf("provider-%d", i), Values: prices[i]})
+		require.NoError(t, err)
+		validators[i].oracle = o
+	}
+	return validators, valStore
+}
+
+// extendedCommit makes every validator extend its vote at height and signs the extensions.
+func extendedCommit(t *testing.T, validators []testValidator, height int64) (abci.ExtendedCommitInfo, abci.CommitInfo) {
+	t.Helper()
+	var (
+		ec abci.ExtendedCommitInfo
+		lc abci.CommitInfo
+	)
+	for _, v := range validators {
+		res, err := v.oracle.ExtendVoteHandler()(context.Background(), nil, &abci.ExtendVoteRequest{Height: height})
+		require.NoError(t, err)
+
+		signBytes, err := voteExtensionSignBytes(chainID, height, 0, res.VoteExtension)
+		require.NoError(t, err)
+		sig, err := v.key.Sign(signBytes)
+		require.NoError(t, err)
+
+		validator := abci.Validator{Address: v.key.PubKey().Address(), Power: v.power}
+		ec.Votes = append(ec.Votes, abci.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      res.VoteExtension,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+		lc.Votes = append(lc.Votes, abci.VoteInfo{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+	}
+	return ec, lc
+}
+
+func TestOracle(t *testing.T) {
+	validators, _ := setupValidators(t, []int64{40, 30, 20, 10}, []map[string]math.LegacyDec{
+		{"ATOM/USD": dec("10")},
+		{"ATOM/USD": dec("11")},
+		{"ATOM/USD": dec("12")},
+		{"ATOM/USD": dec("100"), "BTC/USD": dec("60000")},
+	})
+	proposer := validators[0].oracle
+
+	ec, lc := extendedCommit(t, validators, 9)
+	tx, err := proposer.InjectTx(context.Background(), chainID, &abci.PrepareProposalRequest{Height: 10, LocalLastCommit: ec})
+	require.NoError(t, err)
+
+	processReq := &abci.ProcessProposalRequest{Height: 10, ProposedLastCommit: lc}
+	for _, v := range validators {
+		require.NoError(t, v.oracle.VerifyInjectedTx(context.Background(), chainID, tx, processReq))
+	}
+
+	// the stake-weighted median is used, BTC/USD is reported by too little voting power.
+	observations, err := ObservationsFromContext(handlers.ContextWithInjectedTx(context.Background(), tx))
+	require.NoError(t, err)
+	require.Equal(t, map[string]math.LegacyDec{"ATOM/USD": dec("11")}, observations)
+
+	// no injected data
+	observations, err = ObservationsFromContext(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, observations)
+
+	// tampered observations
+	var data InjectedData
+	require.NoError(t, data.Unmarshal(tx))
+	data.Observations[0].Value = dec("12").String()
+	tampered, err := data.Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, proposer.VerifyInjectedTx(context.Background(), chainID, tampered, processReq), "does not match the aggregated value")
+
+	// tampered vote extension
+	data = InjectedData{}
+	require.NoError(t, data.Unmarshal(tx))
+	data.ExtendedCommitInfo.Votes[1].VoteExtension = data.ExtendedCommitInfo.Votes[0].VoteExtension
+	tampered, err = data.Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, proposer.VerifyInjectedTx(context.Background(), chainID, tampered, processReq), "signature")
+
+	// extended commit not matching the last commit
+	require.ErrorContains(t, proposer.VerifyInjectedTx(context.Background(), chainID, tx, &abci.ProcessProposalRequest{
+		Height:             10,
+		ProposedLastCommit: abci.CommitInfo{Votes: lc.Votes[1:]},
+	}), "votes length")
+
+	// wrong height
+	require.ErrorContains(t, proposer.VerifyInjectedTx(context.Background(), chainID, tx, &abci.ProcessProposalRequest{
+		Height:             11,
+		ProposedLastCommit: lc,
+	}), "height")
+}
+
+func TestOracleInsufficientVotingPower(t *testing.T) {
+	validators, _ := setupValidators(t, []int64{40, 30, 20, 10}, []map[string]math.LegacyDec{
+		{"ATOM/USD": dec("10")},
+		{"ATOM/USD": dec("11")},
+		{"ATOM/USD": dec("12")},
+		{"ATOM/USD": dec("13")},
+	})
+	proposer := validators[0].oracle
+
+	ec, lc := extendedCommit(t, validators, 9)
+	// validators with 40% of the voting power did not sign the block.
+	ec.Votes[0].BlockIdFlag, ec.Votes[0].VoteExtension, ec.Votes[0].ExtensionSignature = cmtproto.BlockIDFlagAbsent, nil, nil
+	lc.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+
+	// the proposer injects no observation.
+	tx, err := proposer.InjectTx(context.Background(), chainID, &abci.PrepareProposalRequest{Height: 10, LocalLastCommit: ec})
+	require.NoError(t, err)
+	processReq := &abci.ProcessProposalRequest{Height: 10, ProposedLastCommit: lc}
+	require.NoError(t, proposer.VerifyInjectedTx(context.Background(), chainID, tx, processReq))
+	observations, err := ObservationsFromContext(handlers.ContextWithInjectedTx(context.Background(), tx))
+	require.NoError(t, err)
+	require.Empty(t, observations)
+
+	// injecting the extended commit anyway is rejected.
+	data := InjectedData{Height: 9, ExtendedCommitInfo: ec}
+	tx, err = data.Marshal()
+	require.NoError(t, err)
+	require.ErrorContains(t, proposer.VerifyInjectedTx(context.Background(), chainID, tx, processReq), "insufficient cumulative voting power")
+}
+
+func TestOracleVoteExtensions(t *testing.T) {
+	o, err := New(DefaultConfig(), mockValidatorStore{}, log.NewNopLogger(),
+		StaticProvider{ProviderName: "a", Values: map[string]math.LegacyDec{"ATOM/USD": dec("10"), "ETH/USD": dec("-1")}},
+		StaticProvider{ProviderName: "b", Values: map[string]math.LegacyDec{"ATOM/USD": dec("11")}},
+		StaticProvider{ProviderName: "c", Err: errors.New("unavailable")},
+	)
+	require.NoError(t, err)
+
+	res, err := o.ExtendVoteHandler()(context.Background(), nil, &abci.ExtendVoteRequest{Height: 5})
+	require.NoError(t, err)
+
+	// providers are combined with their median, invalid values and failing providers are ignored.
+	var ve VoteExtension
+	require.NoError(t, ve.Unmarshal(res.VoteExtension))
+	require.Equal(t, VoteExtension{Height: 5, Observations: []Observation{{Feed: "ATOM/USD", Value: dec("10.5").String()}}}, ve)
+
+	verify := o.VerifyVoteExtensionHandler()
+	_, err = verify(context.Background(), nil, &abci.VerifyVoteExtensionRequest{Height: 5, VoteExtension: res.VoteExtension})
+	require.NoError(t, err)
+	_, err = verify(context.Background(), nil, &abci.VerifyVoteExtensionRequest{Height: 5})
+	require.NoError(t, err)
+	_, err = verify(context.Background(), nil, &abci.VerifyVoteExtensionRequest{Height: 6, VoteExtension: res.VoteExtension})
+	require.ErrorContains(t, err, "height")
+
+	for _, observations := range [][]Observation{
+		{{Feed: "B", Value: "1"}, {Feed: "A", Value: "1"}},
+		{{Feed: "A", Value: "1"}, {Feed: "A", Value: "1"}},
+		{{Feed: "A", Value: "0"}},
+		{{Feed: "A", Value: "one"}},
+		{{Feed: "", Value: "1"}},
+	} {
+		bz, err := (&VoteExtension{Height: 5, Observations: observations}).Marshal()
+		require.NoError(t, err)
+		_, err = verify(context.Background(), nil, &abci.VerifyVoteExtensionRequest{Height: 5, VoteExtension: bz})
+		require.Error(t, err, observations)
+	}
+
+	_, err = New(DefaultConfig(), mockValidatorStore{}, log.NewNopLogger(), StaticProvider{ProviderName: "a"}, StaticProvider{ProviderName: "a"})
+	require.ErrorContains(t, err, "duplicate provider")
+}
+
+func TestWeightedMedian(t *testing.T) {
+	require.Equal(t, dec("2"), weightedMedian([]weightedValue{{dec("3"), 1}, {dec("1"), 1}, {dec("2"), 1}}))
+	require.Equal(t, dec("1"), weightedMedian([]weightedValue{{dec("3"), 1}, {dec("1"), 3}, {dec("2"), 1}}))
+	require.Equal(t, dec("3"), weightedMedian([]weightedValue{{dec("3"), 10}, {dec("1"), 3}, {dec("2"), 1}}))
+	require.Equal(t, dec("1"), weightedMedian([]weightedValue{{dec("1"), 1}, {dec("2"), 1}}))
+}
@@ -0,0 +1,35 @@
+package oracle
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// Provider fetches observations from an external data source, e.g. the prices of currency pairs
+// from an exchange. Providers are queried by validators when they extend their votes.
+type Provider interface {
+	// Name returns the unique name of the provider.
+	Name() string
+	// Observe returns the latest observed values keyed by feed identifier, e.g. ATOM/USD.
+	// Values must be positive.
+	Observe(ctx context.Context) (map[string]math.LegacyDec, error)
+}
+
+var _ Provider = StaticProvider{}
+
+// StaticProvider is a Provider always returning the same observations.
+// It is meant to be used for testing and for feeds with a fixed value.
+type StaticProvider struct {
+	ProviderName string
+	Values       map[string]math.LegacyDec
+	Err          error
+}
+
+// Name implements Provider.
+func (p StaticProvider) Name() string { return p.ProviderName }
+
+// Observe implements Provider.
+func (p StaticProvider) Observe(context.Context) (map[string]math.LegacyDec, error) {
+	return p.Values, p.Err
+}
@@ -24,6 +24,7 @@ import (
 	serverv2 "cosmossdk.io/server/v2"
 	cometlog "cosmossdk.io/server/v2/cometbft/log"
 	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
 
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -46,6 +47,8 @@ type CometBFTServer[T transaction.Tx] struct {
 	serverOptions ServerOptions[T]
 	config        Config
 	cfgOptions    []CfgOption
+
+	closeStreaming func() error
 }
 
 func New[T transaction.Tx](
@@ -121,6 +124,7 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	consensus.checkTxHandler = s.serverOptions.CheckTxHandler
 	consensus.verifyVoteExt = s.serverOptions.VerifyVoteExtensionHandler
 	consensus.extendVote = s.serverOptions.ExtendVoteHandler
+	consensus.txInjector = s.serverOptions.TxInjector
 	consensus.addrPeerFilter = s.serverOptions.AddrPeerFilter
 	consensus.idPeerFilter = s.serverOptions.IdPeerFilter
 
@@ -146,6 +150,20 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 		consensus.listener = &listener.Listener
 	}
 
+	// initialize the streaming plugin
+	if listenerCfg := s.config.AppTomlConfig.Streaming.ListenerConfig; listenerCfg.Plugin != "" {
+		logLevel, _ := cfg[serverv2.FlagLogLevel].(string)
+		listener, closeListener, err := streaming.NewListener(listenerCfg, home, logLevel, s.logger.With(log.ModuleKey, "streaming"))
+		if err != nil {
+			return fmt.Errorf("failed to start streaming: %w", err)
+		}
+		consensus.SetStreamingManager(streaming.Manager{
+			Listeners:     []streaming.Listener{listener},
+			StopNodeOnErr: listenerCfg.StopNodeOnErr,
+		})
+		s.closeStreaming = closeListener
+	}
+
 	s.Consensus = consensus
 
 	return nil
@@ -202,7 +220,13 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 
 func (s *CometBFTServer[T]) Stop(context.Context) error {
 	if s.Node != nil && s.Node.IsRunning() {
-		return s.Node.Stop()
+		if err := s.Node.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.closeStreaming != nil {
+		return s.closeStreaming()
 	}
 
 	return nil
@@ -40,12 +40,14 @@ func (c *Consensus[T]) streamDeliverBlockChanges(
 		}
 	}
 
+	// plugin listeners expect a streaming.Context.
+	streamingCtx := streaming.NewContext(ctx, height, c.logger, c.streaming)
 	for _, streamingListener := range c.streaming.Listeners {
 		events, err := streaming.IntoStreamingEvents(events)
 		if err != nil {
 			return err
 		}
-		if err := streamingListener.ListenDeliverBlock(ctx, streaming.ListenDeliverBlockRequest{
+		if err := streamingListener.ListenDeliverBlock(streamingCtx, streaming.ListenDeliverBlockRequest{
 			BlockHeight: height,
 			Txs:         txs,
 			TxResults:   streamingTxResults,
@@ -54,7 +56,7 @@ func (c *Consensus[T]) streamDeliverBlockChanges(
 			c.logger.Error("ListenDeliverBlock listening hook failed", "height", height, "err", err)
 		}
 
-		if err := streamingListener.ListenStateChanges(ctx, intoStreamingKVPairs(stateChanges)); err != nil {
+		if err := streamingListener.ListenStateChanges(streamingCtx, intoStreamingKVPairs(stateChanges)); err != nil {
 			c.logger.Error("ListenStateChanges listening hook failed", "height", height, "err", err)
 		}
 	}
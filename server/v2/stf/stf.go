@@ -140,7 +140,6 @@ func (s STF[T]) DeliverBlock(
 
 	// execute txs
 	txResults := make([]server.TxResult, len(block.Txs))
-	// TODO: skip first tx if vote extensions are enabled (marko)
 	for i, txBytes := range block.Txs {
 		// check if we need to return early or continue delivering txs
 		if err = isCtxCancelled(ctx); err != nil {
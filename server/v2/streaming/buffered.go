@@ -0,0 +1,194 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/log"
+)
+
+// maxRetryInterval caps the delay between two delivery attempts of the same block.
+const maxRetryInterval = time.Minute
+
+// Dialer connects to a Listener, usually by launching a plugin process.
+// The returned release function disconnects from it.
+type Dialer func() (listener Listener, release func(), err error)
+
+var _ Listener = (*BufferedListener)(nil)
+
+// BufferedListener is a Listener which decouples block production from the delivery of
+// blocks to an underlying listener, usually an out-of-process plugin.
+//
+// Blocks are persisted in a bounded on-disk queue and delivered in order from a background
+// routine. A block leaves the queue only once the underlying listener acknowledged both its
+// ListenDeliverBlock and ListenStateChanges calls, which makes delivery at-least-once: after
+// a failed delivery the listener is dialed again and delivery resumes from the last
+// acknowledged height, including across node restarts.
+// When the queue is full, ListenStateChanges waits for the underlying listener to catch up.
+type BufferedListener struct {
+	dial          Dialer
+	queue         *diskQueue
+	logger        log.Logger
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	pending *ListenDeliverBlockRequest
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewBufferedListener opens the delivery queue located in cfg.QueueDir and starts delivering
+// its pending blocks to the listener returned by dial.
+func NewBufferedListener(cfg ListenerConfig, dial Dialer, logger log.Logger) (*BufferedListener, error) {
+	if cfg.QueueDir == "" {
+		return nil, errors.New("streaming queue directory must be set")
+	}
+	if cfg.MaxQueueSize == 0 {
+		return nil, errors.New("streaming queue size must be positive")
+	}
+
+	queue, err := openDiskQueue(cfg.QueueDir, cfg.MaxQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
+	retryInterval := cfg.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultListenerConfig().RetryInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &BufferedListener{
+		dial:          dial,
+		queue:         queue,
+		logger:        logger,
+		retryInterval: retryInterval,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+	if n := queue.len(); n > 0 {
+		logger.Info("replaying undelivered blocks to streaming listener", "from_height", queue.ackedHeight()+1, "blocks", n)
+	}
+
+	go l.run(ctx)
+
+	return l, nil
+}
+
+// ListenDeliverBlock records the block, it is queued along with its state changes
+// in ListenStateChanges.
+func (l *BufferedListener) ListenDeliverBlock(_ context.Context, req ListenDeliverBlockRequest) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pending = &req
+	return nil
+}
+
+// ListenStateChanges queues the block recorded by the previous ListenDeliverBlock call
+// along with its state changes.
+func (l *BufferedListener) ListenStateChanges(ctx context.Context, changeSet []*StoreKVPair) error {
+	l.mu.Lock()
+	deliverBlock := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	if deliverBlock == nil {
+		return errors.New("state changes received without a delivered block")
+	}
+
+	height := deliverBlock.BlockHeight
+	return l.queue.push(ctx, queueEntry{
+		height:       height,
+		deliverBlock: deliverBlock,
+		stateChanges: &ListenStateChangesRequest{BlockHeight: height, ChangeSet: changeSet},
+	}, func() {
+		l.logger.Warn("streaming queue is full, waiting for the listener to catch up", "height", height, "acked_height", l.queue.ackedHeight())
+	})
+}
+
+// AckedHeight returns the height of the last block acknowledged by the underlying listener.
+func (l *BufferedListener) AckedHeight() int64 {
+	return l.queue.ackedHeight()
+}
+
+// Close stops the delivery. Undelivered blocks stay in the queue and are delivered
+// once a new BufferedListener is created on the same queue directory.
+func (l *BufferedListener) Close() error {
+	l.cancel()
+	l.queue.close()
+	<-l.done
+	return nil
+}
+
+// run delivers the queued blocks until ctx is done.
+func (l *BufferedListener) run(ctx context.Context) {
+	defer close(l.done)
+
+	var (
+		listener Listener
+		release  func()
+	)
+	defer func() {
+		if release != nil {
+			release()
+		}
+	}()
+
+	backoff := l.retryInterval
+	for {
+		entry, err := l.queue.next(ctx)
+		if err != nil {
+			if !errors.Is(err, errQueueClosed) && !errors.Is(err, context.Canceled) {
+				l.logger.Error("failed to read streaming queue", "err", err)
+			}
+			return
+		}
+
+		if listener == nil {
+			listener, release, err = l.dial()
+		}
+		if err == nil {
+			err = l.deliver(ctx, listener, entry)
+		}
+		if err != nil {
+			l.logger.Error("failed to deliver block to streaming listener", "height", entry.height, "retry_in", backoff, "err", err)
+			// the plugin may have crashed, reconnect before the next attempt.
+			if release != nil {
+				release()
+			}
+			listener, release = nil, nil
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			backoff = min(2*backoff, maxRetryInterval)
+			continue
+		}
+
+		backoff = l.retryInterval
+		if err := l.queue.ack(entry.height); err != nil {
+			l.logger.Error("failed to acknowledge streamed block", "height", entry.height, "err", err)
+			return
+		}
+	}
+}
+
+func (l *BufferedListener) deliver(ctx context.Context, listener Listener, e queueEntry) error {
+	// failures are retried, the underlying listener must never stop the node.
+	lctx := NewContext(ctx, e.height, l.logger, Manager{Listeners: []Listener{listener}, StopNodeOnErr: false})
+
+	if err := listener.ListenDeliverBlock(lctx, *e.deliverBlock); err != nil {
+		return fmt.Errorf("ListenDeliverBlock: %w", err)
+	}
+	if err := listener.ListenStateChanges(lctx, e.stateChanges.ChangeSet); err != nil {
+		return fmt.Errorf("ListenStateChanges: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+)
+
+// recordingListener records the delivered heights and fails the first failures calls.
+type recordingListener struct {
+	mu       sync.Mutex
+	failures int
+	heights  []int64
+	changes  map[int64]int
+	height   int64
+}
+
+func (r *recordingListener) ListenDeliverBlock(_ context.Context, req ListenDeliverBlockRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("sink unavailable")
+	}
+	r.height = req.BlockHeight
+	return nil
+}
+
+func (r *recordingListener) ListenStateChanges(ctx context.Context, changeSet []*StoreKVPair) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if ctx.(Context).BlockHeight() != r.height {
+		return errors.New("unexpected height")
+	}
+	r.heights = append(r.heights, r.height)
+	if r.changes == nil {
+		r.changes = map[int64]int{}
+	}
+	r.changes[r.height] = len(changeSet)
+	return nil
+}
+
+func (r *recordingListener) delivered() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int64(nil), r.heights...)
+}
+
+func newTestBufferedListener(t *testing.T, dir string, maxSize uint64, sink Listener, dials *int) *BufferedListener {
+	t.Helper()
+	cfg := DefaultListenerConfig()
+	cfg.DeliveryMode = DeliveryModeAsync
+	cfg.QueueDir = dir
+	cfg.MaxQueueSize = maxSize
+	cfg.RetryInterval = time.Millisecond
+
+	l, err := NewBufferedListener(cfg, func() (Listener, func(), error) {
+		if dials != nil {
+			*dials++
+		}
+		return sink, func() {}, nil
+	}, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	return l
+}
+
+func streamBlock(t *testing.T, l Listener, height int64) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, l.ListenDeliverBlock(ctx, ListenDeliverBlockRequest{BlockHeight: height, Txs: [][]byte{{byte(height)}}}))
+	require.NoError(t, l.ListenStateChanges(ctx, []*StoreKVPair{{Key: []byte("key"), Value: []byte{byte(height)}}}))
+}
+
+func TestBufferedListenerDeliversInOrderWithRetries(t *testing.T) {
+	sink := &recordingListener{failures: 2}
+	dials := 0
+	l := newTestBufferedListener(t, t.TempDir(), 10, sink, &dials)
+
+	for h := int64(1); h <= 5; h++ {
+		streamBlock(t, l, h)
+	}
+
+	require.Eventually(t, func() bool { return l.AckedHeight() == 5 }, 5*time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, sink.delivered())
+	require.Equal(t, 1, sink.changes[3])
+	// the listener is dialed again after every failure.
+	require.Equal(t, 3, dials)
+}
+
+func TestBufferedListenerReplaysFromCursor(t *testing.T) {
+	dir := t.TempDir()
+
+	// nothing gets delivered while the sink is down.
+	down := &recordingListener{failures: 1 << 30}
+	l := newTestBufferedListener(t, dir, 10, down, nil)
+	for h := int64(1); h <= 3; h++ {
+		streamBlock(t, l, h)
+	}
+	require.NoError(t, l.Close())
+	require.Empty(t, down.delivered())
+	require.Equal(t, int64(0), l.AckedHeight())
+
+	// restarting resumes delivery from the stored cursor.
+	sink := &recordingListener{}
+	l = newTestBufferedListener(t, dir, 10, sink, nil)
+	require.Eventually(t, func() bool { return l.AckedHeight() == 3 }, 5*time.Second, time.Millisecond)
+	streamBlock(t, l, 4)
+	require.Eventually(t, func() bool { return l.AckedHeight() == 4 }, 5*time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3, 4}, sink.delivered())
+
+	// acknowledged blocks are not delivered again.
+	sink = &recordingListener{}
+	l = newTestBufferedListener(t, dir, 10, sink, nil)
+	streamBlock(t, l, 4)
+	streamBlock(t, l, 5)
+	require.Eventually(t, func() bool { return l.AckedHeight() == 5 }, 5*time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{5}, sink.delivered())
+}
+
+func TestBufferedListenerBackpressure(t *testing.T) {
+	sink := &recordingListener{failures: 1 << 30}
+	l := newTestBufferedListener(t, t.TempDir(), 2, sink, nil)
+	defer l.Close()
+
+	streamBlock(t, l, 1)
+	streamBlock(t, l, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.NoError(t, l.ListenDeliverBlock(ctx, ListenDeliverBlockRequest{BlockHeight: 3}))
+	require.ErrorIs(t, l.ListenStateChanges(ctx, nil), context.DeadlineExceeded)
+
+	// once the sink recovers, the queue drains and accepts new blocks.
+	sink.mu.Lock()
+	sink.failures = 0
+	sink.mu.Unlock()
+	streamBlock(t, l, 3)
+	require.Eventually(t, func() bool { return l.AckedHeight() == 3 }, 5*time.Second, time.Millisecond)
+	require.Equal(t, []int64{1, 2, 3}, sink.delivered())
+}
+
+func TestListenerConfigValidate(t *testing.T) {
+	cfg := DefaultListenerConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.DeliveryMode = DeliveryModeAsync
+	require.NoError(t, cfg.Validate())
+
+	cfg.MaxQueueSize = 0
+	require.Error(t, cfg.Validate())
+
+	cfg.DeliveryMode = "eventually"
+	require.Error(t, cfg.Validate())
+}
@@ -1,7 +1,21 @@
 package streaming
 
+import (
+	"fmt"
+	"time"
+)
+
 // State Streaming configuration
 
+const (
+	// DeliveryModeSync delivers every block to the listeners synchronously, as part of block
+	// execution. A slow or failing listener directly affects block production.
+	DeliveryModeSync = "sync"
+	// DeliveryModeAsync persists every block into an on-disk queue and delivers it to the
+	// listeners from a background routine, with at-least-once guarantees.
+	DeliveryModeAsync = "async"
+)
+
 // StreamingConfig defines application configuration for external streaming services
 type StreamingConfig struct {
 	ListenerConfig ListenerConfig `mapstructure:"listener-config" toml:"listener-config" comment:"ListenerConfig defines application configuration for ABCIListener streaming service"`
@@ -22,4 +36,49 @@ type ListenerConfig struct {
 	Plugin string `mapstructure:"plugin" toml:"plugin" comment:"The plugin name used for streaming via gRPC. Streaming is only enabled if this is set. Supported plugins: abci"`
 	// stop-node-on-err specifies whether to stop the node on message delivery error.
 	StopNodeOnErr bool `mapstructure:"stop-node-on-err" toml:"stop-node-on-err" comment:"stop-node-on-err specifies whether to stop the node on message delivery error."`
+	// DeliveryMode defines how blocks are delivered to the plugin: sync or async.
+	// In async mode blocks are buffered in an on-disk queue and delivered at-least-once,
+	// stop-node-on-err is then ignored as failed deliveries are retried.
+	DeliveryMode string `mapstructure:"delivery-mode" toml:"delivery-mode" comment:"delivery-mode defines how blocks are delivered to the plugin: sync or async. In async mode blocks are buffered in an on-disk queue and delivered at-least-once, failed deliveries are retried and stop-node-on-err is ignored."`
+	// QueueDir is the directory of the on-disk delivery queue used in async mode.
+	// Relative paths are resolved against the node home directory.
+	QueueDir string `mapstructure:"queue-dir" toml:"queue-dir" comment:"queue-dir is the directory of the on-disk delivery queue used in async mode. Relative paths are resolved against the node home directory."`
+	// MaxQueueSize is the maximum number of undelivered blocks kept in the queue.
+	// Once reached, block production waits for the plugin to catch up.
+	MaxQueueSize uint64 `mapstructure:"max-queue-size" toml:"max-queue-size" comment:"max-queue-size is the maximum number of undelivered blocks kept in the async queue. Once reached, block production waits for the plugin to catch up."`
+	// RetryInterval is the initial delay before retrying a failed delivery.
+	// The delay doubles on every consecutive failure, up to one minute.
+	RetryInterval time.Duration `mapstructure:"retry-interval" toml:"retry-interval" comment:"retry-interval is the initial delay before retrying a failed async delivery. It doubles on every consecutive failure, up to one minute."`
+}
+
+// DefaultListenerConfig returns the default listener configuration. Streaming is disabled
+// until a plugin is set.
+func DefaultListenerConfig() ListenerConfig {
+	return ListenerConfig{
+		Keys:          []string{},
+		Plugin:        "",
+		StopNodeOnErr: true,
+		DeliveryMode:  DeliveryModeSync,
+		QueueDir:      "data/streaming",
+		MaxQueueSize:  1000,
+		RetryInterval: time.Second,
+	}
+}
+
+// Validate performs a basic validation of the listener configuration.
+func (c ListenerConfig) Validate() error {
+	switch c.DeliveryMode {
+	case "", DeliveryModeSync:
+		return nil
+	case DeliveryModeAsync:
+		if c.QueueDir == "" {
+			return fmt.Errorf("queue-dir must be set in %s delivery mode", DeliveryModeAsync)
+		}
+		if c.MaxQueueSize == 0 {
+			return fmt.Errorf("max-queue-size must be positive in %s delivery mode", DeliveryModeAsync)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown delivery mode %q, expected %s or %s", c.DeliveryMode, DeliveryModeSync, DeliveryModeAsync)
+	}
 }
@@ -1,6 +1,10 @@
 package streaming
 
-import "cosmossdk.io/core/log"
+import (
+	"context"
+
+	"cosmossdk.io/core/log"
+)
 
 // Context is an interface used by an App to pass context information
 // needed to process store streaming requests.
@@ -9,3 +13,32 @@ type Context interface {
 	Logger() log.Logger
 	StreamingManager() Manager
 }
+
+var (
+	_ context.Context = listenerContext{}
+	_ Context         = listenerContext{}
+)
+
+// listenerContext is a context.Context that also implements Context.
+type listenerContext struct {
+	context.Context
+
+	height  int64
+	logger  log.Logger
+	manager Manager
+}
+
+// NewContext wraps the given context.Context so that it also implements Context,
+// as expected by the plugin listeners.
+func NewContext(ctx context.Context, height int64, logger log.Logger, sm Manager) context.Context {
+	return listenerContext{
+		Context: ctx,
+		height:  height,
+		logger:  logger,
+		manager: sm,
+	}
+}
+
+func (c listenerContext) BlockHeight() int64        { return c.height }
+func (c listenerContext) Logger() log.Logger        { return c.logger }
+func (c listenerContext) StreamingManager() Manager { return c.manager }
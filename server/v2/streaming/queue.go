@@ -0,0 +1,300 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	queueEntryExt  = ".block"
+	queueCursorKey = "cursor"
+)
+
+var errQueueClosed = errors.New("streaming queue closed")
+
+// queueEntry is a block waiting to be delivered to a listener.
+type queueEntry struct {
+	height       int64
+	deliverBlock *ListenDeliverBlockRequest
+	stateChanges *ListenStateChangesRequest
+}
+
+// diskQueue is a bounded FIFO of blocks persisted in a directory, one file per block height.
+// The height of the last acknowledged block is persisted in a cursor file, entries at or below
+// the cursor are never delivered again.
+type diskQueue struct {
+	dir     string
+	maxSize int
+
+	mu      sync.Mutex
+	heights []int64 // pending heights in ascending order
+	cursor  int64   // last acknowledged height
+	closed  bool
+
+	notify chan struct{} // signaled when an entry is pushed
+	space  chan struct{} // signaled when an entry is acknowledged
+	done   chan struct{} // closed when the queue is closed
+}
+
+// openDiskQueue opens the queue stored in dir, creating it if needed, and loads the entries
+// which were not acknowledged yet.
+func openDiskQueue(dir string, maxSize uint64) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming queue directory: %w", err)
+	}
+
+	q := &diskQueue{
+		dir:     dir,
+		maxSize: int(maxSize),
+		notify:  make(chan struct{}, 1),
+		space:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, queueCursorKey))
+	switch {
+	case err == nil:
+		q.cursor, err = strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid streaming queue cursor: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, queueEntryExt) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(name, queueEntryExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		if height <= q.cursor {
+			// already acknowledged, the node stopped before the entry could be removed.
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		q.heights = append(q.heights, height)
+	}
+	sort.Slice(q.heights, func(i, j int) bool { return q.heights[i] < q.heights[j] })
+
+	return q, nil
+}
+
+// push persists the entry at the end of the queue. If the queue is full, it waits until an
+// entry is acknowledged or ctx is done. Entries must be pushed in increasing height order:
+// an entry for a height which is still pending replaces it, and an entry for a height which
+// was already acknowledged is ignored.
+func (q *diskQueue) push(ctx context.Context, e queueEntry, onFull func()) error {
+	bz, err := encodeQueueEntry(e)
+	if err != nil {
+		return err
+	}
+
+	notified := false
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return errQueueClosed
+		}
+		if e.height <= q.cursor {
+			q.mu.Unlock()
+			return nil
+		}
+		last := q.cursor
+		if len(q.heights) > 0 {
+			last = q.heights[len(q.heights)-1]
+		}
+		if e.height <= last {
+			err := q.write(e.height, bz)
+			q.mu.Unlock()
+			return err
+		}
+		if len(q.heights) < q.maxSize {
+			if err := q.write(e.height, bz); err != nil {
+				q.mu.Unlock()
+				return err
+			}
+			q.heights = append(q.heights, e.height)
+			q.mu.Unlock()
+			signal(q.notify)
+			return nil
+		}
+		q.mu.Unlock()
+
+		if !notified && onFull != nil {
+			onFull()
+			notified = true
+		}
+		select {
+		case <-q.space:
+		case <-q.done:
+			return errQueueClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// next returns the oldest pending entry, waiting for one to be pushed if the queue is empty.
+// The entry stays in the queue until it is acknowledged.
+func (q *diskQueue) next(ctx context.Context) (queueEntry, error) {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return queueEntry{}, errQueueClosed
+		}
+		if len(q.heights) > 0 {
+			height := q.heights[0]
+			bz, err := os.ReadFile(q.entryPath(height))
+			q.mu.Unlock()
+			if err != nil {
+				return queueEntry{}, err
+			}
+			return decodeQueueEntry(height, bz)
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-q.notify:
+		case <-q.done:
+			return queueEntry{}, errQueueClosed
+		case <-ctx.Done():
+			return queueEntry{}, ctx.Err()
+		}
+	}
+}
+
+// ack records height as delivered and removes it from the queue.
+func (q *diskQueue) ack(height int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.heights) == 0 || q.heights[0] != height {
+		return fmt.Errorf("cannot acknowledge height %d: not at the head of the queue", height)
+	}
+	if err := writeFileAtomic(filepath.Join(q.dir, queueCursorKey), []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+	q.cursor = height
+	q.heights = q.heights[1:]
+	signal(q.space)
+
+	return os.Remove(q.entryPath(height))
+}
+
+// ackedHeight returns the height of the last acknowledged entry.
+func (q *diskQueue) ackedHeight() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.cursor
+}
+
+// len returns the number of pending entries.
+func (q *diskQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.heights)
+}
+
+// close releases the routines waiting on the queue. Pending entries stay on disk.
+func (q *diskQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.done)
+	}
+}
+
+func (q *diskQueue) entryPath(height int64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", height, queueEntryExt))
+}
+
+func (q *diskQueue) write(height int64, bz []byte) error {
+	return writeFileAtomic(q.entryPath(height), bz)
+}
+
+// encodeQueueEntry encodes an entry as the length-prefixed ListenDeliverBlockRequest
+// followed by the ListenStateChangesRequest.
+func encodeQueueEntry(e queueEntry) ([]byte, error) {
+	deliverBz, err := e.deliverBlock.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	changesBz, err := e.stateChanges.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	bz := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(deliverBz)+len(changesBz)), uint64(len(deliverBz)))
+	bz = append(bz, deliverBz...)
+	return append(bz, changesBz...), nil
+}
+
+func decodeQueueEntry(height int64, bz []byte) (queueEntry, error) {
+	n, read := binary.Uvarint(bz)
+	if read <= 0 || uint64(len(bz)-read) < n {
+		return queueEntry{}, fmt.Errorf("corrupted streaming queue entry at height %d", height)
+	}
+	e := queueEntry{
+		height:       height,
+		deliverBlock: &ListenDeliverBlockRequest{},
+		stateChanges: &ListenStateChangesRequest{},
+	}
+	if err := e.deliverBlock.Unmarshal(bz[read : read+int(n)]); err != nil {
+		return queueEntry{}, fmt.Errorf("failed to decode streaming queue entry at height %d: %w", height, err)
+	}
+	if err := e.stateChanges.Unmarshal(bz[read+int(n):]); err != nil {
+		return queueEntry{}, fmt.Errorf("failed to decode streaming queue entry at height %d: %w", height, err)
+	}
+	return e, nil
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path, so that readers
+// never observe a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// signal notifies ch without blocking.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+
+	"cosmossdk.io/core/log"
 )
 
 const (
@@ -30,6 +33,73 @@ func GetPluginEnvKey(name string) string {
 }
 
 func NewStreamingPlugin(name, logLevel string) (interface{}, error) {
+	client := newPluginClient(name, logLevel)
+
+	// Connect via RPC
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	// Request streaming plugin
+	return rpcClient.Dispense(name)
+}
+
+// NewPluginDialer returns a Dialer launching the named streaming plugin.
+// Releasing the dialed listener kills the plugin process.
+func NewPluginDialer(name, logLevel string) Dialer {
+	return func() (Listener, func(), error) {
+		client := newPluginClient(name, logLevel)
+		rpcClient, err := client.Client()
+		if err != nil {
+			client.Kill()
+			return nil, nil, err
+		}
+
+		raw, err := rpcClient.Dispense(name)
+		if err != nil {
+			client.Kill()
+			return nil, nil, err
+		}
+
+		listener, ok := raw.(Listener)
+		if !ok {
+			client.Kill()
+			return nil, nil, fmt.Errorf("unexpected plugin type %T", raw)
+		}
+
+		return listener, client.Kill, nil
+	}
+}
+
+// NewListener loads the streaming plugin set in cfg and returns a Listener delivering to it
+// according to the configured delivery mode. In async mode, a relative queue directory is
+// resolved against homeDir. The returned function stops the listener on shutdown.
+func NewListener(cfg ListenerConfig, homeDir, logLevel string, logger log.Logger) (Listener, func() error, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	dial := NewPluginDialer(cfg.Plugin, logLevel)
+	if cfg.DeliveryMode != DeliveryModeAsync {
+		listener, release, err := dial()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load streaming plugin: %w", err)
+		}
+		return listener, func() error { release(); return nil }, nil
+	}
+
+	if !filepath.IsAbs(cfg.QueueDir) {
+		cfg.QueueDir = filepath.Join(homeDir, cfg.QueueDir)
+	}
+	listener, err := NewBufferedListener(cfg, dial, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return listener, listener.Close, nil
+}
+
+func newPluginClient(name, logLevel string) *plugin.Client {
 	logger := hclog.New(&hclog.LoggerOptions{
 		Output: hclog.DefaultOutput,
 		Level:  toHclogLevel(logLevel),
@@ -38,7 +108,7 @@ func NewStreamingPlugin(name, logLevel string) (interface{}, error) {
 
 	// We're a host. Start by launching the streaming process.
 	env := os.Getenv(GetPluginEnvKey(name))
-	client := plugin.NewClient(&plugin.ClientConfig{
+	return plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig: HandshakeMap[name],
 		Managed:         true,
 		Plugins:         PluginMap,
@@ -51,15 +121,6 @@ func NewStreamingPlugin(name, logLevel string) (interface{}, error) {
 			plugin.ProtocolNetRPC, plugin.ProtocolGRPC,
 		},
 	})
-
-	// Connect via RPC
-	rpcClient, err := client.Client()
-	if err != nil {
-		return nil, err
-	}
-
-	// Request streaming plugin
-	return rpcClient.Dispense(name)
 }
 
 func toHclogLevel(s string) hclog.Level {
@@ -29,6 +29,7 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/sessionkey"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
 	"cosmossdk.io/x/authz"
@@ -72,6 +73,9 @@ import (
 	"cosmossdk.io/x/protocolpool"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	pooltypes "cosmossdk.io/x/protocolpool/types"
+	"cosmossdk.io/x/scheduler"
+	schedulerkeeper "cosmossdk.io/x/scheduler/keeper"
+	schedulertypes "cosmossdk.io/x/scheduler/types"
 	"cosmossdk.io/x/slashing"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	slashingtypes "cosmossdk.io/x/slashing/types"
@@ -137,8 +141,10 @@ var (
 		minttypes.ModuleName:               {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:            {authtypes.Minter},
 		govtypes.ModuleName:                {authtypes.Burner},
 		nft.ModuleName:                     nil,
+		schedulertypes.ModuleName:          nil,
 	}
 )
 
@@ -180,6 +186,7 @@ type SimApp struct {
 	CircuitKeeper         circuitkeeper.Keeper
 	PoolKeeper            poolkeeper.Keeper
 	EpochsKeeper          *epochskeeper.Keeper
+	SchedulerKeeper       schedulerkeeper.Keeper
 
 	// managers
 	ModuleManager      *module.Manager
@@ -284,7 +291,7 @@ func NewSimApp(
 		govtypes.StoreKey, consensustypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, circuittypes.StoreKey,
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, pooltypes.StoreKey,
-		accounts.StoreKey, epochstypes.StoreKey,
+		accounts.StoreKey, epochstypes.StoreKey, schedulertypes.StoreKey,
 	)
 
 	// register streaming services
@@ -328,6 +335,8 @@ func NewSimApp(
 		accountstd.AddAccount("multisig", multisig.NewAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey()),
+		baseaccount.NewRecoveryAccount("base_recovery", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey()),
+		sessionkey.NewAccount("session_key", txConfig.SignModeHandler(), sessionkey.WithSecp256K1PubKey(), sessionkey.WithSecp256R1PubKey()),
 	)
 	if err != nil {
 		panic(err)
@@ -388,9 +397,15 @@ func NewSimApp(
 		panic(err)
 	}
 
-	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger.With(log.ModuleKey, "x/protocolpool")), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, govModuleAddr)
+	app.EpochsKeeper = epochskeeper.NewKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[epochstypes.StoreKey]), logger.With(log.ModuleKey, "x/epochs")),
+		appCodec,
+		govModuleAddr,
+	)
+
+	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger.With(log.ModuleKey, "x/protocolpool")), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, app.EpochsKeeper, govModuleAddr)
 
-	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger.With(log.ModuleKey, "x/distribution")), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, cometService, authtypes.FeeCollectorName, govModuleAddr)
+	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger.With(log.ModuleKey, "x/distribution"), runtime.EnvWithMsgRouterService(app.MsgServiceRouter())), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, cometService, authtypes.FeeCollectorName, govModuleAddr)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), logger.With(log.ModuleKey, "x/slashing")),
 		appCodec, legacyAmino, app.StakingKeeper, govModuleAddr,
@@ -464,14 +479,22 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.EpochsKeeper = epochskeeper.NewKeeper(
-		runtime.NewEnvironment(runtime.NewKVStoreService(keys[epochstypes.StoreKey]), logger.With(log.ModuleKey, "x/epochs")),
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
 		appCodec,
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[schedulertypes.StoreKey]), logger.With(log.ModuleKey, "x/scheduler"), runtime.EnvWithMsgRouterService(app.MsgServiceRouter())),
+		app.AuthKeeper,
+		app.BankKeeper,
+		app.EpochsKeeper,
+		schedulertypes.DefaultMaxExecutionsPerBlock,
+		schedulertypes.DefaultMaxGasPerExecution,
 	)
 
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
+			// insert epoch hooks receivers here
+			app.PoolKeeper.Hooks(),
+			app.DistrKeeper.EpochHooks(),
+			app.SchedulerKeeper.Hooks(),
 		),
 	)
 
@@ -500,6 +523,7 @@ func NewSimApp(
 		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 		protocolpool.NewAppModule(appCodec, app.PoolKeeper, app.AuthKeeper, app.BankKeeper),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
+		scheduler.NewAppModule(appCodec, app.SchedulerKeeper),
 	)
 
 	app.ModuleManager.RegisterLegacyAminoCodec(legacyAmino)
@@ -523,6 +547,8 @@ func NewSimApp(
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		epochstypes.ModuleName,
+		// the scheduler executes the schedules of the epochs ended in the block
+		schedulertypes.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
@@ -531,6 +557,7 @@ func NewSimApp(
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		authtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -557,6 +584,7 @@ func NewSimApp(
 		circuittypes.ModuleName,
 		pooltypes.ModuleName,
 		epochstypes.ModuleName,
+		schedulertypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -675,6 +703,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				FeegrantKeeper:           app.FeeGrantKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
 				UnorderedTxManager:       app.UnorderedTxManager,
+				EnableBaseFee:            true,
 			},
 			&app.CircuitKeeper,
 		},
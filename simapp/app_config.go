@@ -24,6 +24,7 @@ import (
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	poolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
+	schedulermodulev1 "cosmossdk.io/api/cosmos/scheduler/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
@@ -61,6 +62,8 @@ import (
 	_ "cosmossdk.io/x/nft/module"   // import for side-effects
 	_ "cosmossdk.io/x/protocolpool" // import for side-effects
 	pooltypes "cosmossdk.io/x/protocolpool/types"
+	_ "cosmossdk.io/x/scheduler" // import for side-effects
+	schedulertypes "cosmossdk.io/x/scheduler/types"
 	_ "cosmossdk.io/x/slashing" // import for side-effects
 	slashingtypes "cosmossdk.io/x/slashing/types"
 	_ "cosmossdk.io/x/staking" // import for side-effects
@@ -89,8 +92,10 @@ var (
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
+		{Account: schedulertypes.ModuleName},
 	}
 
 	// blocked account addresses
@@ -100,7 +105,9 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		stakingtypes.ModuleName,
 		nft.ModuleName,
+		schedulertypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 		// pooltypes.ModuleName
@@ -130,6 +137,8 @@ var (
 						stakingtypes.ModuleName,
 						authz.ModuleName,
 						epochstypes.ModuleName,
+						// the scheduler executes the schedules of the epochs ended in the block
+						schedulertypes.ModuleName,
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
@@ -137,6 +146,7 @@ var (
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						authtypes.ModuleName,
 					},
 					// The following is mostly only needed when ModuleName != StoreKey name.
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
@@ -174,6 +184,7 @@ var (
 						circuittypes.ModuleName,
 						pooltypes.ModuleName,
 						epochstypes.ModuleName,
+						schedulertypes.ModuleName,
 					},
 					// When ExportGenesis is not specified, the export genesis module order
 					// is equal to the init genesis order
@@ -193,8 +204,11 @@ var (
 				Config: appconfig.WrapAny(&txconfigv1.Config{}),
 			},
 			{
-				Name:   validate.ModuleName,
-				Config: appconfig.WrapAny(&validatemodulev1.Module{}),
+				Name: validate.ModuleName,
+				Config: appconfig.WrapAny(&validatemodulev1.Module{
+					// the base fee is charged once enabled in the x/auth params
+					EnableBaseFee: true,
+				}),
 			},
 			{
 				Name: authtypes.ModuleName,
@@ -292,6 +306,10 @@ var (
 				Name:   epochstypes.ModuleName,
 				Config: appconfig.WrapAny(&epochsmodulev1.Module{}),
 			},
+			{
+				Name:   schedulertypes.ModuleName,
+				Config: appconfig.WrapAny(&schedulermodulev1.Module{}),
+			},
 			{
 				Name:   bankv2types.ModuleName,
 				Config: appconfig.WrapAny(&bankmodulev2.Module{}),
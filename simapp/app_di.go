@@ -18,6 +18,7 @@ import (
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
+	sessionkeydepinject "cosmossdk.io/x/accounts/defaults/sessionkey/depinject"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	consensuskeeper "cosmossdk.io/x/consensus/keeper"
@@ -162,10 +163,15 @@ func NewSimApp(
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
+				basedepinject.ProvideRecoveryAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
+				sessionkeydepinject.ProvideAccount,
 
 				// provide base account options
 				basedepinject.ProvideSecp256K1PubKey,
+				// provide session key account options, secp256r1 keys are used by passkeys
+				sessionkeydepinject.ProvideSecp256K1PubKey,
+				sessionkeydepinject.ProvideSecp256R1PubKey,
 				// if you want to provide a custom public key you
 				// can do it from here.
 				// Example:
@@ -302,6 +308,7 @@ func (app *SimApp) setCustomAnteHandler() {
 				SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
 				UnorderedTxManager: app.UnorderedTxManager,
 				Environment:        app.AuthKeeper.Environment,
+				EnableBaseFee:      true,
 			},
 			&app.CircuitBreakerKeeper,
 		},
@@ -28,6 +28,7 @@ import (
 	group "cosmossdk.io/x/group/module"
 	"cosmossdk.io/x/mint"
 	"cosmossdk.io/x/protocolpool"
+	"cosmossdk.io/x/scheduler"
 	"cosmossdk.io/x/slashing"
 	"cosmossdk.io/x/staking"
 	"cosmossdk.io/x/upgrade"
@@ -218,6 +219,7 @@ func TestRunMigrations(t *testing.T) {
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"protocolpool": protocolpool.AppModule{}.ConsensusVersion(),
 					"epochs":       epochs.AppModule{}.ConsensusVersion(),
+					"scheduler":    scheduler.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
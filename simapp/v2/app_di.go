@@ -18,6 +18,7 @@ import (
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
+	sessionkeydepinject "cosmossdk.io/x/accounts/defaults/sessionkey/depinject"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
@@ -136,10 +137,15 @@ func NewSimApp[T transaction.Tx](
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
+				basedepinject.ProvideRecoveryAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
+				sessionkeydepinject.ProvideAccount,
 
 				// provide base account options
 				basedepinject.ProvideSecp256K1PubKey,
+				// provide session key account options, secp256r1 keys are used by passkeys
+				sessionkeydepinject.ProvideSecp256K1PubKey,
+				sessionkeydepinject.ProvideSecp256R1PubKey,
 				// if you want to provide a custom public key you
 				// can do it from here.
 				// Example:
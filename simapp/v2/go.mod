@@ -28,6 +28,7 @@ require (
 	cosmossdk.io/x/mint v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/nft v0.0.0-20230613133644-0a778132a60f
 	cosmossdk.io/x/protocolpool v0.0.0-20230925135524-a1bc045b3190
+	cosmossdk.io/x/scheduler v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/slashing v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/staking v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/upgrade v0.0.0-20230613133644-0a778132a60f
@@ -46,6 +47,7 @@ require (
 	cosmossdk.io/x/accounts/defaults/base v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/accounts/defaults/lockup v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/accounts/defaults/multisig v0.0.0-00010101000000-000000000000
+	cosmossdk.io/x/accounts/defaults/sessionkey v0.0.0-00010101000000-000000000000
 )
 
 require (
@@ -255,6 +257,7 @@ replace (
 	cosmossdk.io/x/accounts/defaults/base => ../../x/accounts/defaults/base
 	cosmossdk.io/x/accounts/defaults/lockup => ../../x/accounts/defaults/lockup
 	cosmossdk.io/x/accounts/defaults/multisig => ../../x/accounts/defaults/multisig
+	cosmossdk.io/x/accounts/defaults/sessionkey => ../../x/accounts/defaults/sessionkey
 	cosmossdk.io/x/authz => ../../x/authz
 	cosmossdk.io/x/bank => ../../x/bank
 	cosmossdk.io/x/circuit => ../../x/circuit
@@ -269,6 +272,7 @@ replace (
 	cosmossdk.io/x/nft => ../../x/nft
 	cosmossdk.io/x/params => ../../x/params
 	cosmossdk.io/x/protocolpool => ../../x/protocolpool
+	cosmossdk.io/x/scheduler => ../../x/scheduler
 	cosmossdk.io/x/slashing => ../../x/slashing
 	cosmossdk.io/x/staking => ../../x/staking
 	cosmossdk.io/x/tx => ../../x/tx
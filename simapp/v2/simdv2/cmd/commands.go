@@ -134,6 +134,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		authcmd.GetTextualPreviewCommand(),
 	)
 
 	return cmd
@@ -10,6 +10,7 @@ import (
 	bankv2types "cosmossdk.io/x/bank/v2/types"
 	epochstypes "cosmossdk.io/x/epochs/types"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
+	schedulertypes "cosmossdk.io/x/scheduler/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 )
 
@@ -40,6 +41,7 @@ func (app *SimApp[T]) RegisterUpgradeHandlers() {
 				accounts.StoreKey,
 				protocolpooltypes.StoreKey,
 				epochstypes.StoreKey,
+				schedulertypes.StoreKey,
 				bankv2types.ModuleName,
 			},
 			Deleted: []string{"crisis"}, // The SDK discontinued the crisis module in v0.52.0
@@ -0,0 +1,58 @@
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/simapp"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// TestRecoveryAccount checks that the recovery account is registered in simapp
+// and that guardians can rotate its pubkey once the timelock expired.
+func TestRecoveryAccount(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ak := app.AccountsKeeper
+	now := time.Now().UTC()
+	ctx := app.NewContext(false).WithHeaderInfo(header.Info{Time: now})
+
+	addrCodec := app.AuthKeeper.AddressCodec()
+	guardian, err := addrCodec.BytesToString([]byte("guardian"))
+	require.NoError(t, err)
+
+	pubKey, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, accAddr, err := ak.Init(ctx, "base_recovery", []byte("creator"), &basev1.MsgInitRecoveryAccount{
+		PubKey:    pubKey,
+		Guardians: []string{guardian},
+		Config:    basev1.RecoveryConfig{Threshold: 1, Timelock: time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	resp, err := ak.Query(ctx, accAddr, &basev1.QueryGuardians{})
+	require.NoError(t, err)
+	require.Equal(t, []string{guardian}, resp.(*basev1.QueryGuardiansResponse).Guardians)
+
+	newPubKey, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ak.Execute(ctx, accAddr, []byte("guardian"), &basev1.MsgInitiateRecovery{NewPubKey: newPubKey}, nil)
+	require.NoError(t, err)
+
+	// the recovery cannot be completed before the timelock expired
+	_, err = ak.Execute(ctx, accAddr, []byte("anyone"), &basev1.MsgCompleteRecovery{}, nil)
+	require.Error(t, err)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	_, err = ak.Execute(ctx, accAddr, []byte("anyone"), &basev1.MsgCompleteRecovery{}, nil)
+	require.NoError(t, err)
+
+	resp, err = ak.Query(ctx, accAddr, &basev1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, resp.(*basev1.QueryPubKeyResponse).PubKey.Value)
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupLiquidStaking returns a fixture with the staking hooks of x/distribution,
+// a bonded validator and a delegator delegating delTokens to it.
+func setupLiquidStaking(t *testing.T, delTokens math.Int) (*fixture, sdk.AccAddress) {
+	t.Helper()
+	f := initFixture(t)
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, types.DefaultParams()))
+	assert.NilError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, types.InitialFeePool()))
+
+	setupValidatorWithCommission(t, f, f.valAddr, delTokens.Int64())
+	_, err := f.stakingKeeper.EndBlocker(f.sdkCtx)
+	assert.NilError(t, err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	delegate(t, f, delAddr, delTokens)
+
+	return f, delAddr
+}
+
+// delegate funds delAddr with amount and delegates it to the validator of the fixture.
+func delegate(t *testing.T, f *fixture, delAddr sdk.AccAddress, amount math.Int) {
+	t.Helper()
+	fundAccount(t, f, delAddr, amount)
+
+	validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.Delegate(f.sdkCtx, delAddr, amount, stakingtypes.Unbonded, validator, true)
+	assert.NilError(t, err)
+}
+
+func fundAccount(t *testing.T, f *fixture, addr sdk.AccAddress, amount math.Int) {
+	t.Helper()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, coins))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, types.ModuleName, addr, coins))
+	if f.accountKeeper.GetAccount(f.sdkCtx, addr) == nil {
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, addr))
+	}
+}
+
+func TestLiquidStakingSlash(t *testing.T) {
+	t.Parallel()
+	delTokens := math.NewInt(10_000_000)
+	f, delAddr := setupLiquidStaking(t, delTokens)
+
+	shareTokens, err := f.stakingKeeper.TokenizeShares(f.sdkCtx, delAddr, f.valAddr, delTokens, delAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens, shareTokens.Amount)
+
+	// slash half of the stake of the validator, half of it being tokenized
+	validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	power := validator.GetConsensusPower(f.stakingKeeper.PowerReduction(f.sdkCtx))
+	_, err = f.stakingKeeper.Slash(f.sdkCtx, sdk.ConsAddress(valConsPk0.Address()), f.sdkCtx.HeaderInfo().Height, power, math.LegacyNewDecWithPrec(5, 1))
+	assert.NilError(t, err)
+
+	liquidValidator, err := f.stakingKeeper.GetLiquidValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens.QuoRaw(2), liquidValidator.LiquidTokens)
+
+	// the redeemed tokens drop pro rata
+	half := sdk.NewCoin(shareTokens.Denom, shareTokens.Amount.QuoRaw(2))
+	amount, err := f.stakingKeeper.RedeemTokensForShares(f.sdkCtx, delAddr, half)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens.QuoRaw(4), amount)
+
+	amount, err = f.stakingKeeper.RedeemTokensForShares(f.sdkCtx, delAddr, half)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens.QuoRaw(4), amount)
+
+	delegation, err := f.stakingKeeper.Delegations.Get(f.sdkCtx, collections.Join(delAddr, f.valAddr))
+	assert.NilError(t, err)
+	validator, err = f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens.QuoRaw(2), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	assert.Assert(t, f.bankKeeper.GetSupply(f.sdkCtx, shareTokens.Denom).IsZero())
+}
+
+func TestLiquidStakingRewards(t *testing.T) {
+	t.Parallel()
+	delTokens := math.NewInt(10_000_000)
+	f, delAddr := setupLiquidStaking(t, delTokens)
+
+	shareTokens, err := f.stakingKeeper.TokenizeShares(f.sdkCtx, delAddr, f.valAddr, delTokens, delAddr)
+	assert.NilError(t, err)
+
+	// the delegations earn rewards from the next block
+	headerInfo := f.sdkCtx.HeaderInfo()
+	headerInfo.Height++
+	f.sdkCtx = f.sdkCtx.WithHeaderInfo(headerInfo).WithBlockHeight(headerInfo.Height)
+
+	// allocate rewards to the validator, half of them going to the commission
+	// and the pool earning half of the rewards of the delegators
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(2_000_000)))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, rewards))
+	validator, err := f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	// redeeming compounds the rewards of the pool first
+	amount, err := f.stakingKeeper.RedeemTokensForShares(f.sdkCtx, delAddr, shareTokens)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, delTokens.Add(math.NewInt(500_000)), amount)
+
+	poolAddr := stakingtypes.LiquidPoolAddress(f.valAddr)
+	assert.Assert(t, f.bankKeeper.GetAllBalances(f.sdkCtx, poolAddr).IsZero())
+	_, err = f.stakingKeeper.Delegations.Get(f.sdkCtx, collections.Join(poolAddr, f.valAddr))
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestLiquidStakingDonation(t *testing.T) {
+	t.Parallel()
+	f, attacker := setupLiquidStaking(t, math.NewInt(10_000_000))
+
+	// the attacker holds the whole supply of share tokens, backed by a single share
+	shareTokens, err := f.stakingKeeper.TokenizeShares(f.sdkCtx, attacker, f.valAddr, math.OneInt(), attacker)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.OneInt(), shareTokens.Amount)
+
+	// and donates to the pool to inflate the exchange rate of the share token
+	poolAddr := stakingtypes.LiquidPoolAddress(f.valAddr)
+	donation := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5_000_000)))
+	fundAccount(t, f, attacker, donation.AmountOf(sdk.DefaultBondDenom))
+	assert.NilError(t, f.bankKeeper.SendCoins(f.sdkCtx, attacker, poolAddr, donation))
+
+	// the donation does not change the exchange rate of the share token
+	victim := sdk.AccAddress(PKS[2].Address())
+	victimTokens := math.NewInt(4_000_000)
+	delegate(t, f, victim, victimTokens)
+	victimShareTokens, err := f.stakingKeeper.TokenizeShares(f.sdkCtx, victim, f.valAddr, victimTokens, victim)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, victimTokens, victimShareTokens.Amount)
+
+	liquidValidator, err := f.stakingKeeper.GetLiquidValidator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, victimTokens.AddRaw(1), liquidValidator.LiquidTokens)
+
+	// the victim redeems its delegation and its share of the donation
+	amount, err := f.stakingKeeper.RedeemTokensForShares(f.sdkCtx, victim, victimShareTokens)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, victimTokens, amount)
+	assert.DeepEqual(t, math.NewInt(4_999_998), f.bankKeeper.GetBalance(f.sdkCtx, victim, sdk.DefaultBondDenom).Amount)
+}
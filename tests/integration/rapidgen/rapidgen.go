@@ -129,6 +129,7 @@ var (
 		GenType(&disttypes.MsgSetWithdrawAddress{}, &distapi.MsgSetWithdrawAddress{}, GenOpts),
 		GenType(&disttypes.MsgUpdateParams{}, &distapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&disttypes.MsgDepositValidatorRewardsPool{}, &distapi.MsgDepositValidatorRewardsPool{}, GenOpts),
+		GenType(&disttypes.MsgSetAutoCompound{}, &distapi.MsgSetAutoCompound{}, GenOpts),
 
 		// evidence
 		GenType(&evidencetypes.MsgSubmitEvidence{}, &evidenceapi.MsgSubmitEvidence{},
@@ -214,6 +215,8 @@ var (
 		GenType(&stakingtypes.MsgUndelegate{}, &stakingapi.MsgUndelegate{}, GenOpts.WithDisallowNil()),
 		GenType(&stakingtypes.MsgBeginRedelegate{}, &stakingapi.MsgBeginRedelegate{}, GenOpts.WithDisallowNil()),
 		GenType(&stakingtypes.MsgUpdateParams{}, &stakingapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&stakingtypes.MsgTokenizeShares{}, &stakingapi.MsgTokenizeShares{}, GenOpts.WithDisallowNil()),
+		GenType(&stakingtypes.MsgRedeemTokensForShares{}, &stakingapi.MsgRedeemTokensForShares{}, GenOpts.WithDisallowNil()),
 
 		// upgrade
 		GenType(&upgradetypes.MsgSoftwareUpgrade{}, &upgradeapi.MsgSoftwareUpgrade{}, GenOpts.WithDisallowNil()),
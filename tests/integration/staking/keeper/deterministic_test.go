@@ -504,7 +504,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 14628, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 15060, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -594,7 +594,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4686, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4830, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -677,7 +677,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4289, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4433, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -812,7 +812,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(t, f)
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6287, false)
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6431, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -899,6 +899,13 @@ func TestGRPCParams(t *testing.T) {
 			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
 			MinCommissionRate: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
 			KeyRotationFee:    sdk.NewInt64Coin(bondDenom, rapid.Int64Range(10000, 100000).Draw(rt, "amount")),
+
+			GlobalLiquidStakingCap:    math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "global-liquid-cap"), 2),
+			ValidatorLiquidStakingCap: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "validator-liquid-cap"), 2),
+
+			CommissionChangePeriodLength: rapid.Uint64Min(1).Draw(rt, "commission-change-period-length"),
+			CommissionChangeDelayPeriods: rapid.Uint32().Draw(rt, "commission-change-delay-periods"),
+			MinSelfBondRatio:            math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "min-self-bond-ratio"), 2),
 		}
 
 		err := f.stakingKeeper.Params.Set(f.ctx, params)
@@ -915,10 +922,17 @@ func TestGRPCParams(t *testing.T) {
 		HistoricalEntries: 5,
 		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2),
 		KeyRotationFee:    sdk.NewInt64Coin("denom", 10000),
+
+		GlobalLiquidStakingCap:    math.LegacyNewDecWithPrec(25, 2),
+		ValidatorLiquidStakingCap: math.LegacyNewDecWithPrec(50, 2),
+
+		CommissionChangePeriodLength: 100,
+		CommissionChangeDelayPeriods: 2,
+		MinSelfBondRatio:            math.LegacyNewDecWithPrec(10, 2),
 	}
 
 	err := f.stakingKeeper.Params.Set(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1162, false)
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1354, false)
 }
@@ -161,7 +161,12 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		fails bool
 	}{
 		"auth/params": {
-			gogo: &authtypes.Params{TxSigLimit: 10},
+			gogo: &authtypes.Params{
+				TxSigLimit:               10,
+				MinBaseFee:               math.LegacyZeroDec(),
+				BaseFeeBurnRatio:         math.LegacyZeroDec(),
+				BaseFeeProtocolPoolRatio: math.LegacyZeroDec(),
+			},
 		},
 		"auth/module_account_nil_permissions": {
 			gogo: &authtypes.ModuleAccount{
@@ -197,7 +202,12 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			},
 		},
 		"authz/msg_update_params": {
-			gogo: &authtypes.MsgUpdateParams{Params: authtypes.Params{TxSigLimit: 10}},
+			gogo: &authtypes.MsgUpdateParams{Params: authtypes.Params{
+				TxSigLimit:               10,
+				MinBaseFee:               math.LegacyZeroDec(),
+				BaseFeeBurnRatio:         math.LegacyZeroDec(),
+				BaseFeeProtocolPoolRatio: math.LegacyZeroDec(),
+			}},
 		},
 		"authz/msg_exec/empty_msgs": {
 			gogo: &authztypes.MsgExec{Msgs: []*codectypes.Any{}},
@@ -277,6 +287,10 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 					UnbondingTime:     0,
 					KeyRotationFee:    types.Coin{},
 					MinCommissionRate: math.LegacyZeroDec(),
+
+					GlobalLiquidStakingCap:    math.LegacyZeroDec(),
+					ValidatorLiquidStakingCap: math.LegacyZeroDec(),
+					MinSelfBondRatio:          math.LegacyZeroDec(),
 				},
 			},
 		},
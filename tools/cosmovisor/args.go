@@ -26,6 +26,7 @@ const (
 	EnvName                     = "DAEMON_NAME"
 	EnvDownloadBin              = "DAEMON_ALLOW_DOWNLOAD_BINARIES"
 	EnvDownloadMustHaveChecksum = "DAEMON_DOWNLOAD_MUST_HAVE_CHECKSUM"
+	EnvDownloadTrustedKeysFile  = "DAEMON_DOWNLOAD_TRUSTED_KEYS_FILE"
 	EnvRestartUpgrade           = "DAEMON_RESTART_AFTER_UPGRADE"
 	EnvRestartDelay             = "DAEMON_RESTART_DELAY"
 	EnvShutdownGrace            = "DAEMON_SHUTDOWN_GRACE"
@@ -57,6 +58,7 @@ type Config struct {
 	Name                     string        `toml:"daemon_name" mapstructure:"daemon_name"`
 	AllowDownloadBinaries    bool          `toml:"daemon_allow_download_binaries" mapstructure:"daemon_allow_download_binaries" default:"false"`
 	DownloadMustHaveChecksum bool          `toml:"daemon_download_must_have_checksum" mapstructure:"daemon_download_must_have_checksum" default:"false"`
+	DownloadTrustedKeysFile  string        `toml:"daemon_download_trusted_keys_file" mapstructure:"daemon_download_trusted_keys_file"`
 	RestartAfterUpgrade      bool          `toml:"daemon_restart_after_upgrade" mapstructure:"daemon_restart_after_upgrade" default:"true"`
 	RestartDelay             time.Duration `toml:"daemon_restart_delay" mapstructure:"daemon_restart_delay"`
 	ShutdownGrace            time.Duration `toml:"daemon_shutdown_grace" mapstructure:"daemon_shutdown_grace"`
@@ -216,10 +218,11 @@ func GetConfigFromFile(filePath string) (*Config, error) {
 func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:             os.Getenv(EnvHome),
-		Name:             os.Getenv(EnvName),
-		DataBackupPath:   os.Getenv(EnvDataBackupPath),
-		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		Home:                    os.Getenv(EnvHome),
+		Name:                    os.Getenv(EnvName),
+		DataBackupPath:          os.Getenv(EnvDataBackupPath),
+		CustomPreUpgrade:        os.Getenv(EnvCustomPreupgrade),
+		DownloadTrustedKeysFile: os.Getenv(EnvDownloadTrustedKeysFile),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -359,6 +362,13 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate the trusted keys file
+	if cfg.DownloadTrustedKeysFile != "" {
+		if _, err := loadTrustedKeysFile(cfg.DownloadTrustedKeysFile); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", EnvDownloadTrustedKeysFile, err))
+		}
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -549,6 +559,7 @@ func (cfg Config) DetailString() string {
 		{EnvName, cfg.Name},
 		{EnvDownloadBin, fmt.Sprintf("%t", cfg.AllowDownloadBinaries)},
 		{EnvDownloadMustHaveChecksum, fmt.Sprintf("%t", cfg.DownloadMustHaveChecksum)},
+		{EnvDownloadTrustedKeysFile, cfg.DownloadTrustedKeysFile},
 		{EnvRestartUpgrade, fmt.Sprintf("%t", cfg.RestartAfterUpgrade)},
 		{EnvRestartDelay, cfg.RestartDelay.String()},
 		{EnvShutdownGrace, cfg.ShutdownGrace.String()},
@@ -261,6 +261,10 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: relPath},
 			valid: false,
 		},
+		"no such trusted keys file": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, DownloadTrustedKeysFile: filepath.FromSlash("/no/such/file")},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
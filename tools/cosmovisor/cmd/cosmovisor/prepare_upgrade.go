@@ -61,7 +61,7 @@ func prepareUpgradeHandler(cmd *cobra.Command, _ []string) error {
 
 	logger.Info("Preparing for upgrade", "name", upgradeInfo.Name, "height", upgradeInfo.Height)
 
-	upgradeInfoParsed, err := plan.ParseInfo(upgradeInfo.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	upgradeInfoParsed, err := cfg.ParseUpgradeInfo(upgradeInfo.Info)
 	if err != nil {
 		return fmt.Errorf("failed to parse upgrade info: %w", err)
 	}
@@ -0,0 +1,172 @@
+package cosmovisor
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"strings"
+
+	"cosmossdk.io/x/upgrade/plan"
+)
+
+// signedUpgradeInfo is the upgrade info format signed by release keys.
+// It mirrors plan.Info and plan.Signature of x/upgrade, which cosmovisor cannot import until
+// it depends on an x/upgrade release including them. The signed payload must stay the one of
+// plan.BinaryDownloadURLMap.SignBytes, so that upgrade infos signed with plan.SignBinaries verify.
+type signedUpgradeInfo struct {
+	Binaries   map[string]string      `json:"binaries"`
+	Signatures []upgradeInfoSignature `json:"signatures"`
+}
+
+// upgradeInfoSignature is a base64 encoded ed25519 signature of the upgrade info binaries.
+type upgradeInfoSignature struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// ParseUpgradeInfo parses the upgrade plan info, downloading it if it is a URL.
+// When a trusted keys file is configured, the binaries must be signed by one of its keys
+// and all binary URLs must contain a checksum.
+func (cfg *Config) ParseUpgradeInfo(info string) (*plan.Info, error) {
+	if cfg.DownloadTrustedKeysFile == "" {
+		return plan.ParseInfo(info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	}
+
+	trustedKeys, err := loadTrustedKeysFile(cfg.DownloadTrustedKeysFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// download a remote upgrade info once, so that the verified info is the parsed one
+	info = strings.TrimSpace(info)
+	if _, err := neturl.ParseRequestURI(info); err == nil {
+		if err := plan.ValidateURL(info, true); err != nil {
+			return nil, err
+		}
+
+		if info, err = plan.DownloadURL(info); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := verifyUpgradeInfo(info, trustedKeys); err != nil {
+		return nil, err
+	}
+
+	return plan.ParseInfo(info, plan.ParseOptionEnforceChecksum(true))
+}
+
+// verifyUpgradeInfo checks that the binaries of the given upgrade info are signed by one of the trusted keys.
+// Signatures made by keys which are not trusted are ignored, but an invalid signature made by a trusted
+// key is always an error.
+func verifyUpgradeInfo(info string, trustedKeys []ed25519.PublicKey) error {
+	var signedInfo signedUpgradeInfo
+	if err := json.Unmarshal([]byte(info), &signedInfo); err != nil {
+		return fmt.Errorf("could not parse upgrade info: %w", err)
+	}
+
+	for osArch, url := range signedInfo.Binaries {
+		if err := plan.ValidateURL(url, true); err != nil {
+			return fmt.Errorf("invalid url for os/arch %s: %w", osArch, err)
+		}
+	}
+
+	if len(signedInfo.Signatures) == 0 {
+		return errors.New("upgrade info is not signed")
+	}
+
+	signBytes, err := json.Marshal(signedInfo.Binaries)
+	if err != nil {
+		return err
+	}
+
+	verified := false
+	for i, sig := range signedInfo.Signatures {
+		pubKey, err := decodeEd25519PublicKey(sig.PublicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key in signatures[%d]: %w", i, err)
+		}
+
+		if !isTrustedKey(pubKey, trustedKeys) {
+			continue
+		}
+
+		sigBz, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature encoding in signatures[%d]: %w", i, err)
+		}
+
+		if !ed25519.Verify(pubKey, signBytes, sigBz) {
+			return fmt.Errorf("invalid signature in signatures[%d] for trusted key %s", i, sig.PublicKey)
+		}
+
+		verified = true
+	}
+
+	if !verified {
+		return errors.New("upgrade info is not signed by any trusted key")
+	}
+
+	return nil
+}
+
+// loadTrustedKeysFile reads base64 encoded ed25519 public keys, one per line.
+// Empty lines and lines starting with '#' are ignored.
+func loadTrustedKeysFile(path string) ([]ed25519.PublicKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read trusted keys file: %w", err)
+	}
+	defer f.Close()
+
+	var keys []ed25519.PublicKey
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, err := decodeEd25519PublicKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key on line %d: %w", lineNum, err)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no trusted keys found")
+	}
+
+	return keys, nil
+}
+
+func decodeEd25519PublicKey(s string) (ed25519.PublicKey, error) {
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length %d", len(bz))
+	}
+
+	return bz, nil
+}
+
+func isTrustedKey(key ed25519.PublicKey, trustedKeys []ed25519.PublicKey) bool {
+	for _, trusted := range trustedKeys {
+		if key.Equal(trusted) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade/plan"
+)
+
+func TestParseUpgradeInfo(t *testing.T) {
+	releaseKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	otherKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{2}, ed25519.SeedSize))
+
+	keysFile := filepath.Join(t.TempDir(), "trusted_keys")
+	trustedKey := base64.StdEncoding.EncodeToString(releaseKey.Public().(ed25519.PublicKey))
+	require.NoError(t, os.WriteFile(keysFile, []byte("# release key\n"+trustedKey+"\n"), 0o600))
+
+	binaries := map[string]string{"any": "https://example.com/simd?checksum=sha256:aaaa"}
+	makeInfo := func(binaries, signedBinaries map[string]string, keys ...ed25519.PrivateKey) string {
+		info := signedUpgradeInfo{Binaries: binaries}
+		signBytes, err := json.Marshal(signedBinaries)
+		require.NoError(t, err)
+		for _, key := range keys {
+			info.Signatures = append(info.Signatures, upgradeInfoSignature{
+				PublicKey: base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey)),
+				Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, signBytes)),
+			})
+		}
+		bz, err := json.Marshal(info)
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	cases := map[string]struct {
+		info      string
+		expErrMsg string
+	}{
+		"signed by trusted key": {
+			info: makeInfo(binaries, binaries, otherKey, releaseKey),
+		},
+		"unsigned": {
+			info:      makeInfo(binaries, binaries),
+			expErrMsg: "upgrade info is not signed",
+		},
+		"signed by untrusted key": {
+			info:      makeInfo(binaries, binaries, otherKey),
+			expErrMsg: "not signed by any trusted key",
+		},
+		"wrongly signed": {
+			info:      makeInfo(binaries, map[string]string{"any": "https://evil.com/simd?checksum=sha256:bbbb"}, releaseKey),
+			expErrMsg: "invalid signature in signatures[0]",
+		},
+		"missing checksum": {
+			info:      makeInfo(map[string]string{"any": "https://example.com/simd"}, map[string]string{"any": "https://example.com/simd"}, releaseKey),
+			expErrMsg: "missing checksum query parameter",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &Config{DownloadTrustedKeysFile: keysFile}
+			info, err := cfg.ParseUpgradeInfo(tc.info)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, plan.BinaryDownloadURLMap(binaries), info.Binaries)
+		})
+	}
+
+	// no trusted keys file configured, signatures are not verified
+	cfg := &Config{}
+	_, err := cfg.ParseUpgradeInfo(makeInfo(binaries, binaries))
+	require.NoError(t, err)
+}
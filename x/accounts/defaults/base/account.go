@@ -243,20 +243,30 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 	return pubKey, nil
 }
 
-func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+// validatePubKey checks that the pubkey is of a supported type and valid,
+// it returns the name of the pubkey type.
+func (a Account) validatePubKey(anyPk *codectypes.Any) (string, error) {
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return "", fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return "", fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
+		return "", fmt.Errorf("unable to validate pubkey: %w", err)
+	}
+	return name, nil
+}
+
+func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	name, err := a.validatePubKey(anyPk)
+	if err != nil {
+		return err
 	}
 
 	// save into state
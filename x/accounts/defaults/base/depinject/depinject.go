@@ -29,3 +29,7 @@ func ProvideCustomPubkey[T any, PT base.PubKeyG[T]]() base.Option {
 func ProvideCustomPubKeyAndValidationFunc[T any, PT base.PubKeyG[T]](validateFn func(PT) error) base.Option {
 	return base.WithPubKeyWithValidationFunc(validateFn)
 }
+
+func ProvideRecoveryAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewRecoveryAccount("base_recovery", in.SignHandlersMap, in.Options...)}
+}
@@ -0,0 +1,305 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	GuardiansPrefix       = collections.NewPrefix(3)
+	RecoveryConfigPrefix  = collections.NewPrefix(4)
+	PendingRecoveryPrefix = collections.NewPrefix(5)
+)
+
+// NewRecoveryAccount creates a base account which pubkey can be rotated by a set of guardians.
+func NewRecoveryAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, acc, err := NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, RecoveryAccount{
+			Account:         acc.(Account),
+			Guardians:       collections.NewKeySet(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey),
+			RecoveryConfig:  collections.NewItem(deps.SchemaBuilder, RecoveryConfigPrefix, "recovery_config", codec.CollValue[v1.RecoveryConfig](deps.LegacyStateCodec)),
+			PendingRecovery: collections.NewItem(deps.SchemaBuilder, PendingRecoveryPrefix, "pending_recovery", codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+		}, nil
+	}
+}
+
+// RecoveryAccount implements a base account with social recovery: a threshold of
+// guardians can rotate the pubkey of the account after a timelock, during which
+// the owner can cancel the recovery.
+type RecoveryAccount struct {
+	Account
+
+	Guardians       collections.KeySet[[]byte]
+	RecoveryConfig  collections.Item[v1.RecoveryConfig]
+	PendingRecovery collections.Item[v1.Recovery]
+}
+
+func (a RecoveryAccount) Init(ctx context.Context, msg *v1.MsgInitRecoveryAccount) (*v1.MsgInitRecoveryAccountResponse, error) {
+	_, err := a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitRecoveryAccountResponse{}, a.updateGuardians(ctx, msg.Guardians, nil, msg.Config)
+}
+
+// UpdateGuardians updates the guardians and the recovery config, cancelling any pending recovery.
+func (a RecoveryAccount) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	err := a.updateGuardians(ctx, msg.Add, msg.Remove, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateGuardiansResponse{}, a.PendingRecovery.Remove(ctx)
+}
+
+func (a RecoveryAccount) updateGuardians(ctx context.Context, add, remove []string, config v1.RecoveryConfig) error {
+	for _, guardian := range add {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		if bytes.Equal(addr, accountstd.Whoami(ctx)) {
+			return errors.New("the account cannot be its own guardian")
+		}
+		if err := a.Guardians.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, guardian := range remove {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		has, err := a.Guardians.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fmt.Errorf("%s is not a guardian", guardian)
+		}
+		if err := a.Guardians.Remove(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	guardians, err := a.guardians(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case config.Timelock < 0:
+		return errors.New("timelock cannot be negative")
+	case len(guardians) == 0 && config.Threshold != 0:
+		return errors.New("threshold must be zero when there are no guardians")
+	case len(guardians) > 0 && config.Threshold == 0:
+		return errors.New("threshold must be greater than zero")
+	case int(config.Threshold) > len(guardians):
+		return fmt.Errorf("threshold %d is greater than the number of guardians %d", config.Threshold, len(guardians))
+	}
+
+	return a.RecoveryConfig.Set(ctx, config)
+}
+
+// InitiateRecovery is used by a guardian to propose a new pubkey for the account.
+func (a RecoveryAccount) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := a.PendingRecovery.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("a recovery is already pending")
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errors.New("new pubkey cannot be empty")
+	}
+	if _, err := a.validatePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitiateRecoveryResponse{}, a.approve(ctx, v1.Recovery{NewPubKey: msg.NewPubKey}, guardian)
+}
+
+// ApproveRecovery is used by a guardian to approve the pending recovery.
+func (a RecoveryAccount) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.pendingRecovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.NewPubKey == nil || msg.NewPubKey.TypeUrl != recovery.NewPubKey.TypeUrl || !bytes.Equal(msg.NewPubKey.Value, recovery.NewPubKey.Value) {
+		return nil, errors.New("pubkey does not match the pending recovery")
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, errors.New("recovery already approved")
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.approve(ctx, recovery, guardian)
+}
+
+// approve adds the guardian approval to the recovery and starts the timelock
+// once the threshold is reached.
+func (a RecoveryAccount) approve(ctx context.Context, recovery v1.Recovery, guardian string) error {
+	config, err := a.RecoveryConfig.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAt == nil && len(recovery.Approvals) >= int(config.Threshold) {
+		executableAt := a.hs.HeaderInfo(ctx).Time.Add(config.Timelock)
+		recovery.ExecutableAt = &executableAt
+	}
+
+	return a.PendingRecovery.Set(ctx, recovery)
+}
+
+// CompleteRecovery rotates the pubkey of the account once the recovery timelock expired.
+// It can be sent by anyone.
+func (a RecoveryAccount) CompleteRecovery(ctx context.Context, _ *v1.MsgCompleteRecovery) (*v1.MsgCompleteRecoveryResponse, error) {
+	recovery, err := a.pendingRecovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if recovery.ExecutableAt == nil {
+		return nil, errors.New("recovery is not approved by enough guardians")
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(*recovery.ExecutableAt) {
+		return nil, fmt.Errorf("recovery timelock expires at %s", recovery.ExecutableAt)
+	}
+
+	err = a.savePubKey(ctx, recovery.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCompleteRecoveryResponse{}, a.PendingRecovery.Remove(ctx)
+}
+
+// CancelRecovery is used by the owner to cancel the pending recovery.
+func (a RecoveryAccount) CancelRecovery(ctx context.Context, _ *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.pendingRecovery(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, a.PendingRecovery.Remove(ctx)
+}
+
+func (a RecoveryAccount) QueryGuardians(ctx context.Context, _ *v1.QueryGuardians) (*v1.QueryGuardiansResponse, error) {
+	guardians, err := a.guardians(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.RecoveryConfig.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGuardiansResponse{Guardians: guardians, Config: config}, nil
+}
+
+func (a RecoveryAccount) QueryPendingRecovery(ctx context.Context, _ *v1.QueryPendingRecovery) (*v1.QueryPendingRecoveryResponse, error) {
+	recovery, err := a.PendingRecovery.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &v1.QueryPendingRecoveryResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &v1.QueryPendingRecoveryResponse{Recovery: &recovery}, nil
+}
+
+func (a RecoveryAccount) guardians(ctx context.Context) ([]string, error) {
+	var guardians []string
+	err := a.Guardians.Walk(ctx, nil, func(addr []byte) (bool, error) {
+		guardian, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		guardians = append(guardians, guardian)
+		return false, nil
+	})
+
+	return guardians, err
+}
+
+// senderGuardian returns the address of the sender, failing if it is not a guardian.
+func (a RecoveryAccount) senderGuardian(ctx context.Context) (string, error) {
+	sender := accountstd.Sender(ctx)
+	has, err := a.Guardians.Has(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if !has {
+		return "", errors.New("unauthorized: sender is not a guardian")
+	}
+
+	return a.addrCodec.BytesToString(sender)
+}
+
+func (a RecoveryAccount) pendingRecovery(ctx context.Context) (v1.Recovery, error) {
+	recovery, err := a.PendingRecovery.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Recovery{}, errors.New("no pending recovery")
+	}
+
+	return recovery, err
+}
+
+func (a RecoveryAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a RecoveryAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CompleteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+}
+
+func (a RecoveryAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryGuardians)
+	accountstd.RegisterQueryHandler(builder, a.QueryPendingRecovery)
+}
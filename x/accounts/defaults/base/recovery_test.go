@@ -0,0 +1,177 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService) RecoveryAccount {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	createAccFn := NewRecoveryAccount("base_recovery", signing.NewHandlerMap(directHandler{}), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
+		return err
+	}))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(RecoveryAccount)
+}
+
+func TestRecoveryInit(t *testing.T) {
+	testcases := []struct {
+		name      string
+		guardians []string
+		config    v1.RecoveryConfig
+		expErr    string
+	}{
+		{"no guardians", nil, v1.RecoveryConfig{}, ""},
+		{"valid", []string{"alice", "bob"}, v1.RecoveryConfig{Threshold: 2, Timelock: time.Hour}, ""},
+		{"threshold without guardians", nil, v1.RecoveryConfig{Threshold: 1}, "threshold must be zero"},
+		{"zero threshold", []string{"alice"}, v1.RecoveryConfig{}, "greater than zero"},
+		{"threshold above guardians", []string{"alice"}, v1.RecoveryConfig{Threshold: 2}, "greater than the number of guardians"},
+		{"negative timelock", []string{"alice"}, v1.RecoveryConfig{Threshold: 1, Timelock: -time.Hour}, "negative"},
+		{"self guardian", []string{"mock_base_account"}, v1.RecoveryConfig{Threshold: 1}, "its own guardian"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupRecoveryAccount(t, ss)
+			_, err := acc.Init(ctx, &v1.MsgInitRecoveryAccount{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: tc.guardians,
+				Config:    tc.config,
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.guardians, res.Guardians)
+			require.Equal(t, tc.config, res.Config)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInitRecoveryAccount{
+		PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []string{"alice", "bob", "carol"},
+		Config:    v1.RecoveryConfig{Threshold: 2, Timelock: time.Hour},
+	})
+	require.NoError(t, err)
+
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	otherPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	alice := accountstd.SetSender(ctx, []byte("alice"))
+	bob := accountstd.SetSender(ctx, []byte("bob"))
+	owner := accountstd.SetSender(ctx, []byte("mock_base_account"))
+
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("eve")), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "not a guardian")
+	_, err = acc.InitiateRecovery(alice, &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")})})
+	require.Error(t, err)
+
+	_, err = acc.InitiateRecovery(alice, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(bob, &v1.MsgInitiateRecovery{NewPubKey: otherPubKey})
+	require.ErrorContains(t, err, "already pending")
+	_, err = acc.ApproveRecovery(alice, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "already approved")
+	_, err = acc.ApproveRecovery(bob, &v1.MsgApproveRecovery{NewPubKey: otherPubKey})
+	require.ErrorContains(t, err, "does not match")
+
+	// the timelock only starts once the threshold is reached.
+	_, err = acc.CompleteRecovery(ctx, &v1.MsgCompleteRecovery{})
+	require.ErrorContains(t, err, "not approved by enough guardians")
+	_, err = acc.ApproveRecovery(bob, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	pending, err := acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, pending.Recovery.Approvals)
+	require.Equal(t, blockTime.Add(time.Hour), *pending.Recovery.ExecutableAt)
+
+	_, err = acc.CompleteRecovery(ctx, &v1.MsgCompleteRecovery{})
+	require.ErrorContains(t, err, "timelock expires")
+
+	// the owner can cancel the recovery during the timelock.
+	_, err = acc.CancelRecovery(alice, &v1.MsgCancelRecovery{})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.CancelRecovery(owner, &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+	pending, err = acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, pending.Recovery)
+
+	// guardians recover the account once the timelock expired.
+	_, err = acc.InitiateRecovery(alice, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(bob, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(time.Hour)
+	defer func() { blockTime = blockTime.Add(-time.Hour) }()
+	_, err = acc.CompleteRecovery(ctx, &v1.MsgCompleteRecovery{})
+	require.NoError(t, err)
+
+	pubKey, err := acc.QueryPubKey(ctx, &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKey.PubKey.Value)
+	_, err = acc.CompleteRecovery(ctx, &v1.MsgCompleteRecovery{})
+	require.ErrorContains(t, err, "no pending recovery")
+}
+
+func TestUpdateGuardians(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInitRecoveryAccount{
+		PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []string{"alice", "bob"},
+		Config:    v1.RecoveryConfig{Threshold: 1},
+	})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgInitiateRecovery{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	msg := &v1.MsgUpdateGuardians{
+		Add:    []string{"carol"},
+		Remove: []string{"alice"},
+		Config: v1.RecoveryConfig{Threshold: 2, Timelock: time.Minute},
+	}
+	_, err = acc.UpdateGuardians(accountstd.SetSender(ctx, []byte("alice")), msg)
+	require.ErrorContains(t, err, "unauthorized")
+
+	owner := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	_, err = acc.UpdateGuardians(owner, &v1.MsgUpdateGuardians{Remove: []string{"eve"}, Config: v1.RecoveryConfig{Threshold: 1}})
+	require.ErrorContains(t, err, "not a guardian")
+
+	_, err = acc.UpdateGuardians(owner, msg)
+	require.NoError(t, err)
+
+	res, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob", "carol"}, res.Guardians)
+	require.Equal(t, msg.Config, res.Config)
+
+	// updating the guardians cancels the pending recovery.
+	pending, err := acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, pending.Recovery)
+}
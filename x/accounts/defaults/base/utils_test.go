@@ -3,6 +3,7 @@ package base
 import (
 	"context"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
@@ -87,11 +88,15 @@ func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependen
 	}
 }
 
+// blockTime is the block time returned by headerService.
+var blockTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 type headerService struct{}
 
 func (h headerService) HeaderInfo(context.Context) header.Info {
 	return header.Info{
 		ChainID: "test",
+		Time:    blockTime,
 	}
 }
 
@@ -52,6 +52,10 @@ func (cva ContinuousLockingAccount) Init(ctx context.Context, msg *lockuptypes.M
 		start = hs.Time
 	}
 
+	if err := validateCliffTime(msg.CliffTime, start, msg.EndTime); err != nil {
+		return nil, err
+	}
+
 	err := cva.StartTime.Set(ctx, start)
 	if err != nil {
 		return nil, err
@@ -78,6 +82,12 @@ func (cva *ContinuousLockingAccount) WithdrawUnlockedCoins(ctx context.Context,
 	return cva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
 }
 
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.Clawback(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (cva ContinuousLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
 	unlockedCoins = sdk.Coins{}
@@ -104,6 +114,10 @@ func (cva ContinuousLockingAccount) GetLockCoinsInfo(ctx context.Context, blockT
 // GetLockCoinInfoWithDenom returns the number of locked coin for a specific denom. If no coins are locked,
 // nil is returned.
 func (cva ContinuousLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	if unlockedCoin, lockedCoin, ok, err := cva.getLockCoinInfoOverride(ctx, blockTime, denom); err != nil || ok {
+		return unlockedCoin, lockedCoin, err
+	}
+
 	// We must handle the case where the start time for a lockup account has
 	// been set into the future or when the start of the chain is not exactly
 	// known.
@@ -195,6 +209,7 @@ func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, cva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
 	cva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
@@ -234,3 +234,186 @@ func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestContinousAccountCliffBounds(t *testing.T) {
+	startTime := time.Now()
+	endTime := startTime.Add(time.Minute * 2)
+
+	testcases := []struct {
+		name      string
+		cliffTime time.Time
+		expErrMsg string
+	}{
+		{
+			name: "no cliff",
+		},
+		{
+			name:      "cliff at start time",
+			cliffTime: startTime,
+		},
+		{
+			name:      "cliff at end time",
+			cliffTime: endTime,
+		},
+		{
+			name:      "cliff before start time",
+			cliffTime: startTime.Add(-time.Second),
+			expErrMsg: "invalid cliff time (must be after start time)",
+		},
+		{
+			name:      "cliff after end time",
+			cliffTime: endTime.Add(time.Second),
+			expErrMsg: "invalid cliff time (must be before end time)",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+				Time: startTime,
+			})
+
+			acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+			require.NoError(t, err)
+			_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+				Owner:     "owner",
+				StartTime: startTime,
+				EndTime:   endTime,
+				CliffTime: tc.cliffTime,
+			})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContinousAccountCliff(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 2),
+		CliffTime: startTime.Add(time.Minute * 3),
+	})
+	require.ErrorContains(t, err, "invalid cliff time")
+
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 2),
+		CliffTime: startTime.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	// all the coins stay locked before the cliff
+	unlockedCoins, lockedCoins, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Second*30))
+	require.NoError(t, err)
+	require.True(t, unlockedCoins.IsZero())
+	require.True(t, lockedCoins.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))))
+
+	// the coins unlocked since the start time are released at the cliff
+	unlockedCoins, lockedCoins, err = acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, unlockedCoins.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, lockedCoins.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 30),
+	})
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1))),
+	})
+	require.ErrorContains(t, err, "spendable balance 0test is smaller than 1test")
+
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(time.Minute).Unix(), info.CliffTime.Unix())
+}
+
+func TestContinousAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc := setupContinousAccount(t, sdkCtx, ss)
+	_, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.ErrorContains(t, err, "clawback is not enabled")
+
+	ctx, ss = newMockContext(t)
+	sdkCtx = sdkCtx.WithContext(ctx)
+	acc, err = NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Minute * 2),
+		Funder:    "funder",
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(4)),
+	})
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "owner"})
+	require.ErrorContains(t, err, "sender is not the funder")
+
+	// the locked coins are sent back to the funder, the delegated ones are undelegated
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1)))))
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+
+	originalLocking, err := acc.OriginalLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(5)))
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+	delFree, err := acc.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.IsZero())
+
+	// the lockup schedule no longer applies, only the coins owed to the funder are locked
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 3),
+	})
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "funder", info.Funder)
+	require.True(t, info.LockedCoins.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+	require.True(t, info.UnlockedCoins.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, info.ClawbackPending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+
+	// the funder claims the pending coins once the unbonding completes
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+	require.True(t, resp.Pending.IsZero())
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.ErrorContains(t, err, "no locked tokens available for clawback")
+}
@@ -34,6 +34,11 @@ func (dva DelayedLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgI
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid end time %s", msg.EndTime.String())
 	}
 
+	hs := dva.headerService.HeaderInfo(ctx)
+	if err := validateCliffTime(msg.CliffTime, hs.Time, msg.EndTime); err != nil {
+		return nil, err
+	}
+
 	return dva.BaseLockup.Init(ctx, msg)
 }
 
@@ -55,8 +60,18 @@ func (dva *DelayedLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg
 	return dva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, dva.GetLockedCoinsWithDenoms)
 }
 
+func (dva *DelayedLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return dva.BaseLockup.Clawback(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (dva DelayedLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (sdk.Coins, sdk.Coins, error) {
+	if unlockedCoins, lockedCoins, ok, err := dva.getLockCoinsInfoOverride(ctx, blockTime); err != nil || ok {
+		return unlockedCoins, lockedCoins, err
+	}
+
 	endTime, err := dva.EndTime.Get(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -88,6 +103,10 @@ func (dva DelayedLockingAccount) GetLockedCoins(ctx context.Context, blockTime t
 
 // GetLockCoinInfoWithDenom returns the number of unlocked and locked coin for a specific denom.
 func (dva DelayedLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (*sdk.Coin, *sdk.Coin, error) {
+	if unlockedCoin, lockedCoin, ok, err := dva.getLockCoinInfoOverride(ctx, blockTime, denom); err != nil || ok {
+		return unlockedCoin, lockedCoin, err
+	}
+
 	endTime, err := dva.EndTime.Get(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -144,6 +163,7 @@ func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.Exe
 	accountstd.RegisterExecuteHandler(builder, dva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, dva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.Clawback)
 	dva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
@@ -223,3 +223,65 @@ func TestDelayedAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestDelayedAccountClawback(t *testing.T) {
+	staking := newMockStaking()
+	ctx, ss := newMockStakingContext(t, staking)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc, err := NewDelayedLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:   "owner",
+		EndTime: startTime.Add(time.Minute * 2),
+		Funder:  "funder",
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(6)),
+	})
+	require.NoError(t, err)
+
+	// all the coins are locked, the delegated ones are undelegated
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))))
+	require.True(t, staking.balance.IsZero())
+	require.True(t, staking.unbondings["val_address"].Equal(math.NewInt(6)))
+
+	// the coins received by the owner are not sent while the unbonding is pending
+	staking.balance = math.NewInt(5)
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))))
+	require.True(t, staking.balance.Equal(math.NewInt(5)))
+
+	// the coins slashed while unbonding are no longer owed to the funder
+	staking.slash("val_address", math.NewInt(1))
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+
+	// the funder claims the coins returned by the unbonding once it completes
+	staking.mature()
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, resp.Pending.IsZero())
+	require.True(t, staking.balance.Equal(math.NewInt(5)))
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.ErrorContains(t, err, "no locked tokens available for clawback")
+}
@@ -25,17 +25,24 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var (
-	OriginalLockingPrefix  = collections.NewPrefix(0)
-	DelegatedFreePrefix    = collections.NewPrefix(1)
-	DelegatedLockingPrefix = collections.NewPrefix(2)
-	EndTimePrefix          = collections.NewPrefix(3)
-	StartTimePrefix        = collections.NewPrefix(4)
-	LockingPeriodsPrefix   = collections.NewPrefix(5)
-	OwnerPrefix            = collections.NewPrefix(6)
-	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	OriginalLockingPrefix       = collections.NewPrefix(0)
+	DelegatedFreePrefix         = collections.NewPrefix(1)
+	DelegatedLockingPrefix      = collections.NewPrefix(2)
+	EndTimePrefix               = collections.NewPrefix(3)
+	StartTimePrefix             = collections.NewPrefix(4)
+	LockingPeriodsPrefix        = collections.NewPrefix(5)
+	OwnerPrefix                 = collections.NewPrefix(6)
+	WithdrawedCoinsPrefix       = collections.NewPrefix(7)
+	CliffTimePrefix             = collections.NewPrefix(8)
+	FunderPrefix                = collections.NewPrefix(9)
+	TerminationTimePrefix       = collections.NewPrefix(10)
+	ClawbackPendingPrefix       = collections.NewPrefix(11)
+	ClawbackUnbondingPrefix     = collections.NewPrefix(12)
+	ClawbackUnbondingTimePrefix = collections.NewPrefix(13)
 )
 
 var (
@@ -50,14 +57,20 @@ type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...st
 // newBaseLockup creates a new BaseLockup object.
 func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 	BaseLockup := &BaseLockup{
-		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
-		OriginalLocking:  collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
-		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
-		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
-		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
-		addressCodec:     d.AddressCodec,
-		headerService:    d.Environment.HeaderService,
-		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		Owner:                 collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		OriginalLocking:       collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
+		DelegatedFree:         collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
+		DelegatedLocking:      collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
+		WithdrawedCoins:       collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		addressCodec:          d.AddressCodec,
+		headerService:         d.Environment.HeaderService,
+		EndTime:               collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		CliffTime:             collections.NewItem(d.SchemaBuilder, CliffTimePrefix, "cliff_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		Funder:                collections.NewItem(d.SchemaBuilder, FunderPrefix, "funder", collections.BytesValue),
+		TerminationTime:       collections.NewItem(d.SchemaBuilder, TerminationTimePrefix, "termination_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		ClawbackPending:       collections.NewMap(d.SchemaBuilder, ClawbackPendingPrefix, "clawback_pending", collections.StringKey, sdk.IntValue),
+		ClawbackUnbonding:     collections.NewMap(d.SchemaBuilder, ClawbackUnbondingPrefix, "clawback_unbonding", collections.StringKey, sdk.IntValue),
+		ClawbackUnbondingTime: collections.NewItem(d.SchemaBuilder, ClawbackUnbondingTimePrefix, "clawback_unbonding_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
 	}
 
 	return BaseLockup
@@ -74,6 +87,19 @@ type BaseLockup struct {
 	headerService    header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
+	// CliffTime is the optional time before which all the coins stay locked.
+	CliffTime collections.Item[time.Time]
+	// Funder is the optional address allowed to claw back the locked coins.
+	Funder collections.Item[[]byte]
+	// TerminationTime is the time at which the lockup was clawed back.
+	TerminationTime collections.Item[time.Time]
+	// ClawbackPending tracks the coins owed to the funder after a clawback.
+	ClawbackPending collections.Map[string, math.Int]
+	// ClawbackUnbonding tracks by validator the coins undelegated by a clawback which are owed to
+	// the funder once their unbonding completes.
+	ClawbackUnbonding collections.Map[string, math.Int]
+	// ClawbackUnbondingTime is the completion time of the unbonding delegations started by a clawback.
+	ClawbackUnbondingTime collections.Item[time.Time]
 }
 
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
@@ -126,9 +152,34 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setCliffAndFunder(ctx, msg.CliffTime, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
 	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
 }
 
+// setCliffAndFunder sets the optional cliff time and funder of the lockup account.
+func (bva *BaseLockup) setCliffAndFunder(ctx context.Context, cliffTime time.Time, funder string) error {
+	if !cliffTime.IsZero() {
+		err := bva.CliffTime.Set(ctx, cliffTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if funder == "" {
+		return nil
+	}
+	funderBytes, err := bva.addressCodec.StringToBytes(funder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	return bva.Funder.Set(ctx, funderBytes)
+}
+
 func (bva *BaseLockup) Delegate(
 	ctx context.Context, msg *lockuptypes.MsgDelegate, getLockedCoinsFunc getLockedCoinsFunc,
 ) (
@@ -381,6 +432,369 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}, nil
 }
 
+// Clawback allows the funder to claw back the coins that are still locked, terminating the lockup:
+// the owner keeps the coins unlocked so far and the lockup schedule no longer applies. The locked
+// coins that are delegated are undelegated, they stay locked and can be claimed by the funder with
+// a new MsgClawback once the unbonding completes.
+func (bva *BaseLockup) Clawback(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	funder, err := bva.Funder.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("clawback is not enabled for this lockup account")
+	} else if err != nil {
+		return nil, err
+	}
+	senderBytes, err := bva.addressCodec.StringToBytes(msg.Funder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err.Error())
+	}
+	if !bytes.Equal(funder, senderBytes) {
+		return nil, errors.New("sender is not the funder of this lockup account")
+	}
+
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	terminated, err := bva.TerminationTime.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []string
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(key string, _ math.Int) (stop bool, err error) {
+		denoms = append(denoms, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, pending := sdk.Coins{}, sdk.Coins{}
+	undelegated := math.ZeroInt()
+	for _, denom := range denoms {
+		lockedAmt := lockedCoins.AmountOf(denom)
+		if !terminated {
+			// the owner keeps the coins unlocked so far
+			originalLockingAmt, err := bva.OriginalLocking.Get(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+			err = bva.OriginalLocking.Set(ctx, denom, originalLockingAmt.Sub(lockedAmt))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		notBondedLockedCoin, err := bva.GetNotBondedLockedCoin(ctx, sdk.NewCoin(denom, lockedAmt), denom)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		unbondingAmt := math.ZeroInt()
+		if denom == bondDenom && terminated {
+			// the coins lost to slashing while unbonding are not owed to the funder
+			var slashedAmt math.Int
+			unbondingAmt, slashedAmt, err = bva.checkClawbackUnbonding(ctx, fromAddress)
+			if err != nil {
+				return nil, err
+			}
+			notBondedLockedCoin.Amount = notBondedLockedCoin.Amount.Sub(slashedAmt)
+		}
+
+		// the locked coins not yet returned by an unbonding delegation stay pending
+		sendAmt := math.MinInt(balance.Amount, notBondedLockedCoin.Amount.Sub(unbondingAmt))
+		pendingAmt := notBondedLockedCoin.Amount.Sub(sendAmt)
+		if denom == bondDenom && !terminated {
+			undelegated, err = bva.undelegateLockedCoins(ctx, fromAddress, bondDenom, lockedAmt.Sub(notBondedLockedCoin.Amount))
+			if err != nil {
+				return nil, err
+			}
+			pendingAmt = pendingAmt.Add(undelegated)
+		}
+
+		if !sendAmt.IsZero() {
+			amount = append(amount, sdk.NewCoin(denom, sendAmt))
+		}
+		if !pendingAmt.IsZero() {
+			pending = append(pending, sdk.NewCoin(denom, pendingAmt))
+		}
+		err = bva.ClawbackPending.Set(ctx, denom, pendingAmt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(amount) == 0 && len(pending) == 0 {
+		return nil, errors.New("no locked tokens available for clawback")
+	}
+
+	if !terminated {
+		err = bva.TerminationTime.Set(ctx, hs.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(amount) != 0 {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: fromAddress,
+			ToAddress:   msg.Funder,
+			Amount:      amount,
+		}
+		_, err = sendMessage(ctx, msgSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgClawbackResponse{
+		Amount:  amount,
+		Pending: pending,
+	}, nil
+}
+
+// undelegateLockedCoins undelegates the given amount of locked coins from the delegations of the
+// account and tracks the undelegated coins as owed to the funder once their unbonding completes.
+// All the remaining delegations belong to the owner once the lockup is clawed back, hence they are
+// tracked as delegated free coins.
+func (bva *BaseLockup) undelegateLockedCoins(ctx context.Context, delegator, bondDenom string, amount math.Int) (math.Int, error) {
+	undelegated := math.ZeroInt()
+	var nextKey []byte
+	for undelegated.LT(amount) {
+		resp, err := accountstd.QueryModule[*stakingtypes.QueryDelegatorDelegationsResponse](ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegator,
+			Pagination:    &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		for _, delegation := range resp.DelegationResponses {
+			undelegateAmt := math.MinInt(delegation.Balance.Amount, amount.Sub(undelegated))
+			if !undelegateAmt.IsPositive() {
+				continue
+			}
+
+			msgUndelegate := &stakingtypes.MsgUndelegate{
+				DelegatorAddress: delegator,
+				ValidatorAddress: delegation.Delegation.ValidatorAddress,
+				Amount:           sdk.NewCoin(bondDenom, undelegateAmt),
+			}
+			resp, err := accountstd.ExecModule[*stakingtypes.MsgUndelegateResponse](ctx, msgUndelegate)
+			if err != nil {
+				return math.Int{}, err
+			}
+			undelegated = undelegated.Add(resp.Amount.Amount)
+
+			owed, err := bva.ClawbackUnbonding.Get(ctx, delegation.Delegation.ValidatorAddress)
+			if errors.Is(err, collections.ErrNotFound) {
+				owed = math.ZeroInt()
+			} else if err != nil {
+				return math.Int{}, err
+			}
+			err = bva.ClawbackUnbonding.Set(ctx, delegation.Delegation.ValidatorAddress, owed.Add(resp.Amount.Amount))
+			if err != nil {
+				return math.Int{}, err
+			}
+			err = bva.ClawbackUnbondingTime.Set(ctx, resp.CompletionTime)
+			if err != nil {
+				return math.Int{}, err
+			}
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+
+	delLockingAmt, err := bva.DelegatedLocking.Get(ctx, bondDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	delFreeAmt, err := bva.DelegatedFree.Get(ctx, bondDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	err = bva.DelegatedFree.Set(ctx, bondDenom, delFreeAmt.Add(delLockingAmt).Sub(undelegated))
+	if err != nil {
+		return math.Int{}, err
+	}
+	err = bva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return undelegated, nil
+}
+
+// checkClawbackUnbonding returns the coins owed to the funder which are still unbonding after a
+// clawback. The unbonding delegations started by the clawback which completed are no longer tracked,
+// their coins are back in the account. The coins lost to slashing while unbonding are not owed to the
+// funder, they are returned as slashed.
+func (bva *BaseLockup) checkClawbackUnbonding(ctx context.Context, delegator string) (unbonding, slashed math.Int, err error) {
+	unbonding, slashed = math.ZeroInt(), math.ZeroInt()
+	completionTime, err := bva.ClawbackUnbondingTime.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return unbonding, slashed, nil
+	} else if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	// the balances of the unbonding delegation entries started by the clawback by validator,
+	// the entries of the undelegations of the owner in the same block are merged into them
+	balances := map[string]math.Int{}
+	var nextKey []byte
+	for {
+		resp, err := accountstd.QueryModule[*stakingtypes.QueryDelegatorUnbondingDelegationsResponse](ctx, &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: delegator,
+			Pagination:    &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+
+		for _, ubd := range resp.UnbondingResponses {
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					balances[ubd.ValidatorAddress] = entry.Balance
+				}
+			}
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+
+	owedByValidator := map[string]math.Int{}
+	err = bva.IterateCoinEntries(ctx, bva.ClawbackUnbonding, func(validator string, owed math.Int) (stop bool, err error) {
+		owedByValidator[validator] = owed
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	for _, validator := range slices.Sorted(maps.Keys(owedByValidator)) {
+		owed := owedByValidator[validator]
+		balance, ok := balances[validator]
+		if !ok {
+			// the unbonding completed
+			err = bva.ClawbackUnbonding.Remove(ctx, validator)
+			if err != nil {
+				return math.Int{}, math.Int{}, err
+			}
+			continue
+		}
+
+		// the balance of the entry only decreases by slashing
+		if balance.LT(owed) {
+			slashed = slashed.Add(owed.Sub(balance))
+			owed = balance
+			if owed.IsZero() {
+				err = bva.ClawbackUnbonding.Remove(ctx, validator)
+			} else {
+				err = bva.ClawbackUnbonding.Set(ctx, validator, owed)
+			}
+			if err != nil {
+				return math.Int{}, math.Int{}, err
+			}
+		}
+		unbonding = unbonding.Add(owed)
+	}
+
+	if unbonding.IsZero() {
+		err = bva.ClawbackUnbondingTime.Remove(ctx)
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	return unbonding, slashed, nil
+}
+
+// getLockCoinInfoOverride returns the unlocked and locked coin for a specific denom when they are not
+// given by the lockup schedule: before the cliff time all the coins are locked, and once the lockup is
+// clawed back only the coins owed to the funder are locked. ok is false when the schedule applies.
+func (bva BaseLockup) getLockCoinInfoOverride(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, ok bool, err error) {
+	terminated, err := bva.TerminationTime.Has(ctx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !terminated {
+		cliffTime, err := bva.CliffTime.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) || (err == nil && !blockTime.Before(cliffTime)) {
+			return nil, nil, false, nil
+		} else if err != nil {
+			return nil, nil, false, err
+		}
+	}
+
+	originalLockingAmt, err := bva.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	originalLockingCoin := sdk.NewCoin(denom, originalLockingAmt)
+	if !terminated {
+		unlockedCoin := sdk.NewCoin(denom, math.ZeroInt())
+		return &unlockedCoin, &originalLockingCoin, true, nil
+	}
+
+	pendingAmt, err := bva.ClawbackPending.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		pendingAmt = math.ZeroInt()
+	} else if err != nil {
+		return nil, nil, false, err
+	}
+	pendingCoin := sdk.NewCoin(denom, pendingAmt)
+
+	return &originalLockingCoin, &pendingCoin, true, nil
+}
+
+// getLockCoinsInfoOverride returns the total number of unlocked and locked coins when they are not
+// given by the lockup schedule, see getLockCoinInfoOverride.
+func (bva BaseLockup) getLockCoinsInfoOverride(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, ok bool, err error) {
+	unlockedCoins = sdk.Coins{}
+	lockedCoins = sdk.Coins{}
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(key string, _ math.Int) (stop bool, err error) {
+		var unlockedCoin, lockedCoin *sdk.Coin
+		unlockedCoin, lockedCoin, ok, err = bva.getLockCoinInfoOverride(ctx, blockTime, key)
+		if err != nil || !ok {
+			return true, err
+		}
+		unlockedCoins = unlockedCoins.Add(*unlockedCoin)
+		lockedCoins = lockedCoins.Add(*lockedCoin)
+		return false, nil
+	})
+	if err != nil || !ok {
+		return nil, nil, false, err
+	}
+
+	return unlockedCoins, lockedCoins, true, nil
+}
+
 func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
 	owner, err := bva.Owner.Get(ctx)
 	if err != nil {
@@ -663,13 +1077,41 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
-	return &lockuptypes.QueryLockupAccountInfoResponse{
+	resp := &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
 		EndTime:          &endTime,
-	}, nil
+		ClawbackPending:  sdk.Coins{},
+	}
+
+	cliffTime, err := bva.CliffTime.Get(ctx)
+	if err == nil {
+		resp.CliffTime = &cliffTime
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	funder, err := bva.Funder.Get(ctx)
+	if err == nil {
+		resp.Funder, err = bva.addressCodec.BytesToString(funder)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	err = bva.IterateCoinEntries(ctx, bva.ClawbackPending, func(key string, value math.Int) (stop bool, err error) {
+		resp.ClawbackPending = resp.ClawbackPending.Add(sdk.NewCoin(key, value))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (bva BaseLockup) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
@@ -70,6 +70,10 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 		}
 	}
 
+	if err := validateCliffTime(msg.CliffTime, msg.StartTime, endTime); err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
 	if !funds.Equal(totalCoins) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
@@ -118,6 +122,10 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	if err != nil {
 		return nil, err
 	}
+	err = pva.setCliffAndFunder(ctx, msg.CliffTime, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
 
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
@@ -140,6 +148,12 @@ func (pva *PeriodicLockingAccount) WithdrawUnlockedCoins(ctx context.Context, ms
 	return pva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
 }
 
+func (pva *PeriodicLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return pva.BaseLockup.Clawback(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
 // IteratePeriods iterates over all the Periods entries.
 func (pva PeriodicLockingAccount) IteratePeriods(
 	ctx context.Context,
@@ -157,6 +171,10 @@ func (pva PeriodicLockingAccount) IteratePeriods(
 
 // GetLockCoinsInfo returns the total number of locked and unlocked coins.
 func (pva PeriodicLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	if unlockedCoins, lockedCoins, ok, err := pva.getLockCoinsInfoOverride(ctx, blockTime); err != nil || ok {
+		return unlockedCoins, lockedCoins, err
+	}
+
 	unlockedCoins = sdk.Coins{}
 	lockedCoins = sdk.Coins{}
 
@@ -224,6 +242,10 @@ func (pva PeriodicLockingAccount) GetLockedCoins(ctx context.Context, blockTime
 
 // GetLockCoinInfoWithDenom returns the total number of locked and unlocked coin for a specific denom.
 func (pva PeriodicLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	if unlockedCoin, lockedCoin, ok, err := pva.getLockCoinInfoOverride(ctx, blockTime, denom); err != nil || ok {
+		return unlockedCoin, lockedCoin, err
+	}
+
 	// We must handle the case where the start time for a lockup account has
 	// been set into the future or when the start of the chain is not exactly
 	// known.
@@ -337,6 +359,7 @@ func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.Ex
 	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, pva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.Clawback)
 	pva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
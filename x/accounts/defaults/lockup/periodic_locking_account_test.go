@@ -277,3 +277,74 @@ func TestPeriodicAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestPeriodicAccountClawback(t *testing.T) {
+	staking := newMockStaking()
+	ctx, ss := newMockStakingContext(t, staking)
+	startTime := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: startTime,
+	})
+
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		Funder:    "funder",
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+				Length: time.Minute,
+			},
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+				Length: time.Minute,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(8)),
+	})
+	require.NoError(t, err)
+
+	// the first period is unlocked, the delegated coins are locked first hence the owner keeps the
+	// coins left in the balance and 3 of the delegated coins
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, staking.balance.Equal(math.NewInt(2)))
+	require.True(t, staking.delegations["val_address"].Equal(math.NewInt(3)))
+	require.True(t, staking.unbondings["val_address"].Equal(math.NewInt(5)))
+
+	delFree, err := acc.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(3)))
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+
+	// the coins undelegated by the owner are not sent to the funder
+	staking.delegations["val_address"] = math.ZeroInt()
+	staking.balance = staking.balance.Add(math.NewInt(3))
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, staking.balance.Equal(math.NewInt(5)))
+
+	staking.mature()
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, resp.Pending.IsZero())
+	require.True(t, staking.balance.Equal(math.NewInt(5)))
+}
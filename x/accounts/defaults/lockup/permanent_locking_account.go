@@ -45,6 +45,14 @@ func (plva PermanentLockingAccount) Init(ctx context.Context, msg *lockuptypes.M
 func (plva PermanentLockingAccount) GetlockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
 	vestingCoins := sdk.Coins{}
 	for _, denom := range denoms {
+		_, lockedCoin, ok, err := plva.getLockCoinInfoOverride(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			vestingCoins = append(vestingCoins, *lockedCoin)
+			continue
+		}
 		originalVestingAmt, err := plva.OriginalLocking.Get(ctx, denom)
 		if err != nil {
 			return nil, err
@@ -72,6 +80,12 @@ func (plva *PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return plva.BaseLockup.SendCoins(ctx, msg, plva.GetlockedCoinsWithDenoms)
 }
 
+func (plva *PermanentLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return plva.BaseLockup.Clawback(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
 func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -79,6 +93,17 @@ func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	hs := plva.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, ok, err := plva.getLockCoinsInfoOverride(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		resp.LockedCoins = lockedCoins
+		resp.UnlockedCoins = unlockedCoins
+		return resp, nil
+	}
+
 	originalLocking := sdk.Coins{}
 	err = plva.IterateCoinEntries(ctx, plva.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
 		originalLocking = append(originalLocking, sdk.NewCoin(key, value))
@@ -103,6 +128,7 @@ func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, plva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, plva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, plva.WithdrawReward)
+	accountstd.RegisterExecuteHandler(builder, plva.Clawback)
 }
 
 func (plva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
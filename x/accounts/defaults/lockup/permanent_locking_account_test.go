@@ -96,3 +96,53 @@ func TestPermanentAccountSendCoins(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestPermanentAccountClawback(t *testing.T) {
+	staking := newMockStaking()
+	ctx, ss := newMockStakingContext(t, staking)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc, err := NewPermanentLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:  "owner",
+		Funder: "funder",
+	})
+	require.NoError(t, err)
+
+	for _, validator := range []string{"val_address_1", "val_address_2"} {
+		_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+			Sender:           "owner",
+			ValidatorAddress: validator,
+			Amount:           sdk.NewCoin("test", math.NewInt(3)),
+		})
+		require.NoError(t, err)
+	}
+
+	// all the coins are locked, the delegations to both validators are undelegated
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))))
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))))
+
+	// only the coins of the matured unbonding are sent
+	staking.balance = staking.balance.Add(staking.unbondings["val_address_1"])
+	delete(staking.unbondings, "val_address_1")
+	staking.slash("val_address_2", math.NewInt(2))
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3)))))
+	require.True(t, resp.Pending.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1)))))
+
+	staking.mature()
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1)))))
+	require.True(t, resp.Pending.IsZero())
+	require.True(t, staking.balance.IsZero())
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{Funder: "funder"})
+	require.ErrorContains(t, err, "no locked tokens available for clawback")
+}
@@ -3,7 +3,10 @@ package lockup
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -23,7 +26,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var TestFunds = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))
+var (
+	TestFunds = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))
+
+	unbondingCompletionTime = time.Unix(1_000_000, 0).UTC()
+)
 
 // mock statecodec
 type mockStateCodec struct {
@@ -77,7 +84,10 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 			case "/cosmos.staking.v1beta1.MsgDelegate":
 				return &stakingtypes.MsgDelegateResponse{}, nil
 			case "/cosmos.staking.v1beta1.MsgUndelegate":
-				return &stakingtypes.MsgUndelegate{}, nil
+				return &stakingtypes.MsgUndelegateResponse{
+					Amount:         msg.(*stakingtypes.MsgUndelegate).Amount,
+					CompletionTime: unbondingCompletionTime,
+				}, nil
 			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
 				return &distrtypes.MsgWithdrawDelegatorRewardResponse{}, nil
 			case "/cosmos.bank.v1beta1.MsgSend":
@@ -86,6 +96,17 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 				return nil, errors.New("unrecognized request type")
 			}
 		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			if _, ok := req.(*stakingtypes.QueryDelegatorDelegationsRequest); ok {
+				return &stakingtypes.QueryDelegatorDelegationsResponse{
+					DelegationResponses: stakingtypes.DelegationResponses{
+						stakingtypes.NewDelegationResp("lockup_account", "val_address", math.LegacyNewDec(10), sdk.NewCoin("test", math.NewInt(10))),
+					},
+				}, nil
+			}
+			if _, ok := req.(*stakingtypes.QueryDelegatorUnbondingDelegationsRequest); ok {
+				return &stakingtypes.QueryDelegatorUnbondingDelegationsResponse{}, nil
+			}
+
 			_, ok := req.(*banktypes.QueryBalanceRequest)
 			if !ok {
 				_, ok = req.(*stakingtypes.QueryParamsRequest)
@@ -107,6 +128,109 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 	)
 }
 
+// mockStaking tracks the balance, delegations and unbonding delegations of the lockup account.
+type mockStaking struct {
+	balance     math.Int
+	delegations map[string]math.Int
+	unbondings  map[string]math.Int
+}
+
+func newMockStaking() *mockStaking {
+	return &mockStaking{
+		balance:     TestFunds.AmountOf("test"),
+		delegations: map[string]math.Int{},
+		unbondings:  map[string]math.Int{},
+	}
+}
+
+// mature completes the unbonding delegations.
+func (s *mockStaking) mature() {
+	for _, amt := range s.unbondings {
+		s.balance = s.balance.Add(amt)
+	}
+	s.unbondings = map[string]math.Int{}
+}
+
+// slash slashes the unbonding delegation to the given validator.
+func (s *mockStaking) slash(validator string, amt math.Int) {
+	s.unbondings[validator] = s.unbondings[validator].Sub(amt)
+}
+
+// newMockStakingContext is like newMockContext, but the messages and queries update and read the
+// given staking state.
+func newMockStakingContext(t *testing.T, staking *mockStaking) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		0, []byte("lockup_account"), []byte("sender"), TestFunds,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			switch msg := msg.(type) {
+			case *stakingtypes.MsgDelegate:
+				staking.balance = staking.balance.Sub(msg.Amount.Amount)
+				delegation, ok := staking.delegations[msg.ValidatorAddress]
+				if !ok {
+					delegation = math.ZeroInt()
+				}
+				staking.delegations[msg.ValidatorAddress] = delegation.Add(msg.Amount.Amount)
+				return &stakingtypes.MsgDelegateResponse{}, nil
+			case *stakingtypes.MsgUndelegate:
+				staking.delegations[msg.ValidatorAddress] = staking.delegations[msg.ValidatorAddress].Sub(msg.Amount.Amount)
+				unbonding, ok := staking.unbondings[msg.ValidatorAddress]
+				if !ok {
+					unbonding = math.ZeroInt()
+				}
+				staking.unbondings[msg.ValidatorAddress] = unbonding.Add(msg.Amount.Amount)
+				return &stakingtypes.MsgUndelegateResponse{
+					Amount:         msg.Amount,
+					CompletionTime: unbondingCompletionTime,
+				}, nil
+			case *banktypes.MsgSend:
+				staking.balance = staking.balance.Sub(msg.Amount.AmountOf("test"))
+				return &banktypes.MsgSendResponse{}, nil
+			default:
+				return nil, errors.New("unrecognized request type")
+			}
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			switch req.(type) {
+			case *stakingtypes.QueryDelegatorDelegationsRequest:
+				resp := &stakingtypes.QueryDelegatorDelegationsResponse{}
+				for _, validator := range slices.Sorted(maps.Keys(staking.delegations)) {
+					amt := staking.delegations[validator]
+					if amt.IsZero() {
+						continue
+					}
+					resp.DelegationResponses = append(resp.DelegationResponses,
+						stakingtypes.NewDelegationResp("lockup_account", validator, math.LegacyNewDecFromInt(amt), sdk.NewCoin("test", amt)))
+				}
+				return resp, nil
+			case *stakingtypes.QueryDelegatorUnbondingDelegationsRequest:
+				resp := &stakingtypes.QueryDelegatorUnbondingDelegationsResponse{}
+				for _, validator := range slices.Sorted(maps.Keys(staking.unbondings)) {
+					resp.UnbondingResponses = append(resp.UnbondingResponses, stakingtypes.UnbondingDelegation{
+						DelegatorAddress: "lockup_account",
+						ValidatorAddress: validator,
+						Entries: []stakingtypes.UnbondingDelegationEntry{
+							{CompletionTime: unbondingCompletionTime, Balance: staking.unbondings[validator]},
+						},
+					})
+				}
+				return resp, nil
+			case *banktypes.QueryBalanceRequest:
+				balance := sdk.NewCoin("test", staking.balance)
+				return &banktypes.QueryBalanceResponse{Balance: &balance}, nil
+			case *stakingtypes.QueryParamsRequest:
+				return &stakingtypes.QueryParamsResponse{
+					Params: stakingtypes.Params{
+						BondDenom: "test",
+					},
+				}, nil
+			default:
+				require.Fail(t, "unrecognized query type")
+				return nil, nil
+			}
+		},
+	)
+}
+
 func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependencies {
 	sb := collections.NewSchemaBuilder(storeservice)
 
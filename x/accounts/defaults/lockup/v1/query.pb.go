@@ -84,6 +84,12 @@ type QueryLockupAccountInfoResponse struct {
 	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 	// owner defines the value of the owner of the lockup account.
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// cliff_time defines the value of the account lockup cliff time.
+	CliffTime *time.Time `protobuf:"bytes,9,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+	// funder defines the address allowed to claw back the locked coins.
+	Funder string `protobuf:"bytes,10,opt,name=funder,proto3" json:"funder,omitempty"`
+	// clawback_pending defines the coins owed to the funder after a clawback.
+	ClawbackPending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=clawback_pending,json=clawbackPending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawback_pending"`
 }
 
 func (m *QueryLockupAccountInfoResponse) Reset()         { *m = QueryLockupAccountInfoResponse{} }
@@ -175,6 +181,27 @@ func (m *QueryLockupAccountInfoResponse) GetOwner() string {
 	return ""
 }
 
+func (m *QueryLockupAccountInfoResponse) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
+func (m *QueryLockupAccountInfoResponse) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *QueryLockupAccountInfoResponse) GetClawbackPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawbackPending
+	}
+	return nil
+}
+
 // QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
 type QueryLockingPeriodsRequest struct {
 }
@@ -270,39 +297,42 @@ func init() {
 }
 
 var fileDescriptor_f2c1403191515490 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0xb6, 0xee, 0xc5, 0x85, 0x6d, 0x44, 0x3b, 0x84, 0x02, 0x49, 0xd5, 0x53, 0x35,
-	0x09, 0x9b, 0x8e, 0x23, 0x07, 0x44, 0x41, 0x48, 0x48, 0x3b, 0x40, 0xe0, 0xc4, 0x25, 0xca, 0xcb,
-	0xd3, 0x60, 0x35, 0xb5, 0x33, 0xdb, 0x29, 0xdb, 0xb7, 0xd8, 0xe7, 0xe0, 0x93, 0xec, 0xb8, 0x23,
-	0x27, 0x86, 0xda, 0xef, 0x81, 0x50, 0x62, 0xbb, 0x68, 0x12, 0x2f, 0x3d, 0x74, 0xa7, 0xc4, 0xf6,
-	0xf3, 0xfc, 0x7f, 0xcf, 0xe3, 0xbf, 0x6d, 0x84, 0x53, 0x2e, 0xa7, 0x5c, 0x92, 0x38, 0x4d, 0x79,
-	0xc5, 0x94, 0x24, 0x19, 0x8c, 0xe3, 0xaa, 0x50, 0x92, 0x14, 0x3c, 0x9d, 0x54, 0x25, 0x99, 0x0d,
-	0xc9, 0x69, 0x05, 0xe2, 0x1c, 0x97, 0x82, 0x2b, 0xee, 0xf6, 0x75, 0x3c, 0xb6, 0xf1, 0xd8, 0xc6,
-	0x63, 0x1d, 0x8f, 0x67, 0xc3, 0x2e, 0x59, 0x41, 0xd3, 0x44, 0x37, 0xa2, 0x5d, 0xdf, 0x24, 0x24,
-	0xb1, 0x04, 0x32, 0x1b, 0x26, 0xa0, 0xe2, 0x21, 0x49, 0x39, 0x65, 0x66, 0xfd, 0x30, 0xe7, 0x39,
-	0x6f, 0x7e, 0x49, 0xfd, 0x67, 0x66, 0x83, 0x9c, 0xf3, 0xbc, 0x00, 0xd2, 0x8c, 0x92, 0x6a, 0x4c,
-	0x14, 0x9d, 0x82, 0x54, 0xf1, 0xd4, 0xc8, 0xf6, 0x03, 0xf4, 0xf8, 0x7d, 0x5d, 0xfa, 0x49, 0xc3,
-	0x7a, 0xa9, 0xab, 0x79, 0xcb, 0xc6, 0x3c, 0x84, 0xd3, 0x0a, 0xa4, 0xea, 0xff, 0x6c, 0x23, 0xff,
-	0x6f, 0x11, 0xb2, 0xe4, 0x4c, 0x82, 0x3b, 0x43, 0x07, 0x5c, 0xd0, 0x9c, 0xb2, 0xb8, 0x88, 0xea,
-	0x9a, 0x29, 0xcb, 0x3d, 0xa7, 0xb7, 0x31, 0xe8, 0x1c, 0x3f, 0x30, 0x5b, 0x87, 0xeb, 0xaa, 0xb1,
-	0xa9, 0x1a, 0xbf, 0xe2, 0x94, 0x8d, 0x9e, 0x5e, 0x7e, 0x0f, 0x5a, 0x5f, 0xaf, 0x83, 0x41, 0x4e,
-	0xd5, 0xe7, 0x2a, 0xc1, 0x29, 0x9f, 0xda, 0x3d, 0xd1, 0x9f, 0x27, 0x32, 0x9b, 0x10, 0x75, 0x5e,
-	0x82, 0x6c, 0x12, 0x64, 0xb8, 0x6f, 0x21, 0x27, 0x9a, 0xe1, 0x0a, 0xb4, 0x97, 0x41, 0x01, 0x79,
-	0xac, 0x20, 0x8b, 0xc6, 0x02, 0xc0, 0xbb, 0xb3, 0x7e, 0xea, 0xbd, 0x25, 0xe2, 0x8d, 0x00, 0x70,
-	0xcf, 0xd0, 0xfd, 0xdf, 0x4c, 0xdb, 0xec, 0xc6, 0xfa, 0xb1, 0x07, 0x4b, 0x8a, 0xed, 0xf6, 0x05,
-	0x42, 0x52, 0xc5, 0x42, 0x45, 0xb5, 0x85, 0xde, 0x66, 0xcf, 0x19, 0x74, 0x8e, 0xbb, 0x58, 0xfb,
-	0x8b, 0xad, 0xbf, 0xf8, 0xa3, 0xf5, 0x77, 0xb4, 0x79, 0x71, 0x1d, 0x38, 0xe1, 0x6e, 0x93, 0x53,
-	0xcf, 0xba, 0xcf, 0xd1, 0x0e, 0xb0, 0x4c, 0xa7, 0xb7, 0x57, 0x4c, 0xdf, 0x06, 0x96, 0x35, 0xc9,
-	0x0c, 0xdd, 0xad, 0xbb, 0x85, 0x2c, 0xaa, 0xcf, 0x9c, 0xf4, 0xb6, 0xd6, 0xdf, 0x72, 0x47, 0x03,
-	0x9a, 0x41, 0xed, 0x6d, 0xc5, 0x6e, 0x10, 0xb7, 0x6f, 0xc1, 0x5b, 0x8b, 0xd0, 0xcc, 0x43, 0xd4,
-	0xe6, 0x5f, 0x18, 0x08, 0x6f, 0xa7, 0xe7, 0x0c, 0x76, 0x43, 0x3d, 0xe8, 0x3f, 0x42, 0xdd, 0xe5,
-	0xf9, 0xa7, 0x2c, 0x7f, 0x07, 0x82, 0xf2, 0x4c, 0xda, 0xeb, 0x21, 0xd0, 0xc3, 0x3f, 0xae, 0x9a,
-	0xab, 0xf1, 0x01, 0xed, 0x9b, 0x43, 0x12, 0x95, 0x7a, 0xc9, 0xdc, 0x8c, 0x23, 0xfc, 0xff, 0x47,
-	0x02, 0x6b, 0xb5, 0x70, 0xaf, 0xb8, 0x21, 0x3e, 0x7a, 0x7d, 0x39, 0xf7, 0x9d, 0xab, 0xb9, 0xef,
-	0xfc, 0x98, 0xfb, 0xce, 0xc5, 0xc2, 0x6f, 0x5d, 0x2d, 0xfc, 0xd6, 0xb7, 0x85, 0xdf, 0xfa, 0x74,
-	0xa4, 0x45, 0x65, 0x36, 0xc1, 0x94, 0x93, 0xb3, 0x7f, 0xbd, 0x2e, 0xc9, 0x56, 0x63, 0xfa, 0xb3,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc7, 0xe4, 0x56, 0xde, 0x04, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xda, 0x26, 0xcd, 0x06, 0xda, 0x12, 0x55, 0xc8, 0x04, 0x70, 0xa2, 0x9c, 0xa2,
+	0x4a, 0xec, 0x92, 0x72, 0xe4, 0x80, 0x08, 0x08, 0x09, 0xa9, 0x87, 0x12, 0x38, 0x71, 0xb1, 0xfc,
+	0x31, 0x36, 0x56, 0x9c, 0x5d, 0xd7, 0xbb, 0x4e, 0xdb, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0xbd, 0xd1,
+	0x23, 0x27, 0x8a, 0x92, 0x17, 0x41, 0xfb, 0x15, 0x54, 0x09, 0x68, 0x0e, 0xe9, 0xc9, 0x1e, 0xef,
+	0xcc, 0xfc, 0x66, 0xe6, 0xbf, 0x63, 0x84, 0x23, 0xc6, 0xa7, 0x8c, 0x93, 0x20, 0x8a, 0x58, 0x45,
+	0x05, 0x27, 0x31, 0x24, 0x41, 0x95, 0x0b, 0x4e, 0x72, 0x16, 0x4d, 0xaa, 0x82, 0xcc, 0x86, 0xe4,
+	0xa4, 0x82, 0xf2, 0x1c, 0x17, 0x25, 0x13, 0xac, 0xdd, 0xd7, 0xfe, 0xd8, 0xfa, 0x63, 0xeb, 0x8f,
+	0xb5, 0x3f, 0x9e, 0x0d, 0x3b, 0x64, 0x85, 0x9c, 0xc6, 0x5b, 0x25, 0xed, 0x78, 0x26, 0x20, 0x0c,
+	0x38, 0x90, 0xd9, 0x30, 0x04, 0x11, 0x0c, 0x49, 0xc4, 0x32, 0x6a, 0xce, 0xf7, 0x53, 0x96, 0x32,
+	0xf5, 0x4a, 0xe4, 0x9b, 0xf9, 0xda, 0x4d, 0x19, 0x4b, 0x73, 0x20, 0xca, 0x0a, 0xab, 0x84, 0x88,
+	0x6c, 0x0a, 0x5c, 0x04, 0x53, 0x93, 0xb6, 0xdf, 0x45, 0xcf, 0x3e, 0xca, 0xd2, 0x8f, 0x14, 0xeb,
+	0x8d, 0xae, 0xe6, 0x03, 0x4d, 0xd8, 0x18, 0x4e, 0x2a, 0xe0, 0xa2, 0xff, 0xbd, 0x81, 0xbc, 0x7f,
+	0x79, 0xf0, 0x82, 0x51, 0x0e, 0xed, 0x19, 0xda, 0x63, 0x65, 0x96, 0x66, 0x34, 0xc8, 0x7d, 0x59,
+	0x73, 0x46, 0x53, 0xd7, 0xe9, 0x6d, 0x0c, 0x5a, 0x87, 0x8f, 0xcd, 0xe8, 0xb0, 0xac, 0x1a, 0x9b,
+	0xaa, 0xf1, 0x5b, 0x96, 0xd1, 0xd1, 0x8b, 0xcb, 0x9f, 0xdd, 0xda, 0xb7, 0xeb, 0xee, 0x20, 0xcd,
+	0xc4, 0xd7, 0x2a, 0xc4, 0x11, 0x9b, 0xda, 0x99, 0xe8, 0xc7, 0x73, 0x1e, 0x4f, 0x88, 0x38, 0x2f,
+	0x80, 0xab, 0x00, 0x3e, 0xde, 0xb5, 0x90, 0x23, 0xcd, 0x68, 0x97, 0x68, 0x27, 0x86, 0x1c, 0xd2,
+	0x40, 0x40, 0xec, 0x27, 0x25, 0x80, 0x7b, 0x6f, 0xfd, 0xd4, 0x07, 0x4b, 0xc4, 0xfb, 0x12, 0xa0,
+	0x7d, 0x86, 0x1e, 0xfe, 0x61, 0xda, 0x66, 0x37, 0xd6, 0x8f, 0xdd, 0x5b, 0x52, 0x6c, 0xb7, 0xaf,
+	0x11, 0xe2, 0x22, 0x28, 0x85, 0x2f, 0x25, 0x74, 0x37, 0x7b, 0xce, 0xa0, 0x75, 0xd8, 0xc1, 0x5a,
+	0x5f, 0x6c, 0xf5, 0xc5, 0x9f, 0xad, 0xbe, 0xa3, 0xcd, 0x8b, 0xeb, 0xae, 0x33, 0x6e, 0xaa, 0x18,
+	0xf9, 0xb5, 0xfd, 0x0a, 0x6d, 0x03, 0x8d, 0x75, 0xf8, 0xd6, 0x8a, 0xe1, 0x0d, 0xa0, 0xb1, 0x0a,
+	0xa6, 0xe8, 0xbe, 0xec, 0x16, 0x62, 0x5f, 0xde, 0x39, 0xee, 0xd6, 0xd7, 0xdf, 0x72, 0x4b, 0x03,
+	0x94, 0x21, 0xb5, 0xad, 0xe8, 0x0d, 0x62, 0xe3, 0x0e, 0xb4, 0xb5, 0x08, 0xcd, 0xdc, 0x47, 0x5b,
+	0xec, 0x94, 0x42, 0xe9, 0x6e, 0xf7, 0x9c, 0x41, 0x73, 0xac, 0x0d, 0x39, 0xf7, 0x28, 0xcf, 0x92,
+	0x44, 0x0f, 0xae, 0xb9, 0xea, 0xdc, 0x55, 0x8c, 0x1a, 0xdd, 0x23, 0x54, 0x4f, 0x2a, 0x1a, 0x43,
+	0xe9, 0x22, 0x95, 0xd7, 0x58, 0x72, 0x6d, 0xa2, 0x3c, 0x38, 0x0d, 0x83, 0x68, 0xe2, 0x17, 0x40,
+	0x63, 0x79, 0x93, 0x5a, 0x77, 0xb0, 0x36, 0x16, 0x72, 0xac, 0x19, 0xfd, 0xa7, 0xa8, 0xb3, 0x5c,
+	0xe8, 0x8c, 0xa6, 0xc7, 0x50, 0x66, 0x2c, 0xe6, 0x76, 0xdf, 0x4b, 0xf4, 0xe4, 0xaf, 0xa7, 0x66,
+	0xd7, 0x3f, 0xa1, 0x5d, 0x73, 0xeb, 0xfd, 0x42, 0x1f, 0x99, 0x55, 0x3f, 0xc0, 0xb7, 0xff, 0xf5,
+	0xb0, 0xce, 0x36, 0xde, 0xc9, 0x6f, 0x24, 0x1f, 0xbd, 0xbb, 0x9c, 0x7b, 0xce, 0xd5, 0xdc, 0x73,
+	0x7e, 0xcd, 0x3d, 0xe7, 0x62, 0xe1, 0xd5, 0xae, 0x16, 0x5e, 0xed, 0xc7, 0xc2, 0xab, 0x7d, 0x39,
+	0xd0, 0x49, 0x79, 0x3c, 0xc1, 0x19, 0x23, 0x67, 0xff, 0xfb, 0x5d, 0x86, 0x75, 0x25, 0xc6, 0xcb,
+	0xdf, 0x03, 0x00, 0x2e, 0x44, 0x8d, 0xc0, 0xaf, 0x05, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -348,6 +378,37 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClawbackPending) > 0 {
+		for iNdEx := len(m.ClawbackPending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawbackPending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CliffTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -384,22 +445,22 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	if m.EndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if m.StartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -576,6 +637,20 @@ func (m *QueryLockupAccountInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ClawbackPending) > 0 {
+		for _, e := range m.ClawbackPending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -962,6 +1037,108 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackPending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackPending = append(m.ClawbackPending, types.Coin{})
+			if err := m.ClawbackPending[len(m.ClawbackPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
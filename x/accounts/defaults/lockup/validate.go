@@ -1,6 +1,8 @@
 package lockup
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -16,3 +18,20 @@ func validateAmount(amount sdk.Coins) error {
 
 	return nil
 }
+
+// validateCliffTime checks that the optional cliff time is within the locking period.
+func validateCliffTime(cliffTime, startTime, endTime time.Time) error {
+	if cliffTime.IsZero() {
+		return nil
+	}
+
+	if cliffTime.Before(startTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid cliff time (must be after start time)")
+	}
+
+	if cliffTime.After(endTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid cliff time (must be before end time)")
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ var (
 	ConfigPrefix    = collections.NewPrefix(2)
 	ProposalsPrefix = collections.NewPrefix(3)
 	VotesPrefix     = collections.NewPrefix(4)
+	SpendingPrefix  = collections.NewPrefix(5)
 )
 
 // Compile-time type assertions
@@ -40,19 +41,22 @@ type Account struct {
 
 	Proposals collections.Map[uint64, v1.Proposal]
 	Votes     collections.Map[collections.Pair[uint64, []byte], int32] // key: proposalID + voter address
+
+	SpendingWindow collections.Item[v1.SpendingWindow]
 }
 
 // NewAccount returns a new multisig account creator function.
 func NewAccount(deps accountstd.Dependencies) (*Account, error) {
 	return &Account{
-		Members:       collections.NewMap(deps.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, collections.Uint64Value),
-		Sequence:      collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
-		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
-		Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
-		Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
-		addrCodec:     deps.AddressCodec,
-		headerService: deps.Environment.HeaderService,
-		eventService:  deps.Environment.EventService,
+		Members:        collections.NewMap(deps.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, collections.Uint64Value),
+		Sequence:       collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+		Config:         collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+		Proposals:      collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
+		Votes:          collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		addrCodec:      deps.AddressCodec,
+		headerService:  deps.Environment.HeaderService,
+		eventService:   deps.Environment.EventService,
+		SpendingWindow: collections.NewItem(deps.SchemaBuilder, SpendingPrefix, "spending_window", codec.CollValue[v1.SpendingWindow](deps.LegacyStateCodec)),
 	}, nil
 }
 
@@ -99,6 +103,10 @@ func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitRespons
 		return nil, err
 	}
 
+	if err := a.validateCanceller(*msg.Config); err != nil {
+		return nil, err
+	}
+
 	if err := a.Config.Set(ctx, *msg.Config); err != nil {
 		return nil, err
 	}
@@ -240,6 +248,14 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		return nil, err
 	}
 
+	switch prop.Status {
+	case v1.ProposalStatus_PROPOSAL_STATUS_QUEUED:
+		return a.executeQueuedProposal(ctx, msg.ProposalId, prop)
+	case v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD:
+	default:
+		return nil, fmt.Errorf("proposal cannot be executed with status %s", prop.Status)
+	}
+
 	config, err := a.Config.Get(ctx)
 	if err != nil {
 		return nil, err
@@ -294,6 +310,10 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if config.ExecutionTimelock > 0 {
+		// we have quorum and threshold, queue the proposal until the timelock expires
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+		prop.ExecutableAt = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.ExecutionTimelock)).Unix()
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
@@ -301,7 +321,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -329,6 +349,74 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	return resp, nil
 }
 
+// executeQueuedProposal executes a proposal that passed once its execution timelock expired.
+func (a Account) executeQueuedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) (*v1.MsgExecuteProposalResponse, error) {
+	if a.headerService.HeaderInfo(ctx).Time.Unix() < prop.ExecutableAt {
+		return nil, fmt.Errorf("proposal is timelocked until %d", prop.ExecutableAt)
+	}
+
+	var (
+		execErr error
+		resp    = &v1.MsgExecuteProposalResponse{}
+	)
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_executed",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, a.Proposals.Set(ctx, proposalID, prop)
+}
+
+// CancelProposal cancels a proposal that has not been executed yet, either during its voting period
+// or while it waits for the execution timelock. Only the canceller set in the config can cancel proposals.
+func (a Account) CancelProposal(ctx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Canceller == "" {
+		return nil, errors.New("no canceller set per config")
+	}
+
+	canceller, err := a.addrCodec.StringToBytes(cfg.Canceller)
+	if err != nil {
+		return nil, err
+	}
+	if !accountstd.HasSender(ctx, canceller) {
+		return nil, errors.New("only the canceller can cancel proposals")
+	}
+
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return nil, fmt.Errorf("proposal cannot be cancelled with status %s", prop.Status)
+	}
+
+	// the proposal is kept with the cancelled status, only its votes are pruned
+	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.ProposalId)
+	if err = a.Votes.Clear(ctx, rng); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_cancelled",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("canceller", cfg.Canceller),
+	); err != nil {
+		return nil, err
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_CANCELLED
+	return &v1.MsgCancelProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
+
 // QuerySequence returns the current sequence number, used for proposal IDs.
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
@@ -376,6 +464,8 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.Spend)
+	accountstd.RegisterExecuteHandler(builder, a.CancelProposal)
 }
 
 // RegisterInitHandler implements implementation.Account.
@@ -388,6 +478,7 @@ func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QuerySpending)
 }
 
 func safeAdd(nums ...uint64) (uint64, error) {
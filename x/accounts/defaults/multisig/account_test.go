@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/accounts/accountstd"
@@ -710,3 +711,110 @@ func TestWeightOverflow(t *testing.T) {
 	_, err = acc.Init(ctx, startAcc)
 	require.ErrorContains(t, err, "overflow")
 }
+
+func TestProposalTimelockAndCancel(t *testing.T) {
+	executed := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds,
+		func(ictx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executed++
+			return &accountsv1.MsgExecuteResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:         100,
+			Quorum:            100,
+			VotingPeriod:      60,
+			ExecutionTimelock: 3600,
+			Canceller:         "guardian",
+		},
+		Members: []*v1.Member{{Address: "addr1", Weight: 100}},
+	})
+	require.NoError(t, err)
+
+	execMsgAny, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: "multisig_acc", Target: "target"})
+	require.NoError(t, err)
+
+	createAndPass := func() uint64 {
+		createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{
+				Title:    "test",
+				Summary:  "test",
+				Messages: []*types.Any{execMsgAny},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: createRes.ProposalId, Vote: v1.VoteOption_VOTE_OPTION_YES})
+		require.NoError(t, err)
+		return createRes.ProposalId
+	}
+
+	// the proposal is queued once it passes
+	propId := createAndPass()
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 0, executed)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, prop.Proposal.Status)
+	require.Equal(t, currentTime.Add(time.Hour).Unix(), prop.Proposal.ExecutableAt)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal is timelocked")
+
+	// it is executed once the timelock expires
+	currentTime = currentTime.Add(time.Hour)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Proposal.Status)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be executed with status PROPOSAL_STATUS_PASSED")
+
+	// only the canceller can cancel a queued proposal
+	propId = createAndPass()
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	_, err = acc.CancelProposal(ctx, &v1.MsgCancelProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "only the canceller can cancel proposals")
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian"))
+	_, err = acc.CancelProposal(ctx, &v1.MsgCancelProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_CANCELLED, prop.Proposal.Status)
+
+	// the votes are pruned
+	votesIter, err := acc.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, []byte](propId))
+	require.NoError(t, err)
+	require.False(t, votesIter.Valid())
+	require.NoError(t, votesIter.Close())
+
+	currentTime = currentTime.Add(time.Hour)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be executed with status PROPOSAL_STATUS_CANCELLED")
+	require.Equal(t, 1, executed)
+
+	_, err = acc.CancelProposal(ctx, &v1.MsgCancelProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be cancelled")
+}
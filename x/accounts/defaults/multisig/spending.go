@@ -0,0 +1,129 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Spend sends coins from the multisig account without a proposal. The sender must be a member of the
+// multisig and the amount must be within the remaining spending limit of the current spending period.
+func (a Account) Spend(ctx context.Context, msg *v1.MsgSpend) (*v1.MsgSpendResponse, error) {
+	sender := accountstd.Sender(ctx)
+
+	// check if the sender is a member
+	_, err := a.Members.Get(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount: %s", msg.Amount)
+	}
+
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SpendingLimit.Empty() {
+		return nil, errors.New("spending without a proposal is not enabled per config")
+	}
+
+	window, err := a.currentSpendingWindow(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	spent := window.Spent.Add(msg.Amount...)
+	if !spent.IsAllLTE(cfg.SpendingLimit) {
+		return nil, fmt.Errorf("spending limit exceeded, remaining: %s", remainingSpending(cfg.SpendingLimit, window.Spent))
+	}
+	window.Spent = spent
+	if err = a.SpendingWindow.Set(ctx, window); err != nil {
+		return nil, err
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	_, err = accountstd.ExecModule[*banktypes.MsgSendResponse](ctx, &banktypes.MsgSend{
+		FromAddress: self,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("spend",
+		event.NewAttribute("spender", spender),
+		event.NewAttribute("to_address", msg.ToAddress),
+		event.NewAttribute("amount", msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSpendResponse{}, nil
+}
+
+// currentSpendingWindow returns the spending window of the current spending period, a new spending
+// period starts with the first spend after the previous one ended.
+func (a Account) currentSpendingWindow(ctx context.Context, cfg v1.Config) (v1.SpendingWindow, error) {
+	now := a.headerService.HeaderInfo(ctx).Time
+	window, err := a.SpendingWindow.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return v1.SpendingWindow{}, err
+	}
+
+	periodEnd := time.Unix(window.PeriodStart, 0).Add(time.Second * time.Duration(cfg.SpendingPeriod))
+	if errors.Is(err, collections.ErrNotFound) || !now.Before(periodEnd) {
+		return v1.SpendingWindow{PeriodStart: now.Unix(), Spent: sdk.Coins{}}, nil
+	}
+
+	return window, nil
+}
+
+// remainingSpending returns the coins of the spending limit that were not spent yet.
+func remainingSpending(limit, spent sdk.Coins) sdk.Coins {
+	remaining := sdk.Coins{}
+	for _, coin := range limit {
+		amount := coin.Amount.Sub(spent.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return remaining
+}
+
+// QuerySpending returns the spending of the current spending period.
+func (a Account) QuerySpending(ctx context.Context, _ *v1.QuerySpending) (*v1.QuerySpendingResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := a.currentSpendingWindow(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySpendingResponse{
+		Window:    &window,
+		Remaining: remainingSpending(cfg.SpendingLimit, window.Spent),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package multisig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSpend(t *testing.T) {
+	var sent []*banktypes.MsgSend
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds,
+		func(_ context.Context, _ []byte, msg transaction.Msg) (transaction.Msg, error) {
+			sent = append(sent, msg.(*banktypes.MsgSend))
+			return &banktypes.MsgSendResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+
+	members := []*v1.Member{{Address: "addr1", Weight: 100}, {Address: "addr2", Weight: 100}}
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      200,
+			Quorum:         200,
+			VotingPeriod:   120,
+			SpendingLimit:  sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(100))),
+			SpendingPeriod: 0,
+		},
+		Members: members,
+	})
+	require.ErrorContains(t, err, "spending period must be greater than zero")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      200,
+			Quorum:         200,
+			VotingPeriod:   120,
+			SpendingLimit:  sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(100))),
+			SpendingPeriod: 3600,
+		},
+		Members: members,
+	})
+	require.NoError(t, err)
+
+	spend := func(amount int64) error {
+		_, err := acc.Spend(ctx, &v1.MsgSpend{
+			ToAddress: "recipient",
+			Amount:    sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(amount))),
+		})
+		return err
+	}
+
+	// a single member spends without a proposal
+	require.NoError(t, spend(60))
+	require.Len(t, sent, 1)
+	require.Equal(t, "multisig_acc", sent[0].FromAddress)
+	require.Equal(t, "recipient", sent[0].ToAddress)
+
+	ctx = accountstd.SetSender(ctx, []byte("addr2"))
+	require.ErrorContains(t, spend(50), "spending limit exceeded, remaining: 40atom")
+	require.NoError(t, spend(40))
+
+	res, err := acc.QuerySpending(ctx, &v1.QuerySpending{})
+	require.NoError(t, err)
+	require.Equal(t, currentTime.Unix(), res.Window.PeriodStart)
+	require.True(t, res.Window.Spent.Equal(sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(100)))))
+	require.True(t, res.Remaining.IsZero())
+
+	// other denoms are not allowed
+	_, err = acc.Spend(ctx, &v1.MsgSpend{
+		ToAddress: "recipient",
+		Amount:    sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1))),
+	})
+	require.ErrorContains(t, err, "spending limit exceeded")
+
+	// non members cannot spend
+	ctx = accountstd.SetSender(ctx, []byte("addr3"))
+	require.Error(t, spend(1))
+
+	// the limit resets once the spending period ends
+	currentTime = currentTime.Add(time.Hour)
+	ctx = accountstd.SetSender(ctx, []byte("addr1"))
+	require.NoError(t, spend(100))
+	require.Len(t, sent, 3)
+}
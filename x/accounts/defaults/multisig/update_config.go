@@ -3,6 +3,7 @@ package multisig
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
@@ -65,6 +66,10 @@ func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1
 		return nil, err
 	}
 
+	if err := a.validateCanceller(config); err != nil {
+		return nil, err
+	}
+
 	return &v1.MsgUpdateConfigResponse{}, nil
 }
 
@@ -84,5 +89,30 @@ func validateConfig(cfg v1.Config, totalWeight uint64) error {
 		return errors.New("quorum must be less than or equal to the total weight")
 	}
 
+	// spending without a proposal requires a valid limit and period
+	if !cfg.SpendingLimit.IsValid() {
+		return fmt.Errorf("invalid spending limit: %s", cfg.SpendingLimit)
+	}
+	if !cfg.SpendingLimit.Empty() && cfg.SpendingPeriod <= 0 {
+		return errors.New("spending period must be greater than zero when a spending limit is set")
+	}
+
+	if cfg.ExecutionTimelock < 0 {
+		return errors.New("execution timelock cannot be negative")
+	}
+
+	return nil
+}
+
+// validateCanceller checks that the optional canceller of the config is a valid address.
+func (a Account) validateCanceller(cfg v1.Config) error {
+	if cfg.Canceller == "" {
+		return nil
+	}
+
+	if _, err := a.addrCodec.StringToBytes(cfg.Canceller); err != nil {
+		return fmt.Errorf("invalid canceller address: %w", err)
+	}
+
 	return nil
 }
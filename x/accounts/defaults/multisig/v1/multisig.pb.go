@@ -6,6 +6,9 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -36,6 +39,10 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed and waits for the execution timelock.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
+	// PROPOSAL_STATUS_CANCELLED defines the proposal status when the proposal was cancelled by the canceller.
+	ProposalStatus_PROPOSAL_STATUS_CANCELLED ProposalStatus = 5
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -43,6 +50,8 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_QUEUED",
+	5: "PROPOSAL_STATUS_CANCELLED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -50,6 +59,8 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_QUEUED":        4,
+	"PROPOSAL_STATUS_CANCELLED":     5,
 }
 
 func (x ProposalStatus) String() string {
@@ -458,6 +469,179 @@ func (m *MsgExecuteProposalResponse) GetResponses() []*any.Any {
 	return nil
 }
 
+// MsgSpend is used by a member to send coins from the multisig account without a proposal,
+// within the spending limit of the current spending period.
+type MsgSpend struct {
+	ToAddress string                                   `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSpend) Reset()         { *m = MsgSpend{} }
+func (m *MsgSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgSpend) ProtoMessage()    {}
+func (*MsgSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{8}
+}
+func (m *MsgSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpend.Merge(m, src)
+}
+func (m *MsgSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpend proto.InternalMessageInfo
+
+func (m *MsgSpend) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgSpend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSpendResponse is the response returned after spending.
+type MsgSpendResponse struct {
+}
+
+func (m *MsgSpendResponse) Reset()         { *m = MsgSpendResponse{} }
+func (m *MsgSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendResponse) ProtoMessage()    {}
+func (*MsgSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{9}
+}
+func (m *MsgSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendResponse.Merge(m, src)
+}
+func (m *MsgSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendResponse proto.InternalMessageInfo
+
+// MsgCancelProposal is used by the canceller to cancel a proposal that has not been executed yet.
+type MsgCancelProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{10}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgCancelProposalResponse is the response returned after cancelling a proposal.
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{11}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	// only the members that are changing are required, if their weight is 0, they are removed.
@@ -470,7 +654,7 @@ func (m *MsgUpdateConfig) Reset()         { *m = MsgUpdateConfig{} }
 func (m *MsgUpdateConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfig) ProtoMessage()    {}
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{8}
+	return fileDescriptor_e6da8796717704d7, []int{12}
 }
 func (m *MsgUpdateConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +705,7 @@ func (m *MsgUpdateConfigResponse) Reset()         { *m = MsgUpdateConfigResponse
 func (m *MsgUpdateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{9}
+	return fileDescriptor_e6da8796717704d7, []int{13}
 }
 func (m *MsgUpdateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +744,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{10}
+	return fileDescriptor_e6da8796717704d7, []int{14}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,13 +799,22 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// spending_limit is the amount of coins that a single member can send without a proposal during a spending period.
+	SpendingLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=spending_limit,json=spendingLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spending_limit"`
+	// spending_period is the duration in seconds of a spending period, required when spending_limit is set.
+	SpendingPeriod int64 `protobuf:"varint,7,opt,name=spending_period,json=spendingPeriod,proto3" json:"spending_period,omitempty"`
+	// execution_timelock is the duration in seconds between the moment a proposal passes and the moment it can be
+	// executed. Proposals are executed as soon as they pass when it is zero.
+	ExecutionTimelock int64 `protobuf:"varint,8,opt,name=execution_timelock,json=executionTimelock,proto3" json:"execution_timelock,omitempty"`
+	// canceller is the optional address allowed to cancel proposals that have not been executed yet.
+	Canceller string `protobuf:"bytes,9,opt,name=canceller,proto3" json:"canceller,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{11}
+	return fileDescriptor_e6da8796717704d7, []int{15}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,6 +878,89 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetSpendingLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendingLimit
+	}
+	return nil
+}
+
+func (m *Config) GetSpendingPeriod() int64 {
+	if m != nil {
+		return m.SpendingPeriod
+	}
+	return 0
+}
+
+func (m *Config) GetExecutionTimelock() int64 {
+	if m != nil {
+		return m.ExecutionTimelock
+	}
+	return 0
+}
+
+func (m *Config) GetCanceller() string {
+	if m != nil {
+		return m.Canceller
+	}
+	return ""
+}
+
+// SpendingWindow tracks the coins spent without a proposal during the current spending period.
+type SpendingWindow struct {
+	// period_start is the unix time in seconds at which the spending period started.
+	PeriodStart int64 `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// spent is the amount of coins spent during the spending period.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *SpendingWindow) Reset()         { *m = SpendingWindow{} }
+func (m *SpendingWindow) String() string { return proto.CompactTextString(m) }
+func (*SpendingWindow) ProtoMessage()    {}
+func (*SpendingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{16}
+}
+func (m *SpendingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingWindow.Merge(m, src)
+}
+func (m *SpendingWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingWindow proto.InternalMessageInfo
+
+func (m *SpendingWindow) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *SpendingWindow) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
 // Proposal defines the structure of a proposal.
 type Proposal struct {
 	// title is the title of the proposal.
@@ -697,13 +973,16 @@ type Proposal struct {
 	VotingPeriodEnd int64 `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	// status is the current status of the proposal.
 	Status ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// executable_at is the unix time in seconds after which a queued proposal can be executed, it will be set by the
+	// account when the proposal passes.
+	ExecutableAt int64 `protobuf:"varint,6,opt,name=executable_at,json=executableAt,proto3" json:"executable_at,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{12}
+	return fileDescriptor_e6da8796717704d7, []int{17}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,6 +1046,13 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutableAt() int64 {
+	if m != nil {
+		return m.ExecutableAt
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -775,7 +1061,7 @@ func (m *QuerySequence) Reset()         { *m = QuerySequence{} }
 func (m *QuerySequence) String() string { return proto.CompactTextString(m) }
 func (*QuerySequence) ProtoMessage()    {}
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{13}
+	return fileDescriptor_e6da8796717704d7, []int{18}
 }
 func (m *QuerySequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,7 +1100,7 @@ func (m *QuerySequenceResponse) Reset()         { *m = QuerySequenceResponse{} }
 func (m *QuerySequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySequenceResponse) ProtoMessage()    {}
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{14}
+	return fileDescriptor_e6da8796717704d7, []int{19}
 }
 func (m *QuerySequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1144,7 @@ func (m *QueryConfig) Reset()         { *m = QueryConfig{} }
 func (m *QueryConfig) String() string { return proto.CompactTextString(m) }
 func (*QueryConfig) ProtoMessage()    {}
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{15}
+	return fileDescriptor_e6da8796717704d7, []int{20}
 }
 func (m *QueryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1185,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{16}
+	return fileDescriptor_e6da8796717704d7, []int{21}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,7 +1237,7 @@ func (m *QueryProposal) Reset()         { *m = QueryProposal{} }
 func (m *QueryProposal) String() string { return proto.CompactTextString(m) }
 func (*QueryProposal) ProtoMessage()    {}
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{17}
+	return fileDescriptor_e6da8796717704d7, []int{22}
 }
 func (m *QueryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1282,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{18}
+	return fileDescriptor_e6da8796717704d7, []int{23}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,6 +1318,98 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QuerySpending is the request for the spending of the current spending period.
+type QuerySpending struct {
+}
+
+func (m *QuerySpending) Reset()         { *m = QuerySpending{} }
+func (m *QuerySpending) String() string { return proto.CompactTextString(m) }
+func (*QuerySpending) ProtoMessage()    {}
+func (*QuerySpending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{24}
+}
+func (m *QuerySpending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpending.Merge(m, src)
+}
+func (m *QuerySpending) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpending) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpending proto.InternalMessageInfo
+
+// QuerySpendingResponse returns the spending of the current spending period.
+type QuerySpendingResponse struct {
+	// window is the current spending window.
+	Window *SpendingWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// remaining is the amount of coins that can still be spent without a proposal during the spending period.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *QuerySpendingResponse) Reset()         { *m = QuerySpendingResponse{} }
+func (m *QuerySpendingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendingResponse) ProtoMessage()    {}
+func (*QuerySpendingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{25}
+}
+func (m *QuerySpendingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendingResponse.Merge(m, src)
+}
+func (m *QuerySpendingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendingResponse proto.InternalMessageInfo
+
+func (m *QuerySpendingResponse) GetWindow() *SpendingWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *QuerySpendingResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1043,10 +1421,15 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExecuteProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal")
 	proto.RegisterType((*MsgExecuteProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse")
+	proto.RegisterType((*MsgSpend)(nil), "cosmos.accounts.defaults.multisig.v1.MsgSpend")
+	proto.RegisterType((*MsgSpendResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgSpendResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgCancelProposalResponse")
 	proto.RegisterType((*MsgUpdateConfig)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse")
 	proto.RegisterType((*Member)(nil), "cosmos.accounts.defaults.multisig.v1.Member")
 	proto.RegisterType((*Config)(nil), "cosmos.accounts.defaults.multisig.v1.Config")
+	proto.RegisterType((*SpendingWindow)(nil), "cosmos.accounts.defaults.multisig.v1.SpendingWindow")
 	proto.RegisterType((*Proposal)(nil), "cosmos.accounts.defaults.multisig.v1.Proposal")
 	proto.RegisterType((*QuerySequence)(nil), "cosmos.accounts.defaults.multisig.v1.QuerySequence")
 	proto.RegisterType((*QuerySequenceResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse")
@@ -1054,6 +1437,8 @@ func init() {
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse")
 	proto.RegisterType((*QueryProposal)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposal")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse")
+	proto.RegisterType((*QuerySpending)(nil), "cosmos.accounts.defaults.multisig.v1.QuerySpending")
+	proto.RegisterType((*QuerySpendingResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QuerySpendingResponse")
 }
 
 func init() {
@@ -1061,61 +1446,81 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xfe, 0x04, 0xe2,
-	0xc6, 0x0f, 0x80, 0x23, 0x47, 0x7e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1d, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0x7b, 0xf3, 0xbe, 0xef, 0x7d, 0xf3,
-	0xcd, 0x9b, 0x91, 0x61, 0x7f, 0x44, 0x59, 0x48, 0x59, 0xcb, 0x1d, 0x8d, 0x68, 0x1a, 0x71, 0xd6,
-	0xf2, 0xc8, 0x99, 0x9b, 0x8e, 0x39, 0x6b, 0x85, 0xe9, 0x98, 0x07, 0x2c, 0xf0, 0x5b, 0x17, 0xcf,
-	0x8b, 0x6f, 0x3d, 0x4e, 0x28, 0xa7, 0xe8, 0xd3, 0x0c, 0xa4, 0xe7, 0x20, 0x3d, 0x07, 0xe9, 0x45,
-	0xe1, 0xc5, 0xf3, 0xc6, 0xb6, 0x4f, 0xa9, 0x3f, 0x26, 0x2d, 0x81, 0x39, 0x4d, 0xcf, 0x5a, 0x6e,
-	0x34, 0xc9, 0x08, 0x1a, 0xdb, 0x19, 0x81, 0x23, 0xa2, 0x96, 0x64, 0x13, 0x81, 0xf6, 0x93, 0x02,
-	0xcb, 0x7d, 0xe6, 0xf7, 0xa2, 0x80, 0xa3, 0x23, 0x58, 0x0e, 0x49, 0x78, 0x4a, 0x12, 0xa6, 0x2a,
-	0x3b, 0xa5, 0xa7, 0xab, 0xed, 0x67, 0xfa, 0x3c, 0x9d, 0xf5, 0xbe, 0x00, 0xe1, 0x1c, 0x8c, 0xba,
-	0x50, 0x1e, 0xd1, 0xe8, 0x2c, 0xf0, 0xd5, 0xc5, 0x1d, 0x65, 0x7e, 0x9a, 0x43, 0x81, 0xc1, 0x12,
-	0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5, 0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc,
-	0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29, 0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad,
-	0x2a, 0xa2, 0x9f, 0x3e, 0x5f, 0xbf, 0x9c, 0x01, 0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0xd3, 0x20,
-	0xef, 0x8e, 0x9e, 0xc0, 0x6a, 0x5e, 0xe8, 0x04, 0x9e, 0xe8, 0xb5, 0x84, 0x21, 0x4f, 0xf5, 0x3c,
-	0x2d, 0x16, 0x56, 0x9e, 0x50, 0xfe, 0xfe, 0x5a, 0xd4, 0x85, 0xa5, 0x0b, 0xca, 0x89, 0x70, 0xa8,
-	0xda, 0xde, 0x9b, 0x4f, 0xf1, 0x35, 0xb5, 0x19, 0xf3, 0x80, 0x46, 0x58, 0xa0, 0xa5, 0x47, 0xd7,
-	0xe9, 0xc2, 0xa3, 0x2f, 0x01, 0xf5, 0x99, 0x6f, 0xbc, 0x21, 0xa3, 0x74, 0xca, 0xa4, 0xf7, 0x6a,
-	0xb7, 0xa0, 0x71, 0x17, 0x56, 0x6c, 0xbd, 0x0d, 0x2b, 0x89, 0xfc, 0xce, 0x67, 0x63, 0x53, 0xcf,
-	0xe6, 0x4d, 0xcf, 0xe7, 0x4d, 0xef, 0x44, 0x13, 0x7c, 0x53, 0xa6, 0xfd, 0xaa, 0x08, 0x71, 0xc7,
-	0xb1, 0xe7, 0x72, 0x92, 0x9d, 0x2d, 0xb2, 0xa1, 0x9a, 0x8a, 0xd8, 0xf9, 0x2f, 0x83, 0xb6, 0x96,
-	0x71, 0xf4, 0xef, 0x75, 0xdc, 0xb6, 0x61, 0x6b, 0x46, 0x6d, 0x61, 0xe9, 0x10, 0xca, 0x59, 0x2f,
-	0xd4, 0x86, 0x65, 0xd7, 0xf3, 0x12, 0xc2, 0x98, 0xb0, 0x70, 0xe5, 0x40, 0xfd, 0xe3, 0xb7, 0xcf,
-	0x37, 0x65, 0xbb, 0x4e, 0xb6, 0x62, 0xf3, 0x24, 0x88, 0x7c, 0x9c, 0x17, 0xa2, 0x87, 0x50, 0xfe,
-	0x9e, 0x04, 0xfe, 0x39, 0x17, 0xf2, 0x96, 0xb0, 0x8c, 0xb4, 0x5f, 0x14, 0x28, 0x4b, 0x5b, 0x1e,
-	0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3, 0xec, 0x6c, 0x4a, 0xf8, 0x26, 0x71, 0x4d, 0xf0,
-	0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96, 0x11, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22,
-	0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89, 0xe5, 0x0f, 0xb2, 0xa4, 0x25, 0x72, 0xd7, 0xe0,
-	0x84, 0x88, 0x49, 0x5b, 0xda, 0x51, 0x9e, 0x56, 0xb0, 0x8c, 0xd0, 0x67, 0x50, 0x23, 0x6e, 0x32,
-	0x9e, 0x38, 0x44, 0x1c, 0x79, 0x40, 0x23, 0xf5, 0x81, 0x28, 0xa8, 0x8a, 0xb4, 0x91, 0x67, 0xb5,
-	0xbf, 0x15, 0xa8, 0x14, 0x63, 0xb4, 0x09, 0x0f, 0x78, 0xc0, 0xc7, 0x24, 0xdb, 0x3d, 0xce, 0x02,
-	0xa4, 0xc2, 0x32, 0x4b, 0xc3, 0xd0, 0x4d, 0x26, 0x42, 0xe1, 0x0a, 0xce, 0x43, 0xb4, 0x07, 0x95,
-	0x90, 0x30, 0xe6, 0xfa, 0x84, 0xa9, 0xa5, 0x77, 0x8c, 0x4d, 0x51, 0x85, 0x76, 0x61, 0xfd, 0xd6,
-	0xa6, 0x1c, 0x12, 0x79, 0x42, 0x7a, 0x09, 0xd7, 0xa6, 0x37, 0x66, 0x44, 0x1e, 0x7a, 0x09, 0x65,
-	0xc6, 0x5d, 0x9e, 0x32, 0x21, 0xbd, 0xda, 0xfe, 0xe2, 0xdf, 0xdd, 0x7b, 0x5b, 0x60, 0xb1, 0xe4,
-	0xd0, 0x6a, 0xb0, 0xf6, 0x4d, 0x4a, 0x92, 0x89, 0x4d, 0x5e, 0xa7, 0x24, 0x1a, 0x11, 0x6d, 0x1f,
-	0x3e, 0xbc, 0x95, 0x28, 0x6e, 0x43, 0x03, 0x2a, 0x4c, 0xe6, 0xe4, 0x4d, 0x2a, 0x62, 0x6d, 0x0d,
-	0x56, 0x05, 0x28, 0x3b, 0x59, 0xed, 0x67, 0x05, 0x36, 0xa6, 0xe2, 0x82, 0xe2, 0xff, 0xf5, 0xd4,
-	0xee, 0xc9, 0xad, 0xcf, 0xff, 0x5c, 0x8c, 0xa4, 0x37, 0x77, 0x5e, 0x8a, 0x7b, 0x7c, 0x8d, 0x77,
-	0x7f, 0x50, 0xa0, 0x7a, 0xfb, 0xb0, 0xd0, 0x13, 0x78, 0x64, 0x61, 0xd3, 0x32, 0xed, 0xce, 0x4b,
-	0xc7, 0x1e, 0x76, 0x86, 0xc7, 0xb6, 0x73, 0x3c, 0xb0, 0x2d, 0xe3, 0xb0, 0x77, 0xd4, 0x33, 0xba,
-	0xf5, 0x05, 0xf4, 0x31, 0x7c, 0x34, 0x5b, 0x70, 0x62, 0x0e, 0x7b, 0x83, 0xaf, 0x1d, 0xcb, 0xc0,
-	0x3d, 0xb3, 0x5b, 0x57, 0x50, 0x03, 0x1e, 0xce, 0x96, 0x58, 0x1d, 0xdb, 0x36, 0xba, 0xf5, 0x45,
-	0xf4, 0x18, 0xd4, 0xd9, 0x35, 0x6c, 0xbc, 0x30, 0x0e, 0x87, 0x46, 0xb7, 0x5e, 0xda, 0x7d, 0x05,
-	0x70, 0xf3, 0x04, 0xa3, 0x47, 0xb0, 0x75, 0x62, 0x0e, 0x0d, 0xc7, 0xb4, 0x86, 0x3d, 0x73, 0x30,
-	0xa3, 0x63, 0x03, 0x6a, 0xd3, 0x8b, 0xdf, 0x1a, 0x76, 0x5d, 0x41, 0x5b, 0xb0, 0x31, 0x9d, 0xec,
-	0x1c, 0xd8, 0xc3, 0x4e, 0x6f, 0x50, 0x5f, 0x44, 0x08, 0xaa, 0xd3, 0x0b, 0x03, 0xb3, 0x5e, 0x3a,
-	0x38, 0xfa, 0xfd, 0xb2, 0xa9, 0xbc, 0xbd, 0x6c, 0x2a, 0x7f, 0x5d, 0x36, 0x95, 0x1f, 0xaf, 0x9a,
-	0x0b, 0x6f, 0xaf, 0x9a, 0x0b, 0x7f, 0x5e, 0x35, 0x17, 0xbe, 0x7b, 0x96, 0x19, 0xca, 0xbc, 0x57,
-	0x7a, 0x40, 0x5b, 0x6f, 0xde, 0xfd, 0x33, 0x71, 0x5a, 0x16, 0x37, 0x6d, 0xff, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6b, 0x50, 0x07, 0xb0, 0x7b, 0x08, 0x00, 0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x72, 0xd3, 0x46,
+	0x18, 0x8f, 0xe2, 0xc4, 0xb1, 0xbf, 0x10, 0xc7, 0x59, 0x02, 0xc8, 0x01, 0x1c, 0x50, 0x3b, 0x53,
+	0x86, 0x01, 0x39, 0x09, 0xb4, 0xbd, 0xf4, 0xe2, 0xd8, 0xa2, 0x63, 0x26, 0xb1, 0x8d, 0xe4, 0xd0,
+	0x69, 0x2f, 0x1a, 0x59, 0x5a, 0x14, 0x4d, 0x24, 0xad, 0xd1, 0xae, 0x02, 0x7e, 0x86, 0x5e, 0x7a,
+	0x6a, 0x1f, 0xa0, 0x17, 0xa6, 0xd3, 0x63, 0x1f, 0x82, 0xde, 0x98, 0x9e, 0x7a, 0x6a, 0x3b, 0xf0,
+	0x22, 0x1d, 0xad, 0x56, 0x8a, 0x6d, 0x3a, 0xe0, 0x4e, 0xc3, 0x4c, 0x4f, 0xd9, 0xef, 0xcf, 0xef,
+	0xfb, 0xb7, 0xbf, 0x6f, 0xad, 0xc0, 0x3d, 0x9b, 0xd0, 0x80, 0xd0, 0x86, 0x65, 0xdb, 0x24, 0x0e,
+	0x19, 0x6d, 0x38, 0xf8, 0x89, 0x15, 0xfb, 0x8c, 0x36, 0x82, 0xd8, 0x67, 0x1e, 0xf5, 0xdc, 0xc6,
+	0xe9, 0x6e, 0x7e, 0x56, 0x47, 0x11, 0x61, 0x04, 0x7d, 0x9c, 0x82, 0xd4, 0x0c, 0xa4, 0x66, 0x20,
+	0x35, 0x77, 0x3c, 0xdd, 0xdd, 0xaa, 0xb9, 0x84, 0xb8, 0x3e, 0x6e, 0x70, 0xcc, 0x30, 0x7e, 0xd2,
+	0xb0, 0xc2, 0x71, 0x1a, 0x60, 0xab, 0x96, 0x06, 0x30, 0xb9, 0xd4, 0x10, 0xd1, 0x52, 0x53, 0x5d,
+	0x14, 0x34, 0xb4, 0x28, 0x6e, 0x9c, 0xee, 0x0e, 0x31, 0xb3, 0x76, 0x1b, 0x36, 0xf1, 0x42, 0x61,
+	0xdf, 0x74, 0x89, 0x4b, 0x52, 0x5c, 0x72, 0x4a, 0xb5, 0xca, 0x0f, 0x12, 0xac, 0x1c, 0x52, 0xb7,
+	0x13, 0x7a, 0x0c, 0x3d, 0x80, 0x95, 0x00, 0x07, 0x43, 0x1c, 0x51, 0x59, 0xba, 0x51, 0xb8, 0xb5,
+	0xba, 0x77, 0x47, 0x9d, 0xa7, 0x5e, 0xf5, 0x90, 0x83, 0xf4, 0x0c, 0x8c, 0xda, 0x50, 0xb4, 0x49,
+	0xf8, 0xc4, 0x73, 0xe5, 0xc5, 0x1b, 0xd2, 0xfc, 0x61, 0x5a, 0x1c, 0xa3, 0x0b, 0xac, 0xb2, 0x01,
+	0xeb, 0xa2, 0x30, 0x1d, 0xd3, 0x11, 0x09, 0x29, 0x56, 0x4c, 0xd8, 0x38, 0xa4, 0x6e, 0x2b, 0xc2,
+	0x16, 0xc3, 0xfd, 0x88, 0x8c, 0x08, 0xb5, 0x7c, 0xf4, 0x10, 0x4a, 0x23, 0x71, 0x96, 0x25, 0x9e,
+	0x4f, 0x9d, 0x2f, 0x5f, 0x16, 0x41, 0xcf, 0xf1, 0xca, 0x17, 0x50, 0x7b, 0x2b, 0x41, 0x96, 0x1d,
+	0x6d, 0xc3, 0x6a, 0xe6, 0x68, 0x7a, 0x0e, 0xcf, 0xb5, 0xa4, 0x43, 0xa6, 0xea, 0x38, 0xca, 0x88,
+	0x8f, 0xf2, 0x31, 0x61, 0xef, 0xf7, 0x45, 0x6d, 0x58, 0x3a, 0x25, 0x0c, 0xf3, 0x09, 0x55, 0xf6,
+	0x76, 0xe6, 0xab, 0x38, 0x09, 0xdd, 0x1b, 0x31, 0x8f, 0x84, 0x3a, 0x47, 0x8b, 0x19, 0x25, 0xea,
+	0x7c, 0x46, 0x9f, 0x02, 0x3a, 0xa4, 0xae, 0xf6, 0x1c, 0xdb, 0xf1, 0xc4, 0x90, 0xde, 0x5b, 0x7b,
+	0x1f, 0xb6, 0xde, 0x86, 0xe5, 0xad, 0xef, 0x41, 0x39, 0x12, 0xe7, 0x8c, 0x1b, 0x9b, 0x6a, 0xca,
+	0x52, 0x35, 0x63, 0xa9, 0xda, 0x0c, 0xc7, 0xfa, 0x99, 0x9b, 0xf2, 0x42, 0x82, 0xd2, 0x21, 0x75,
+	0x8d, 0x11, 0x0e, 0x1d, 0xf4, 0x39, 0x00, 0x23, 0xa6, 0xe5, 0x38, 0x11, 0xa6, 0x94, 0xa7, 0x2f,
+	0xef, 0xcb, 0xbf, 0xfd, 0x72, 0x77, 0x53, 0xf4, 0xdd, 0x4c, 0x2d, 0x06, 0x8b, 0xbc, 0xd0, 0xd5,
+	0xcb, 0x8c, 0x08, 0x05, 0xb2, 0xa1, 0x68, 0x05, 0xc9, 0x48, 0xe4, 0x45, 0x9e, 0xb6, 0x96, 0x4d,
+	0x2a, 0xa1, 0xb9, 0x2a, 0x68, 0xae, 0xb6, 0x88, 0x17, 0xee, 0xef, 0xbc, 0xfc, 0x63, 0x7b, 0xe1,
+	0xa7, 0x3f, 0xb7, 0x6f, 0xb9, 0x1e, 0x3b, 0x8e, 0x87, 0xaa, 0x4d, 0x02, 0xb1, 0x21, 0xe2, 0xcf,
+	0x5d, 0xea, 0x9c, 0x34, 0xd8, 0x78, 0x84, 0x29, 0x07, 0x50, 0x5d, 0x84, 0x56, 0x10, 0x54, 0xb3,
+	0x4a, 0xf3, 0x39, 0xde, 0x4f, 0xb9, 0x66, 0x85, 0x36, 0xf6, 0xe7, 0x1f, 0xe3, 0x55, 0xa8, 0xbd,
+	0x85, 0xca, 0x43, 0xfe, 0x2c, 0xf1, 0xeb, 0x3a, 0x1a, 0x39, 0x16, 0xc3, 0x29, 0xdb, 0x91, 0x01,
+	0x95, 0x98, 0xcb, 0xe6, 0x7f, 0x59, 0xbd, 0xb5, 0x34, 0xc6, 0xe1, 0xb9, 0x2e, 0x60, 0x0d, 0xae,
+	0xcc, 0x54, 0x9b, 0x77, 0x32, 0x80, 0x62, 0x9a, 0x0b, 0xed, 0xc1, 0xca, 0xbc, 0xb7, 0x9a, 0x39,
+	0xa2, 0xcb, 0x50, 0x7c, 0x86, 0x3d, 0xf7, 0x98, 0xf1, 0xf2, 0x96, 0x74, 0x21, 0x29, 0x2f, 0x0a,
+	0x50, 0x14, 0x63, 0xb9, 0x06, 0x65, 0x76, 0x1c, 0x61, 0x7a, 0x4c, 0xfc, 0x74, 0xcc, 0x05, 0xfd,
+	0x4c, 0x91, 0x04, 0x78, 0x1a, 0x93, 0x28, 0x0e, 0x78, 0x80, 0x82, 0x2e, 0x24, 0xf4, 0x11, 0xac,
+	0x9d, 0x12, 0xe6, 0x85, 0xae, 0x39, 0xc2, 0x91, 0x47, 0x1c, 0xb9, 0xc0, 0xcd, 0x17, 0x52, 0x65,
+	0x9f, 0xeb, 0x12, 0x70, 0x84, 0xf9, 0xee, 0x2d, 0xdd, 0x90, 0x6e, 0x95, 0x74, 0x21, 0xa1, 0x4f,
+	0x60, 0x1d, 0x5b, 0x91, 0x3f, 0x36, 0x31, 0x5f, 0x02, 0x8f, 0x84, 0xf2, 0x32, 0x77, 0xa8, 0x70,
+	0xb5, 0x96, 0x69, 0x51, 0x04, 0x15, 0x9a, 0x50, 0x25, 0xc9, 0xe3, 0x7b, 0x81, 0xc7, 0xe4, 0xe2,
+	0xf9, 0x53, 0x73, 0x2d, 0x4b, 0x71, 0x90, 0x64, 0x48, 0x8a, 0xcb, 0x73, 0x8a, 0xde, 0x56, 0x78,
+	0x6f, 0x79, 0x29, 0xa2, 0xbb, 0xbb, 0x80, 0xf2, 0xfa, 0x4d, 0xe6, 0x05, 0xd8, 0x27, 0xf6, 0x89,
+	0x5c, 0xe2, 0xbe, 0x1b, 0xb9, 0x65, 0x20, 0x0c, 0xe8, 0x33, 0x28, 0xdb, 0x9c, 0xac, 0x3e, 0x8e,
+	0xe4, 0xf2, 0xfb, 0xd6, 0x32, 0x77, 0x55, 0xbe, 0x97, 0xa0, 0x62, 0x88, 0xcc, 0x5f, 0x79, 0xa1,
+	0x43, 0x9e, 0xa1, 0x9b, 0x70, 0x21, 0xad, 0xcc, 0xa4, 0xcc, 0x8a, 0x98, 0xb8, 0xb5, 0xd5, 0x54,
+	0x67, 0x24, 0x2a, 0x64, 0xc1, 0x72, 0x52, 0xee, 0x07, 0xd9, 0xe5, 0x34, 0xb2, 0xf2, 0xed, 0x22,
+	0x94, 0xf2, 0x75, 0xdd, 0x84, 0x65, 0xe6, 0x31, 0x1f, 0xa7, 0xd4, 0xd4, 0x53, 0x01, 0xc9, 0xb0,
+	0x42, 0xe3, 0x20, 0xb0, 0xa2, 0x31, 0xa7, 0x4f, 0x59, 0xcf, 0x44, 0xb4, 0x03, 0xa5, 0x00, 0x53,
+	0x6a, 0xb9, 0x98, 0xca, 0x85, 0x77, 0xbc, 0x72, 0xb9, 0x17, 0xba, 0x0d, 0x1b, 0x53, 0x8c, 0x33,
+	0x71, 0xe8, 0x70, 0x5e, 0x15, 0xf4, 0xf5, 0x49, 0xd6, 0x69, 0xa1, 0x83, 0x0e, 0xa0, 0x48, 0x99,
+	0xc5, 0x62, 0xca, 0x79, 0x55, 0xd9, 0xbb, 0xff, 0xef, 0x7e, 0xa6, 0x0c, 0x8e, 0xd5, 0x45, 0x8c,
+	0x84, 0xeb, 0xe9, 0x75, 0x5a, 0x43, 0x1f, 0x9b, 0x56, 0x42, 0x42, 0xce, 0xf5, 0x33, 0x65, 0x93,
+	0x29, 0xeb, 0xb0, 0xf6, 0x28, 0xc6, 0xd1, 0xd8, 0xc0, 0x4f, 0x63, 0x1c, 0xda, 0x58, 0xb9, 0x07,
+	0x97, 0xa6, 0x14, 0xf9, 0x0b, 0xbf, 0x05, 0x25, 0x2a, 0x74, 0xe2, 0x59, 0xcb, 0x65, 0x65, 0x0d,
+	0x56, 0x39, 0x28, 0xdd, 0x4d, 0xe5, 0x47, 0x09, 0x2e, 0x4e, 0xc8, 0x79, 0x88, 0xff, 0xd7, 0xe7,
+	0xc3, 0x8e, 0x68, 0x7d, 0xfe, 0xb7, 0xdb, 0x86, 0x4b, 0x53, 0x88, 0xbc, 0xb1, 0xf3, 0xfc, 0xc2,
+	0xc8, 0x6f, 0x44, 0x2c, 0x8f, 0xf2, 0x4a, 0x82, 0x4b, 0x53, 0x9a, 0x3c, 0xed, 0x01, 0x14, 0x9f,
+	0xf1, 0xd5, 0x12, 0x49, 0xe7, 0xe4, 0xcb, 0xf4, 0x5a, 0xea, 0x22, 0x06, 0xf2, 0x92, 0x9f, 0xf0,
+	0xc0, 0xf2, 0x42, 0x2f, 0x74, 0x3f, 0xc4, 0xfe, 0x9d, 0x45, 0xbf, 0xfd, 0xab, 0x04, 0x95, 0x69,
+	0xd6, 0xa2, 0x6d, 0xb8, 0xda, 0xd7, 0x7b, 0xfd, 0x9e, 0xd1, 0x3c, 0x30, 0x8d, 0x41, 0x73, 0x70,
+	0x64, 0x98, 0x47, 0x5d, 0xa3, 0xaf, 0xb5, 0x3a, 0x0f, 0x3a, 0x5a, 0xbb, 0xba, 0x80, 0x6e, 0xc2,
+	0xf5, 0x59, 0x87, 0xc7, 0xbd, 0x41, 0xa7, 0xfb, 0xa5, 0xd9, 0xd7, 0xf4, 0x4e, 0xaf, 0x5d, 0x95,
+	0xd0, 0x16, 0x5c, 0x9e, 0x75, 0xe9, 0x37, 0x0d, 0x43, 0x6b, 0x57, 0x17, 0xd1, 0x35, 0x90, 0x67,
+	0x6d, 0xba, 0xf6, 0x50, 0x6b, 0x0d, 0xb4, 0x76, 0xb5, 0xf0, 0x4f, 0xc8, 0x47, 0x47, 0xda, 0x91,
+	0xd6, 0xae, 0x2e, 0xa1, 0xeb, 0x50, 0x9b, 0xb5, 0xb5, 0x9a, 0xdd, 0x96, 0x76, 0x70, 0xa0, 0xb5,
+	0xab, 0xcb, 0xb7, 0x4f, 0x00, 0xce, 0xbe, 0xba, 0xd0, 0x55, 0xb8, 0xf2, 0xb8, 0x37, 0xd0, 0xcc,
+	0x5e, 0x7f, 0xd0, 0xe9, 0x75, 0x67, 0x5a, 0xb8, 0x08, 0xeb, 0x93, 0xc6, 0xaf, 0x35, 0xa3, 0x2a,
+	0xa1, 0x2b, 0x70, 0x71, 0x52, 0xd9, 0xdc, 0x37, 0x06, 0xcd, 0x4e, 0xb7, 0xba, 0x88, 0x10, 0x54,
+	0x26, 0x0d, 0xdd, 0x5e, 0xb5, 0xb0, 0xff, 0xe0, 0xe5, 0xeb, 0xba, 0xf4, 0xea, 0x75, 0x5d, 0xfa,
+	0xeb, 0x75, 0x5d, 0xfa, 0xee, 0x4d, 0x7d, 0xe1, 0xd5, 0x9b, 0xfa, 0xc2, 0xef, 0x6f, 0xea, 0x0b,
+	0xdf, 0xdc, 0x49, 0xa7, 0x4e, 0x9d, 0x13, 0xd5, 0x23, 0x8d, 0xe7, 0xef, 0xfe, 0xaf, 0x63, 0x58,
+	0xe4, 0xaf, 0xd5, 0xbd, 0xbf, 0x07, 0x00, 0x12, 0x56, 0x85, 0xa3, 0xa4, 0x0c, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1374,7 +1779,7 @@ func (m *MsgExecuteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
+func (m *MsgSpend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1384,32 +1789,20 @@ func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSpend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMultisig(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UpdateMembers) > 0 {
-		for iNdEx := len(m.UpdateMembers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UpdateMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1417,13 +1810,20 @@ func (m *MsgUpdateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintMultisig(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSpendResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1433,12 +1833,135 @@ func (m *MsgUpdateConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSpendResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpdateMembers) > 0 {
+		for iNdEx := len(m.UpdateMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1501,6 +2024,37 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Canceller) > 0 {
+		i -= len(m.Canceller)
+		copy(dAtA[i:], m.Canceller)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Canceller)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExecutionTimelock != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionTimelock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SpendingPeriod != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.SpendingPeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SpendingLimit) > 0 {
+		for iNdEx := len(m.SpendingLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendingLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1539,6 +2093,48 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PeriodStart != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1559,6 +2155,11 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutableAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1786,6 +2387,78 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySpending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMultisig(v)
 	base := offset
@@ -1901,26 +2574,26 @@ func (m *MsgExecuteProposalResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateConfig) Size() (n int) {
+func (m *MsgSpend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.UpdateMembers) > 0 {
-		for _, e := range m.UpdateMembers {
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovMultisig(uint64(l))
 		}
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovMultisig(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgUpdateConfigResponse) Size() (n int) {
+func (m *MsgSpendResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1929,23 +2602,72 @@ func (m *MsgUpdateConfigResponse) Size() (n int) {
 	return n
 }
 
-func (m *Member) Size() (n int) {
+func (m *MsgCancelProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMultisig(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
 	}
-	if m.Weight != 0 {
-		n += 1 + sovMultisig(uint64(m.Weight))
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *Config) Size() (n int) {
+func (m *MsgUpdateConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpdateMembers) > 0 {
+		for _, e := range m.UpdateMembers {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovMultisig(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *Config) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1966,6 +2688,40 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if len(m.SpendingLimit) > 0 {
+		for _, e := range m.SpendingLimit {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.SpendingPeriod != 0 {
+		n += 1 + sovMultisig(uint64(m.SpendingPeriod))
+	}
+	if m.ExecutionTimelock != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionTimelock))
+	}
+	l = len(m.Canceller)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodStart != 0 {
+		n += 1 + sovMultisig(uint64(m.PeriodStart))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1995,6 +2751,9 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
+	if m.ExecutableAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAt))
+	}
 	return n
 }
 
@@ -2072,6 +2831,34 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySpendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMultisig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2694,7 +3481,7 @@ func (m *MsgExecuteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2717,17 +3504,17 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -2737,29 +3524,27 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMultisig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultisig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMembers = append(m.UpdateMembers, &Member{})
-			if err := m.UpdateMembers[len(m.UpdateMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2786,10 +3571,8 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &Config{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2814,7 +3597,7 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2837,10 +3620,10 @@ func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2864,7 +3647,7 @@ func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2887,17 +3670,136 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -2907,29 +3809,31 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultisig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultisig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.UpdateMembers = append(m.UpdateMembers, &Member{})
+			if err := m.UpdateMembers[len(m.UpdateMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -2939,11 +3843,430 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revote = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarlyExecution = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendingLimit = append(m.SpendingLimit, types.Coin{})
+			if err := m.SpendingLimit[len(m.SpendingLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPeriod", wireType)
+			}
+			m.SpendingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimelock", wireType)
+			}
+			m.ExecutionTimelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTimelock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Canceller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -2965,7 +4288,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Config) Unmarshal(dAtA []byte) error {
+func (m *SpendingWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2988,17 +4311,17 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
-			m.Threshold = 0
+			m.PeriodStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3008,35 +4331,16 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= int64(b&0x7F) << shift
+				m.PeriodStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
-			}
-			m.Quorum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Quorum |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			m.VotingPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3046,51 +4350,26 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPeriod |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
 			}
-			m.Revote = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.EarlyExecution = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3277,6 +4556,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			m.ExecutableAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3742,6 +5040,176 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpending) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpending: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpending: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &SpendingWindow{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMultisig(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,632 @@
+package sessionkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types/any"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	PubKeyPrefix      = collections.NewPrefix(0)
+	PubKeyTypePrefix  = collections.NewPrefix(1)
+	SequencePrefix    = collections.NewPrefix(2)
+	WebAuthnPrefix    = collections.NewPrefix(3)
+	SessionKeysPrefix = collections.NewPrefix(4)
+)
+
+const (
+	msgSendTypeURL    = "/cosmos.bank.v1beta1.MsgSend"
+	msgExecuteTypeURL = "/cosmos.accounts.v1.MsgExecute"
+)
+
+type Option func(a *Account)
+
+func (Option) IsManyPerContainerType() {}
+
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		acc := Account{
+			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key_bytes", collections.BytesValue),
+			PubKeyType:       collections.NewItem(deps.SchemaBuilder, PubKeyTypePrefix, "pub_key_type", collections.StringValue),
+			WebAuthn:         collections.NewItem(deps.SchemaBuilder, WebAuthnPrefix, "webauthn", codec.CollValue[v1.WebAuthn](deps.LegacyStateCodec)),
+			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:      collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:        deps.AddressCodec,
+			hs:               deps.Environment.HeaderService,
+			ts:               deps.Environment.TransactionService,
+			supportedPubKeys: map[string]pubKeyImpl{},
+			signingHandlers:  handlerMap,
+		}
+		for _, option := range options {
+			option(&acc)
+		}
+		if len(acc.supportedPubKeys) == 0 {
+			return "", nil, fmt.Errorf("no public keys plugged for account type %s", name)
+		}
+		return name, acc, nil
+	}
+}
+
+// Account implements an account authenticated by a root key, which can be a
+// passkey, and by scoped session keys.
+type Account struct {
+	// PubKey and PubKeyType hold the root key, which has full control over the account.
+	PubKey     collections.Item[[]byte]
+	PubKeyType collections.Item[string]
+	// WebAuthn is the relying party of the root key when it is a passkey signing WebAuthn assertions.
+	WebAuthn collections.Item[v1.WebAuthn]
+
+	Sequence collections.Sequence
+
+	// SessionKeys maps the session keys pubkey bytes to their scope.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+	ts        transaction.Service
+
+	supportedPubKeys map[string]pubKeyImpl
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.InitSequence != 0 {
+		err := a.Sequence.Set(ctx, msg.InitSequence)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &v1.MsgInitResponse{}, a.saveRootPubKey(ctx, msg.PubKey, msg.Webauthn)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.saveRootPubKey(ctx, msg.NewPubKey, msg.Webauthn)
+}
+
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if msg.PubKey == nil {
+		return nil, errors.New("session key pubkey cannot be empty")
+	}
+	if _, err := a.decodePubKey(msg.PubKey); err != nil {
+		return nil, err
+	}
+	if !msg.ExpiresAt.After(a.hs.HeaderInfo(ctx).Time) {
+		return nil, errors.New("session key expiration must be in the future")
+	}
+	if len(msg.AllowedMessages) == 0 {
+		return nil, errors.New("session key must allow at least one message type")
+	}
+	if err := msg.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+	if !msg.SpendLimit.IsZero() {
+		for _, typeURL := range msg.AllowedMessages {
+			if !hasSpendAccounting(typeURL) {
+				return nil, fmt.Errorf("message %s cannot be allowed with a spend limit, its spent amount is not tracked", typeURL)
+			}
+		}
+	}
+
+	rootPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(rootPubKey, msg.PubKey.Value) {
+		return nil, errors.New("the root pubkey cannot be used as a session key")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("session key already exists")
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, msg.PubKey.Value, v1.SessionKey{
+		PubKey:          msg.PubKey,
+		ExpiresAt:       msg.ExpiresAt,
+		AllowedMessages: msg.AllowedMessages,
+		SpendLimit:      msg.SpendLimit,
+	})
+}
+
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if msg.PubKey == nil {
+		return nil, errors.New("session key pubkey cannot be empty")
+	}
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey.Value)
+}
+
+// Authenticate implements the authentication flow of an abstracted account.
+// The transaction is signed by the root key, unless the public key of the signer
+// info is one of the session keys, in which case the scope of the session key is enforced.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	pubKey, sessionKey, err := a.loadSigner(ctx, signerInfo.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute signer data: %w", err)
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get tx data: %w", err)
+	}
+
+	gotSeq := signerInfo.Sequence
+
+	execMode := a.ts.ExecMode(ctx)
+	if execMode == transaction.ExecModeCheck {
+		if gotSeq < signerData.Sequence {
+			return nil, fmt.Errorf("sequence number must be higher than: %d, got: %d", signerData.Sequence, gotSeq)
+		}
+	} else if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
+	}
+
+	if sessionKey == nil {
+		webAuthn, err := a.WebAuthn.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			err = verifyWebAuthnSignature(pubKey, &webAuthn, signBytes, signature)
+			if err != nil {
+				return nil, err
+			}
+			return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+		}
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	if sessionKey != nil {
+		if err := a.checkSessionKeyScope(ctx, sessionKey, msg.Tx.Body.Messages); err != nil {
+			return nil, fmt.Errorf("session key: %w", err)
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// loadSigner returns the key which signed the transaction given the public key of
+// the signer info. The root key is used when the public key is not set. The returned
+// session key is nil when the signer is the root key.
+func (a Account) loadSigner(ctx context.Context, signerPubKey *codectypes.Any) (PubKey, *v1.SessionKey, error) {
+	rootPubKey, err := a.loadPubKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signerPubKey == nil {
+		return rootPubKey, nil, nil
+	}
+
+	rootPubKeyBytes, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if bytes.Equal(rootPubKeyBytes, signerPubKey.Value) {
+		return rootPubKey, nil, nil
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPubKey.Value)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil, errors.New("signer pubkey is neither the root key nor a session key")
+		}
+		return nil, nil, err
+	}
+
+	pubKey, err := a.decodePubKey(sessionKey.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, &sessionKey, nil
+}
+
+// checkSessionKeyScope checks that the messages are within the scope of the
+// session key and records the amount spent by the session key.
+func (a Account) checkSessionKeyScope(ctx context.Context, sessionKey *v1.SessionKey, msgs []*codectypes.Any) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.ExpiresAt) {
+		return errors.New("expired")
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	spent := sdk.NewCoins()
+	for _, msg := range msgs {
+		if !slices.Contains(sessionKey.AllowedMessages, msg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed", msg.TypeUrl)
+		}
+		if !sessionKey.SpendLimit.IsZero() && !hasSpendAccounting(msg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed with a spend limit, its spent amount is not tracked", msg.TypeUrl)
+		}
+
+		amount, err := spentAmount(self, msg)
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(amount...)
+	}
+
+	if spent.IsZero() {
+		return nil
+	}
+
+	total := sessionKey.Spent.Add(spent...)
+	if !total.IsAllLTE(sessionKey.SpendLimit) {
+		return fmt.Errorf("spend limit exceeded: %s spent, limit is %s", total, sessionKey.SpendLimit)
+	}
+	sessionKey.Spent = total
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, *sessionKey)
+}
+
+// hasSpendAccounting reports whether the coins sent by the account through a message
+// of the given type are tracked by spentAmount. Other messages, such as MsgMultiSend,
+// MsgDelegate or IBC transfers, can move funds without being counted.
+func hasSpendAccounting(typeURL string) bool {
+	return typeURL == msgSendTypeURL || typeURL == msgExecuteTypeURL
+}
+
+// spentAmount returns the coins sent by the account through a message.
+// Executing the account itself is rejected, so that session keys cannot
+// manage the keys of the account.
+func spentAmount(self string, msg *codectypes.Any) (sdk.Coins, error) {
+	switch msg.TypeUrl {
+	case msgSendTypeURL:
+		send := new(bankv1beta1.MsgSend)
+		if err := proto.Unmarshal(msg.Value, send); err != nil {
+			return nil, err
+		}
+		if send.FromAddress != self {
+			return nil, nil
+		}
+		coins := sdk.NewCoins()
+		for _, coin := range send.Amount {
+			amount, ok := math.NewIntFromString(coin.Amount)
+			if !ok {
+				return nil, fmt.Errorf("invalid amount %s", coin.Amount)
+			}
+			coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		return coins, nil
+	case msgExecuteTypeURL:
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+			return nil, err
+		}
+		if execute.Target == self {
+			return nil, errors.New("cannot execute the account itself")
+		}
+		if execute.Sender != self {
+			return nil, nil
+		}
+		return execute.Funds, nil
+	default:
+		return nil, nil
+	}
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pk PubKey) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pkAny.TypeUrl,
+			Value:   pkAny.Value,
+		},
+	}, nil
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[*accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
+	pkType, err := a.PubKeyType.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, exists := a.supportedPubKeys[pkType]
+	// this means that the chain developer suddenly started using a key type.
+	if !exists {
+		return nil, fmt.Errorf("pubkey type %s is not supported by the chain anymore", pkType)
+	}
+
+	pkBytes, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return publicKey.decode(pkBytes)
+}
+
+// decodePubKey decodes and validates a pubkey of a supported type.
+func (a Account) decodePubKey(anyPk *codectypes.Any) (PubKey, error) {
+	name := nameFromTypeURL(anyPk.TypeUrl)
+	impl, exists := a.supportedPubKeys[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown pubkey type %s", name)
+	}
+	pk, err := impl.decode(anyPk.Value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pubkey: %w", err)
+	}
+	err = impl.validate(pk)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate pubkey: %w", err)
+	}
+	return pk, nil
+}
+
+func (a Account) saveRootPubKey(ctx context.Context, anyPk *codectypes.Any, webAuthn *v1.WebAuthn) error {
+	if anyPk == nil {
+		return errors.New("pubkey cannot be empty")
+	}
+	pk, err := a.decodePubKey(anyPk)
+	if err != nil {
+		return err
+	}
+	if webAuthn != nil {
+		if !isWebAuthnPubKey(pk) {
+			return fmt.Errorf("pubkey type %s cannot be used as a passkey", nameFromTypeURL(anyPk.TypeUrl))
+		}
+		if err := validateWebAuthn(webAuthn); err != nil {
+			return err
+		}
+	}
+	has, err := a.SessionKeys.Has(ctx, anyPk.Value)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errors.New("a session key cannot be used as the root pubkey")
+	}
+
+	// save into state
+	err = a.PubKey.Set(ctx, anyPk.Value)
+	if err != nil {
+		return fmt.Errorf("unable to save pubkey: %w", err)
+	}
+	err = a.PubKeyType.Set(ctx, nameFromTypeURL(anyPk.TypeUrl))
+	if err != nil {
+		return err
+	}
+	if webAuthn == nil {
+		return a.WebAuthn.Remove(ctx)
+	}
+	return a.WebAuthn.Set(ctx, *webAuthn)
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryPubKey(ctx context.Context, _ *v1.QueryPubKey) (*v1.QueryPubKeyResponse, error) {
+	pubKey, err := a.loadPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	res := &v1.QueryPubKeyResponse{PubKey: anyPubKey}
+	webAuthn, err := a.WebAuthn.Get(ctx)
+	if err == nil {
+		res.Webauthn = &webAuthn
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (bool, error) {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) AuthRetroCompatibility(ctx context.Context, _ *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	addr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	accNumber, err := accountstd.QueryModule[*accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+	pk, err := a.loadPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	anyPk, err := gogotypes.NewAnyWithCacheWithValue(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount := &authtypes.BaseAccount{
+		Address:       addr,
+		PubKey:        anyPk,
+		AccountNumber: accNumber.Number,
+		Sequence:      seq,
+	}
+
+	baseAccountAny, err := gogotypes.NewAnyWithCacheWithValue(baseAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authtypes.QueryLegacyAccountResponse{
+		Account: baseAccountAny,
+		Base:    baseAccount,
+	}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+	accountstd.RegisterQueryHandler(builder, a.AuthRetroCompatibility)
+}
@@ -0,0 +1,393 @@
+package sessionkey
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	selfAddr            = "mock_session_key_account"
+	msgMultiSendTypeURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+)
+
+func setupAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	createAccFn := NewAccount("session_key", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey(), WithSecp256R1PubKey())
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	pb, err := codectypes.NewAnyWithValue(pm)
+	require.NoError(t, err)
+	return pb
+}
+
+func newSecp256r1Key(t *testing.T) *secp256r1.PrivKey {
+	t.Helper()
+	priv, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	return priv
+}
+
+func bankSend(t *testing.T, from string, amount int64) *codectypes.Any {
+	t.Helper()
+	bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   "recipient",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: math.NewInt(amount).String()}},
+	})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgSendTypeURL, Value: bz}
+}
+
+func bankMultiSend(t *testing.T, from string, amount int64) *codectypes.Any {
+	t.Helper()
+	coins := []*basev1beta1.Coin{{Denom: "stake", Amount: math.NewInt(amount).String()}}
+	bz, err := proto.Marshal(&bankv1beta1.MsgMultiSend{
+		Inputs:  []*bankv1beta1.Input{{Address: from, Coins: coins}},
+		Outputs: []*bankv1beta1.Output{{Address: "recipient", Coins: coins}},
+	})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgMultiSendTypeURL, Value: bz}
+}
+
+// authenticateMsg builds a transaction containing msgs signed by signerPubKey using signFn.
+func authenticateMsg(t *testing.T, signerPubKey cryptotypes.PubKey, sequence uint64, msgs []*codectypes.Any, signFn func([]byte) []byte) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	var pkAny *codectypes.Any
+	if signerPubKey != nil {
+		pkAny = toAnyPb(t, signerPubKey)
+	}
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{
+				PublicKey: pkAny,
+				ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: 1}}},
+				Sequence:  sequence,
+			}},
+		},
+	}
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{signFn(signBytes)}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+func signWith(t *testing.T, priv cryptotypes.PrivKey) func([]byte) []byte {
+	t.Helper()
+	return func(signBytes []byte) []byte {
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		return sig
+	}
+}
+
+// relyingParty is the relying party the test passkeys are registered with.
+var relyingParty = &v1.WebAuthn{RpId: "wallet.example.com", Origin: "https://wallet.example.com"}
+
+// signWithPasskey produces a WebAuthn assertion over the sign bytes for the relying party.
+func signWithPasskey(t *testing.T, priv cryptotypes.PrivKey, rp *v1.WebAuthn, challenge func([]byte) string, flags byte) func([]byte) []byte {
+	t.Helper()
+	return func(signBytes []byte) []byte {
+		clientDataJSON, err := json.Marshal(clientData{
+			Type:      webAuthnTypeGet,
+			Challenge: challenge(signBytes),
+			Origin:    rp.Origin,
+		})
+		require.NoError(t, err)
+		rpIDHash := sha256.Sum256([]byte(rp.RpId))
+		authData := append(rpIDHash[:], flags, 0, 0, 0, 1)
+		clientDataHash := sha256.Sum256(clientDataJSON)
+
+		sig, err := priv.Sign(append(append([]byte{}, authData...), clientDataHash[:]...))
+		require.NoError(t, err)
+		bz, err := (&v1.WebAuthnSignature{
+			AuthenticatorData: authData,
+			ClientDataJson:    clientDataJSON,
+			Signature:         sig,
+		}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount(t, ss)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()), Webauthn: relyingParty})
+	require.ErrorContains(t, err, "cannot be used as a passkey")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, newSecp256r1Key(t).PubKey()), Webauthn: &v1.WebAuthn{Origin: relyingParty.Origin}})
+	require.ErrorContains(t, err, "rp id cannot be empty")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, newSecp256r1Key(t).PubKey()), Webauthn: &v1.WebAuthn{RpId: relyingParty.RpId, Origin: "wallet.example.com"}})
+	require.ErrorContains(t, err, "invalid webauthn origin")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")})})
+	require.Error(t, err)
+
+	passkey := newSecp256r1Key(t)
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, passkey.PubKey()), Webauthn: relyingParty, InitSequence: 3})
+	require.NoError(t, err)
+
+	res, err := acc.QueryPubKey(ctx, &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, relyingParty, res.Webauthn)
+	seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), seq.Sequence)
+}
+
+func TestSessionKeyManagement(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount(t, ss)
+	root := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, root.PubKey())})
+	require.NoError(t, err)
+
+	sessionPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	validMsg := func() *v1.MsgAddSessionKey {
+		return &v1.MsgAddSessionKey{
+			PubKey:          sessionPubKey,
+			ExpiresAt:       blockTime.Add(time.Hour),
+			AllowedMessages: []string{msgSendTypeURL},
+			SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		}
+	}
+
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("sender")), validMsg())
+	require.ErrorContains(t, err, "unauthorized")
+
+	ctx = accountstd.SetSender(ctx, []byte(selfAddr))
+	testcases := []struct {
+		name     string
+		malleate func(msg *v1.MsgAddSessionKey)
+		expErr   string
+	}{
+		{"expired", func(msg *v1.MsgAddSessionKey) { msg.ExpiresAt = blockTime }, "must be in the future"},
+		{"no allowed messages", func(msg *v1.MsgAddSessionKey) { msg.AllowedMessages = nil }, "at least one message type"},
+		{"root key", func(msg *v1.MsgAddSessionKey) { msg.PubKey = toAnyPb(t, root.PubKey()) }, "root pubkey"},
+		{"invalid spend limit", func(msg *v1.MsgAddSessionKey) { msg.SpendLimit = sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}} }, "invalid spend limit"},
+		{"untracked message with a spend limit", func(msg *v1.MsgAddSessionKey) { msg.AllowedMessages = append(msg.AllowedMessages, msgMultiSendTypeURL) }, "not tracked"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.malleate(msg)
+			_, err := acc.AddSessionKey(ctx, msg)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	_, err = acc.AddSessionKey(ctx, validMsg())
+	require.NoError(t, err)
+	_, err = acc.AddSessionKey(ctx, validMsg())
+	require.ErrorContains(t, err, "already exists")
+
+	// a session key cannot become the root key.
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: sessionPubKey})
+	require.ErrorContains(t, err, "session key cannot be used as the root pubkey")
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, res.SessionKeys, 1)
+
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionPubKey})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionPubKey})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestAuthenticateWebAuthn(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount(t, ss)
+	passkey := newSecp256r1Key(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, passkey.PubKey()), Webauthn: relyingParty})
+	require.NoError(t, err)
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 0, nil, signWithPasskey(t, passkey, relyingParty, WebAuthnChallenge, flagUserPresent)))
+	require.NoError(t, err)
+
+	// a raw signature is not a WebAuthn assertion.
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, passkey.PubKey(), 1, nil, signWith(t, passkey)))
+	require.ErrorContains(t, err, "webauthn")
+
+	// the user must be present.
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 2, nil, signWithPasskey(t, passkey, relyingParty, WebAuthnChallenge, 0)))
+	require.ErrorContains(t, err, "user presence")
+
+	// the challenge must commit to the transaction.
+	wrongChallenge := func([]byte) string { return WebAuthnChallenge([]byte("other")) }
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 3, nil, signWithPasskey(t, passkey, relyingParty, wrongChallenge, flagUserPresent)))
+	require.ErrorContains(t, err, "challenge does not match")
+
+	// another passkey cannot sign for the account.
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 4, nil, signWithPasskey(t, newSecp256r1Key(t), relyingParty, WebAuthnChallenge, flagUserPresent)))
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// the assertion must be made for the relying party of the passkey.
+	otherOrigin := &v1.WebAuthn{RpId: relyingParty.RpId, Origin: "https://evil.example.com"}
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 5, nil, signWithPasskey(t, passkey, otherOrigin, WebAuthnChallenge, flagUserPresent)))
+	require.ErrorContains(t, err, "invalid webauthn origin")
+
+	otherRpID := &v1.WebAuthn{RpId: "evil.example.com", Origin: relyingParty.Origin}
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, nil, 6, nil, signWithPasskey(t, passkey, otherRpID, WebAuthnChallenge, flagUserPresent)))
+	require.ErrorContains(t, err, "rp id hash does not match")
+}
+
+func TestAuthenticateSessionKeyUntrackedMessages(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount(t, ss)
+	root := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, root.PubKey())})
+	require.NoError(t, err)
+
+	// a session key stored with a spend limit and a message moving funds which are not tracked.
+	limited := secp256k1.GenPrivKey()
+	limitedPubKey := toAnyPb(t, limited.PubKey())
+	require.NoError(t, acc.SessionKeys.Set(ctx, limitedPubKey.Value, v1.SessionKey{
+		PubKey:          limitedPubKey,
+		ExpiresAt:       blockTime.Add(time.Hour),
+		AllowedMessages: []string{msgSendTypeURL, msgMultiSendTypeURL},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}))
+
+	// a session key without spend limit.
+	unlimited := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte(selfAddr)), &v1.MsgAddSessionKey{
+		PubKey:          toAnyPb(t, unlimited.PubKey()),
+		ExpiresAt:       blockTime.Add(time.Hour),
+		AllowedMessages: []string{msgSendTypeURL, msgMultiSendTypeURL},
+	})
+	require.NoError(t, err)
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	multiSend := bankMultiSend(t, selfAddr, 1000)
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, limited.PubKey(), 0, []*codectypes.Any{multiSend}, signWith(t, limited)))
+	require.ErrorContains(t, err, "not tracked")
+
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, unlimited.PubKey(), 1, []*codectypes.Any{bankSend(t, selfAddr, 1)}, signWith(t, unlimited)))
+	require.ErrorContains(t, err, "spend limit exceeded")
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, unlimited.PubKey(), 2, []*codectypes.Any{multiSend}, signWith(t, unlimited)))
+	require.NoError(t, err)
+}
+
+func TestAuthenticateSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount(t, ss)
+	root := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, root.PubKey())})
+	require.NoError(t, err)
+
+	session := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte(selfAddr)), &v1.MsgAddSessionKey{
+		PubKey:          toAnyPb(t, session.PubKey()),
+		ExpiresAt:       blockTime.Add(time.Hour),
+		AllowedMessages: []string{msgSendTypeURL, msgExecuteTypeURL},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(t, err)
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	executeSelf, err := codectypes.NewAnyWithValue(&accountsv1.MsgExecute{
+		Sender:  selfAddr,
+		Target:  selfAddr,
+		Message: toAnyPb(t, &v1.MsgRevokeSessionKey{PubKey: toAnyPb(t, session.PubKey())}),
+	})
+	require.NoError(t, err)
+	delegate := &codectypes.Any{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate"}
+
+	sequence := uint64(0)
+	testcases := []struct {
+		name   string
+		signer cryptotypes.PrivKey
+		msgs   []*codectypes.Any
+		expErr string
+	}{
+		{"root key", root, []*codectypes.Any{bankSend(t, selfAddr, 1000), delegate}, ""},
+		{"within the spend limit", session, []*codectypes.Any{bankSend(t, selfAddr, 60)}, ""},
+		{"message not allowed", session, []*codectypes.Any{delegate}, "not allowed"},
+		{"spend limit exceeded", session, []*codectypes.Any{bankSend(t, selfAddr, 30), bankSend(t, selfAddr, 20)}, "spend limit exceeded"},
+		{"funds sent from another account are not counted", session, []*codectypes.Any{bankSend(t, "other", 1000)}, ""},
+		{"within the remaining spend limit", session, []*codectypes.Any{bankSend(t, selfAddr, 40)}, ""},
+		{"executing the account itself", session, []*codectypes.Any{executeSelf}, "cannot execute the account itself"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Authenticate(ctx, authenticateMsg(t, tc.signer.PubKey(), sequence, tc.msgs, signWith(t, tc.signer)))
+			// the mock context does not revert the sequence increment of failed authentications.
+			sequence++
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	unknown := secp256k1.GenPrivKey()
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, unknown.PubKey(), sequence, nil, signWith(t, unknown)))
+	require.ErrorContains(t, err, "neither the root key nor a session key")
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), res.SessionKeys[0].Spent)
+
+	// session keys cannot be used once expired.
+	blockTime = blockTime.Add(time.Hour)
+	defer func() { blockTime = blockTime.Add(-time.Hour) }()
+	_, err = acc.Authenticate(ctx, authenticateMsg(t, session.PubKey(), sequence, []*codectypes.Any{bankSend(t, "other", 1)}, signWith(t, session)))
+	require.ErrorContains(t, err, "expired")
+}
@@ -0,0 +1,27 @@
+package sessionkeydepinject
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/sessionkey"
+	"cosmossdk.io/x/tx/signing"
+)
+
+type Inputs struct {
+	depinject.In
+
+	SignHandlersMap *signing.HandlerMap
+	Options         []sessionkey.Option
+}
+
+func ProvideAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: sessionkey.NewAccount("session_key", in.SignHandlersMap, in.Options...)}
+}
+
+func ProvideSecp256K1PubKey() sessionkey.Option {
+	return sessionkey.WithSecp256K1PubKey()
+}
+
+func ProvideSecp256R1PubKey() sessionkey.Option {
+	return sessionkey.WithSecp256R1PubKey()
+}
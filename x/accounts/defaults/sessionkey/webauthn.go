@@ -0,0 +1,101 @@
+package sessionkey
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+const (
+	// webAuthnTypeGet is the client data type of WebAuthn assertions.
+	webAuthnTypeGet = "webauthn.get"
+	// authenticatorDataMinLength is the length of the rp id hash, flags and counter.
+	authenticatorDataMinLength = 37
+	// rpIDHashLength is the length of the sha256 hash of the rp id at the start of the authenticator data.
+	rpIDHashLength = 32
+	// authenticatorDataFlagsIndex is the index of the flags in the authenticator data.
+	authenticatorDataFlagsIndex = 32
+	// flagUserPresent is set when the user was present during the assertion.
+	flagUserPresent = 0x01
+)
+
+// clientData is the subset of the WebAuthn client data verified by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// validateWebAuthn validates the relying party of a passkey.
+func validateWebAuthn(webAuthn *v1.WebAuthn) error {
+	if webAuthn.RpId == "" {
+		return errors.New("webauthn rp id cannot be empty")
+	}
+	origin, err := url.Parse(webAuthn.Origin)
+	if err != nil || origin.Scheme == "" || origin.Host == "" {
+		return fmt.Errorf("invalid webauthn origin %q", webAuthn.Origin)
+	}
+	return nil
+}
+
+// isWebAuthnPubKey returns true if the pubkey can be used as a passkey.
+func isWebAuthnPubKey(pk PubKey) bool {
+	_, ok := pk.(*secp256r1.PubKey)
+	return ok
+}
+
+// WebAuthnChallenge returns the challenge a passkey must sign for the given sign bytes.
+func WebAuthnChallenge(signBytes []byte) string {
+	hash := sha256.Sum256(signBytes)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// verifyWebAuthnSignature verifies a WebAuthn assertion made over signBytes for the relying party.
+// The assertion must be of type webauthn.get, signed with the user present, its challenge must be
+// the WebAuthnChallenge of signBytes and its rp id hash and origin must be the ones of the relying party.
+func verifyWebAuthnSignature(pk PubKey, webAuthn *v1.WebAuthn, signBytes, sigBz []byte) error {
+	var sig v1.WebAuthnSignature
+	if err := sig.Unmarshal(sigBz); err != nil {
+		return fmt.Errorf("invalid webauthn signature: %w", err)
+	}
+
+	var data clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &data); err != nil {
+		return fmt.Errorf("invalid webauthn client data: %w", err)
+	}
+	if data.Type != webAuthnTypeGet {
+		return fmt.Errorf("invalid webauthn client data type %s", data.Type)
+	}
+	if data.Challenge != WebAuthnChallenge(signBytes) {
+		return errors.New("webauthn challenge does not match the transaction")
+	}
+	if data.Origin != webAuthn.Origin {
+		return fmt.Errorf("invalid webauthn origin %s, expected %s", data.Origin, webAuthn.Origin)
+	}
+
+	if len(sig.AuthenticatorData) < authenticatorDataMinLength {
+		return errors.New("invalid webauthn authenticator data")
+	}
+	rpIDHash := sha256.Sum256([]byte(webAuthn.RpId))
+	if !bytes.Equal(sig.AuthenticatorData[:rpIDHashLength], rpIDHash[:]) {
+		return errors.New("webauthn rp id hash does not match the relying party")
+	}
+	if sig.AuthenticatorData[authenticatorDataFlagsIndex]&flagUserPresent == 0 {
+		return errors.New("webauthn user presence is required")
+	}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	msg := bytes.Join([][]byte{sig.AuthenticatorData, clientDataHash[:]}, nil)
+	if !pk.VerifySignature(msg, sig.Signature) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
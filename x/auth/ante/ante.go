@@ -25,7 +25,11 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter gas.Meter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	FeeDenomOracle           FeeDenomOracle
+	FeeSwapHook              FeeSwapHook
 	UnorderedTxManager       *unorderedtx.Manager
+	// EnableBaseFee enables the checks of the base fee, see DeductFeeDecorator.SetBaseFeeEnabled.
+	EnableBaseFee bool
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -44,6 +48,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.FeeSwapHook != nil && options.FeeDenomOracle == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "fee denom oracle is required for the fee swap hook")
+	}
+
+	deductFeeDecorator := NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+	deductFeeDecorator.SetFeeDenomOracle(options.FeeDenomOracle)
+	deductFeeDecorator.SetFeeSwapHook(options.FeeSwapHook)
+	deductFeeDecorator.SetBaseFeeEnabled(options.EnableBaseFee)
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(options.Environment, options.ConsensusKeeper), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -51,7 +64,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(options.Environment),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		deductFeeDecorator,
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
 	}
@@ -0,0 +1,64 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// requiredBaseFee returns the base fee owed by a transaction of the given gas limit,
+// where fee = ceil(baseFee * gasLimit).
+func (dfd *DeductFeeDecorator) requiredBaseFee(ctx context.Context, params types.Params, gas uint64) (sdk.Coin, error) {
+	baseFee, err := dfd.accountKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := baseFee.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoin(params.BaseFeeDenom, amount), nil
+}
+
+// checkBaseFee checks that the fee covers the base fee of the transaction.
+// Unlike the minimum gas prices of the validators, the base fee is a consensus
+// rule and is checked in every execution mode but simulation.
+// The base fee must be paid in the base fee denom: the fees paid in the denoms
+// converted by the fee denom oracle don't count, as they can't be burned or sent
+// to x/protocolpool as base fee.
+func (dfd *DeductFeeDecorator) checkBaseFee(requiredFee sdk.Coin, fee sdk.Coins) error {
+	if fee.AmountOf(requiredFee.Denom).LT(requiredFee.Amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee, it must be paid in %s; got: %s required: %s", requiredFee.Denom, fee, requiredFee)
+	}
+
+	return nil
+}
+
+// distributeBaseFee burns and sends to x/protocolpool their share of the base fee
+// paid by the transaction, once the fee is in the fee collector. The fee paid above
+// the base fee is left in the fee collector.
+func (dfd *DeductFeeDecorator) distributeBaseFee(ctx context.Context, params types.Params, requiredFee sdk.Coin, fee sdk.Coins) error {
+	paidBaseFee := sdkmath.MinInt(requiredFee.Amount, fee.AmountOf(requiredFee.Denom))
+	if !paidBaseFee.IsPositive() {
+		return nil
+	}
+
+	if burned := params.BaseFeeBurnRatio.MulInt(paidBaseFee).TruncateInt(); burned.IsPositive() {
+		burnedCoins := sdk.NewCoins(sdk.NewCoin(requiredFee.Denom, burned))
+		if err := dfd.bankKeeper.BurnCoins(ctx, dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName), burnedCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn base fee %s", burnedCoins)
+		}
+	}
+
+	if pooled := params.BaseFeeProtocolPoolRatio.MulInt(paidBaseFee).TruncateInt(); pooled.IsPositive() {
+		pooledCoins := sdk.NewCoins(sdk.NewCoin(requiredFee.Denom, pooled))
+		if err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, types.ProtocolPoolDistrAccount, pooledCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to send base fee %s to the protocol pool", pooledCoins)
+		}
+	}
+
+	return nil
+}
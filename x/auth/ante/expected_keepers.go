@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +21,8 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() address.Codec
 	GetEnvironment() appmodule.Environment
+	GetBaseFee(ctx context.Context) (math.LegacyDec, error)
+	AddBlockGasWanted(ctx context.Context, gas uint64) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -27,6 +30,24 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// FeeDenomOracle defines the denoms, other than the native fee denoms, in which
+// fees can be paid and their exchange rate to a native fee denom.
+// The native fee denoms must not be allowed.
+type FeeDenomOracle interface {
+	// IsAllowedFeeDenom returns true if fees can be paid in the given non-native denom.
+	IsAllowedFeeDenom(ctx context.Context, denom string) bool
+	// ConvertToNativeFee returns the value in a native fee denom of a fee coin of an allowed denom.
+	ConvertToNativeFee(ctx context.Context, fee sdk.Coin) (sdk.Coin, error)
+}
+
+// FeeSwapHook defines the hook swapping the fees paid in non-native denoms,
+// e.g. implemented by a DEX module.
+type FeeSwapHook interface {
+	// SwapFees is called once the fees paid in the denoms allowed by the
+	// FeeDenomOracle are sent to the fee collector.
+	SwapFees(ctx context.Context, fees sdk.Coins) error
+}
+
 type ConsensusKeeper interface {
 	BlockParams(context.Context) (uint64, uint64, error)
 }
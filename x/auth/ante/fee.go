@@ -28,6 +28,9 @@ type DeductFeeDecorator struct {
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
 	minGasPrices   sdk.DecCoins
+	feeDenomOracle FeeDenomOracle
+	feeSwapHook    FeeSwapHook
+	baseFeeEnabled bool
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) *DeductFeeDecorator {
@@ -51,6 +54,26 @@ func (dfd *DeductFeeDecorator) SetMinGasPrices(minGasPrices sdk.DecCoins) {
 	dfd.minGasPrices = minGasPrices
 }
 
+// SetFeeDenomOracle sets the oracle allowing fees to be paid in non-native denoms.
+// The fees paid in the allowed denoms are converted to a native denom before being
+// checked against the minimum gas prices by the default TxFeeChecker.
+func (dfd *DeductFeeDecorator) SetFeeDenomOracle(oracle FeeDenomOracle) {
+	dfd.feeDenomOracle = oracle
+}
+
+// SetFeeSwapHook sets the hook called with the fees paid in the non-native denoms
+// allowed by the fee denom oracle, once they are deducted.
+func (dfd *DeductFeeDecorator) SetFeeSwapHook(hook FeeSwapHook) {
+	dfd.feeSwapHook = hook
+}
+
+// SetBaseFeeEnabled enables the checks of the base fee, which is then charged once it
+// is also enabled in the x/auth params. It is opt-in so that the chains not using the
+// base fee don't read the x/auth params on every transaction.
+func (dfd *DeductFeeDecorator) SetBaseFeeEnabled(enabled bool) {
+	dfd.baseFeeEnabled = enabled
+}
+
 // AnteHandle implements an AnteHandler decorator for the DeductFeeDecorator
 func (dfd *DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	dfd.minGasPrices = ctx.MinGasPrices()
@@ -84,10 +107,47 @@ func (dfd *DeductFeeDecorator) innerValidateTx(ctx context.Context, tx transacti
 		}
 	}
 
+	// genesis transactions do not pay the base fee
+	var (
+		params         types.Params
+		requiredFee    sdk.Coin
+		baseFeeEnabled bool
+	)
+	if dfd.baseFeeEnabled && headerInfo.Height > 0 {
+		params = dfd.accountKeeper.GetParams(ctx)
+		baseFeeEnabled = params.BaseFeeEnabled()
+	}
+
+	if baseFeeEnabled {
+		requiredFee, err = dfd.requiredBaseFee(ctx, params, feeTx.GetGas())
+		if err != nil {
+			return 0, err
+		}
+
+		if execMode != transaction.ExecModeSimulate {
+			if err := dfd.checkBaseFee(requiredFee, fee); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if err := dfd.checkDeductFee(ctx, feeTx, fee); err != nil {
 		return 0, err
 	}
 
+	if baseFeeEnabled {
+		if err := dfd.distributeBaseFee(ctx, params, requiredFee, fee); err != nil {
+			return 0, err
+		}
+
+		// the base fee follows the gas wanted by the blocks, on which the fees are paid
+		if execMode == transaction.ExecModeFinalize {
+			if err := dfd.accountKeeper.AddBlockGasWanted(ctx, feeTx.GetGas()); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return priority, nil
 }
 
@@ -135,6 +195,10 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 		if err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee); err != nil {
 			return err
 		}
+
+		if err := dfd.swapNonNativeFees(ctx, fee); err != nil {
+			return err
+		}
 	}
 
 	if err := dfd.accountKeeper.GetEnvironment().EventService.EventManager(ctx).EmitKV(
@@ -148,6 +212,27 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 	return nil
 }
 
+// swapNonNativeFees calls the fee swap hook with the fees paid in the denoms
+// allowed by the fee denom oracle.
+func (dfd *DeductFeeDecorator) swapNonNativeFees(ctx context.Context, fee sdk.Coins) error {
+	if dfd.feeSwapHook == nil || dfd.feeDenomOracle == nil {
+		return nil
+	}
+
+	var nonNativeFees sdk.Coins
+	for _, coin := range fee {
+		if dfd.feeDenomOracle.IsAllowedFeeDenom(ctx, coin.Denom) {
+			nonNativeFees = append(nonNativeFees, coin)
+		}
+	}
+
+	if nonNativeFees.IsZero() {
+		return nil
+	}
+
+	return dfd.feeSwapHook.SwapFees(ctx, nonNativeFees)
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx context.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
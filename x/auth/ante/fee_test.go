@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -142,3 +143,141 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+type testFeeDenomOracle struct {
+	denom string
+	rate  math.LegacyDec
+}
+
+func (o testFeeDenomOracle) IsAllowedFeeDenom(_ context.Context, denom string) bool {
+	return denom == o.denom
+}
+
+func (o testFeeDenomOracle) ConvertToNativeFee(_ context.Context, fee sdk.Coin) (sdk.Coin, error) {
+	return sdk.NewCoin("atom", o.rate.MulInt(fee.Amount).TruncateInt()), nil
+}
+
+type testFeeSwapHook struct {
+	swapped sdk.Coins
+}
+
+func (h *testFeeSwapHook) SwapFees(_ context.Context, fees sdk.Coins) error {
+	h.swapped = h.swapped.Add(fees...)
+	return nil
+}
+
+func TestDeductFeeDecorator_FeeDenomOracle(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	hook := &testFeeSwapHook{}
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	mfd.SetFeeDenomOracle(testFeeDenomOracle{denom: "usdc", rate: math.LegacyNewDec(2)})
+	mfd.SetFeeSwapHook(hook)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(15)
+
+	// 15atom are required
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyOneDec())))
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+
+	// 5usdc are worth 10atom
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("usdc", 5)))
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// fees in denoms not allowed by the oracle are not converted
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("foo", 100)))
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// 10usdc are worth 20atom, the fee is deducted as paid and swapped
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("usdc", 10))
+	s.txBuilder.SetFeeAmount(fee)
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, fee).Return(nil)
+	newCtx, err := antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdc", 10)), hook.swapped)
+	// the priority is computed from the converted fees: 21atom for 15 gas
+	require.Equal(t, int64(1), newCtx.Priority())
+}
+
+func TestDeductFeeDecorator_BaseFee(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	params := authtypes.DefaultParams()
+	params.BaseFeeTargetBlockGas = 100
+	params.BaseFeeDenom = "atom"
+	params.MinBaseFee = math.LegacyOneDec()
+	params.BaseFeeBurnRatio = math.LegacyNewDecWithPrec(5, 1)
+	params.BaseFeeProtocolPoolRatio = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+	require.NoError(t, s.accountKeeper.BaseFee.Set(s.ctx, math.LegacyNewDec(2)))
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	mfd.SetBaseFeeEnabled(true)
+	mfd.SetFeeDenomOracle(testFeeDenomOracle{denom: "usdc", rate: math.LegacyNewDec(2)})
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(10)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+
+	// 20atom are required, the base fee is checked when delivering transactions
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 19)))
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// 10usdc are worth 20atom, but the base fee must be paid in the base fee denom
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("usdc", 10)))
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// half of the base fee is burned and a quarter sent to the protocol pool,
+	// the fee paid above the base fee is left in the fee collector
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 30))
+	s.txBuilder.SetFeeAmount(fee)
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	feeCollector := s.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 10))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, authtypes.ProtocolPoolDistrAccount, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// the gas limit of the transaction counts towards the gas of the block
+	blockGasWanted, err := s.accountKeeper.BlockGasWanted.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), blockGasWanted)
+
+	// the base fee is neither checked nor distributed when it isn't enabled in the decorator
+	fee = sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	s.txBuilder.SetFeeAmount(fee)
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, fee).Return(nil)
+	_, err = sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil))(s.ctx, tx, false)
+	require.NoError(t, err)
+	blockGasWanted, err = s.accountKeeper.BlockGasWanted.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), blockGasWanted)
+}
@@ -15,6 +15,7 @@ import (
 
 	address "cosmossdk.io/core/address"
 	appmodule "cosmossdk.io/core/appmodule"
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "go.uber.org/mock/gomock"
@@ -44,6 +45,20 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddBlockGasWanted mocks base method.
+func (m *MockAccountKeeper) AddBlockGasWanted(ctx context.Context, gas uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlockGasWanted", ctx, gas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlockGasWanted indicates an expected call of AddBlockGasWanted.
+func (mr *MockAccountKeeperMockRecorder) AddBlockGasWanted(ctx, gas any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlockGasWanted", reflect.TypeOf((*MockAccountKeeper)(nil).AddBlockGasWanted), ctx, gas)
+}
+
 // AddressCodec mocks base method.
 func (m *MockAccountKeeper) AddressCodec() address.Codec {
 	m.ctrl.T.Helper()
@@ -72,6 +87,21 @@ func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
 }
 
+// GetBaseFee mocks base method.
+func (m *MockAccountKeeper) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockAccountKeeperMockRecorder) GetBaseFee(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockAccountKeeper)(nil).GetBaseFee), ctx)
+}
+
 // GetEnvironment mocks base method.
 func (m *MockAccountKeeper) GetEnvironment() appmodule.Environment {
 	m.ctrl.T.Helper()
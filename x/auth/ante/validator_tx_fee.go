@@ -23,6 +23,12 @@ func (dfd *DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx context.C
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
+	// the fees paid in non-native denoms are valued in a native denom
+	nativeFeeCoins, err := dfd.convertToNativeFees(ctx, feeCoins)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -39,16 +45,40 @@ func (dfd *DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx context.C
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
-				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			if !nativeFeeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", nativeFeeCoins, requiredFees)
 			}
 		}
 	}
 
-	priority := getTxPriority(feeCoins, int64(gas))
+	priority := getTxPriority(nativeFeeCoins, int64(gas))
 	return feeCoins, priority, nil
 }
 
+// convertToNativeFees returns the fees with the coins of the denoms allowed by the
+// fee denom oracle converted to a native denom.
+func (dfd *DeductFeeDecorator) convertToNativeFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	if dfd.feeDenomOracle == nil {
+		return fees, nil
+	}
+
+	nativeFees := sdk.NewCoins()
+	for _, fee := range fees {
+		if dfd.feeDenomOracle.IsAllowedFeeDenom(ctx, fee.Denom) {
+			nativeFee, err := dfd.feeDenomOracle.ConvertToNativeFee(ctx, fee)
+			if err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "cannot convert fee %s: %s", fee, err)
+			}
+
+			fee = nativeFee
+		}
+
+		nativeFees = nativeFees.Add(fee)
+	}
+
+	return nativeFees, nil
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
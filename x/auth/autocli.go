@@ -74,6 +74,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Query the current base fee, the minimum gas price of transactions",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagExpert = "expert"
+
+// GetTextualPreviewCommand returns the command printing the SIGN_MODE_TEXTUAL
+// screens of an unsigned transaction, as displayed by a hardware wallet.
+func GetTextualPreviewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-textual <path/to/unsigned-tx.json> --from <keyname>",
+		Short: "Preview the SIGN_MODE_TEXTUAL screens of a transaction",
+		Long: strings.TrimSpace(`Print the screens a signer would review on a hardware wallet when signing
+the transaction with SIGN_MODE_TEXTUAL, one screen per line.
+
+The user must provide the path to a JSON-encoded unsigned transaction, typically
+generated by any transaction command with the --generate-only flag. The signer
+account number and sequence are queried unless the --offline flag is set, in which
+case they must be provided with the --account-number and --sequence flags.
+
+Indented screens are prefixed with one '>' per indentation level. Expert screens
+are prefixed with '*' and are only printed with the --expert flag.
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			handler, err := clientCtx.TxConfig.SignModeHandler().GetHandler(apisigning.SignMode_SIGN_MODE_TEXTUAL)
+			if err != nil {
+				return err
+			}
+			textualHandler, ok := handler.(*textual.SignModeHandler)
+			if !ok {
+				return fmt.Errorf("expected textual sign mode handler, got %T", handler)
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+
+			k, err := txf.Keybase().Key(clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			addressStr, err := clientCtx.AddressCodec.BytesToString(pubKey.Address())
+			if err != nil {
+				return err
+			}
+
+			// the signer infos are part of the screens, set them as when signing
+			err = txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL},
+				Sequence: txf.Sequence(),
+			})
+			if err != nil {
+				return err
+			}
+
+			adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+			if !ok {
+				return errors.New("transaction cannot be rendered with SIGN_MODE_TEXTUAL")
+			}
+
+			signerData, err := authsigning.InternalSignerDataToAPI(authsigning.SignerData{
+				ChainID:       txf.ChainID(),
+				AccountNumber: txf.AccountNumber(),
+				Sequence:      txf.Sequence(),
+				PubKey:        pubKey,
+				Address:       addressStr,
+			})
+			if err != nil {
+				return err
+			}
+
+			screens, err := textualHandler.GetScreens(cmd.Context(), signerData, adaptableTx.GetSigningTxData())
+			if err != nil {
+				return err
+			}
+
+			expert, _ := cmd.Flags().GetBool(flagExpert)
+			return clientCtx.PrintString(formatScreens(screens, expert))
+		},
+	}
+
+	cmd.Flags().Bool(flagExpert, false, "Print the expert screens")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// formatScreens returns the textual representation of the screens.
+func formatScreens(screens []textual.Screen, expert bool) string {
+	var sb strings.Builder
+	for _, screen := range screens {
+		if screen.Expert && !expert {
+			continue
+		}
+
+		if screen.Expert {
+			sb.WriteString("*")
+		}
+		sb.WriteString(strings.Repeat(">", screen.Indent))
+		if screen.Indent > 0 {
+			sb.WriteString(" ")
+		}
+		if screen.Title != "" {
+			sb.WriteString(screen.Title)
+			sb.WriteString(": ")
+		}
+		sb.WriteString(screen.Content)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,84 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestGetTextualPreviewCommand(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	cdc := encodingConfig.Codec
+	signingCtx := encodingConfig.InterfaceRegistry.SigningContext()
+
+	txConfig, err := authtx.NewTxConfigWithOptions(cdc, authtx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: func(_ context.Context, _ string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+		SigningOptions: &txsigning.Options{
+			AddressCodec:          signingCtx.AddressCodec(),
+			ValidatorAddressCodec: signingCtx.ValidatorAddressCodec(),
+		},
+	})
+	require.NoError(t, err)
+
+	kr := keyring.NewInMemory(cdc)
+	_, _, err = kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	builder.SetMemo("foomemo")
+	jsonEncoded, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFile := testutil.WriteToNewTempFile(t, string(jsonEncoded))
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithCodec(cdc).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithAddressCodec(signingCtx.AddressCodec()).
+		WithKeyring(kr)
+
+	args := []string{
+		txFile.Name(),
+		"--" + flags.FlagFrom, "signer",
+		"--" + flags.FlagOffline,
+		"--" + flags.FlagChainID, "test-chain",
+		"--" + flags.FlagAccountNumber, "1",
+		"--" + flags.FlagSequence, "2",
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetTextualPreviewCommand(), args)
+	require.NoError(t, err)
+
+	screens := out.String()
+	require.Contains(t, screens, "Chain id: test-chain\n")
+	require.Contains(t, screens, "Memo: foomemo\n")
+	require.NotContains(t, screens, "*")
+
+	// the expert screens are printed on demand
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetTextualPreviewCommand(), append(args, "--expert"))
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "*Gas limit: 50'000\n")
+}
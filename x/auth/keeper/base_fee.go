@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetBaseFee returns the current base fee, in the base fee denom per unit of gas.
+// It defaults to the minimum base fee until the base fee is first adjusted.
+func (ak AccountKeeper) GetBaseFee(ctx context.Context) (sdkmath.LegacyDec, error) {
+	baseFee, err := ak.BaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ak.GetParams(ctx).MinBaseFee, nil
+	}
+
+	return baseFee, err
+}
+
+// AddBlockGasWanted adds the gas limit of a transaction to the gas wanted by the current block.
+// The gas wanted is used rather than the gas consumed as fees, including the base fee, are paid
+// on the gas limit, which is also what the block gas limit and proposals account for.
+func (ak AccountKeeper) AddBlockGasWanted(ctx context.Context, gas uint64) error {
+	blockGasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if blockGasWanted > math.MaxUint64-gas {
+		blockGasWanted = math.MaxUint64
+	} else {
+		blockGasWanted += gas
+	}
+
+	return ak.BlockGasWanted.Set(ctx, blockGasWanted)
+}
+
+// UpdateBaseFee adjusts the base fee at the end of a block, following EIP-1559:
+// the base fee increases when the gas wanted by the block is above the target, and
+// decreases when it is below, by at most 1/BaseFeeChangeDenominator of its value.
+// The base fee never goes below the minimum base fee.
+func (ak AccountKeeper) UpdateBaseFee(ctx context.Context) error {
+	blockGasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := ak.BlockGasWanted.Remove(ctx); err != nil {
+		return err
+	}
+
+	params := ak.GetParams(ctx)
+	if !params.BaseFeeEnabled() {
+		return nil
+	}
+
+	baseFee, err := ak.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ak.BaseFee.Set(ctx, nextBaseFee(params, baseFee, blockGasWanted))
+}
+
+// nextBaseFee returns the base fee following a block of the given gas wanted.
+func nextBaseFee(params types.Params, baseFee sdkmath.LegacyDec, blockGasWanted uint64) sdkmath.LegacyDec {
+	target := sdkmath.NewIntFromUint64(params.BaseFeeTargetBlockGas)
+	// the gas wanted by a block can be much larger than the target, the change is bounded
+	// as with EIP-1559 where the block gas limit is twice the target
+	gasDelta := sdkmath.MinInt(sdkmath.NewIntFromUint64(blockGasWanted).Sub(target), target)
+
+	// baseFee * (blockGasWanted - target) / target / denominator
+	delta := baseFee.MulInt(gasDelta).QuoInt(target).QuoInt(sdkmath.NewIntFromUint64(params.BaseFeeChangeDenominator))
+
+	return sdkmath.LegacyMaxDec(baseFee.Add(delta), params.MinBaseFee)
+}
+
+// validateBaseFeeParams checks the base fee parameters against the state of the module.
+func (ak AccountKeeper) validateBaseFeeParams(params types.Params) error {
+	if !params.BaseFeeBurnRatio.IsNil() && params.BaseFeeBurnRatio.IsPositive() && !ak.permAddrs[types.FeeCollectorName].HasPermission(types.Burner) {
+		return fmt.Errorf("the %s module account must have the %s permission to burn base fees", types.FeeCollectorName, types.Burner)
+	}
+
+	return nil
+}
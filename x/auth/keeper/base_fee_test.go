@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	ctx, ak := suite.ctx, suite.accountKeeper
+	require := suite.Require()
+
+	params := types.DefaultParams()
+	params.BaseFeeTargetBlockGas = 1000
+	params.MinBaseFee = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(ak.Params.Set(ctx, params))
+
+	// the base fee starts at the minimum base fee
+	baseFee, err := ak.GetBaseFee(ctx)
+	require.NoError(err)
+	require.Equal(params.MinBaseFee, baseFee)
+	require.NoError(ak.BaseFee.Set(ctx, math.LegacyOneDec()))
+
+	testCases := []struct {
+		name           string
+		blockGasWanted uint64
+		expBaseFee     math.LegacyDec
+	}{
+		{"gas at target", 1000, math.LegacyOneDec()},
+		{"gas above target", 1500, math.LegacyMustNewDecFromStr("1.0625")},
+		{"gas increase is bounded", 10000, math.LegacyMustNewDecFromStr("1.1953125")},
+		{"gas below target", 500, math.LegacyMustNewDecFromStr("1.12060546875")},
+		{"empty block", 0, math.LegacyMustNewDecFromStr("0.98052978515625")},
+	}
+
+	for _, tc := range testCases {
+		require.NoError(ak.AddBlockGasWanted(ctx, tc.blockGasWanted), tc.name)
+		require.NoError(ak.UpdateBaseFee(ctx), tc.name)
+
+		baseFee, err := ak.GetBaseFee(ctx)
+		require.NoError(err, tc.name)
+		require.Equal(tc.expBaseFee, baseFee, tc.name)
+
+		has, err := ak.BlockGasWanted.Has(ctx)
+		require.NoError(err)
+		require.False(has, tc.name)
+	}
+
+	// the base fee does not go below the minimum
+	for i := 0; i < 10; i++ {
+		require.NoError(ak.UpdateBaseFee(ctx))
+	}
+	baseFee, err = ak.GetBaseFee(ctx)
+	require.NoError(err)
+	require.Equal(params.MinBaseFee, baseFee)
+
+	res, err := suite.queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoinFromDec(params.BaseFeeDenom, params.MinBaseFee), res.BaseFee)
+	require.Equal(params.BaseFeeTargetBlockGas, res.TargetBlockGas)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsBaseFeeBurn() {
+	params := types.DefaultParams()
+	params.BaseFeeTargetBlockGas = 1000
+	params.MinBaseFee = math.LegacyOneDec()
+	params.BaseFeeBurnRatio = math.LegacyNewDecWithPrec(5, 1)
+
+	// the fee collector of the test keeper cannot burn coins
+	_, err := suite.msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.accountKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().ErrorContains(err, "must have the burner permission")
+
+	params.BaseFeeBurnRatio = math.LegacyZeroDec()
+	params.BaseFeeProtocolPoolRatio = math.LegacyNewDecWithPrec(5, 1)
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.accountKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -13,10 +15,21 @@ import (
 // CONTRACT: old coins from the FeeCollectionKeeper need to be transferred through
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState) error {
+	if err := ak.validateBaseFeeParams(data.Params); err != nil {
+		return err
+	}
+
 	if err := ak.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
 
+	// genesis files predating the base fee do not set it
+	if !data.BaseFee.IsNil() {
+		if err := ak.BaseFee.Set(ctx, math.LegacyMaxDec(data.BaseFee, data.Params.MinBaseFee)); err != nil {
+			return err
+		}
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
@@ -45,8 +58,13 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	params := ak.GetParams(ctx)
 
+	baseFee, err := ak.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var genAccounts types.GenesisAccounts
-	err := ak.Accounts.Walk(ctx, nil, func(key sdk.AccAddress, value sdk.AccountI) (stop bool, err error) {
+	err = ak.Accounts.Walk(ctx, nil, func(key sdk.AccAddress, value sdk.AccountI) (stop bool, err error) {
 		genAcc, ok := value.(types.GenesisAccount)
 		if !ok {
 			return true, fmt.Errorf("unable to convert account with address %s into a genesis account: type %T", key, value)
@@ -54,5 +72,7 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.BaseFee = baseFee
+	return genState, err
 }
@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -279,3 +281,25 @@ func (s queryServer) getFromXAccounts(ctx context.Context, address []byte) (*typ
 	}
 	return typedResp, nil
 }
+
+// BaseFee returns the current base fee.
+func (s queryServer) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params := s.k.GetParams(ctx)
+	baseFee := math.LegacyZeroDec()
+	if params.BaseFeeEnabled() {
+		var err error
+		baseFee, err = s.k.GetBaseFee(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee:        sdk.NewDecCoinFromDec(params.BaseFeeDenom, baseFee),
+		TargetBlockGas: params.BaseFeeTargetBlockGas,
+	}, nil
+}
@@ -90,6 +90,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		"cosmos",
 		types.NewModuleAddress("gov").String(),
 	)
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
 	suite.msgServer = keeper.NewMsgServerImpl(suite.accountKeeper)
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.encCfg.InterfaceRegistry)
 	types.RegisterQueryServer(queryHelper, keeper.NewQueryServer(suite.accountKeeper))
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v5 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v6"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -44,6 +45,12 @@ func (m Migrator) Migrate4To5(ctx context.Context) error {
 	return v5.Migrate(ctx, m.keeper.KVStoreService, m.keeper.accountNumber)
 }
 
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It sets the base fee parameters to their default values.
+func (m Migrator) Migrate5To6(ctx context.Context) error {
+	return v6.Migrate(ctx, m.keeper.Params)
+}
+
 // V45SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
@@ -69,6 +69,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	if err := ms.ak.validateBaseFeeParams(msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := ms.ak.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -107,13 +107,7 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			name: "valid transaction",
 			req: &types.MsgUpdateParams{
 				Authority: s.accountKeeper.GetAuthority(),
-				Params: types.Params{
-					MaxMemoCharacters:      140,
-					TxSigLimit:             9,
-					TxSizeCostPerByte:      5,
-					SigVerifyCostED25519:   694,
-					SigVerifyCostSecp256k1: 511,
-				},
+				Params:    types.NewParams(140, 9, 5, 694, 511),
 			},
 			expectErr: false,
 		},
@@ -0,0 +1,28 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate sets the base fee parameters, introduced in this version, to their
+// default values. The base fee is disabled by default.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.BaseFeeTargetBlockGas = types.DefaultBaseFeeTargetBlockGas
+	p.BaseFeeChangeDenominator = types.DefaultBaseFeeChangeDenom
+	p.BaseFeeDenom = types.DefaultBaseFeeDenom
+	p.MinBaseFee = math.LegacyZeroDec()
+	p.BaseFeeBurnRatio = math.LegacyZeroDec()
+	p.BaseFeeProtocolPoolRatio = math.LegacyZeroDec()
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,44 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	v6 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := coretesting.Context()
+	kv := coretesting.KVStoreService(ctx, "test")
+	sb := collections.NewSchemaBuilder(kv)
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// params stored before the base fee was introduced
+	oldParams := types.Params{
+		MaxMemoCharacters:      100,
+		TxSigLimit:             types.DefaultTxSigLimit,
+		TxSizeCostPerByte:      types.DefaultTxSizeCostPerByte,
+		SigVerifyCostED25519:   types.DefaultSigVerifyCostED25519,
+		SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+	}
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v6.Migrate(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, got.Validate())
+	require.Equal(t, uint64(100), got.MaxMemoCharacters)
+	require.False(t, got.BaseFeeEnabled())
+	require.Equal(t, types.DefaultBaseFeeDenom, got.BaseFeeDenom)
+	require.Equal(t, math.LegacyZeroDec(), got.MinBaseFee)
+}
@@ -41,11 +41,22 @@ func GetSignBytesAdapter(
 		return nil, err
 	}
 
+	txSignerData, err := InternalSignerDataToAPI(signerData)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate the bytes to be signed.
+	return handlerMap.GetSignBytes(ctx, txSignMode, txSignerData, txData)
+}
+
+// InternalSignerDataToAPI converts the signer data of x/auth/signing to the signer data expected by x/tx/signing.
+func InternalSignerDataToAPI(signerData SignerData) (txsigning.SignerData, error) {
 	var pubKey *anypb.Any
 	if signerData.PubKey != nil {
 		anyPk, err := codectypes.NewAnyWithValue(signerData.PubKey)
 		if err != nil {
-			return nil, err
+			return txsigning.SignerData{}, err
 		}
 
 		pubKey = &anypb.Any{
@@ -53,13 +64,12 @@ func GetSignBytesAdapter(
 			Value:   anyPk.Value,
 		}
 	}
-	txSignerData := txsigning.SignerData{
+
+	return txsigning.SignerData{
 		ChainID:       signerData.ChainID,
 		AccountNumber: signerData.AccountNumber,
 		Sequence:      signerData.Sequence,
 		Address:       signerData.Address,
 		PubKey:        pubKey,
-	}
-	// Generate the bytes to be signed.
-	return handlerMap.GetSignBytes(ctx, txSignMode, txSignerData, txData)
+	}, nil
 }
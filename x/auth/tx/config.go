@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"cosmossdk.io/core/address"
 	txdecode "cosmossdk.io/x/tx/decode"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -47,6 +49,12 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// TextualMessageRenderers are the custom value renderers of messages, keyed by message full name, that will be
+	// registered in the textual sign mode handler.
+	TextualMessageRenderers map[protoreflect.FullName]textual.ValueRenderer
+	// TextualFieldRenderers are the custom value renderers of message fields, keyed by field full name, that will be
+	// registered in the textual sign mode handler.
+	TextualFieldRenderers map[protoreflect.FullName]textual.ValueRenderer
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -147,6 +155,8 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
 				FileResolver:        signingOpts.FileResolver,
 				TypeResolver:        signingOpts.TypeResolver,
+				MessageRenderers:    configOpts.TextualMessageRenderers,
+				FieldRenderers:      configOpts.TextualFieldRenderers,
 			})
 			if configOpts.TextualCoinMetadataQueryFn == nil {
 				return nil, errors.New("cannot enable SIGN_MODE_TEXTUAL without a TextualCoinMetadataQueryFn")
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -172,6 +173,23 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// base_fee_target_block_gas is the block gas, i.e. the sum of the gas limits of
+	// the transactions of a block, the base fee adjusts towards. Zero disables the
+	// base fee.
+	BaseFeeTargetBlockGas uint64 `protobuf:"varint,6,opt,name=base_fee_target_block_gas,json=baseFeeTargetBlockGas,proto3" json:"base_fee_target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks to 1/base_fee_change_denominator of its value.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,7,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// base_fee_denom is the denom of the base fee.
+	BaseFeeDenom string `protobuf:"bytes,8,opt,name=base_fee_denom,json=baseFeeDenom,proto3" json:"base_fee_denom,omitempty"`
+	// min_base_fee is the minimum base fee, in base_fee_denom per unit of gas.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// base_fee_burn_ratio is the ratio of the base fee paid by the transactions
+	// which is burned.
+	BaseFeeBurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_ratio"`
+	// base_fee_protocol_pool_ratio is the ratio of the base fee paid by the
+	// transactions which is sent to x/protocolpool.
+	BaseFeeProtocolPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=base_fee_protocol_pool_ratio,json=baseFeeProtocolPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_protocol_pool_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,6 +260,27 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeTargetBlockGas() uint64 {
+	if m != nil {
+		return m.BaseFeeTargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeDenom() string {
+	if m != nil {
+		return m.BaseFeeDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -252,54 +291,66 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0xd0, 0xa5, 0x93, 0x6e, 0x97, 0x7a, 0x43, 0xf1, 0x46, 0x28, 0xf6, 0x46, 0x42,
-	0x1b, 0x2a, 0x62, 0x6f, 0x02, 0x05, 0x35, 0xb7, 0x26, 0x20, 0x54, 0x95, 0x96, 0xca, 0x11, 0x3d,
-	0xf4, 0x62, 0x8d, 0x9d, 0xa9, 0x3b, 0x6a, 0xc6, 0x63, 0x3c, 0xe3, 0x2a, 0xee, 0x2f, 0xa8, 0x38,
-	0x21, 0x2e, 0x5c, 0x0b, 0xbf, 0xa0, 0x87, 0xfe, 0x08, 0xc4, 0xa9, 0xea, 0x05, 0x4e, 0x11, 0x4a,
-	0x0f, 0xad, 0x10, 0x3f, 0x02, 0x79, 0xc6, 0x69, 0x92, 0x6e, 0x2e, 0x91, 0xdf, 0xf7, 0x7d, 0xef,
-	0xbd, 0xef, 0xbd, 0x3c, 0x1b, 0x54, 0x3d, 0xca, 0x08, 0x65, 0x16, 0x8c, 0xf9, 0x89, 0x75, 0xd6,
-	0x74, 0x11, 0x87, 0x4d, 0x11, 0x98, 0x61, 0x44, 0x39, 0x55, 0x5f, 0x4a, 0xde, 0x14, 0x50, 0xc6,
-	0x57, 0xd6, 0x20, 0xc1, 0x01, 0xb5, 0xc4, 0xaf, 0xd4, 0x55, 0x5e, 0x49, 0x9d, 0x23, 0x22, 0x2b,
-	0x4b, 0x92, 0x54, 0xd9, 0xa7, 0x3e, 0x95, 0x78, 0xfa, 0x34, 0x49, 0xf0, 0x29, 0xf5, 0x07, 0xc8,
-	0x12, 0x91, 0x1b, 0x1f, 0x5b, 0x30, 0x48, 0x24, 0x55, 0xfb, 0x2d, 0x0f, 0x4a, 0x1d, 0xc8, 0xd0,
-	0xb6, 0xe7, 0xd1, 0x38, 0xe0, 0x6a, 0x0b, 0x3c, 0x83, 0xfd, 0x7e, 0x84, 0x18, 0xd3, 0x14, 0x43,
-	0xa9, 0x2f, 0x77, 0xb4, 0xdb, 0xeb, 0x46, 0x39, 0xeb, 0xb1, 0x2d, 0x99, 0x1e, 0x8f, 0x70, 0xe0,
-	0xdb, 0x13, 0xa1, 0x7a, 0x08, 0x9e, 0x85, 0xb1, 0xeb, 0x9c, 0xa2, 0x44, 0xcb, 0x1b, 0x4a, 0xbd,
-	0xd4, 0x2a, 0x9b, 0xb2, 0xa1, 0x39, 0x69, 0x68, 0x6e, 0x07, 0x49, 0xe7, 0xcd, 0xbf, 0x23, 0xbd,
-	0x1c, 0xc6, 0xee, 0x00, 0x7b, 0xa9, 0xf6, 0x33, 0x4a, 0x30, 0x47, 0x24, 0xe4, 0xc9, 0xef, 0xf7,
-	0x57, 0x1b, 0x60, 0x4a, 0xd8, 0x4b, 0x61, 0xec, 0xee, 0xa2, 0x44, 0xfd, 0x04, 0xac, 0x42, 0x69,
-	0xcb, 0x09, 0x62, 0xe2, 0xa2, 0x48, 0x2b, 0x18, 0x4a, 0xbd, 0x68, 0x3f, 0xcf, 0xd0, 0x7d, 0x01,
-	0xaa, 0x15, 0xf0, 0x3e, 0x43, 0x3f, 0xc6, 0x28, 0xf0, 0x90, 0x56, 0x14, 0x82, 0xc7, 0xb8, 0xdd,
-	0xbd, 0xb8, 0xd4, 0x73, 0x0f, 0x97, 0x7a, 0xee, 0xcf, 0xeb, 0xc6, 0xc7, 0x0b, 0xd6, 0x6b, 0x66,
-	0x73, 0xef, 0xfc, 0x74, 0x7f, 0xb5, 0xb1, 0x2e, 0x05, 0x0d, 0xd6, 0x3f, 0xb5, 0x66, 0x76, 0x52,
-	0xfb, 0x4f, 0x01, 0xcf, 0xf7, 0x68, 0x3f, 0x1e, 0x3c, 0x6e, 0x69, 0x07, 0xac, 0xb8, 0x90, 0x21,
-	0x27, 0x33, 0x22, 0x56, 0x55, 0x6a, 0x19, 0xe6, 0xa2, 0x0e, 0x33, 0x95, 0x3a, 0xc5, 0x9b, 0x91,
-	0xae, 0xd8, 0x25, 0x77, 0x66, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xb1, 0xb9, 0x65, 0x5b, 0x3c,
-	0xab, 0x06, 0x28, 0x85, 0x28, 0x22, 0x98, 0x31, 0x4c, 0x03, 0xa6, 0x15, 0x8c, 0x42, 0x7d, 0xd9,
-	0x9e, 0x85, 0xda, 0x47, 0x17, 0x72, 0xa6, 0xda, 0xa2, 0x8e, 0x73, 0x5e, 0xc5, 0x64, 0xda, 0xcc,
-	0x64, 0x73, 0xec, 0x2f, 0xf7, 0x57, 0x1b, 0xab, 0x44, 0x20, 0x93, 0x61, 0x6a, 0xbf, 0x2a, 0xe0,
-	0x03, 0x29, 0xea, 0x46, 0xa8, 0x8f, 0x02, 0x8e, 0xe1, 0x40, 0xd5, 0x41, 0x29, 0x93, 0x09, 0xb7,
-	0xe2, 0x36, 0x6c, 0x20, 0xa1, 0xfd, 0xd4, 0xf3, 0x1b, 0xf0, 0xa2, 0x8f, 0x22, 0x7c, 0x06, 0x39,
-	0xa6, 0x41, 0xfa, 0x37, 0x32, 0x2d, 0x6f, 0x14, 0xea, 0x2b, 0xf6, 0xea, 0x14, 0xde, 0x45, 0x09,
-	0x6b, 0x6f, 0xdd, 0x5e, 0x37, 0x5e, 0x4c, 0xfd, 0x18, 0x6f, 0xcd, 0x2f, 0xbe, 0x4a, 0x3d, 0xbe,
-	0x9e, 0xf1, 0xf8, 0x6d, 0x44, 0xe3, 0x30, 0xb3, 0x38, 0x35, 0x51, 0xfb, 0x2b, 0x0f, 0x96, 0x0e,
-	0x60, 0x04, 0x09, 0x53, 0x4d, 0xf0, 0x92, 0xc0, 0xa1, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x02, 0x23,
-	0xe8, 0x71, 0x14, 0xc9, 0x9b, 0x2d, 0xda, 0x6b, 0x04, 0x0e, 0xf7, 0x10, 0xa1, 0xdd, 0x47, 0x42,
-	0x35, 0xc0, 0x0a, 0x1f, 0x3a, 0x0c, 0xfb, 0xce, 0x00, 0x13, 0xcc, 0xc5, 0xba, 0x8b, 0x36, 0xe0,
-	0xc3, 0x1e, 0xf6, 0xbf, 0x4b, 0x11, 0xf5, 0x2d, 0xf8, 0x50, 0x28, 0xce, 0x91, 0xe3, 0x51, 0xc6,
-	0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70, 0x94, 0x1d, 0xdd, 0x5a, 0x2a, 0x3d, 0x47, 0x5d, 0xca, 0xf8,
-	0x01, 0x8a, 0x3a, 0x09, 0x47, 0xea, 0xf7, 0xe0, 0xa3, 0xb4, 0xe0, 0x19, 0x8a, 0xf0, 0x71, 0x22,
-	0x93, 0x50, 0xbf, 0xb5, 0xb9, 0xd9, 0xdc, 0x92, 0x77, 0xd8, 0xd1, 0xc6, 0x23, 0xbd, 0xdc, 0xc3,
-	0xfe, 0xa1, 0x50, 0xa4, 0xa9, 0xdf, 0x7c, 0x2d, 0x78, 0xbb, 0xcc, 0xe6, 0x50, 0x99, 0xa5, 0xfe,
-	0x00, 0x5e, 0x3d, 0x2d, 0xc8, 0x90, 0x17, 0xb6, 0x36, 0xbf, 0x3c, 0x6d, 0x6a, 0xef, 0x89, 0x92,
-	0x95, 0xf1, 0x48, 0x5f, 0x9f, 0x2b, 0xd9, 0x9b, 0x28, 0xec, 0x75, 0xb6, 0x10, 0x6f, 0xbf, 0x7e,
-	0xb8, 0xd4, 0x95, 0xa7, 0x67, 0x30, 0x94, 0x9f, 0x21, 0xb9, 0xce, 0x4e, 0xf7, 0x8f, 0x71, 0x55,
-	0xb9, 0x19, 0x57, 0x95, 0x7f, 0xc6, 0x55, 0xe5, 0xe7, 0xbb, 0x6a, 0xee, 0xe6, 0xae, 0x9a, 0xfb,
-	0xfb, 0xae, 0x9a, 0x3b, 0xfa, 0xd4, 0xc7, 0xfc, 0x24, 0x76, 0x4d, 0x8f, 0x92, 0xec, 0x53, 0x63,
-	0xbd, 0x5b, 0x85, 0x27, 0x21, 0x62, 0xee, 0x92, 0x78, 0xdd, 0x3f, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x05, 0x77, 0x9c, 0xe8, 0x04, 0x00, 0x00,
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x63, 0xd5, 0x8e, 0x57, 0x8e, 0x13, 0xd3, 0x8a, 0x4b, 0x3b, 0x81, 0xa8, 0x18, 0x2d,
+	0xe2, 0x1a, 0x15, 0x15, 0xab, 0x75, 0xda, 0x18, 0xe8, 0xc1, 0x94, 0xdb, 0x20, 0xc8, 0x47, 0x0d,
+	0xba, 0x0d, 0x8a, 0x5c, 0x88, 0x25, 0x35, 0xa6, 0x16, 0xd2, 0x72, 0x59, 0xee, 0xd2, 0x10, 0xf3,
+	0x0b, 0x82, 0x9e, 0x8a, 0x5e, 0x7a, 0x75, 0x7b, 0xea, 0xad, 0x3e, 0xf8, 0x47, 0x04, 0x3d, 0x19,
+	0x3e, 0x15, 0x3d, 0x08, 0x85, 0x7c, 0x70, 0x50, 0xf4, 0x47, 0x14, 0xdc, 0xa5, 0x3e, 0x9c, 0xfa,
+	0x96, 0x8b, 0xc0, 0x9d, 0xf7, 0x66, 0xde, 0x9b, 0xd1, 0x2c, 0x89, 0x2a, 0x3e, 0xe3, 0x94, 0xf1,
+	0x3a, 0x4e, 0x44, 0xbb, 0x7e, 0xb0, 0xe1, 0x81, 0xc0, 0x1b, 0xf2, 0x60, 0x45, 0x31, 0x13, 0x4c,
+	0x5f, 0x54, 0xb8, 0x25, 0x43, 0x39, 0xbe, 0xb2, 0x80, 0x29, 0x09, 0x59, 0x5d, 0xfe, 0x2a, 0xde,
+	0xca, 0xb2, 0xe2, 0xb9, 0xf2, 0x54, 0xcf, 0x93, 0x14, 0x54, 0x0e, 0x58, 0xc0, 0x54, 0x3c, 0x7b,
+	0x1a, 0x26, 0x04, 0x8c, 0x05, 0x5d, 0xa8, 0xcb, 0x93, 0x97, 0xec, 0xd7, 0x71, 0x98, 0x2a, 0x68,
+	0xf5, 0x97, 0x2b, 0xa8, 0x64, 0x63, 0x0e, 0xdb, 0xbe, 0xcf, 0x92, 0x50, 0xe8, 0x0d, 0x34, 0x83,
+	0x5b, 0xad, 0x18, 0x38, 0x37, 0xb4, 0xaa, 0xb6, 0x36, 0x6b, 0x1b, 0xa7, 0xc7, 0xb5, 0x72, 0xae,
+	0xb1, 0xad, 0x90, 0x3d, 0x11, 0x93, 0x30, 0x70, 0x86, 0x44, 0xfd, 0x39, 0x9a, 0x89, 0x12, 0xcf,
+	0xed, 0x40, 0x6a, 0x5c, 0xa9, 0x6a, 0x6b, 0xa5, 0x46, 0xd9, 0x52, 0x82, 0xd6, 0x50, 0xd0, 0xda,
+	0x0e, 0x53, 0xfb, 0xee, 0x3f, 0x7d, 0xb3, 0x1c, 0x25, 0x5e, 0x97, 0xf8, 0x19, 0xf7, 0x63, 0x46,
+	0x89, 0x00, 0x1a, 0x89, 0xf4, 0xd7, 0xf3, 0xa3, 0x75, 0x34, 0x06, 0x9c, 0xe9, 0x28, 0xf1, 0x1e,
+	0x43, 0xaa, 0x7f, 0x88, 0xe6, 0xb1, 0xb2, 0xe5, 0x86, 0x09, 0xf5, 0x20, 0x36, 0xa6, 0xaa, 0xda,
+	0x5a, 0xd1, 0xb9, 0x96, 0x47, 0x9f, 0xc9, 0xa0, 0xbe, 0x82, 0xae, 0x72, 0xf8, 0x3e, 0x81, 0xd0,
+	0x07, 0xa3, 0x28, 0x09, 0xa3, 0xf3, 0x56, 0xf3, 0xd5, 0xa1, 0x59, 0x78, 0x73, 0x68, 0x16, 0xfe,
+	0x38, 0xae, 0xdd, 0xbe, 0x64, 0xbc, 0x56, 0xde, 0xf7, 0xa3, 0x1f, 0xce, 0x8f, 0xd6, 0x97, 0x14,
+	0xa1, 0xc6, 0x5b, 0x9d, 0xfa, 0xc4, 0x4c, 0x56, 0xff, 0xd5, 0xd0, 0xb5, 0xa7, 0xac, 0x95, 0x74,
+	0x47, 0x53, 0x7a, 0x84, 0xe6, 0x3c, 0xcc, 0xc1, 0xcd, 0x8d, 0xc8, 0x51, 0x95, 0x1a, 0x55, 0xeb,
+	0x32, 0x85, 0x89, 0x4a, 0x76, 0xf1, 0xa4, 0x6f, 0x6a, 0x4e, 0xc9, 0x9b, 0x18, 0xb8, 0x8e, 0x8a,
+	0x21, 0xa6, 0x20, 0x27, 0x37, 0xeb, 0xc8, 0x67, 0xbd, 0x8a, 0x4a, 0x11, 0xc4, 0x94, 0x70, 0x4e,
+	0x58, 0xc8, 0x8d, 0xa9, 0xea, 0xd4, 0xda, 0xac, 0x33, 0x19, 0xda, 0x7a, 0xf1, 0x4a, 0xf5, 0xb4,
+	0x7a, 0x99, 0xe2, 0x05, 0xaf, 0xb2, 0x33, 0x63, 0xa2, 0xb3, 0x0b, 0xe8, 0x4f, 0xe7, 0x47, 0xeb,
+	0xf3, 0x54, 0x46, 0x86, 0xcd, 0xac, 0xfe, 0xac, 0xa1, 0x1b, 0x8a, 0xd4, 0x8c, 0xa1, 0x05, 0xa1,
+	0x20, 0xb8, 0xab, 0x9b, 0xa8, 0x94, 0xd3, 0xa4, 0x5b, 0xb9, 0x1b, 0x0e, 0x52, 0xa1, 0x67, 0x99,
+	0xe7, 0xbb, 0xe8, 0x7a, 0x0b, 0x62, 0x72, 0x80, 0x05, 0x61, 0x61, 0xf6, 0x37, 0x72, 0xe3, 0x4a,
+	0x75, 0x6a, 0x6d, 0xce, 0x99, 0x1f, 0x87, 0x1f, 0x43, 0xca, 0xb7, 0x1e, 0x9c, 0x1e, 0xd7, 0xae,
+	0x8f, 0xfd, 0x54, 0xef, 0x59, 0x9f, 0x7e, 0x96, 0x79, 0xbc, 0x33, 0xe1, 0xf1, 0x61, 0xcc, 0x92,
+	0x28, 0xb7, 0x38, 0x36, 0xb1, 0xfa, 0xfb, 0x34, 0x9a, 0xde, 0xc5, 0x31, 0xa6, 0x5c, 0xb7, 0xd0,
+	0x22, 0xc5, 0x3d, 0x97, 0x02, 0x65, 0xae, 0xdf, 0xc6, 0x31, 0xf6, 0x05, 0xc4, 0x6a, 0x67, 0x8b,
+	0xce, 0x02, 0xc5, 0xbd, 0xa7, 0x40, 0x59, 0x73, 0x04, 0xe8, 0x55, 0x34, 0x27, 0x7a, 0x2e, 0x27,
+	0x81, 0xdb, 0x25, 0x94, 0x08, 0x39, 0xee, 0xa2, 0x83, 0x44, 0x6f, 0x8f, 0x04, 0x4f, 0xb2, 0x88,
+	0x7e, 0x0f, 0xdd, 0x94, 0x8c, 0x97, 0xe0, 0xfa, 0x8c, 0x0b, 0x37, 0x82, 0xd8, 0xf5, 0x52, 0x01,
+	0xf9, 0xd2, 0x2d, 0x64, 0xd4, 0x97, 0xd0, 0x64, 0x5c, 0xec, 0x42, 0x6c, 0xa7, 0x02, 0xf4, 0xaf,
+	0xd1, 0xfb, 0x59, 0xc1, 0x03, 0x88, 0xc9, 0x7e, 0xaa, 0x92, 0xa0, 0xd5, 0xd8, 0xdc, 0xdc, 0x78,
+	0xa0, 0xf6, 0xd0, 0x36, 0x06, 0x7d, 0xb3, 0xbc, 0x47, 0x82, 0xe7, 0x92, 0x91, 0xa5, 0x7e, 0xb9,
+	0x23, 0x71, 0xa7, 0xcc, 0x2f, 0x44, 0x55, 0x96, 0xfe, 0x2d, 0x5a, 0x7e, 0xbb, 0x20, 0x07, 0x3f,
+	0x6a, 0x6c, 0xde, 0xef, 0x6c, 0x18, 0xef, 0xc9, 0x92, 0x2b, 0x83, 0xbe, 0xb9, 0x74, 0xa1, 0xe4,
+	0xde, 0x90, 0xe1, 0x2c, 0xf1, 0x4b, 0xe3, 0xfa, 0xe7, 0x68, 0x59, 0x6e, 0xeb, 0x3e, 0x80, 0x2b,
+	0x70, 0x1c, 0x80, 0x70, 0xbd, 0x2e, 0xf3, 0x3b, 0x6e, 0x80, 0xb9, 0x31, 0x2d, 0xbb, 0xbb, 0x99,
+	0x11, 0xbe, 0x02, 0xf8, 0x46, 0xc2, 0x76, 0x86, 0x3e, 0xc4, 0x5c, 0xff, 0x02, 0xdd, 0x1a, 0x65,
+	0xfa, 0x6d, 0x1c, 0x06, 0xe0, 0xb6, 0x20, 0x64, 0x94, 0x84, 0x58, 0xb0, 0xd8, 0x98, 0x91, 0xb9,
+	0x46, 0x9e, 0xdb, 0x94, 0x84, 0x9d, 0x31, 0xae, 0x7f, 0x80, 0xe6, 0x47, 0xe9, 0x32, 0xcf, 0xb8,
+	0x2a, 0xf7, 0x66, 0x2e, 0xcf, 0x90, 0x5c, 0xfd, 0x3b, 0x34, 0x47, 0x49, 0xe8, 0x0e, 0x99, 0xc6,
+	0xac, 0x7c, 0xef, 0xdc, 0x7f, 0xdd, 0x37, 0x0b, 0x7f, 0xf5, 0xcd, 0x5b, 0x6a, 0x2d, 0x78, 0xab,
+	0x63, 0x11, 0x56, 0xa7, 0x58, 0xb4, 0xad, 0x27, 0x10, 0x60, 0x3f, 0xdd, 0x01, 0xff, 0xf4, 0xb8,
+	0x86, 0xf2, 0x0b, 0xb0, 0x03, 0xfe, 0x6f, 0xe7, 0x47, 0xeb, 0x9a, 0x83, 0x28, 0x09, 0x6d, 0x55,
+	0x5e, 0x07, 0xb4, 0x38, 0xd2, 0xf7, 0x92, 0x38, 0x74, 0xe3, 0x6c, 0x0d, 0x0d, 0xf4, 0x4e, 0x02,
+	0x37, 0x72, 0xf3, 0x76, 0x12, 0x87, 0x4e, 0x56, 0x4f, 0x3f, 0x40, 0xb7, 0x47, 0x32, 0xf2, 0x8d,
+	0xe7, 0xb3, 0xae, 0x1b, 0x31, 0xd6, 0xcd, 0xf5, 0x4a, 0xef, 0xa4, 0x37, 0x1c, 0xef, 0x6e, 0x5e,
+	0x79, 0x97, 0xb1, 0xae, 0xd4, 0xdd, 0xba, 0xf3, 0xe6, 0xd0, 0xd4, 0xde, 0xbe, 0xde, 0x3d, 0xf5,
+	0x79, 0x51, 0xd7, 0xc4, 0x6e, 0xbe, 0x1e, 0x54, 0xb4, 0x93, 0x41, 0x45, 0xfb, 0x7b, 0x50, 0xd1,
+	0x7e, 0x3c, 0xab, 0x14, 0x4e, 0xce, 0x2a, 0x85, 0x3f, 0xcf, 0x2a, 0x85, 0x17, 0x1f, 0x05, 0x44,
+	0xb4, 0x13, 0xcf, 0xf2, 0x19, 0xcd, 0x3f, 0x21, 0xf5, 0xff, 0x57, 0x11, 0x69, 0x04, 0xdc, 0x9b,
+	0x96, 0x4d, 0x7d, 0xf2, 0xdf, 0x00, 0x09, 0x2c, 0x23, 0xa6, 0xc0, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +387,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.BaseFeeTargetBlockGas != that1.BaseFeeTargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.BaseFeeDenom != that1.BaseFeeDenom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if !this.BaseFeeBurnRatio.Equal(that1.BaseFeeBurnRatio) {
+		return false
+	}
+	if !this.BaseFeeProtocolPoolRatio.Equal(that1.BaseFeeProtocolPoolRatio) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +569,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFeeProtocolPoolRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeProtocolPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.BaseFeeDenom) > 0 {
+		i -= len(m.BaseFeeDenom)
+		copy(dAtA[i:], m.BaseFeeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.BaseFeeDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BaseFeeTargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeTargetBlockGas))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -625,6 +741,22 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.BaseFeeTargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeTargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.BaseFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	l = m.BaseFeeProtocolPoolRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -1178,6 +1310,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetBlockGas", wireType)
+			}
+			m.BaseFeeTargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeTargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeProtocolPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeProtocolPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
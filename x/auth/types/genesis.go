@@ -29,6 +29,7 @@ func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
 	return &GenesisState{
 		Params:   params,
 		Accounts: genAccounts,
+		BaseFee:  params.MinBaseFee,
 	}
 }
 
@@ -68,6 +69,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if !data.BaseFee.IsNil() && data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative: %s", data.BaseFee)
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
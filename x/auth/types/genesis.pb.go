@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +33,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// base_fee is the current base fee, in params.base_fee_denom per unit of gas.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,24 +91,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0x2a, 0x41,
+	0x14, 0xc6, 0x77, 0x2e, 0x09, 0x17, 0x96, 0xdb, 0xdc, 0x95, 0x82, 0x3f, 0xc9, 0x82, 0x56, 0x68,
+	0xc2, 0x8c, 0x60, 0x62, 0x69, 0x22, 0x12, 0x6d, 0x2c, 0x14, 0x3b, 0x1b, 0x32, 0x3b, 0x1c, 0x86,
+	0x0d, 0xee, 0x0e, 0x61, 0x66, 0x8d, 0xfb, 0x16, 0x3e, 0x86, 0xa5, 0x85, 0x0f, 0x41, 0x49, 0xb4,
+	0x31, 0x16, 0xc4, 0x40, 0xe1, 0x6b, 0x98, 0x9d, 0x19, 0xad, 0x68, 0x66, 0xce, 0x9c, 0xf9, 0x9d,
+	0xef, 0x7c, 0xe7, 0xb8, 0xbb, 0x4c, 0xc8, 0x48, 0x48, 0x42, 0x13, 0x35, 0x21, 0xf7, 0x9d, 0x00,
+	0x14, 0xed, 0x10, 0x0e, 0x31, 0xc8, 0x50, 0xe2, 0xd9, 0x5c, 0x28, 0xe1, 0xed, 0x18, 0x04, 0x67,
+	0x08, 0xb6, 0x48, 0xad, 0xca, 0x85, 0xe0, 0x77, 0x40, 0x34, 0x12, 0x24, 0x63, 0x42, 0xe3, 0xd4,
+	0xf0, 0xb5, 0x32, 0x17, 0x5c, 0xe8, 0x90, 0x64, 0x91, 0xcd, 0xfa, 0xdb, 0x1a, 0x69, 0x49, 0xf3,
+	0xff, 0x9f, 0x46, 0x61, 0x2c, 0x88, 0x3e, 0x6d, 0xaa, 0x6a, 0x4a, 0x86, 0x46, 0xcb, 0xba, 0xd0,
+	0x8f, 0xbd, 0x37, 0xe4, 0xfe, 0xbb, 0x30, 0x2e, 0x6f, 0x14, 0x55, 0xe0, 0x9d, 0xb8, 0xf9, 0x19,
+	0x9d, 0xd3, 0x48, 0x56, 0x50, 0x13, 0xb5, 0x4a, 0xdd, 0x3a, 0xde, 0xe2, 0x1a, 0x5f, 0x69, 0xa4,
+	0x57, 0x5c, 0xac, 0x1a, 0xce, 0xd3, 0xd7, 0xf3, 0x01, 0x1a, 0xd8, 0x2a, 0xef, 0xd0, 0x2d, 0x50,
+	0xc6, 0x44, 0x12, 0x2b, 0x59, 0xf9, 0xd3, 0xcc, 0xb5, 0x4a, 0xdd, 0x32, 0x36, 0x23, 0xe2, 0x9f,
+	0x11, 0xf1, 0x69, 0x9c, 0x0e, 0x7e, 0x29, 0xef, 0xda, 0x2d, 0x04, 0x54, 0xc2, 0x70, 0x0c, 0x50,
+	0xc9, 0x35, 0x51, 0xab, 0xd8, 0x3b, 0xce, 0x64, 0x3f, 0x56, 0x8d, 0xba, 0x69, 0x2d, 0x47, 0x53,
+	0x1c, 0x0a, 0x12, 0x51, 0x35, 0xc1, 0x97, 0xc0, 0x29, 0x4b, 0xfb, 0xc0, 0x5e, 0x5f, 0xda, 0xae,
+	0x75, 0xd6, 0x07, 0x66, 0x3c, 0xfc, 0xcd, 0x74, 0xce, 0x01, 0x7a, 0x67, 0x8b, 0xb5, 0x8f, 0x96,
+	0x6b, 0x1f, 0x7d, 0xae, 0x7d, 0xf4, 0xb8, 0xf1, 0x9d, 0xe5, 0xc6, 0x77, 0xde, 0x37, 0xbe, 0x73,
+	0xbb, 0xcf, 0x43, 0x35, 0x49, 0x02, 0xcc, 0x44, 0x64, 0x17, 0x61, 0xaf, 0xb6, 0x1c, 0x4d, 0xc9,
+	0x83, 0xd9, 0xaa, 0x4a, 0x67, 0x20, 0x83, 0xbc, 0xf6, 0x7b, 0xf4, 0x3d, 0x00, 0x4b, 0x8c, 0xe2,
+	0xfe, 0xda, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +136,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +198,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +305,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
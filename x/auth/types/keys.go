@@ -13,6 +13,10 @@ const (
 
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
+
+	// ProtocolPoolDistrAccount duplicates the protocolpool_distr account's name to avoid a dependency on x/protocolpool.
+	// This account is an intermediary account that holds the funds to be distributed to the protocolpool accounts.
+	ProtocolPoolDistrAccount = "protocolpool_distr"
 )
 
 var (
@@ -28,4 +32,10 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// BaseFeeKey is the key of the current base fee
+	BaseFeeKey = collections.NewPrefix(3)
+
+	// BlockGasWantedKey is the key of the sum of the gas limits of the transactions of the current block
+	BlockGasWantedKey = collections.NewPrefix(4)
 )
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
@@ -11,30 +16,52 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultBaseFeeTargetBlockGas  uint64 = 0
+	DefaultBaseFeeChangeDenom     uint64 = 8
 )
 
+// DefaultBaseFeeDenom is the default denom of the base fee.
+var DefaultBaseFeeDenom = sdk.DefaultBondDenom
+
 // NewParams creates a new Params object
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
 	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxMemoCharacters:        maxMemoCharacters,
+		TxSigLimit:               txSigLimit,
+		TxSizeCostPerByte:        txSizeCostPerByte,
+		SigVerifyCostED25519:     sigVerifyCostED25519,
+		SigVerifyCostSecp256k1:   sigVerifyCostSecp256k1,
+		BaseFeeTargetBlockGas:    DefaultBaseFeeTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenom,
+		BaseFeeDenom:             DefaultBaseFeeDenom,
+		MinBaseFee:               math.LegacyZeroDec(),
+		BaseFeeBurnRatio:         math.LegacyZeroDec(),
+		BaseFeeProtocolPoolRatio: math.LegacyZeroDec(),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxMemoCharacters:      DefaultMaxMemoCharacters,
-		TxSigLimit:             DefaultTxSigLimit,
-		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
-		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
-		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMemoCharacters:        DefaultMaxMemoCharacters,
+		TxSigLimit:               DefaultTxSigLimit,
+		TxSizeCostPerByte:        DefaultTxSizeCostPerByte,
+		SigVerifyCostED25519:     DefaultSigVerifyCostED25519,
+		SigVerifyCostSecp256k1:   DefaultSigVerifyCostSecp256k1,
+		BaseFeeTargetBlockGas:    DefaultBaseFeeTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenom,
+		BaseFeeDenom:             DefaultBaseFeeDenom,
+		MinBaseFee:               math.LegacyZeroDec(),
+		BaseFeeBurnRatio:         math.LegacyZeroDec(),
+		BaseFeeProtocolPoolRatio: math.LegacyZeroDec(),
 	}
 }
 
+// BaseFeeEnabled returns true if transactions must pay the base fee.
+func (p Params) BaseFeeEnabled() bool {
+	return p.BaseFeeTargetBlockGas > 0
+}
+
 // SigVerifyCostSecp256r1 returns gas fee of secp256r1 signature verification.
 // Set by benchmarking current implementation:
 //
@@ -129,6 +156,45 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := p.validateBaseFee(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p Params) validateBaseFee() error {
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+	if err := sdk.ValidateDenom(p.BaseFeeDenom); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	// the base fee adjusts proportionally to its value, it could never rise from zero
+	if p.BaseFeeEnabled() && !p.MinBaseFee.IsPositive() {
+		return errors.New("min base fee must be positive when the base fee is enabled")
+	}
+
+	if err := validateBaseFeeRatio("burn", p.BaseFeeBurnRatio); err != nil {
+		return err
+	}
+	if err := validateBaseFeeRatio("protocol pool", p.BaseFeeProtocolPoolRatio); err != nil {
+		return err
+	}
+	if p.BaseFeeBurnRatio.Add(p.BaseFeeProtocolPoolRatio).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn and protocol pool ratios must not exceed 1: %s + %s", p.BaseFeeBurnRatio, p.BaseFeeProtocolPoolRatio)
+	}
+
+	return nil
+}
+
+func validateBaseFeeRatio(name string, ratio math.LegacyDec) error {
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee %s ratio must be between 0 and 1: %s", name, ratio)
+	}
 
 	return nil
 }
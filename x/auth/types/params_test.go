@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -35,6 +37,20 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid tx size cost per byte: 0")},
+		{"invalid base fee change denominator", withBaseFee(func(p *types.Params) {
+			p.BaseFeeChangeDenominator = 0
+		}), errors.New("base fee change denominator must be positive")},
+		{"zero min base fee", withBaseFee(func(p *types.Params) {
+			p.MinBaseFee = math.LegacyZeroDec()
+		}), errors.New("min base fee must be positive when the base fee is enabled")},
+		{"invalid base fee burn ratio", withBaseFee(func(p *types.Params) {
+			p.BaseFeeBurnRatio = math.LegacyNewDec(2)
+		}), errors.New("base fee burn ratio must be between 0 and 1: 2.000000000000000000")},
+		{"base fee ratios above one", withBaseFee(func(p *types.Params) {
+			p.BaseFeeBurnRatio = math.LegacyNewDecWithPrec(6, 1)
+			p.BaseFeeProtocolPoolRatio = math.LegacyNewDecWithPrec(5, 1)
+		}), errors.New("base fee burn and protocol pool ratios must not exceed 1: 0.600000000000000000 + 0.500000000000000000")},
+		{"valid base fee", withBaseFee(func(p *types.Params) {}), nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -49,3 +65,12 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+// withBaseFee returns the default params with the base fee enabled and modified by fn.
+func withBaseFee(fn func(*types.Params)) types.Params {
+	p := types.DefaultParams()
+	p.BaseFeeTargetBlockGas = 1000
+	p.MinBaseFee = math.LegacyOneDec()
+	fn(&p)
+	return p
+}
@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompoundValidators",
+					Use:       "auto-compound-validators <delegator-addr>",
+					Short:     "Query the validators of the delegations of a delegator opted in to auto-compounding",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound-validators [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -125,6 +134,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <validator-addr> <enabled>",
+					Short:     "Opt a delegation in or out of the auto-compounding of its rewards",
+					Long:      "Opt a delegation in or out of the auto-compounding of its rewards. The rewards of the delegations opted in are re-delegated to their validator at the end of every auto-compound epoch.",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
 	staking "cosmossdk.io/x/staking/types"
@@ -44,6 +45,7 @@ type ModuleOutputs struct {
 	DistrKeeper keeper.Keeper
 	Module      appmodule.AppModule
 	Hooks       staking.StakingHooksWrapper
+	EpochHooks  epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -80,5 +82,6 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		DistrKeeper: k,
 		Module:      m,
 		Hooks:       staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		EpochHooks:  epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
 	}
 }
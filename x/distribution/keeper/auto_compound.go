@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks wrapper struct for the distribution keeper.
+// It satisfies the x/epochs EpochHooks interface and must be registered on the
+// epochs keeper for the rewards of the delegations opted in to auto-compounding
+// to be re-delegated.
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks of the distribution keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is a no-op.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd compounds the rewards of the delegations opted in to auto-compounding
+// when the ended epoch is the auto-compound epoch.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	return h.k.CompoundEpochRewards(ctx, epochIdentifier)
+}
+
+// IsEpochIdentifierInUse reports whether the epoch identifier is the auto-compound epoch,
+// preventing it from being removed from x/epochs.
+func (h EpochHooks) IsEpochIdentifierInUse(ctx context.Context, epochIdentifier string) (bool, error) {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.AutoCompoundEpochIdentifier != "" && params.AutoCompoundEpochIdentifier == epochIdentifier, nil
+}
+
+// SetAutoCompound opts a delegation in or out of auto-compounding. Opting in requires
+// the delegation to exist and the rewards of the delegator to be withdrawn to its own
+// address, as they are re-delegated from it.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if enabled {
+		if _, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr); err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.ErrNoDelegationExists
+			}
+			return err
+		}
+
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+		if !withdrawAddr.Equals(delAddr) {
+			return types.ErrAutoCompoundWithdrawAddr
+		}
+
+		if err := k.AutoCompoundDelegations.Set(ctx, key); err != nil {
+			return err
+		}
+	} else if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// GetDelegatorAutoCompoundValidators returns the validators of the delegations of the
+// delegator opted in to auto-compounding.
+func (k Keeper) GetDelegatorAutoCompoundValidators(ctx context.Context, delAddr sdk.AccAddress) ([]sdk.ValAddress, error) {
+	var validators []sdk.ValAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	err := k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		validators = append(validators, key.K2())
+		return false, nil
+	})
+	return validators, err
+}
+
+// CompoundEpochRewards re-delegates the rewards of the delegations opted in to
+// auto-compounding if the epoch identifier is the auto-compound epoch of the params.
+// At most AutoCompoundMaxDelegations delegations are compounded, the next epoch resumes
+// after the last one, wrapping around to the first delegations. Each delegation is compounded in its own branch limited to
+// AutoCompoundGasLimit gas, a failing delegation does not prevent the remaining ones
+// from being compounded, its error is only logged.
+func (k Keeper) CompoundEpochRewards(ctx context.Context, epochIdentifier string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	limit := params.AutoCompoundMaxDelegations
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]])
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	hasCursor := err == nil
+	switch {
+	case hasCursor:
+		rng = rng.StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	keys, err := k.autoCompoundDelegations(ctx, rng, limit)
+	if err != nil {
+		return err
+	}
+
+	// wrap around to the delegations up to the cursor
+	if hasCursor && uint64(len(keys)) < limit {
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).EndInclusive(cursor)
+		wrapped, err := k.autoCompoundDelegations(ctx, rng, limit-uint64(len(keys)))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, wrapped...)
+	}
+
+	// resume after the last delegation if the limit was reached, restart from the beginning otherwise
+	if len(keys) > 0 && uint64(len(keys)) == limit {
+		err = k.AutoCompoundCursor.Set(ctx, keys[len(keys)-1])
+	} else {
+		err = k.AutoCompoundCursor.Remove(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, params.AutoCompoundGasLimit, func(ctx context.Context) error {
+			return k.compoundDelegationRewards(ctx, key.K1(), key.K2())
+		})
+		if err != nil {
+			k.Logger.Error("failed to compound delegation rewards", "delegator", key.K1().String(), "validator", key.K2().String(), "gas_used", gasUsed, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// autoCompoundDelegations returns at most limit delegations opted in to auto-compounding within the range.
+func (k Keeper) autoCompoundDelegations(
+	ctx context.Context, rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]], limit uint64,
+) ([]collections.Pair[sdk.AccAddress, sdk.ValAddress], error) {
+	var keys []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	if limit == 0 {
+		return keys, nil
+	}
+	err := k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) >= limit, nil
+	})
+	return keys, err
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation to the delegator and
+// delegates their bond denom amount to the same validator.
+func (k Keeper) compoundDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if amount.IsZero() {
+		return nil
+	}
+
+	delegator, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.MsgRouterService.Invoke(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+	}); err != nil {
+		return errorsmod.Wrap(err, "failed to delegate rewards")
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+	)
+}
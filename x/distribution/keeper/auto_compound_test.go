@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// delegateRouter records the MsgDelegate messages routed by the keeper.
+type delegateRouter struct {
+	msgs []*stakingtypes.MsgDelegate
+}
+
+func (r *delegateRouter) CanInvoke(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *delegateRouter) Invoke(_ context.Context, req transaction.Msg) (transaction.Msg, error) {
+	r.msgs = append(r.msgs, req.(*stakingtypes.MsgDelegate))
+	return &stakingtypes.MsgDelegateResponse{}, nil
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addrCdc := codectestutil.CodecOptions{}.GetAddressCodec()
+	addr0Str, err := addrCdc.BytesToString(addrs[0])
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(addrs[1])
+	valAddrStr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(stakingtypes.Delegation{}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[1], valAddr).Return(stakingtypes.Delegation{}, collections.ErrNotFound).AnyTimes()
+
+	// a delegation must exist to be opted in
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(addr0Str, valAddrStr, true))
+	require.NoError(t, err)
+	has, err := distrKeeper.AutoCompoundDelegations.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	addr1Str, err := addrCdc.BytesToString(addrs[1])
+	require.NoError(t, err)
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(addr1Str, valAddrStr, true))
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound("invalid", valAddrStr, true))
+	require.ErrorContains(t, err, "invalid delegator address")
+
+	// setting another withdraw address opts the delegations out
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[1]))
+	has, err = distrKeeper.AutoCompoundDelegations.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the rewards must be withdrawn to the delegator
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(addr0Str, valAddrStr, true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+
+	// opting out doesn't require anything
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(addr0Str, valAddrStr, false))
+	require.NoError(t, err)
+	has, err = distrKeeper.AutoCompoundDelegations.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestCompoundEpochRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+	addrCdc := address.NewBech32Codec(sdk.Bech32MainPrefix)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(addrCdc)
+
+	router := &delegateRouter{}
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	env.MsgRouterService = router
+
+	authorityAddr, err := addrCdc.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	params := types.DefaultParams()
+	params.AutoCompoundEpochIdentifier = "day"
+	params.AutoCompoundMaxDelegations = 1
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create two self-delegated validators opted in to auto-compounding
+	var (
+		vals     []stakingtypes.Validator
+		valAddrs []sdk.ValAddress
+	)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		valAddr := sdk.ValAddress(pk.Address())
+		addr := sdk.AccAddress(valAddr)
+		operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		require.NoError(t, err)
+		val, err := distrtestutil.CreateValidator(pk, operatorAddr, math.NewInt(100))
+		require.NoError(t, err)
+
+		addrStr, err := addrCdc.BytesToString(addr)
+		require.NoError(t, err)
+		del := stakingtypes.NewDelegation(addrStr, operatorAddr, val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+		require.NoError(t, distrKeeper.AutoCompoundDelegations.Set(ctx, collections.Join(addr, valAddr)))
+
+		vals = append(vals, val)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	for _, val := range vals {
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+	}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).AnyTimes()
+
+	// other epochs are ignored
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "week", 1))
+	require.Empty(t, router.msgs)
+
+	// a single delegation is compounded per epoch
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "day", 1))
+	require.Len(t, router.msgs, 1)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, initial), router.msgs[0].Amount)
+	first := router.msgs[0].ValidatorAddress
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "day", 2))
+	require.Len(t, router.msgs, 2)
+	require.NotEqual(t, first, router.msgs[1].ValidatorAddress)
+
+	// the third epoch wraps around to the first delegation, which has no rewards left
+	cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "day", 3))
+	require.Len(t, router.msgs, 2)
+	newCursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, cursor, newCursor)
+
+	// removed delegations are opted out
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0]))
+	validators, err := distrKeeper.GetDelegatorAutoCompoundValidators(ctx, sdk.AccAddress(valAddrs[0]))
+	require.NoError(t, err)
+	require.Empty(t, validators)
+
+	inUse, err := distrKeeper.EpochHooks().IsEpochIdentifierInUse(ctx, "day")
+	require.NoError(t, err)
+	require.True(t, inUse)
+}
@@ -113,6 +113,22 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, rec := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.addrCdc.StringToBytes(rec.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		err = k.AutoCompoundDelegations.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -282,5 +298,27 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	autoCompound := make([]types.AutoCompoundRecord, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		delAddr, err := k.addrCdc.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompound = append(autoCompound, types.AutoCompoundRecord{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes, autoCompound), nil
 }
@@ -369,6 +369,37 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: addr}, nil
 }
 
+// DelegatorAutoCompoundValidators queries the validators of the delegations of a delegator opted in to auto-compounding
+func (k Querier) DelegatorAutoCompoundValidators(ctx context.Context, req *types.QueryDelegatorAutoCompoundValidatorsRequest) (*types.QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs, err := k.GetDelegatorAutoCompoundValidators(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		addr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, addr)
+	}
+
+	return &types.QueryDelegatorAutoCompoundValidatorsResponse{Validators: validators}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
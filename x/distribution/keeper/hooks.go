@@ -181,8 +181,9 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationRemoved opts the removed delegation out of auto-compounding
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
@@ -54,6 +54,11 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegations key: delAddr+valAddr | the delegations opted in to auto-compounding
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor stores the last delegation compounded when an epoch reached the
+	// maximum number of compounded delegations, the next epoch resumes after it.
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -136,6 +141,18 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegationsPrefix,
+			"auto_compound_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -178,6 +195,20 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 		return err
 	}
 
+	// auto-compounding re-delegates the rewards from the delegator address, the
+	// delegations of the delegator are opted out of it
+	if !withdrawAddr.Equals(delegatorAddr) {
+		validators, err := k.GetDelegatorAutoCompoundValidators(ctx, delegatorAddr)
+		if err != nil {
+			return err
+		}
+		for _, valAddr := range validators {
+			if err := k.SetAutoCompound(ctx, delegatorAddr, valAddr, false); err != nil {
+				return err
+			}
+		}
+	}
+
 	return k.DelegatorsWithdrawAddress.Set(ctx, delegatorAddr, withdrawAddr)
 }
 
@@ -38,6 +38,15 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the identifier of the x/epochs epoch at the end of
+	// which the rewards of the delegations opted in to auto-compounding are re-delegated.
+	// Auto-compounding is disabled when empty.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_max_delegations is the maximum number of delegations compounded per epoch.
+	// The remaining delegations are compounded in the following epochs.
+	AutoCompoundMaxDelegations uint64 `protobuf:"varint,6,opt,name=auto_compound_max_delegations,json=autoCompoundMaxDelegations,proto3" json:"auto_compound_max_delegations,omitempty"`
+	// auto_compound_gas_limit is the gas limit of compounding the rewards of a single delegation.
+	AutoCompoundGasLimit uint64 `protobuf:"varint,7,opt,name=auto_compound_gas_limit,json=autoCompoundGasLimit,proto3" json:"auto_compound_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +89,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundMaxDelegations() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxDelegations
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundGasLimit() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasLimit
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +687,78 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xa4, 0xae, 0xd3, 0x4c, 0xd2, 0xa4, 0x9d, 0x38, 0x89, 0xe3, 0xb4, 0xb6, 0x6b, 0x51,
+	0x61, 0x02, 0xb1, 0x49, 0x2b, 0x10, 0xca, 0x05, 0x25, 0x4e, 0x0a, 0x95, 0x52, 0x88, 0x36, 0x08,
+	0x24, 0x38, 0xac, 0xc6, 0xbb, 0x13, 0x7b, 0xc8, 0xee, 0xce, 0x32, 0x33, 0x76, 0x92, 0x13, 0x1c,
+	0x0b, 0x07, 0xe0, 0x06, 0xe2, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0x3e, 0x44, 0xc5, 0xa9, 0xaa,
+	0x00, 0x01, 0x87, 0x00, 0xc9, 0x21, 0x88, 0x4f, 0x81, 0x66, 0x67, 0xbc, 0x6b, 0x87, 0x80, 0x42,
+	0xaa, 0x88, 0x8b, 0xe5, 0x79, 0xef, 0xed, 0xfb, 0xfd, 0xde, 0x9b, 0xf7, 0x67, 0x60, 0xd5, 0x61,
+	0xc2, 0x67, 0xa2, 0xe6, 0x52, 0x21, 0x39, 0x6d, 0xb4, 0x25, 0x65, 0x41, 0xad, 0x33, 0xdf, 0x20,
+	0x12, 0xcf, 0xf7, 0x09, 0xab, 0x21, 0x67, 0x92, 0xa1, 0x19, 0x6d, 0x5f, 0xed, 0x53, 0x19, 0xfb,
+	0x7c, 0xb6, 0xc9, 0x9a, 0x2c, 0xb2, 0xab, 0xa9, 0x7f, 0xfa, 0x93, 0x7c, 0xc1, 0x40, 0x34, 0xb0,
+	0x20, 0xb1, 0x6b, 0x87, 0x51, 0xe3, 0x32, 0x3f, 0xad, 0xf5, 0xb6, 0xfe, 0xd0, 0xf8, 0xd7, 0xaa,
+	0xab, 0xd8, 0xa7, 0x01, 0xab, 0x45, 0xbf, 0x5a, 0x54, 0xfe, 0x39, 0x0d, 0x33, 0x6b, 0x98, 0x63,
+	0x5f, 0xa0, 0xf7, 0xe0, 0x65, 0x87, 0xf9, 0x7e, 0x3b, 0xa0, 0x72, 0xc7, 0x96, 0x78, 0x3b, 0x07,
+	0x4a, 0xa0, 0x32, 0xb4, 0xf4, 0xf2, 0xa3, 0xfd, 0x62, 0xea, 0x97, 0xfd, 0xa2, 0xa1, 0x2a, 0xdc,
+	0xcd, 0x2a, 0x65, 0x35, 0x1f, 0xcb, 0x56, 0x75, 0x95, 0x34, 0xb1, 0xb3, 0xb3, 0x4c, 0x9c, 0x27,
+	0x7b, 0x73, 0xd0, 0x20, 0x2d, 0x13, 0xe7, 0xe1, 0xd1, 0xee, 0x2c, 0xb0, 0x46, 0x62, 0x67, 0x6f,
+	0xe1, 0x6d, 0xf4, 0x3e, 0xcc, 0x2a, 0xc2, 0x8a, 0x55, 0xc8, 0x04, 0xe1, 0x36, 0x27, 0x5b, 0x98,
+	0xbb, 0xb9, 0x81, 0x08, 0xe3, 0x95, 0xb3, 0x61, 0xe4, 0x80, 0x85, 0x94, 0xd7, 0x35, 0xe3, 0xd4,
+	0x8a, 0x7c, 0x22, 0x0f, 0x4e, 0x34, 0x58, 0xd0, 0x16, 0x7f, 0x03, 0xbb, 0xf0, 0x94, 0x60, 0xe3,
+	0x91, 0xdb, 0x63, 0x68, 0xb7, 0xe0, 0xc4, 0x16, 0x95, 0x2d, 0x97, 0xe3, 0x2d, 0x1b, 0xbb, 0x2e,
+	0xb7, 0x49, 0x80, 0x1b, 0x1e, 0x71, 0x73, 0xe9, 0x12, 0xa8, 0x5c, 0xb2, 0xc6, 0xbb, 0xca, 0x45,
+	0xd7, 0xe5, 0x2b, 0x5a, 0x85, 0xea, 0xb0, 0x80, 0xdb, 0x92, 0xd9, 0x0e, 0xf3, 0x43, 0xd6, 0x0e,
+	0x5c, 0x9b, 0x84, 0xcc, 0x69, 0xd9, 0xd4, 0x25, 0x81, 0xa4, 0x1b, 0x94, 0xf0, 0xdc, 0x45, 0x45,
+	0xd5, 0x9a, 0x51, 0x56, 0x75, 0x63, 0xb4, 0xa2, 0x6c, 0xee, 0xc6, 0x26, 0x68, 0x11, 0x5e, 0xef,
+	0x77, 0xe2, 0xe3, 0x6d, 0xdb, 0x25, 0x1e, 0x69, 0x62, 0x55, 0x46, 0x22, 0x97, 0x29, 0x81, 0x4a,
+	0xda, 0xca, 0xf7, 0xfa, 0xb8, 0x87, 0xb7, 0x97, 0x13, 0x0b, 0xf4, 0x12, 0x9c, 0xea, 0x77, 0xd1,
+	0xc4, 0xc2, 0xf6, 0xa8, 0x4f, 0x65, 0x6e, 0x30, 0xfa, 0x38, 0xdb, 0xfb, 0xf1, 0x6b, 0x58, 0xac,
+	0x2a, 0xdd, 0xc2, 0xcd, 0x4f, 0x8e, 0x76, 0x67, 0x4b, 0x3a, 0x3f, 0x73, 0xc2, 0xdd, 0xac, 0x6d,
+	0xf7, 0x17, 0xbc, 0x2e, 0xa8, 0xf2, 0x8f, 0x00, 0xe6, 0xdf, 0xc6, 0x1e, 0x75, 0xb1, 0x64, 0xfc,
+	0x75, 0x2a, 0x24, 0xe3, 0xd4, 0xc1, 0x9e, 0xce, 0x9b, 0x40, 0x9f, 0x02, 0x38, 0xe5, 0xb4, 0xfd,
+	0xb6, 0x87, 0x25, 0xed, 0x10, 0x73, 0x47, 0x36, 0x57, 0xd4, 0x72, 0xa0, 0x74, 0xa1, 0x32, 0x7c,
+	0xeb, 0x9a, 0x69, 0xa7, 0xaa, 0xba, 0xe4, 0x6e, 0x5b, 0xa8, 0x0b, 0xa9, 0x33, 0x1a, 0xe8, 0x7b,
+	0xfc, 0xf6, 0xd7, 0xe2, 0xf3, 0x4d, 0x2a, 0x5b, 0xed, 0x46, 0xd5, 0x61, 0xbe, 0x29, 0xf7, 0x5a,
+	0x0f, 0x35, 0xb9, 0x13, 0x12, 0xd1, 0xfd, 0x46, 0xe8, 0xd2, 0x9c, 0x48, 0x60, 0x35, 0x19, 0x4b,
+	0x81, 0xa2, 0x67, 0xe1, 0x18, 0x27, 0x1b, 0x84, 0x93, 0xc0, 0x21, 0xb6, 0xc3, 0xda, 0x81, 0x8c,
+	0xca, 0xf3, 0xb2, 0x35, 0x1a, 0x8b, 0xeb, 0x4a, 0x5a, 0xfe, 0x06, 0xc0, 0xa9, 0x38, 0xb0, 0x7a,
+	0x9b, 0x73, 0x12, 0xc8, 0x6e, 0x54, 0x21, 0x1c, 0xd4, 0x91, 0x88, 0x73, 0x0e, 0xa2, 0x0b, 0x83,
+	0x26, 0x61, 0x26, 0x24, 0x9c, 0x32, 0xdd, 0x4c, 0x69, 0xcb, 0x9c, 0xca, 0x5f, 0x02, 0x58, 0x88,
+	0x59, 0x2e, 0x3a, 0x26, 0x66, 0xe2, 0xd6, 0x99, 0xef, 0x53, 0x21, 0x28, 0x0b, 0x50, 0x07, 0x42,
+	0x27, 0x3e, 0x9d, 0x33, 0xdf, 0x1e, 0xa4, 0xf2, 0x67, 0x00, 0xce, 0xc4, 0xd4, 0xde, 0x6c, 0x4b,
+	0x21, 0x71, 0xe0, 0xd2, 0xa0, 0xf9, 0xbf, 0x25, 0x51, 0x31, 0x1a, 0x8f, 0x19, 0xad, 0x7b, 0x58,
+	0xb4, 0x56, 0x3a, 0x24, 0x90, 0xe8, 0x39, 0x78, 0xa5, 0xd3, 0x15, 0xdb, 0x26, 0xcd, 0x20, 0x4a,
+	0xf3, 0x58, 0x2c, 0x5f, 0x8b, 0xc4, 0xe8, 0x1e, 0xbc, 0xb4, 0xc1, 0xb1, 0xa3, 0x3a, 0xc0, 0x8c,
+	0xb5, 0xf9, 0xff, 0x3c, 0x69, 0xac, 0xd8, 0x45, 0xf9, 0x63, 0x00, 0xb3, 0x27, 0x30, 0x12, 0xe8,
+	0x03, 0x38, 0x99, 0x50, 0x12, 0x4a, 0x61, 0x93, 0x48, 0x63, 0x72, 0xf5, 0x62, 0xf5, 0x5f, 0x96,
+	0x4a, 0xf5, 0x04, 0x97, 0x4b, 0x43, 0x8a, 0xa7, 0x4e, 0x48, 0xb6, 0x73, 0x02, 0x64, 0xf9, 0xa3,
+	0x01, 0x38, 0x78, 0x87, 0x90, 0x35, 0xc6, 0x3c, 0xf4, 0x21, 0x1c, 0x4d, 0xd6, 0x44, 0xc8, 0x98,
+	0x77, 0xaa, 0x2b, 0x5a, 0x38, 0xeb, 0x15, 0xe5, 0x80, 0x95, 0xac, 0xa5, 0x88, 0x80, 0x84, 0x23,
+	0x2e, 0x71, 0xa8, 0x8f, 0x3d, 0x0d, 0x3f, 0x70, 0x0a, 0xf8, 0xdb, 0x67, 0x80, 0xb7, 0x86, 0x0d,
+	0x8c, 0x42, 0x2d, 0x7f, 0x31, 0x00, 0xf3, 0xf5, 0x5e, 0x1e, 0xeb, 0x21, 0x09, 0x5c, 0xbd, 0x0b,
+	0xb0, 0x87, 0xb2, 0xf0, 0xa2, 0xa4, 0xd2, 0x23, 0x7a, 0x69, 0x5a, 0xfa, 0x80, 0x4a, 0x70, 0xd8,
+	0x25, 0xc2, 0xe1, 0x34, 0x4c, 0xaa, 0xc2, 0xea, 0x15, 0xa1, 0x6b, 0x70, 0x88, 0x13, 0x87, 0x86,
+	0x94, 0x04, 0x52, 0xef, 0x27, 0x2b, 0x11, 0xa0, 0x1d, 0x98, 0xc1, 0x7e, 0x34, 0x88, 0xd2, 0x51,
+	0x90, 0xd3, 0x27, 0x06, 0x19, 0x45, 0x78, 0xc7, 0x44, 0x58, 0x39, 0x45, 0x84, 0x51, 0x78, 0x5f,
+	0x1d, 0xed, 0xce, 0x8e, 0x78, 0x51, 0x19, 0xda, 0x4e, 0xd2, 0x11, 0x06, 0x70, 0xa1, 0x72, 0xff,
+	0x41, 0x31, 0xf5, 0xc7, 0x83, 0x62, 0xea, 0xbb, 0xbd, 0xb9, 0xbc, 0x41, 0x6d, 0xb2, 0x4e, 0x0f,
+	0x68, 0x20, 0x15, 0x67, 0x50, 0xfe, 0x1e, 0xc0, 0x09, 0xb3, 0x54, 0x18, 0x5f, 0x97, 0x98, 0x4b,
+	0x1a, 0x34, 0xef, 0x06, 0x1b, 0xd1, 0x40, 0x0d, 0x39, 0xe9, 0x50, 0xa6, 0x76, 0x71, 0x6f, 0xef,
+	0x8c, 0x76, 0xc5, 0xa6, 0x75, 0x56, 0xe1, 0x45, 0x21, 0xf1, 0x26, 0xc9, 0x0d, 0x3c, 0xd5, 0x93,
+	0x43, 0x3b, 0x41, 0xcb, 0x30, 0xd3, 0x22, 0xb4, 0xd9, 0xd2, 0x09, 0x4d, 0x2f, 0xbd, 0xf0, 0xe7,
+	0x7e, 0x71, 0xcc, 0xe1, 0x24, 0x5a, 0x7a, 0xb6, 0x56, 0x7d, 0x7d, 0xb4, 0x3b, 0x7b, 0x5c, 0x66,
+	0x12, 0xa0, 0x0f, 0xe5, 0xdf, 0x01, 0x9c, 0x4e, 0x76, 0x65, 0x1c, 0xa0, 0xd9, 0xfa, 0x6f, 0xc0,
+	0xab, 0x49, 0x13, 0xaa, 0xb5, 0x4f, 0x84, 0x30, 0x0f, 0xa6, 0x1b, 0x4f, 0xf6, 0xe6, 0xae, 0x1b,
+	0x6a, 0xc9, 0xfc, 0xd5, 0x26, 0xeb, 0x92, 0xab, 0x31, 0x77, 0xa5, 0x73, 0x4c, 0x8e, 0x02, 0x98,
+	0x89, 0x5f, 0x44, 0xe7, 0x39, 0xf0, 0x0c, 0xca, 0x42, 0x5a, 0x5d, 0x6f, 0xf9, 0x07, 0x00, 0x6f,
+	0xfe, 0x73, 0x51, 0xbf, 0x43, 0x65, 0x6b, 0x99, 0x84, 0x4c, 0x50, 0x79, 0x4e, 0xf5, 0x3d, 0xd9,
+	0x53, 0xdf, 0x4a, 0x65, 0x4e, 0x28, 0x07, 0x07, 0x5d, 0x0d, 0x6c, 0x1e, 0x42, 0xdd, 0xe3, 0xc2,
+	0x33, 0xf7, 0x4f, 0x51, 0x92, 0x4b, 0xaf, 0x3e, 0x3c, 0x28, 0x80, 0x47, 0x07, 0x05, 0xf0, 0xf8,
+	0xa0, 0x00, 0x7e, 0x3b, 0x28, 0x80, 0xcf, 0x0f, 0x0b, 0xa9, 0xc7, 0x87, 0x85, 0xd4, 0x4f, 0x87,
+	0x85, 0xd4, 0xbb, 0x37, 0xfa, 0xca, 0xea, 0xd8, 0xdb, 0x25, 0x4a, 0x5a, 0x23, 0x13, 0xbd, 0x8e,
+	0x6f, 0xff, 0x35, 0x00, 0xb3, 0x75, 0x35, 0xe0, 0xd0, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +792,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundMaxDelegations != that1.AutoCompoundMaxDelegations {
+		return false
+	}
+	if this.AutoCompoundGasLimit != that1.AutoCompoundGasLimit {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1136,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasLimit != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundMaxDelegations != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxDelegations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1709,16 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundMaxDelegations != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxDelegations))
+	}
+	if m.AutoCompoundGasLimit != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasLimit))
+	}
 	return n
 }
 
@@ -2016,6 +2087,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxDelegations", wireType)
+			}
+			m.AutoCompoundMaxDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasLimit", wireType)
+			}
+			m.AutoCompoundGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -326,6 +326,48 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundRecord is used for import / export via genesis json of the
+// delegations opted in to auto-compounding.
+type AutoCompoundRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundRecord) Reset()         { *m = AutoCompoundRecord{} }
+func (m *AutoCompoundRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRecord) ProtoMessage()    {}
+func (*AutoCompoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *AutoCompoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRecord.Merge(m, src)
+}
+func (m *AutoCompoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -346,13 +388,15 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegations defines the delegations opted in to auto-compounding at genesis.
+	AutoCompoundDelegations []AutoCompoundRecord `protobuf:"bytes,11,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +433,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundRecord)(nil), "cosmos.distribution.v1beta1.AutoCompoundRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -397,65 +442,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x38, 0x69, 0x9a, 0x8c, 0x8b, 0x48, 0xa6, 0x69, 0x70, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x0d, 0x08, 0xaa, 0x22, 0x40, 0x89, 0xd3, 0x02, 0x3d, 0x40, 0x95, 0x48, 0x20,
+	0x10, 0xd2, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xf5, 0xee, 0x58, 0x3b, 0xb3, 0x6b, 0x40, 0xe2, 0xc0,
+	0xa9, 0x88, 0x13, 0x27, 0xc4, 0xb1, 0xe2, 0x54, 0x21, 0x21, 0x71, 0x28, 0x9f, 0x80, 0x4b, 0x8f,
+	0x55, 0xc5, 0x81, 0x13, 0xa0, 0xe4, 0x00, 0xe2, 0xc8, 0x27, 0x40, 0x3b, 0x33, 0xbb, 0x3b, 0xce,
+	0x6e, 0xb7, 0x4e, 0xc0, 0x52, 0x2f, 0x89, 0x3d, 0xf3, 0xe6, 0xfd, 0xfe, 0xcc, 0xdb, 0xf7, 0xd6,
+	0xf0, 0x39, 0x87, 0x71, 0x8f, 0xf1, 0x96, 0x4b, 0xb9, 0x08, 0x68, 0x27, 0x14, 0x94, 0xf9, 0xad,
+	0xe8, 0x72, 0x87, 0x08, 0x7c, 0xb9, 0xd5, 0x25, 0x3e, 0xe1, 0x94, 0x5b, 0x83, 0x80, 0x09, 0x86,
+	0xce, 0xaa, 0x50, 0xcb, 0x0c, 0xb5, 0x74, 0xe8, 0xca, 0x62, 0x97, 0x75, 0x99, 0x8c, 0x6b, 0xc5,
+	0x9f, 0xd4, 0x91, 0x95, 0x86, 0xce, 0xde, 0xc1, 0x9c, 0xa4, 0x59, 0x1d, 0x46, 0x7d, 0xbd, 0x6f,
+	0x95, 0xa1, 0x8f, 0xe0, 0xa8, 0xf8, 0x65, 0x15, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x6d, 0x2d, 0x60,
+	0x8f, 0xfa, 0xac, 0x25, 0xff, 0xaa, 0xa5, 0x0b, 0x3f, 0x00, 0x78, 0x66, 0x9b, 0xf4, 0x49, 0x17,
+	0x0b, 0x16, 0x7c, 0x40, 0x45, 0xcf, 0x0d, 0xf0, 0xf0, 0x1d, 0x7f, 0x8f, 0xa1, 0x6b, 0x70, 0xc1,
+	0x4d, 0x36, 0x6c, 0xec, 0xba, 0x01, 0xe1, 0xbc, 0x0e, 0x56, 0xc1, 0xa5, 0xb9, 0xad, 0xfa, 0xc3,
+	0x7b, 0xeb, 0x8b, 0x3a, 0xf3, 0xa6, 0xda, 0xd9, 0x15, 0x01, 0xf5, 0xbb, 0x3b, 0xf3, 0xe9, 0x11,
+	0xbd, 0x8e, 0xda, 0x70, 0x7e, 0xa8, 0xd3, 0xa6, 0x59, 0xaa, 0x8f, 0xc9, 0xf2, 0x74, 0x72, 0x42,
+	0x2f, 0x5f, 0x9d, 0xfd, 0xf2, 0x4e, 0xb3, 0xf2, 0xd7, 0x9d, 0x66, 0xe5, 0xc2, 0xed, 0x2a, 0x5c,
+	0x7b, 0x1f, 0xf7, 0xa9, 0x1b, 0x63, 0xbc, 0x17, 0x0a, 0x2e, 0xb0, 0xef, 0xc6, 0x67, 0xc8, 0x10,
+	0x07, 0x2e, 0xdf, 0x21, 0x0e, 0x0b, 0x5c, 0xf4, 0x2e, 0x5c, 0x88, 0x92, 0xa0, 0x43, 0xdc, 0xd7,
+	0x1e, 0xde, 0x5b, 0x3f, 0xaf, 0x51, 0xd3, 0x44, 0x87, 0x44, 0x44, 0x87, 0xd6, 0xd1, 0x6d, 0x00,
+	0x4f, 0xb3, 0x0c, 0xcc, 0x0e, 0x14, 0x5a, 0xbd, 0xba, 0x3a, 0x75, 0xa9, 0xb6, 0x71, 0x4e, 0x5f,
+	0x91, 0x15, 0x5f, 0x61, 0x72, 0xdb, 0xd6, 0x36, 0x71, 0xda, 0x8c, 0xfa, 0x5b, 0x57, 0xee, 0xff,
+	0xd6, 0xac, 0x7c, 0xff, 0x7b, 0xf3, 0x85, 0x2e, 0x15, 0xbd, 0xb0, 0x63, 0x39, 0xcc, 0xd3, 0xb7,
+	0xa2, 0xff, 0xad, 0x73, 0xf7, 0x56, 0x4b, 0x7c, 0x3a, 0x20, 0x3c, 0x39, 0xc3, 0xef, 0xfe, 0xf9,
+	0xe3, 0xf3, 0x60, 0x07, 0xb1, 0x9c, 0x3e, 0xc3, 0x89, 0xbf, 0x01, 0xbc, 0x98, 0x09, 0x70, 0x9c,
+	0xd0, 0x0b, 0xfb, 0x58, 0x10, 0xb7, 0xcd, 0x3c, 0x8f, 0x72, 0x4e, 0x99, 0x3f, 0x21, 0x33, 0x7a,
+	0xb0, 0x86, 0x33, 0x38, 0x79, 0x99, 0xb5, 0x8d, 0xd7, 0xac, 0x92, 0xca, 0xb7, 0xca, 0x79, 0x6e,
+	0xcd, 0xc5, 0x16, 0x29, 0xcd, 0x66, 0x6a, 0x43, 0xec, 0x3f, 0x00, 0xae, 0xa6, 0x49, 0xde, 0xa6,
+	0x5c, 0xb0, 0x80, 0x3a, 0xb8, 0x3f, 0xd9, 0x5b, 0x5f, 0x82, 0x33, 0x03, 0x12, 0x50, 0xa6, 0x34,
+	0x4e, 0xef, 0xe8, 0x6f, 0xe8, 0x63, 0x78, 0x32, 0x29, 0x80, 0x29, 0x29, 0xfe, 0xd5, 0xf1, 0xc4,
+	0xe7, 0x78, 0x9b, 0xc2, 0x93, 0x94, 0x86, 0xe8, 0x5f, 0x00, 0x3c, 0x9f, 0x1e, 0x6e, 0x87, 0x41,
+	0x40, 0x7c, 0x31, 0x59, 0xc5, 0x1f, 0x66, 0xca, 0xd4, 0xb5, 0xbe, 0x3c, 0x9e, 0xb2, 0x51, 0x72,
+	0x8f, 0x91, 0xf5, 0x5d, 0x15, 0x9e, 0x4d, 0x5b, 0xce, 0xae, 0xc0, 0x81, 0xa0, 0x7e, 0x37, 0x6e,
+	0x39, 0x5a, 0xd4, 0xff, 0xd4, 0x78, 0x0a, 0xbd, 0xa9, 0x1e, 0xdf, 0x9b, 0x0e, 0x7c, 0x8a, 0x6b,
+	0xb2, 0x36, 0xf5, 0xf7, 0x98, 0xbe, 0xfb, 0x8d, 0x52, 0x87, 0x0a, 0x75, 0x9a, 0xfe, 0x9c, 0xe2,
+	0xc6, 0x86, 0x61, 0xd2, 0x37, 0x55, 0xb8, 0x9c, 0x52, 0xdb, 0xed, 0x63, 0xde, 0xbb, 0x16, 0x49,
+	0x87, 0x27, 0x55, 0xe9, 0x3d, 0x42, 0xbb, 0x3d, 0x91, 0x54, 0xba, 0xfa, 0x66, 0x3c, 0x01, 0x53,
+	0x23, 0x4f, 0x00, 0x83, 0x67, 0x32, 0x7c, 0x1e, 0xb3, 0xb3, 0x49, 0x4c, 0xaf, 0x3e, 0x2d, 0x3d,
+	0x79, 0x71, 0xbc, 0xaa, 0xc9, 0x64, 0x99, 0x8e, 0x9c, 0x8e, 0xf2, 0xfb, 0x86, 0x31, 0x3f, 0x01,
+	0x88, 0x36, 0x43, 0xc1, 0xda, 0xcc, 0x1b, 0xb0, 0xd0, 0x77, 0x9f, 0xe8, 0xa2, 0x31, 0x78, 0xff,
+	0x3c, 0x07, 0x4f, 0xbd, 0xa5, 0xde, 0x15, 0x76, 0x05, 0x16, 0x04, 0x5d, 0x87, 0x33, 0x03, 0x1c,
+	0x60, 0x4f, 0xd1, 0xac, 0x6d, 0x3c, 0x5b, 0x6a, 0xda, 0x4d, 0x19, 0x6a, 0xfa, 0xa4, 0x4f, 0xa3,
+	0x1b, 0x70, 0x76, 0x8f, 0x10, 0x7b, 0xc0, 0x58, 0x5f, 0x3f, 0xb4, 0x17, 0x4b, 0x33, 0x5d, 0x27,
+	0xe4, 0x26, 0x63, 0xfd, 0x91, 0x87, 0x74, 0x4f, 0xad, 0xa1, 0x21, 0xac, 0x67, 0x2e, 0xa6, 0x63,
+	0x3b, 0xae, 0xf6, 0xb8, 0xd5, 0x4d, 0x8d, 0x5f, 0xee, 0xe6, 0x9b, 0x84, 0x89, 0xb4, 0xe4, 0x16,
+	0x45, 0x70, 0xf4, 0x59, 0xf1, 0x7c, 0x3d, 0x21, 0x31, 0xdf, 0x18, 0xaf, 0x9c, 0x1e, 0xf5, 0x36,
+	0x60, 0xe2, 0x17, 0x8c, 0x54, 0xf4, 0x2d, 0x80, 0x6b, 0xc6, 0xa5, 0x67, 0xf3, 0xc7, 0x76, 0xd2,
+	0x11, 0xc5, 0xeb, 0x33, 0x92, 0xca, 0xe6, 0x7f, 0x18, 0x73, 0x79, 0x36, 0xcd, 0xa8, 0xf4, 0x00,
+	0x47, 0x5f, 0x01, 0x78, 0x2e, 0xa3, 0xd6, 0x4b, 0xe7, 0x47, 0x6a, 0xd0, 0x49, 0xc9, 0xea, 0xf5,
+	0x63, 0xce, 0x9f, 0x3c, 0xa3, 0x95, 0xe8, 0x91, 0xc1, 0xe8, 0x0b, 0x00, 0x97, 0x33, 0x32, 0x8e,
+	0x6a, 0xf9, 0x29, 0x93, 0x59, 0xc9, 0xe4, 0xea, 0x71, 0xe6, 0x45, 0x9e, 0xc6, 0x33, 0x51, 0x71,
+	0x24, 0xfa, 0xdc, 0x2c, 0xd0, 0x91, 0x76, 0xcc, 0xeb, 0x73, 0x92, 0xc1, 0x95, 0xa3, 0xf7, 0xe3,
+	0x3c, 0xfe, 0x92, 0x5b, 0x14, 0xc7, 0xd1, 0x10, 0x2e, 0x15, 0xf6, 0x3d, 0x5e, 0x87, 0x12, 0xfc,
+	0x95, 0xa3, 0x36, 0xbe, 0x3c, 0xf4, 0x62, 0x41, 0xfb, 0xe3, 0x28, 0x82, 0xcb, 0x38, 0x14, 0xcc,
+	0x76, 0x74, 0xd7, 0xb3, 0x35, 0x41, 0x59, 0x9a, 0x35, 0x89, 0xdd, 0x2a, 0xc5, 0xce, 0xb7, 0xcc,
+	0x11, 0xbf, 0xb1, 0xb1, 0xbd, 0x9d, 0xa5, 0xce, 0xfa, 0xd7, 0x8d, 0xe9, 0xd9, 0xe9, 0xf9, 0x13,
+	0x5b, 0x6f, 0xde, 0xdd, 0x6f, 0x80, 0xfb, 0xfb, 0x0d, 0xf0, 0x60, 0xbf, 0x01, 0xfe, 0xd8, 0x6f,
+	0x80, 0xaf, 0x0f, 0x1a, 0x95, 0x07, 0x07, 0x8d, 0xca, 0xaf, 0x07, 0x8d, 0xca, 0x47, 0x6b, 0x8a,
+	0x01, 0x77, 0x6f, 0x59, 0x94, 0xb5, 0x3e, 0x19, 0xfd, 0xc9, 0x22, 0xdf, 0x70, 0x3b, 0x33, 0xf2,
+	0x67, 0xc7, 0x4b, 0xff, 0x0e, 0x00, 0xfe, 0x39, 0x74, 0x40, 0x54, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -761,6 +809,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -781,6 +866,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1037,6 +1136,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1089,6 +1205,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1992,6 +2114,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2325,6 +2561,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundRecord{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
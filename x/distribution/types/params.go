@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 )
@@ -14,12 +15,44 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+		// auto-compounding is disabled until an epoch identifier is set
+		AutoCompoundEpochIdentifier: "",
+		AutoCompoundMaxDelegations:  DefaultAutoCompoundMaxDelegations,
+		AutoCompoundGasLimit:        DefaultAutoCompoundGasLimit,
 	}
 }
 
+const (
+	// DefaultAutoCompoundMaxDelegations is the default maximum number of delegations compounded per epoch.
+	DefaultAutoCompoundMaxDelegations uint64 = 500
+	// DefaultAutoCompoundGasLimit is the default gas limit of compounding the rewards of a single delegation.
+	DefaultAutoCompoundGasLimit uint64 = 300_000
+)
+
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.AutoCompoundMaxDelegations, p.AutoCompoundGasLimit)
+}
+
+// validateAutoCompound checks that the auto-compound limits are set when auto-compounding is enabled.
+func validateAutoCompound(epochIdentifier string, maxDelegations, gasLimit uint64) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("auto-compound epoch identifier cannot contain leading or trailing spaces: %q", epochIdentifier)
+	}
+	if maxDelegations == 0 {
+		return errors.New("auto-compound max delegations must be positive when auto-compounding is enabled")
+	}
+	if gasLimit == 0 {
+		return errors.New("auto-compound gas limit must be positive when auto-compounding is enabled")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -47,3 +47,33 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_ValidateBasicAutoCompound(t *testing.T) {
+	tests := []struct {
+		name            string
+		epochIdentifier string
+		maxDelegations  uint64
+		gasLimit        uint64
+		wantErr         bool
+	}{
+		{"disabled", "", 0, 0, false},
+		{"enabled", "day", 100, 200_000, false},
+		{"spaces in epoch identifier", " day", 100, 200_000, true},
+		{"zero max delegations", "day", 0, 200_000, true},
+		{"zero gas limit", "day", 100, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.AutoCompoundEpochIdentifier = tt.epochIdentifier
+			p.AutoCompoundMaxDelegations = tt.maxDelegations
+			p.AutoCompoundGasLimit = tt.gasLimit
+			err := p.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -867,6 +867,94 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorAutoCompoundValidatorsRequest is the request type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsRequest{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundValidatorsResponse is the response type for the
+// Query/DelegatorAutoCompoundValidators RPC method.
+type QueryDelegatorAutoCompoundValidatorsResponse struct {
+	// validators defines the validators of the delegations opted in to auto-compounding.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Reset() {
+	*m = QueryDelegatorAutoCompoundValidatorsResponse{}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) ProtoMessage() {}
+func (*QueryDelegatorAutoCompoundValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundValidatorsResponse proto.InternalMessageInfo
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -880,7 +968,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1012,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,6 +1067,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundValidatorsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -988,89 +1078,93 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0x69, 0x4b, 0x5f, 0x29, 0x49, 0x26, 0x01, 0x39, 0xdb, 0xd4, 0x71, 0x37, 0xa4,
+	0x89, 0x1a, 0xe2, 0x4d, 0x53, 0x51, 0x4a, 0x42, 0x04, 0xb1, 0x93, 0x90, 0x88, 0x28, 0x6d, 0xdd,
+	0xd0, 0x88, 0x9f, 0xca, 0x5a, 0x7b, 0x37, 0xce, 0x52, 0x7b, 0xc7, 0xd9, 0x9f, 0x98, 0x28, 0x2a,
+	0x87, 0x72, 0x29, 0x3d, 0x21, 0xe0, 0xc0, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58, 0xc1,
+	0xa5, 0x0a, 0x12, 0xe2, 0x04, 0x28, 0x41, 0xa2, 0x1c, 0x40, 0x70, 0xe2, 0x8a, 0x3c, 0x33, 0x6b,
+	0xef, 0xfa, 0x67, 0xfd, 0x87, 0xb9, 0xb4, 0xd6, 0xdb, 0x79, 0xdf, 0xf7, 0xbe, 0xf7, 0xde, 0xcc,
+	0xbc, 0x09, 0x8c, 0xa5, 0x88, 0x99, 0x25, 0xa6, 0xa4, 0x68, 0xa6, 0x65, 0x68, 0x49, 0xdb, 0xd2,
+	0x88, 0x2e, 0xed, 0x5c, 0x4a, 0xaa, 0x96, 0x7c, 0x49, 0xda, 0xb6, 0x55, 0x63, 0x37, 0x92, 0x33,
+	0x88, 0x45, 0xf0, 0x59, 0xb6, 0x30, 0xe2, 0x5e, 0x18, 0xe1, 0x0b, 0x85, 0x8b, 0x1c, 0x25, 0x29,
+	0x9b, 0x2a, 0xf3, 0x2a, 0x62, 0xe4, 0xe4, 0xb4, 0xa6, 0xcb, 0x74, 0x35, 0x05, 0x12, 0x06, 0xd2,
+	0x24, 0x4d, 0xe8, 0x4f, 0xa9, 0xf0, 0x8b, 0x5b, 0x87, 0xd2, 0x84, 0xa4, 0x33, 0xaa, 0x24, 0xe7,
+	0x34, 0x49, 0xd6, 0x75, 0x62, 0x51, 0x17, 0x93, 0x7f, 0x0d, 0xb9, 0xf1, 0x1d, 0xe4, 0x14, 0xd1,
+	0x1c, 0xcc, 0x88, 0x9f, 0x0a, 0x4f, 0xc4, 0x6c, 0xfd, 0x20, 0x5b, 0x9f, 0x60, 0x61, 0x70, 0x65,
+	0xec, 0x53, 0x9f, 0x9c, 0xd5, 0x74, 0x22, 0xd1, 0x7f, 0x99, 0x49, 0x1c, 0x00, 0x7c, 0xa3, 0xa0,
+	0xe9, 0xba, 0x6c, 0xc8, 0x59, 0x33, 0xae, 0x6e, 0xdb, 0xaa, 0x69, 0x89, 0xb7, 0xa1, 0xdf, 0x63,
+	0x35, 0x73, 0x44, 0x37, 0x55, 0xbc, 0x04, 0x27, 0x72, 0xd4, 0x12, 0x44, 0x61, 0x34, 0x7e, 0x7a,
+	0x7a, 0x24, 0xe2, 0x93, 0xb8, 0x08, 0x73, 0x8e, 0x9e, 0x7a, 0xf8, 0xd3, 0x70, 0xd7, 0x17, 0xbf,
+	0x7d, 0x75, 0x11, 0xc5, 0xb9, 0xb7, 0x98, 0x87, 0x51, 0x0a, 0x7f, 0x4b, 0xce, 0x68, 0x8a, 0x6c,
+	0x11, 0x63, 0xc1, 0xe5, 0xbf, 0xa2, 0x6f, 0x12, 0x1e, 0x07, 0x5e, 0x83, 0xbe, 0x1d, 0x67, 0x4d,
+	0x42, 0x56, 0x14, 0x43, 0x35, 0x19, 0xf7, 0xa9, 0xe8, 0xf9, 0x83, 0xfd, 0xc9, 0x73, 0x9c, 0xbe,
+	0x88, 0x33, 0xcf, 0x96, 0xdc, 0xb4, 0x0c, 0x4d, 0x4f, 0xc7, 0x7b, 0x77, 0xca, 0xec, 0xe2, 0x9f,
+	0x01, 0xb8, 0x50, 0x8f, 0x99, 0x6b, 0x5d, 0x85, 0x5e, 0x92, 0x53, 0x8d, 0xd6, 0x98, 0x7b, 0x1c,
+	0x57, 0x6e, 0xc6, 0xf7, 0x10, 0xf4, 0x99, 0x6a, 0x66, 0x33, 0x91, 0x24, 0xba, 0x92, 0x30, 0xd4,
+	0xbc, 0x6c, 0x28, 0x66, 0x30, 0x10, 0x3e, 0x36, 0x7e, 0x7a, 0x7a, 0xc8, 0xc9, 0x62, 0xa1, 0x03,
+	0x8a, 0xd9, 0x5b, 0x50, 0x53, 0x31, 0xa2, 0xe9, 0xd1, 0xab, 0x85, 0xf4, 0x7d, 0xf9, 0xf3, 0xf0,
+	0x44, 0x5a, 0xb3, 0xb6, 0xec, 0x64, 0x24, 0x45, 0xb2, 0xbc, 0xa8, 0xfc, 0xbf, 0x49, 0x53, 0xb9,
+	0x23, 0x59, 0xbb, 0x39, 0xd5, 0x74, 0x7c, 0x4c, 0x96, 0xed, 0x9e, 0x02, 0x61, 0x94, 0xe8, 0x4a,
+	0x9c, 0xd1, 0xe1, 0x6d, 0x80, 0x14, 0xc9, 0x66, 0x35, 0xd3, 0xd4, 0x88, 0x1e, 0x3c, 0xd6, 0x00,
+	0xf9, 0xe5, 0x16, 0xc8, 0xe3, 0x2e, 0x12, 0x71, 0x17, 0xc6, 0xbc, 0xf9, 0xbe, 0x66, 0x5b, 0xa6,
+	0x25, 0xeb, 0x4a, 0x21, 0x4b, 0x2c, 0xac, 0x4e, 0xd5, 0xfa, 0x03, 0x04, 0xe3, 0xf5, 0xb9, 0x79,
+	0xb5, 0x6f, 0xc3, 0x49, 0xa7, 0x28, 0xac, 0xb5, 0xaf, 0xfa, 0xb6, 0xb6, 0x0f, 0xa4, 0xbb, 0xdf,
+	0x1d, 0x4c, 0x71, 0x1b, 0x86, 0xbd, 0xa1, 0xc4, 0x8a, 0x29, 0xea, 0x94, 0xfc, 0x07, 0x08, 0xc2,
+	0xb5, 0x39, 0xb9, 0xec, 0x4d, 0x4f, 0x47, 0x30, 0xe5, 0xb3, 0x8d, 0x29, 0x9f, 0x4f, 0xa5, 0xec,
+	0xac, 0x9d, 0x91, 0x2d, 0x55, 0x29, 0x01, 0xbb, 0xc5, 0xbb, 0xdb, 0xe0, 0x41, 0x00, 0x86, 0xbc,
+	0xc1, 0xdc, 0xcc, 0xc8, 0xe6, 0x96, 0xda, 0xa9, 0xe2, 0xe3, 0x31, 0xe8, 0x31, 0x2d, 0xd9, 0xb0,
+	0x34, 0x3d, 0x9d, 0xd8, 0x52, 0xb5, 0xf4, 0x96, 0x15, 0x0c, 0x84, 0xd1, 0x78, 0x77, 0xfc, 0x29,
+	0xc7, 0xbc, 0x4c, 0xad, 0x78, 0x04, 0xce, 0xa8, 0xba, 0xe2, 0x5a, 0x76, 0x8c, 0x2e, 0x7b, 0x92,
+	0x19, 0xf9, 0xa2, 0x25, 0x80, 0xd2, 0x51, 0x1f, 0xec, 0xa6, 0x69, 0xba, 0xe0, 0xd9, 0x38, 0xec,
+	0x36, 0x29, 0x9d, 0x7c, 0x69, 0x95, 0x2b, 0x8b, 0xbb, 0x3c, 0x67, 0xba, 0xef, 0x7f, 0x3e, 0xdc,
+	0x25, 0x7e, 0x8d, 0xe0, 0x5c, 0x8d, 0x64, 0xf0, 0xb2, 0xbc, 0x0e, 0x27, 0x4d, 0x66, 0x0a, 0x22,
+	0xba, 0x4b, 0xa7, 0x1a, 0xab, 0x09, 0xc5, 0x59, 0xdc, 0x51, 0x75, 0xcb, 0xd3, 0x85, 0x1c, 0x0b,
+	0xbf, 0xea, 0x91, 0x11, 0xa0, 0x32, 0xc6, 0xea, 0xca, 0x60, 0x31, 0xb9, 0x75, 0x88, 0xdf, 0x38,
+	0x0a, 0x16, 0xd4, 0x8c, 0x9a, 0xa6, 0xb6, 0xb2, 0xcd, 0xbc, 0x08, 0x7d, 0x0a, 0xfb, 0x56, 0x51,
+	0xcf, 0xe0, 0xc1, 0xfe, 0xe4, 0x00, 0x27, 0x2d, 0x2b, 0x63, 0xd1, 0xc5, 0x29, 0x63, 0xd5, 0xb6,
+	0x08, 0xb4, 0xdc, 0x16, 0x33, 0x4f, 0x14, 0x0a, 0xf0, 0xb8, 0x50, 0x84, 0x8f, 0x10, 0x84, 0x6a,
+	0x49, 0xe0, 0x55, 0xc8, 0xb9, 0xcf, 0x84, 0x4e, 0x1e, 0xd4, 0xc5, 0x63, 0xc2, 0x06, 0xb1, 0x2c,
+	0xa6, 0x75, 0x62, 0xc9, 0x99, 0x8e, 0xe4, 0xd6, 0x95, 0x8b, 0xbf, 0x10, 0x8c, 0xf8, 0xf2, 0xf2,
+	0x84, 0xbc, 0x55, 0x9e, 0x90, 0x2b, 0xbe, 0x6d, 0x59, 0x42, 0x5b, 0x70, 0xb8, 0x19, 0x62, 0xb5,
+	0x23, 0x12, 0x67, 0xe0, 0xb8, 0x55, 0x20, 0xed, 0xf0, 0xa5, 0xc8, 0x48, 0x44, 0x83, 0x1f, 0xc8,
+	0xc5, 0xc8, 0x8a, 0x2d, 0xd4, 0xb9, 0x34, 0xaf, 0x42, 0xb8, 0x36, 0x27, 0x4f, 0x71, 0x08, 0xa0,
+	0xd8, 0xb4, 0x2c, 0xcb, 0xa7, 0xe2, 0x2e, 0x8b, 0x0b, 0x2d, 0x0f, 0xcf, 0x7a, 0xd1, 0x36, 0x34,
+	0x6b, 0x4b, 0x31, 0xe4, 0x3c, 0x27, 0xee, 0x98, 0x8c, 0x1d, 0x18, 0xad, 0x43, 0xcc, 0xb5, 0xc4,
+	0xa0, 0x37, 0xcf, 0x3f, 0x35, 0x4c, 0xdc, 0x93, 0xf7, 0x82, 0xb9, 0x78, 0xdf, 0x83, 0x09, 0x2f,
+	0xef, 0xbc, 0x6d, 0x91, 0x18, 0xc9, 0xe6, 0x88, 0xad, 0x2b, 0xff, 0x43, 0xf9, 0xf6, 0xe0, 0xb9,
+	0xc6, 0xf8, 0xb9, 0xfc, 0xf9, 0xca, 0x52, 0x36, 0x72, 0x68, 0x55, 0xaf, 0xf6, 0x34, 0x0c, 0x52,
+	0xf2, 0xc2, 0x55, 0x6b, 0xeb, 0x9a, 0xb5, 0x7b, 0x9d, 0x90, 0x0c, 0x97, 0x3a, 0xf3, 0xf4, 0xc1,
+	0xfe, 0x64, 0x4f, 0xa9, 0xef, 0xc3, 0x53, 0x91, 0xe7, 0xa7, 0x82, 0x48, 0xfc, 0x0c, 0x81, 0x50,
+	0xcd, 0x89, 0xc7, 0xf7, 0x0e, 0x74, 0xe7, 0x08, 0xc9, 0x74, 0xf8, 0x6c, 0xa3, 0x1c, 0x35, 0x22,
+	0x9c, 0xfe, 0xbb, 0x1f, 0x8e, 0xd3, 0x08, 0xf1, 0xa7, 0x08, 0x4e, 0xb0, 0xf7, 0x02, 0x96, 0x7c,
+	0x0f, 0x95, 0xca, 0xc7, 0x8a, 0x30, 0xd5, 0xb8, 0x03, 0x93, 0x2e, 0x4e, 0xdc, 0xfb, 0xfe, 0xd7,
+	0x8f, 0x03, 0xa3, 0x78, 0x44, 0xf2, 0x7b, 0x5b, 0xb1, 0xc7, 0x0a, 0xfe, 0x1d, 0xc1, 0x60, 0xcd,
+	0xe7, 0x02, 0x8e, 0xd6, 0x27, 0xaf, 0xf7, 0xca, 0x11, 0x62, 0x6d, 0x61, 0x70, 0x4d, 0x31, 0xaa,
+	0x69, 0x0e, 0xcf, 0xfa, 0x6a, 0x2a, 0x35, 0x97, 0xb4, 0x57, 0x71, 0xb3, 0xde, 0xc5, 0xef, 0x07,
+	0xe0, 0xac, 0xcf, 0x6c, 0x8b, 0x17, 0x9a, 0x88, 0xb4, 0xe6, 0xa4, 0x2f, 0x2c, 0xb6, 0x89, 0xc2,
+	0x15, 0x6f, 0x50, 0xc5, 0x37, 0xf0, 0xb5, 0x36, 0x14, 0x4b, 0xa4, 0x84, 0xef, 0x3c, 0xcb, 0xf0,
+	0x21, 0x82, 0xfe, 0x2a, 0x53, 0x33, 0x7e, 0xa9, 0x89, 0xb8, 0x2b, 0x06, 0x7c, 0x61, 0xae, 0x45,
+	0x6f, 0xae, 0x76, 0x8d, 0xaa, 0x5d, 0xc6, 0x4b, 0xed, 0xa8, 0x2d, 0x8d, 0xe4, 0xf8, 0x07, 0x04,
+	0xbd, 0xe5, 0x03, 0x28, 0x7e, 0xb1, 0x89, 0x18, 0xbd, 0x13, 0xbc, 0x30, 0xd3, 0x8a, 0x2b, 0xd7,
+	0xf6, 0x1a, 0xd5, 0xb6, 0x88, 0x63, 0xed, 0x68, 0x73, 0xa6, 0xdc, 0x3f, 0x10, 0xf4, 0x55, 0x0c,
+	0x75, 0xb8, 0x81, 0xf0, 0x6a, 0x0d, 0xb3, 0xc2, 0x6c, 0x4b, 0xbe, 0x5c, 0x5b, 0x82, 0x6a, 0x7b,
+	0x03, 0x6f, 0xf8, 0x6a, 0x2b, 0xde, 0x3b, 0xa6, 0xb4, 0x57, 0x71, 0x6d, 0xdd, 0x95, 0x78, 0x67,
+	0x56, 0xdd, 0xb3, 0x8f, 0x11, 0x3c, 0x53, 0x7d, 0x70, 0xc3, 0x2f, 0x37, 0x13, 0x78, 0x95, 0x51,
+	0x53, 0x78, 0xa5, 0x75, 0x80, 0xa6, 0x4a, 0xdb, 0x98, 0x7c, 0xba, 0x31, 0xab, 0x4c, 0x4f, 0x8d,
+	0x6c, 0xcc, 0xda, 0x83, 0x9e, 0x30, 0xd7, 0xa2, 0x77, 0x53, 0x1b, 0xb3, 0x8e, 0xc2, 0x52, 0x6f,
+	0xe3, 0x7f, 0x10, 0x04, 0x6b, 0xcd, 0x56, 0x78, 0xbe, 0x89, 0x58, 0xab, 0x0f, 0x84, 0x42, 0xb4,
+	0x1d, 0x08, 0xae, 0x79, 0x9d, 0x6a, 0x5e, 0xc3, 0xab, 0xed, 0x68, 0x2e, 0x1f, 0x0e, 0xf1, 0x27,
+	0x01, 0x18, 0xae, 0x33, 0x5d, 0xe1, 0xe5, 0x26, 0xa2, 0xf7, 0x1d, 0x10, 0x85, 0x95, 0xff, 0x00,
+	0x89, 0xa7, 0xe3, 0x6d, 0x9a, 0x8e, 0x5b, 0x78, 0xbd, 0x9d, 0x74, 0xc8, 0xb6, 0x45, 0x12, 0x29,
+	0x4e, 0x92, 0x70, 0x35, 0xc4, 0x77, 0x08, 0xce, 0x78, 0x46, 0x38, 0x7c, 0xa5, 0x7e, 0xe8, 0xd5,
+	0x06, 0x45, 0xe1, 0x85, 0xa6, 0xfd, 0xb8, 0xc0, 0x95, 0x6f, 0x2b, 0xe7, 0x37, 0xaa, 0x79, 0x12,
+	0x4f, 0xf8, 0x6a, 0x4e, 0x39, 0x70, 0x89, 0xc2, 0x1c, 0x78, 0x3f, 0x80, 0xa2, 0xb3, 0x0f, 0x0f,
+	0x43, 0xe8, 0xd1, 0x61, 0x08, 0xfd, 0x72, 0x18, 0x42, 0x1f, 0x1e, 0x85, 0xba, 0x1e, 0x1d, 0x85,
+	0xba, 0x7e, 0x3c, 0x0a, 0x75, 0xbd, 0x79, 0x9e, 0x01, 0x99, 0xca, 0x9d, 0x88, 0x46, 0xa4, 0x77,
+	0xbd, 0x80, 0x74, 0xb6, 0x4c, 0x9e, 0xa0, 0x7f, 0xb4, 0xbe, 0xfc, 0xef, 0x00, 0xb7, 0xdf, 0xd3,
+	0xb4, 0xda, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1104,6 +1198,9 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators of the delegations of
+	// a delegator opted in to auto-compounding.
+	DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1199,6 +1296,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompoundValidators(ctx context.Context, in *QueryDelegatorAutoCompoundValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1230,6 +1336,9 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompoundValidators queries the validators of the delegations of
+	// a delegator opted in to auto-compounding.
+	DelegatorAutoCompoundValidators(context.Context, *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1267,6 +1376,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompoundValidators(ctx context.Context, req *QueryDelegatorAutoCompoundValidatorsRequest) (*QueryDelegatorAutoCompoundValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundValidators not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1437,6 +1549,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompoundValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompoundValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundValidators(ctx, req.(*QueryDelegatorAutoCompoundValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1496,6 +1626,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompoundValidators",
+			Handler:    _Query_DelegatorAutoCompoundValidators_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2153,6 +2287,68 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2492,6 +2688,34 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4219,6 +4443,170 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,12 @@
 package epochs
 
 import (
+	"fmt"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -23,5 +27,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "AddEpochInfo",
+					Use:            "add-epoch-proposal [epoch-info]",
+					Short:          "Submit a proposal to add a new epoch identifier",
+					Example:        fmt.Sprintf(`%s tx epochs add-epoch-proposal '{ "identifier": "month", "start_time": "2025-01-01T00:00:00Z", "duration": "720h" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_info"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateEpochInfo",
+					Use:            "update-epoch-proposal [identifier]",
+					Short:          "Submit a proposal to update the duration or start time of an epoch identifier",
+					Example:        fmt.Sprintf(`%s tx epochs update-epoch-proposal day --duration 12h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveEpochInfo",
+					Use:            "remove-epoch-proposal [identifier]",
+					Short:          "Submit a proposal to remove an epoch identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
+			},
+		},
 	}
 }
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/epochs/types"
 )
 
@@ -26,7 +30,7 @@ func (k Keeper) AddEpochInfo(ctx context.Context, epoch types.EpochInfo) error {
 		return err
 	}
 	if isExist {
-		return fmt.Errorf("epoch with identifier %s already exists", epoch.Identifier)
+		return errorsmod.Wrapf(types.ErrEpochAlreadyExist, "epoch with identifier %s already exists", epoch.Identifier)
 	}
 
 	// Initialize empty and default epoch values
@@ -39,6 +43,56 @@ func (k Keeper) AddEpochInfo(ctx context.Context, epoch types.EpochInfo) error {
 	return k.EpochInfo.Set(ctx, epoch.Identifier, epoch)
 }
 
+// UpdateEpochInfo updates the duration and/or the start time of an existing epoch.
+// A nil value leaves the field unchanged. The start time can only be updated before
+// the epoch counting started, while a new duration applies from the current epoch onwards.
+func (k Keeper) UpdateEpochInfo(ctx context.Context, identifier string, duration *time.Duration, startTime *time.Time) (types.EpochInfo, error) {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", identifier)
+		}
+		return types.EpochInfo{}, err
+	}
+
+	if duration != nil {
+		epoch.Duration = *duration
+	}
+	if startTime != nil {
+		if epoch.EpochCountingStarted {
+			return types.EpochInfo{}, errorsmod.Wrapf(types.ErrInvalidEpochInfo, "cannot update start time of epoch %s: counting already started", identifier)
+		}
+		epoch.StartTime = *startTime
+	}
+
+	if err := epoch.Validate(); err != nil {
+		return types.EpochInfo{}, errorsmod.Wrap(types.ErrInvalidEpochInfo, err.Error())
+	}
+	return epoch, k.EpochInfo.Set(ctx, identifier, epoch)
+}
+
+// RemoveEpochInfo removes an epoch. Will return an error if the epoch does not exist
+// or if a registered epoch hook still relies on its identifier.
+func (k Keeper) RemoveEpochInfo(ctx context.Context, identifier string) error {
+	isExist, err := k.EpochInfo.Has(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if !isExist {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch with identifier %s not found", identifier)
+	}
+
+	inUse, err := types.IsEpochIdentifierInUse(ctx, k.Hooks(), identifier)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errorsmod.Wrapf(types.ErrEpochInUse, "epoch with identifier %s is used by a registered epoch hook", identifier)
+	}
+
+	return k.EpochInfo.Remove(ctx, identifier)
+}
+
 // AllEpochInfos iterate through epochs to return all epochs info.
 func (k Keeper) AllEpochInfos(ctx context.Context) ([]types.EpochInfo, error) {
 	epochs := []types.EpochInfo{}
@@ -14,16 +14,21 @@ type Keeper struct {
 	cdc   codec.BinaryCodec
 	hooks types.EpochHooks
 
+	// the address capable of executing epoch messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema    collections.Schema
 	EpochInfo collections.Map[string, types.EpochInfo]
 }
 
 // NewKeeper returns a new keeper by codec and storeKey inputs.
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec) *Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := Keeper{
 		Environment: env,
 		cdc:         cdc,
+		authority:   authority,
 		EpochInfo:   collections.NewMap(sb, types.KeyPrefixEpoch, "epoch_info", collections.StringKey, codec.CollValue[types.EpochInfo](cdc)),
 	}
 
@@ -35,6 +40,11 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec) *Keeper {
 	return &k
 }
 
+// GetAuthority returns the x/epochs module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Set the gamm hooks.
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -19,16 +19,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
+var authority = sdk.AccAddress(address.Module("gov")).String()
+
 type KeeperTestSuite struct {
 	suite.Suite
 	Ctx          sdk.Context
 	environment  appmodule.Environment
 	EpochsKeeper *epochskeeper.Keeper
 	queryClient  types.QueryClient
+	msgServer    types.MsgServer
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -37,6 +41,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Ctx = ctx
 	s.EpochsKeeper = epochsKeeper
 	s.environment = environment
+	s.msgServer = epochskeeper.NewMsgServerImpl(epochsKeeper)
 	queryRouter := baseapp.NewGRPCQueryRouter()
 	cfg := module.NewConfigurator(nil, nil, queryRouter)
 	types.RegisterQueryServer(cfg.QueryServer(), epochskeeper.NewQuerier(*s.EpochsKeeper))
@@ -62,6 +67,7 @@ func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environme
 	epochsKeeper := epochskeeper.NewKeeper(
 		environment,
 		encCfg.Codec,
+		authority,
 	)
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC(), ChainID: "epochs"})
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/epochs/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AddEpochInfo adds a new epoch identifier.
+func (k msgServer) AddEpochInfo(ctx context.Context, msg *types.MsgAddEpochInfo) (*types.MsgAddEpochInfoResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateEpochIdentifierString(msg.EpochInfo.Identifier); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpochInfo, err.Error())
+	}
+	if err := validateDuration(msg.EpochInfo.Duration); err != nil {
+		return nil, err
+	}
+
+	// only the identifier, start time and duration are taken from the message,
+	// the counting starts from scratch.
+	epoch := types.NewGenesisEpochInfo(msg.EpochInfo.Identifier, msg.EpochInfo.Duration)
+	epoch.StartTime = msg.EpochInfo.StartTime
+	if err := k.Keeper.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	epoch, err := k.Keeper.GetEpochInfo(ctx, epoch.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventEpochInfoAdded{EpochInfo: epoch}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddEpochInfoResponse{}, nil
+}
+
+// UpdateEpochInfo updates the duration or start time of an existing epoch identifier.
+func (k msgServer) UpdateEpochInfo(ctx context.Context, msg *types.MsgUpdateEpochInfo) (*types.MsgUpdateEpochInfoResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if msg.Duration == nil && msg.StartTime == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpochInfo, "either duration or start time must be set")
+	}
+	if msg.Duration != nil {
+		if err := validateDuration(*msg.Duration); err != nil {
+			return nil, err
+		}
+	}
+
+	epoch, err := k.Keeper.UpdateEpochInfo(ctx, msg.Identifier, msg.Duration, msg.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventEpochInfoUpdated{EpochInfo: epoch}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochInfoResponse{}, nil
+}
+
+// RemoveEpochInfo removes an epoch identifier not used by any registered epoch hook.
+func (k msgServer) RemoveEpochInfo(ctx context.Context, msg *types.MsgRemoveEpochInfo) (*types.MsgRemoveEpochInfoResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventEpochInfoRemoved{Identifier: msg.Identifier}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochInfoResponse{}, nil
+}
+
+func (k msgServer) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+func validateDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidEpochInfo, "epoch duration must be positive, got %s", duration)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// consumerHook is an epoch hook relying on a single epoch identifier.
+type consumerHook struct {
+	identifier string
+}
+
+func (consumerHook) AfterEpochEnd(context.Context, string, int64) error    { return nil }
+func (consumerHook) BeforeEpochStart(context.Context, string, int64) error { return nil }
+
+func (h consumerHook) IsEpochIdentifierInUse(_ context.Context, identifier string) (bool, error) {
+	return h.identifier == identifier, nil
+}
+
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, e := range s.Ctx.EventManager().Events() {
+		if e.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestMsgAddEpochInfo() {
+	future := s.Ctx.HeaderInfo().Time.Add(time.Hour)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAddEpochInfo
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgAddEpochInfo{Authority: "invalid", EpochInfo: types.NewGenesisEpochInfo("month", time.Hour*24*30)},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "empty identifier",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.NewGenesisEpochInfo("", time.Hour)},
+			expErr: types.ErrInvalidEpochInfo,
+		},
+		{
+			name:   "zero duration",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.NewGenesisEpochInfo("month", 0)},
+			expErr: types.ErrInvalidEpochInfo,
+		},
+		{
+			name:   "negative duration",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.NewGenesisEpochInfo("month", -time.Hour)},
+			expErr: types.ErrInvalidEpochInfo,
+		},
+		{
+			name:   "existing identifier",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.NewGenesisEpochInfo("day", time.Hour)},
+			expErr: types.ErrEpochAlreadyExist,
+		},
+		{
+			name: "valid",
+			msg:  &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.NewGenesisEpochInfo("month", time.Hour*24*30)},
+		},
+		{
+			name: "valid with start time, counting fields are reset",
+			msg: &types.MsgAddEpochInfo{Authority: authority, EpochInfo: types.EpochInfo{
+				Identifier:           "month",
+				StartTime:            future,
+				Duration:             time.Hour * 24 * 30,
+				CurrentEpoch:         10,
+				EpochCountingStarted: true,
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.msgServer.AddEpochInfo(s.Ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			epoch, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, tc.msg.EpochInfo.Identifier)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.msg.EpochInfo.Duration, epoch.Duration)
+			s.Require().Equal(int64(0), epoch.CurrentEpoch)
+			s.Require().False(epoch.EpochCountingStarted)
+			if tc.msg.EpochInfo.StartTime.IsZero() {
+				s.Require().Equal(s.Ctx.HeaderInfo().Time, epoch.StartTime)
+			} else {
+				s.Require().Equal(tc.msg.EpochInfo.StartTime, epoch.StartTime)
+			}
+			s.Require().True(s.hasEvent("cosmos.epochs.v1beta1.EventEpochInfoAdded"))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateEpochInfo() {
+	newDuration := time.Hour * 12
+	newStart := s.Ctx.HeaderInfo().Time.Add(time.Hour)
+
+	_, err := s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: "invalid", Identifier: "day", Duration: &newDuration})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "day"})
+	s.Require().ErrorIs(err, types.ErrInvalidEpochInfo)
+
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "month", Duration: &newDuration})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	zero := time.Duration(0)
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "day", Duration: &zero})
+	s.Require().ErrorIs(err, types.ErrInvalidEpochInfo)
+
+	// the start time can be updated before the counting started
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "day", Duration: &newDuration, StartTime: &newStart})
+	s.Require().NoError(err)
+	s.Require().True(s.hasEvent("cosmos.epochs.v1beta1.EventEpochInfoUpdated"))
+
+	epoch, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(newDuration, epoch.Duration)
+	s.Require().Equal(newStart, epoch.StartTime)
+
+	// start counting the hour epoch, its start time cannot be updated anymore
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().True(epoch.EpochCountingStarted)
+
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "hour", StartTime: &newStart})
+	s.Require().ErrorIs(err, types.ErrInvalidEpochInfo)
+
+	// the new duration applies to the current epoch: with the previous one hour
+	// duration, the epoch would have ticked after two hours
+	_, err = s.msgServer.UpdateEpochInfo(s.Ctx, &types.MsgUpdateEpochInfo{Authority: authority, Identifier: "hour", Duration: &newDuration})
+	s.Require().NoError(err)
+
+	header := s.Ctx.HeaderInfo()
+	header.Time = header.Time.Add(2 * time.Hour)
+	s.Ctx = s.Ctx.WithHeaderInfo(header)
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveEpochInfo() {
+	_, err := s.msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: "invalid", Identifier: "day"})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "month"})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = s.msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "minute"})
+	s.Require().NoError(err)
+	s.Require().True(s.hasEvent("cosmos.epochs.v1beta1.EventEpochInfoRemoved"))
+
+	_, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "minute")
+	s.Require().Error(err)
+
+	// an identifier used by a registered hook cannot be removed
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	k := epochskeeper.NewKeeper(s.environment, encCfg.Codec, authority)
+	k.SetHooks(types.NewMultiEpochHooks(types.EpochHooksWrapper{EpochHooks: consumerHook{identifier: "day"}}))
+	msgServer := epochskeeper.NewMsgServerImpl(k)
+
+	_, err = msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "day"})
+	s.Require().ErrorIs(err, types.ErrEpochInUse)
+
+	_, err = msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "week"})
+	s.Require().NoError(err)
+}
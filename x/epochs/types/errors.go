@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidSigner     = errors.Register(ModuleName, 2, "expected authority account as only signer for epoch message")
+	ErrEpochNotFound     = errors.Register(ModuleName, 3, "epoch not found")
+	ErrEpochInUse        = errors.Register(ModuleName, 4, "epoch identifier is in use")
+	ErrInvalidEpochInfo  = errors.Register(ModuleName, 5, "invalid epoch info")
+	ErrEpochAlreadyExist = errors.Register(ModuleName, 6, "epoch already exists")
+)
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -121,9 +122,147 @@ func (m *EventEpochStart) GetEpochStartTime() int64 {
 	return 0
 }
 
+// EventEpochInfoAdded is an event emitted when an epoch identifier is added at runtime.
+type EventEpochInfoAdded struct {
+	EpochInfo EpochInfo `protobuf:"bytes,1,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info"`
+}
+
+func (m *EventEpochInfoAdded) Reset()         { *m = EventEpochInfoAdded{} }
+func (m *EventEpochInfoAdded) String() string { return proto.CompactTextString(m) }
+func (*EventEpochInfoAdded) ProtoMessage()    {}
+func (*EventEpochInfoAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{2}
+}
+func (m *EventEpochInfoAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochInfoAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochInfoAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochInfoAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochInfoAdded.Merge(m, src)
+}
+func (m *EventEpochInfoAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochInfoAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochInfoAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochInfoAdded proto.InternalMessageInfo
+
+func (m *EventEpochInfoAdded) GetEpochInfo() EpochInfo {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return EpochInfo{}
+}
+
+// EventEpochInfoUpdated is an event emitted when an epoch identifier is updated at runtime.
+type EventEpochInfoUpdated struct {
+	EpochInfo EpochInfo `protobuf:"bytes,1,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info"`
+}
+
+func (m *EventEpochInfoUpdated) Reset()         { *m = EventEpochInfoUpdated{} }
+func (m *EventEpochInfoUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochInfoUpdated) ProtoMessage()    {}
+func (*EventEpochInfoUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{3}
+}
+func (m *EventEpochInfoUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochInfoUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochInfoUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochInfoUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochInfoUpdated.Merge(m, src)
+}
+func (m *EventEpochInfoUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochInfoUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochInfoUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochInfoUpdated proto.InternalMessageInfo
+
+func (m *EventEpochInfoUpdated) GetEpochInfo() EpochInfo {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return EpochInfo{}
+}
+
+// EventEpochInfoRemoved is an event emitted when an epoch identifier is removed at runtime.
+type EventEpochInfoRemoved struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochInfoRemoved) Reset()         { *m = EventEpochInfoRemoved{} }
+func (m *EventEpochInfoRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventEpochInfoRemoved) ProtoMessage()    {}
+func (*EventEpochInfoRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{4}
+}
+func (m *EventEpochInfoRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochInfoRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochInfoRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochInfoRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochInfoRemoved.Merge(m, src)
+}
+func (m *EventEpochInfoRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochInfoRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochInfoRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochInfoRemoved proto.InternalMessageInfo
+
+func (m *EventEpochInfoRemoved) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventEpochEnd)(nil), "cosmos.epochs.v1beta1.EventEpochEnd")
 	proto.RegisterType((*EventEpochStart)(nil), "cosmos.epochs.v1beta1.EventEpochStart")
+	proto.RegisterType((*EventEpochInfoAdded)(nil), "cosmos.epochs.v1beta1.EventEpochInfoAdded")
+	proto.RegisterType((*EventEpochInfoUpdated)(nil), "cosmos.epochs.v1beta1.EventEpochInfoUpdated")
+	proto.RegisterType((*EventEpochInfoRemoved)(nil), "cosmos.epochs.v1beta1.EventEpochInfoRemoved")
 }
 
 func init() {
@@ -131,22 +270,27 @@ func init() {
 }
 
 var fileDescriptor_691f9b4b0a500cb4 = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x92, 0x84, 0x08, 0xc7, 0x83, 0x15, 0xe9,
-	0x43, 0xd5, 0x80, 0x39, 0x52, 0xca, 0xd8, 0x4d, 0x4d, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x84, 0x2a,
-	0x52, 0x32, 0xe2, 0xe2, 0x75, 0x05, 0x59, 0xe3, 0x0a, 0x52, 0xe4, 0x9a, 0x97, 0x22, 0xa4, 0xc8,
-	0xc5, 0x03, 0xd6, 0x10, 0x9f, 0x57, 0x9a, 0x9b, 0x94, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x1c, 0xc4, 0x0d, 0x16, 0xf3, 0x03, 0x0b, 0x29, 0xc5, 0x71, 0xf1, 0x23, 0xf4, 0x04, 0x97, 0x24,
-	0x16, 0x95, 0x10, 0xa1, 0x4b, 0x48, 0x83, 0x4b, 0x00, 0xa2, 0xa4, 0x18, 0xa4, 0x23, 0xbe, 0x24,
-	0x33, 0x37, 0x55, 0x82, 0x09, 0xac, 0x8c, 0x2f, 0x15, 0x6e, 0x50, 0x48, 0x66, 0x6e, 0xaa, 0x93,
-	0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x43, 0xbc, 0x54, 0x9c,
-	0x92, 0xad, 0x97, 0x99, 0xaf, 0x5f, 0x01, 0xf3, 0x5a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x47, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x88, 0x5e, 0xe4, 0x4e, 0x01, 0x00,
-	0x00,
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x13, 0x15, 0xa1, 0x57, 0xff, 0x11, 0x2d, 0xd4, 0x0a, 0x67, 0x8d, 0x4b, 0xa7, 0x0b,
+	0xad, 0x88, 0xb3, 0x85, 0x0c, 0x2e, 0x0e, 0x51, 0x17, 0x91, 0x96, 0xb6, 0xf7, 0x26, 0x1e, 0x92,
+	0xbb, 0x90, 0x3b, 0x83, 0x7e, 0x0b, 0x3f, 0x56, 0xc7, 0x8e, 0x4e, 0x22, 0xc9, 0x17, 0x91, 0xdc,
+	0xa5, 0xad, 0x4a, 0x07, 0x07, 0xb7, 0xe3, 0x79, 0x7f, 0xcf, 0xf3, 0x1c, 0xef, 0x8b, 0xdc, 0x89,
+	0x90, 0xb1, 0x90, 0x1e, 0x24, 0x62, 0xf2, 0x28, 0xbd, 0xac, 0x3b, 0x06, 0x35, 0xea, 0x7a, 0x90,
+	0x01, 0x57, 0x92, 0x24, 0xa9, 0x50, 0xc2, 0x69, 0x18, 0x86, 0x18, 0x86, 0x54, 0x4c, 0xeb, 0x20,
+	0x12, 0x91, 0xd0, 0x84, 0x57, 0xbe, 0x0c, 0xdc, 0x3a, 0x34, 0xf0, 0xd0, 0x0c, 0x2a, 0xa7, 0x19,
+	0x9d, 0xae, 0xee, 0x8a, 0x80, 0x83, 0x64, 0x15, 0xe4, 0xf6, 0xd0, 0xb6, 0x5f, 0x96, 0xfb, 0x25,
+	0xe4, 0x73, 0xea, 0x9c, 0xa0, 0x2d, 0x6d, 0x18, 0xf2, 0xe7, 0x78, 0x0c, 0x69, 0xd3, 0x6e, 0xdb,
+	0x9d, 0xf5, 0xa0, 0xae, 0xb5, 0x6b, 0x2d, 0xb9, 0x03, 0xb4, 0xbb, 0xf4, 0xdc, 0xa8, 0x51, 0xaa,
+	0xfe, 0xe0, 0x72, 0x3a, 0x68, 0xcf, 0x20, 0xb2, 0x74, 0x0c, 0x15, 0x8b, 0xa1, 0xb9, 0xa6, 0xb1,
+	0x1d, 0x58, 0x04, 0xdd, 0xb2, 0x18, 0xdc, 0x07, 0xb4, 0xbf, 0xcc, 0xbf, 0xe2, 0xa1, 0xb8, 0xa4,
+	0x14, 0xa8, 0xe3, 0x23, 0x64, 0x02, 0x18, 0x0f, 0x85, 0x6e, 0xa8, 0xf7, 0xda, 0x64, 0xe5, 0xb2,
+	0xc8, 0xc2, 0xda, 0xdf, 0x98, 0x7e, 0x1c, 0x5b, 0x41, 0x0d, 0xe6, 0x82, 0x3b, 0x40, 0x8d, 0x9f,
+	0xe9, 0x77, 0x09, 0x1d, 0xa9, 0xff, 0xcb, 0xbf, 0xf8, 0x9d, 0x1f, 0x40, 0x2c, 0x32, 0xa0, 0x0e,
+	0x46, 0x88, 0x51, 0xe0, 0x8a, 0x85, 0xac, 0xda, 0x50, 0x2d, 0xf8, 0xa6, 0xf4, 0xcf, 0xa7, 0x39,
+	0xb6, 0x67, 0x39, 0xb6, 0x3f, 0x73, 0x6c, 0xbf, 0x15, 0xd8, 0x9a, 0x15, 0xd8, 0x7a, 0x2f, 0xb0,
+	0x75, 0x7f, 0x64, 0x3e, 0x21, 0xe9, 0x13, 0x61, 0xc2, 0x7b, 0x99, 0x5f, 0x54, 0xbd, 0x26, 0x20,
+	0xc7, 0x9b, 0xfa, 0x90, 0x67, 0x5f, 0x03, 0x00, 0xf5, 0x1e, 0x97, 0x59, 0x5b, 0x02, 0x00, 0x00,
 }
 
 func (m *EventEpochEnd) Marshal() (dAtA []byte, err error) {
@@ -210,6 +354,102 @@ func (m *EventEpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochInfoAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochInfoAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochInfoAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochInfoUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochInfoUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochInfoUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochInfoRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochInfoRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochInfoRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -248,6 +488,41 @@ func (m *EventEpochStart) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochInfoAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventEpochInfoUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventEpochInfoRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -411,6 +686,254 @@ func (m *EventEpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochInfoAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochInfoAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochInfoAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochInfoUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochInfoUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochInfoUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochInfoRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochInfoRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochInfoRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,7 +12,17 @@ type EpochHooks interface {
 	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
 }
 
-var _ EpochHooks = MultiEpochHooks{}
+// EpochIdentifierConsumer can optionally be implemented by EpochHooks to report
+// whether they rely on an epoch identifier. An epoch identifier cannot be removed
+// while it is in use by a consumer.
+type EpochIdentifierConsumer interface {
+	IsEpochIdentifierInUse(ctx context.Context, epochIdentifier string) (bool, error)
+}
+
+var (
+	_ EpochHooks              = MultiEpochHooks{}
+	_ EpochIdentifierConsumer = MultiEpochHooks{}
+)
 
 // combine multiple gamm hooks, all hook functions are run in array sequence.
 type MultiEpochHooks []EpochHooks
@@ -39,6 +49,31 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier s
 	return errs
 }
 
+// IsEpochIdentifierInUse returns true if any of the hooks implementing EpochIdentifierConsumer
+// relies on the given epoch identifier.
+func (h MultiEpochHooks) IsEpochIdentifierInUse(ctx context.Context, epochIdentifier string) (bool, error) {
+	for i := range h {
+		inUse, err := IsEpochIdentifierInUse(ctx, h[i], epochIdentifier)
+		if err != nil || inUse {
+			return inUse, err
+		}
+	}
+	return false, nil
+}
+
+// IsEpochIdentifierInUse returns true if the hooks implement EpochIdentifierConsumer and rely on
+// the given epoch identifier. Hooks wrapped in an EpochHooksWrapper are unwrapped.
+func IsEpochIdentifierInUse(ctx context.Context, hooks EpochHooks, epochIdentifier string) (bool, error) {
+	if wrapper, ok := hooks.(EpochHooksWrapper); ok {
+		hooks = wrapper.EpochHooks
+	}
+	consumer, ok := hooks.(EpochIdentifierConsumer)
+	if !ok {
+		return false, nil
+	}
+	return consumer.IsEpochIdentifierInUse(ctx, epochIdentifier)
+}
+
 // EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
 type EpochHooksWrapper struct{ EpochHooks }
 
@@ -0,0 +1,1500 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddEpochInfo is the Msg/AddEpochInfo request type.
+type MsgAddEpochInfo struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// epoch_info is the epoch to add. Only identifier, start_time and duration are
+	// taken into account, the counting fields are reset.
+	EpochInfo EpochInfo `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info"`
+}
+
+func (m *MsgAddEpochInfo) Reset()         { *m = MsgAddEpochInfo{} }
+func (m *MsgAddEpochInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpochInfo) ProtoMessage()    {}
+func (*MsgAddEpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{0}
+}
+func (m *MsgAddEpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpochInfo.Merge(m, src)
+}
+func (m *MsgAddEpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEpochInfo proto.InternalMessageInfo
+
+func (m *MsgAddEpochInfo) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddEpochInfo) GetEpochInfo() EpochInfo {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return EpochInfo{}
+}
+
+// MsgAddEpochInfoResponse defines the response structure for executing a
+// MsgAddEpochInfo message.
+type MsgAddEpochInfoResponse struct {
+}
+
+func (m *MsgAddEpochInfoResponse) Reset()         { *m = MsgAddEpochInfoResponse{} }
+func (m *MsgAddEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpochInfoResponse) ProtoMessage()    {}
+func (*MsgAddEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{1}
+}
+func (m *MsgAddEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpochInfoResponse.Merge(m, src)
+}
+func (m *MsgAddEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEpochInfoResponse proto.InternalMessageInfo
+
+// MsgUpdateEpochInfo is the Msg/UpdateEpochInfo request type.
+type MsgUpdateEpochInfo struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the identifier of the epoch to update.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// duration is the new duration of the epoch. It is left unchanged if not set.
+	// The new duration applies from the current epoch onwards.
+	Duration *time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
+	// start_time is the new start time of the epoch. It is left unchanged if not set.
+	// It can only be updated before the epoch counting started.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+}
+
+func (m *MsgUpdateEpochInfo) Reset()         { *m = MsgUpdateEpochInfo{} }
+func (m *MsgUpdateEpochInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochInfo) ProtoMessage()    {}
+func (*MsgUpdateEpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{2}
+}
+func (m *MsgUpdateEpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochInfo.Merge(m, src)
+}
+func (m *MsgUpdateEpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochInfo proto.InternalMessageInfo
+
+func (m *MsgUpdateEpochInfo) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateEpochInfo) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *MsgUpdateEpochInfo) GetDuration() *time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *MsgUpdateEpochInfo) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+// MsgUpdateEpochInfoResponse defines the response structure for executing a
+// MsgUpdateEpochInfo message.
+type MsgUpdateEpochInfoResponse struct {
+}
+
+func (m *MsgUpdateEpochInfoResponse) Reset()         { *m = MsgUpdateEpochInfoResponse{} }
+func (m *MsgUpdateEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEpochInfoResponse) ProtoMessage()    {}
+func (*MsgUpdateEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{3}
+}
+func (m *MsgUpdateEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEpochInfoResponse.Merge(m, src)
+}
+func (m *MsgUpdateEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEpochInfoResponse proto.InternalMessageInfo
+
+// MsgRemoveEpochInfo is the Msg/RemoveEpochInfo request type.
+type MsgRemoveEpochInfo struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the identifier of the epoch to remove.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgRemoveEpochInfo) Reset()         { *m = MsgRemoveEpochInfo{} }
+func (m *MsgRemoveEpochInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpochInfo) ProtoMessage()    {}
+func (*MsgRemoveEpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{4}
+}
+func (m *MsgRemoveEpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpochInfo.Merge(m, src)
+}
+func (m *MsgRemoveEpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEpochInfo proto.InternalMessageInfo
+
+func (m *MsgRemoveEpochInfo) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveEpochInfo) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// MsgRemoveEpochInfoResponse defines the response structure for executing a
+// MsgRemoveEpochInfo message.
+type MsgRemoveEpochInfoResponse struct {
+}
+
+func (m *MsgRemoveEpochInfoResponse) Reset()         { *m = MsgRemoveEpochInfoResponse{} }
+func (m *MsgRemoveEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpochInfoResponse) ProtoMessage()    {}
+func (*MsgRemoveEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{5}
+}
+func (m *MsgRemoveEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpochInfoResponse.Merge(m, src)
+}
+func (m *MsgRemoveEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEpochInfoResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddEpochInfo)(nil), "cosmos.epochs.v1beta1.MsgAddEpochInfo")
+	proto.RegisterType((*MsgAddEpochInfoResponse)(nil), "cosmos.epochs.v1beta1.MsgAddEpochInfoResponse")
+	proto.RegisterType((*MsgUpdateEpochInfo)(nil), "cosmos.epochs.v1beta1.MsgUpdateEpochInfo")
+	proto.RegisterType((*MsgUpdateEpochInfoResponse)(nil), "cosmos.epochs.v1beta1.MsgUpdateEpochInfoResponse")
+	proto.RegisterType((*MsgRemoveEpochInfo)(nil), "cosmos.epochs.v1beta1.MsgRemoveEpochInfo")
+	proto.RegisterType((*MsgRemoveEpochInfoResponse)(nil), "cosmos.epochs.v1beta1.MsgRemoveEpochInfoResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/epochs/v1beta1/tx.proto", fileDescriptor_a9df40940234702e) }
+
+var fileDescriptor_a9df40940234702e = []byte{
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xb5, 0x01, 0xe1, 0x03, 0x51, 0xc9, 0x2a, 0xaa, 0x63, 0xd0, 0x25, 0x0a, 0x12, 0x2a,
+	0x95, 0x38, 0xcb, 0x45, 0x30, 0xc0, 0x80, 0x6a, 0xd1, 0x81, 0x21, 0x8b, 0x81, 0x85, 0x25, 0x72,
+	0xea, 0xf3, 0xf5, 0x04, 0xf6, 0x59, 0xbe, 0x4b, 0xd4, 0x4a, 0x0c, 0x88, 0x5f, 0xd0, 0x91, 0x91,
+	0x9f, 0xd0, 0x81, 0x1f, 0xd1, 0xb1, 0x62, 0x40, 0x4c, 0x80, 0x92, 0xa1, 0xff, 0x81, 0x09, 0xf9,
+	0xce, 0x97, 0x16, 0x27, 0x41, 0x91, 0x50, 0x27, 0xdb, 0xf7, 0xde, 0xbb, 0xf7, 0x7d, 0xef, 0xfb,
+	0x64, 0x88, 0xf6, 0xb8, 0x48, 0xb9, 0xf0, 0x48, 0xce, 0xf7, 0xf6, 0x85, 0x37, 0xf2, 0x07, 0x44,
+	0x46, 0xbe, 0x27, 0x0f, 0x70, 0x5e, 0x70, 0xc9, 0xed, 0x5b, 0x1a, 0xc7, 0x1a, 0xc7, 0x15, 0xee,
+	0xae, 0x53, 0x4e, 0xb9, 0x62, 0x78, 0xe5, 0x9b, 0x26, 0xbb, 0x2d, 0x4d, 0xee, 0x6b, 0xa0, 0x52,
+	0x6a, 0x68, 0xa3, 0xf2, 0x49, 0x05, 0xf5, 0x46, 0x7e, 0xf9, 0xa8, 0x80, 0xbb, 0xf3, 0x0b, 0xa0,
+	0x24, 0x23, 0x82, 0x19, 0x35, 0xa2, 0x9c, 0xd3, 0x77, 0xc4, 0x53, 0x5f, 0x83, 0x61, 0xe2, 0xc5,
+	0xc3, 0x22, 0x92, 0x8c, 0x67, 0x15, 0xde, 0xae, 0xe3, 0x92, 0xa5, 0x44, 0xc8, 0x28, 0xcd, 0x35,
+	0xa1, 0xfb, 0x19, 0xc0, 0xb5, 0x9e, 0xa0, 0x3b, 0x71, 0xbc, 0x5b, 0xfa, 0xbc, 0xc8, 0x12, 0x6e,
+	0x3f, 0x86, 0x56, 0x34, 0x94, 0xfb, 0xbc, 0x60, 0xf2, 0xd0, 0x01, 0x1d, 0xb0, 0x69, 0x05, 0xce,
+	0xd7, 0x2f, 0x0f, 0xd6, 0xab, 0xba, 0x77, 0xe2, 0xb8, 0x20, 0x42, 0xbc, 0x94, 0x05, 0xcb, 0x68,
+	0x78, 0x4e, 0xb5, 0x77, 0x21, 0x54, 0xc5, 0xf6, 0x59, 0x96, 0x70, 0x67, 0xa5, 0x03, 0x36, 0xaf,
+	0x6f, 0x77, 0xf0, 0xdc, 0x9c, 0xf0, 0xd4, 0x2d, 0x68, 0x9e, 0xfc, 0x68, 0x37, 0x42, 0x8b, 0x98,
+	0x83, 0x27, 0x37, 0x3f, 0x9e, 0x1d, 0x6f, 0x9d, 0x5f, 0xdb, 0x6d, 0xc1, 0x8d, 0x5a, 0x85, 0x21,
+	0x11, 0x39, 0xcf, 0x04, 0xe9, 0xfe, 0x06, 0xd0, 0xee, 0x09, 0xfa, 0x3a, 0x8f, 0x23, 0x49, 0xfe,
+	0xbf, 0x01, 0x04, 0x21, 0x8b, 0x49, 0x26, 0x59, 0xc2, 0x48, 0xa1, 0x1a, 0xb0, 0xc2, 0x0b, 0x27,
+	0xf6, 0x53, 0x78, 0xcd, 0xe4, 0xeb, 0xac, 0xaa, 0xf6, 0x5a, 0x58, 0x07, 0x8c, 0x4d, 0xc0, 0xf8,
+	0x79, 0x45, 0x08, 0x9a, 0x9f, 0x7e, 0xb6, 0x41, 0x38, 0x15, 0xd8, 0xcf, 0x20, 0x14, 0x32, 0x2a,
+	0x64, 0xbf, 0x1c, 0x81, 0xd3, 0x54, 0x72, 0x77, 0x46, 0xfe, 0xca, 0xcc, 0x27, 0x68, 0x1e, 0x95,
+	0x7a, 0x4b, 0x69, 0xca, 0xd3, 0x99, 0x5c, 0xee, 0x40, 0x77, 0xb6, 0xf7, 0x69, 0x34, 0xef, 0x55,
+	0x32, 0x21, 0x49, 0xf9, 0xe8, 0xf2, 0x93, 0x59, 0x50, 0x5b, 0xcd, 0xdd, 0xd4, 0xb6, 0xfd, 0x6d,
+	0x05, 0xae, 0xf6, 0x04, 0xb5, 0x13, 0x78, 0xe3, 0xaf, 0xc5, 0xbb, 0xb7, 0x60, 0x59, 0x6a, 0xe3,
+	0x77, 0xf1, 0x72, 0x3c, 0xe3, 0x67, 0x73, 0xb8, 0x56, 0x5f, 0x91, 0xfb, 0x8b, 0xaf, 0xa8, 0x51,
+	0x5d, 0x7f, 0x69, 0xea, 0x45, 0xc3, 0x7a, 0xf2, 0xff, 0x30, 0xac, 0x51, 0x5d, 0x7f, 0x69, 0xaa,
+	0x31, 0x74, 0xaf, 0x7c, 0x38, 0x3b, 0xde, 0x02, 0xc1, 0xa3, 0x93, 0x31, 0x02, 0xa7, 0x63, 0x04,
+	0x7e, 0x8d, 0x11, 0x38, 0x9a, 0xa0, 0xc6, 0xe9, 0x04, 0x35, 0xbe, 0x4f, 0x50, 0xe3, 0xcd, 0x6d,
+	0x7d, 0xa5, 0x88, 0xdf, 0x62, 0xc6, 0xbd, 0x03, 0xf3, 0x57, 0x91, 0x87, 0x39, 0x11, 0x83, 0xab,
+	0x6a, 0xfd, 0x1e, 0xfe, 0x19, 0x00, 0xbf, 0xfd, 0x1e, 0x1e, 0xf4, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddEpochInfo defines a governance operation to add a new epoch identifier.
+	AddEpochInfo(ctx context.Context, in *MsgAddEpochInfo, opts ...grpc.CallOption) (*MsgAddEpochInfoResponse, error)
+	// UpdateEpochInfo defines a governance operation to update the duration or
+	// start time of an existing epoch identifier.
+	UpdateEpochInfo(ctx context.Context, in *MsgUpdateEpochInfo, opts ...grpc.CallOption) (*MsgUpdateEpochInfoResponse, error)
+	// RemoveEpochInfo defines a governance operation to remove an epoch identifier.
+	// It fails if a registered epoch hook still relies on the identifier.
+	RemoveEpochInfo(ctx context.Context, in *MsgRemoveEpochInfo, opts ...grpc.CallOption) (*MsgRemoveEpochInfoResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddEpochInfo(ctx context.Context, in *MsgAddEpochInfo, opts ...grpc.CallOption) (*MsgAddEpochInfoResponse, error) {
+	out := new(MsgAddEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/AddEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEpochInfo(ctx context.Context, in *MsgUpdateEpochInfo, opts ...grpc.CallOption) (*MsgUpdateEpochInfoResponse, error) {
+	out := new(MsgUpdateEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/UpdateEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEpochInfo(ctx context.Context, in *MsgRemoveEpochInfo, opts ...grpc.CallOption) (*MsgRemoveEpochInfoResponse, error) {
+	out := new(MsgRemoveEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/RemoveEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddEpochInfo defines a governance operation to add a new epoch identifier.
+	AddEpochInfo(context.Context, *MsgAddEpochInfo) (*MsgAddEpochInfoResponse, error)
+	// UpdateEpochInfo defines a governance operation to update the duration or
+	// start time of an existing epoch identifier.
+	UpdateEpochInfo(context.Context, *MsgUpdateEpochInfo) (*MsgUpdateEpochInfoResponse, error)
+	// RemoveEpochInfo defines a governance operation to remove an epoch identifier.
+	// It fails if a registered epoch hook still relies on the identifier.
+	RemoveEpochInfo(context.Context, *MsgRemoveEpochInfo) (*MsgRemoveEpochInfoResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddEpochInfo(ctx context.Context, req *MsgAddEpochInfo) (*MsgAddEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpochInfo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEpochInfo(ctx context.Context, req *MsgUpdateEpochInfo) (*MsgUpdateEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochInfo not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEpochInfo(ctx context.Context, req *MsgRemoveEpochInfo) (*MsgRemoveEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEpochInfo not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/AddEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpochInfo(ctx, req.(*MsgAddEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/UpdateEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochInfo(ctx, req.(*MsgUpdateEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/RemoveEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEpochInfo(ctx, req.(*MsgRemoveEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddEpochInfo",
+			Handler:    _Msg_AddEpochInfo_Handler,
+		},
+		{
+			MethodName: "UpdateEpochInfo",
+			Handler:    _Msg_UpdateEpochInfo_Handler,
+		},
+		{
+			MethodName: "RemoveEpochInfo",
+			Handler:    _Msg_RemoveEpochInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
+
+func (m *MsgAddEpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Duration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Duration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddEpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Duration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddEpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -141,6 +141,20 @@ func (mr *MockSlashingKeeperMockRecorder) Jail(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), arg0, arg1)
 }
 
+// JailWithInfractionReason mocks base method.
+func (m *MockSlashingKeeper) JailWithInfractionReason(arg0 context.Context, arg1 types0.ConsAddress, arg2 stakingv1beta1.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailWithInfractionReason", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailWithInfractionReason indicates an expected call of JailWithInfractionReason.
+func (mr *MockSlashingKeeperMockRecorder) JailWithInfractionReason(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailWithInfractionReason", reflect.TypeOf((*MockSlashingKeeper)(nil).JailWithInfractionReason), arg0, arg1, arg2)
+}
+
 // JailUntil mocks base method.
 func (m *MockSlashingKeeper) JailUntil(arg0 context.Context, arg1 types0.ConsAddress, arg2 time.Time) error {
 	m.ctrl.T.Helper()
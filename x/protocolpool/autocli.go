@@ -28,6 +28,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod: "Budgets",
+					Use:       "budgets",
+					Short:     "Query all the budget proposals",
+					Example:   fmt.Sprintf(`$ %s query protocolpool budgets`, version.AppName),
+				},
+				{
+					RpcMethod:      "MilestoneBudget",
+					Use:            "milestone-budget [id]",
+					Short:          "Query a milestone budget by its id",
+					Example:        fmt.Sprintf(`$ %s query protocolpool milestone-budget 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "MilestoneBudgets",
+					Use:       "milestone-budgets",
+					Short:     "Query all the milestone budgets",
+					Example:   fmt.Sprintf(`$ %s query protocolpool milestone-budgets`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +108,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreateMilestoneBudget",
+					Use:       "create-milestone-budget <recipient> <milestones>",
+					Short:     "Create a budget released milestone by milestone, with an optional attestor",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-milestone-budget cosmos1... '{"description":"mainnet launch","amount":{"denom":"uatom","amount":"1000"}}' --attestor cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient_address"},
+						{ProtoField: "milestones", Varargs: true},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "AttestMilestone",
+					Use:       "attest-milestone <budget-id> <milestone-index>",
+					Short:     "Attest a milestone and release its amount to the budget recipient",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool attest-milestone 1 0 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "budget_id"},
+						{ProtoField: "milestone_index"},
+					},
+				},
+				{
+					RpcMethod:      "CancelMilestoneBudget",
+					Use:            "cancel-milestone-budget <budget-id>",
+					Short:          "Cancel the unreleased milestones of a milestone budget",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool cancel-milestone-budget 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "budget_id"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -52,6 +52,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		}
 	}
 
+	for _, mb := range data.MilestoneBudgets {
+		if err := k.MilestoneBudgets.Set(ctx, mb.Id, mb); err != nil {
+			return fmt.Errorf("failed to set milestone budget %d: %w", mb.Id, err)
+		}
+	}
+
+	if err := k.NextMilestoneBudgetID.Set(ctx, data.NextMilestoneBudgetId); err != nil {
+		return fmt.Errorf("failed to set next milestone budget id: %w", err)
+	}
+
 	if err := k.LastBalance.Set(ctx, data.LastBalance); err != nil {
 		return fmt.Errorf("failed to set last balance: %w", err)
 	}
@@ -97,12 +107,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 			return true, err
 		}
 		budget = append(budget, &types.Budget{
-			RecipientAddress: recipient,
-			ClaimedAmount:    value.ClaimedAmount,
-			LastClaimedAt:    value.LastClaimedAt,
-			TranchesLeft:     value.TranchesLeft,
-			Period:           value.Period,
-			BudgetPerTranche: value.BudgetPerTranche,
+			RecipientAddress:      recipient,
+			ClaimedAmount:         value.ClaimedAmount,
+			LastClaimedAt:         value.LastClaimedAt,
+			TranchesLeft:          value.TranchesLeft,
+			Period:                value.Period,
+			BudgetPerTranche:      value.BudgetPerTranche,
+			AutoDistributionEpoch: value.AutoDistributionEpoch,
 		})
 		return false, nil
 	})
@@ -129,5 +140,18 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.MilestoneBudgets.Walk(ctx, nil, func(_ uint64, value types.MilestoneBudget) (stop bool, err error) {
+		genState.MilestoneBudgets = append(genState.MilestoneBudgets, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextMilestoneBudgetId, err = k.NextMilestoneBudgetID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
@@ -31,6 +31,16 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		},
 	)
 
+	gs.Budget[0].AutoDistributionEpoch = "day"
+	gs.MilestoneBudgets = []types.MilestoneBudget{
+		{
+			Id:               1,
+			RecipientAddress: "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf",
+			Milestones:       []types.Milestone{{Description: "launch", Amount: sdk.NewInt64Coin("stake", 100)}},
+		},
+	}
+	gs.NextMilestoneBudgetId = 2
+
 	gs.Distributions = append(gs.Distributions, &types.Distribution{
 		Amount: math.OneInt(),
 		Time:   &time.Time{},
@@ -50,4 +60,6 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(gs.ContinuousFund, exportedGenState.ContinuousFund)
 	suite.Require().Equal(gs.Budget, exportedGenState.Budget)
 	suite.Require().Equal(math.OneInt(), exportedGenState.LastBalance)
+	suite.Require().Equal(gs.MilestoneBudgets, exportedGenState.MilestoneBudgets)
+	suite.Require().Equal(gs.NextMilestoneBudgetId, exportedGenState.NextMilestoneBudgetId)
 }
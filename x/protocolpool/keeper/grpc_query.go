@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -73,3 +74,52 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// Budgets queries all the budget proposals
+func (k Querier) Budgets(ctx context.Context, req *types.QueryBudgetsRequest) (*types.QueryBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	budgets, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.BudgetProposal, req.Pagination, func(_ sdk.AccAddress, budget types.Budget) (types.Budget, error) {
+		return budget, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+}
+
+// MilestoneBudget queries a milestone budget by its id
+func (k Querier) MilestoneBudget(ctx context.Context, req *types.QueryMilestoneBudgetRequest) (*types.QueryMilestoneBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	mb, err := k.Keeper.MilestoneBudgets.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no milestone budget found for id: %d", req.Id)
+		}
+		return nil, err
+	}
+
+	return &types.QueryMilestoneBudgetResponse{MilestoneBudget: mb}, nil
+}
+
+// MilestoneBudgets queries all the milestone budgets
+func (k Querier) MilestoneBudgets(ctx context.Context, req *types.QueryMilestoneBudgetsRequest) (*types.QueryMilestoneBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	budgets, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.MilestoneBudgets, req.Pagination, func(_ uint64, mb types.MilestoneBudget) (types.MilestoneBudget, error) {
+		return mb, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMilestoneBudgetsResponse{MilestoneBudgets: budgets, Pagination: pageRes}, nil
+}
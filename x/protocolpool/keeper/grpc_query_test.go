@@ -8,6 +8,7 @@ import (
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (suite *KeeperTestSuite) TestUnclaimedBudget() {
@@ -123,3 +124,58 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestBudgets() {
+	period := time.Hour
+	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress([]byte("budget1_____________")), sdk.AccAddress([]byte("budget2_____________"))} {
+		addrStr, err := addrCodec.BytesToString(addr)
+		suite.Require().NoError(err)
+		err = suite.poolKeeper.BudgetProposal.Set(suite.ctx, addr, types.Budget{
+			RecipientAddress:      addrStr,
+			LastClaimedAt:         &startTime,
+			TranchesLeft:          2,
+			BudgetPerTranche:      &fooCoin,
+			Period:                &period,
+			AutoDistributionEpoch: "day",
+		})
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryServer.Budgets(suite.ctx, &types.QueryBudgetsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Budgets, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().Equal("day", res.Budgets[0].AutoDistributionEpoch)
+
+	_, err = suite.queryServer.Budgets(suite.ctx, nil)
+	suite.Require().ErrorContains(err, "empty request")
+}
+
+func (suite *KeeperTestSuite) TestMilestoneBudgets() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err := suite.msgServer.CreateMilestoneBudget(suite.ctx, &types.MsgCreateMilestoneBudget{
+			Authority:        suite.poolKeeper.GetAuthority(),
+			RecipientAddress: recipientStrAddr,
+			Milestones:       []types.Milestone{{Description: "launch", Amount: fooCoin}},
+		})
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryServer.MilestoneBudget(suite.ctx, &types.QueryMilestoneBudgetRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.MilestoneBudget.Id)
+	suite.Require().Equal(recipientStrAddr, res.MilestoneBudget.RecipientAddress)
+
+	_, err = suite.queryServer.MilestoneBudget(suite.ctx, &types.QueryMilestoneBudgetRequest{Id: 5})
+	suite.Require().ErrorContains(err, "no milestone budget found for id: 5")
+
+	allRes, err := suite.queryServer.MilestoneBudgets(suite.ctx, &types.QueryMilestoneBudgetsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.MilestoneBudgets, 2)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for the protocolpool keeper.
+// It satisfies the x/epochs EpochHooks interface and must be registered on the
+// epochs keeper for budgets with an auto distribution epoch to be distributed.
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ epochstypes.EpochHooks              = Hooks{}
+	_ epochstypes.EpochIdentifierConsumer = Hooks{}
+)
+
+// Hooks returns the epoch hooks of the protocolpool keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart is a no-op.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// IsEpochIdentifierInUse returns true if a budget is auto distributed on the epoch identifier,
+// preventing its removal from x/epochs.
+func (h Hooks) IsEpochIdentifierInUse(ctx context.Context, epochIdentifier string) (bool, error) {
+	inUse := false
+	err := h.k.BudgetProposal.Walk(ctx, nil, func(_ sdk.AccAddress, budget types.Budget) (stop bool, err error) {
+		inUse = budget.AutoDistributionEpoch == epochIdentifier
+		return inUse, nil
+	})
+	return inUse, err
+}
+
+// AfterEpochEnd distributes the due tranches of every budget whose auto distribution
+// epoch matches the ended epoch.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	return h.k.DistributeEpochBudgets(ctx, epochIdentifier)
+}
+
+// DistributeEpochBudgets pushes the due tranches of all the budgets configured for auto
+// distribution on the given epoch identifier to their recipients. A failing budget does
+// not prevent the remaining ones from being distributed, its error is only logged.
+func (k Keeper) DistributeEpochBudgets(ctx context.Context, epochIdentifier string) error {
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+
+	var recipients []string
+	err := k.BudgetProposal.Walk(ctx, nil, func(_ sdk.AccAddress, budget types.Budget) (stop bool, err error) {
+		if budget.AutoDistributionEpoch == epochIdentifier && isBudgetDue(budget, currentTime) {
+			recipients = append(recipients, budget.RecipientAddress)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			amount, err := k.claimFunds(ctx, recipient)
+			if err != nil {
+				return err
+			}
+
+			k.Logger.Info("distributed budget tranches", "recipient", recipient, "amount", amount.String(), "epoch", epochIdentifier)
+			return k.removeCompletedBudget(ctx, recipient)
+		})
+		if err != nil {
+			k.Logger.Error("failed to distribute budget", "recipient", recipient, "epoch", epochIdentifier, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// removeCompletedBudget removes the budget of the recipient once all its tranches have been distributed.
+func (k Keeper) removeCompletedBudget(ctx context.Context, recipientAddr string) error {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(recipientAddr)
+	if err != nil {
+		return err
+	}
+
+	budget, err := k.BudgetProposal.Get(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	if budget.TranchesLeft != 0 {
+		return nil
+	}
+
+	return k.BudgetProposal.Remove(ctx, recipient)
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/x/protocolpool/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestAfterEpochEndDistributesBudgets() {
+	period := time.Hour
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	lastClaimedAt := now.Add(-2*period - time.Minute)
+	notDueClaimedAt := now.Add(-time.Minute)
+
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	dailyAddr := sdk.AccAddress([]byte("daily_______________"))
+	weeklyAddr := sdk.AccAddress([]byte("weekly______________"))
+	manualAddr := sdk.AccAddress([]byte("manual______________"))
+	notDueAddr := sdk.AccAddress([]byte("notdue______________"))
+	failingAddr := sdk.AccAddress([]byte("failing_____________"))
+
+	setBudget := func(addr sdk.AccAddress, epoch string, tranches uint64, lastClaimedAt time.Time) {
+		addrStr, err := addrCodec.BytesToString(addr)
+		suite.Require().NoError(err)
+		err = suite.poolKeeper.BudgetProposal.Set(suite.ctx, addr, types.Budget{
+			RecipientAddress:      addrStr,
+			LastClaimedAt:         &lastClaimedAt,
+			TranchesLeft:          tranches,
+			BudgetPerTranche:      &fooCoin,
+			Period:                &period,
+			AutoDistributionEpoch: epoch,
+		})
+		suite.Require().NoError(err)
+	}
+
+	setBudget(dailyAddr, "day", 2, lastClaimedAt)
+	setBudget(weeklyAddr, "week", 2, lastClaimedAt)
+	setBudget(manualAddr, "", 2, lastClaimedAt)
+	setBudget(notDueAddr, "day", 2, notDueClaimedAt)
+	setBudget(failingAddr, "day", 3, lastClaimedAt)
+
+	// the daily budget gets its two due tranches and is completed
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, dailyAddr, sdk.NewCoins(fooCoin.Add(fooCoin))).Return(nil)
+	// a failing distribution does not halt the other ones and is reverted
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, failingAddr, gomock.Any()).Return(errors.New("insufficient funds"))
+
+	err := suite.poolKeeper.Hooks().AfterEpochEnd(suite.ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	has, err := suite.poolKeeper.BudgetProposal.Has(suite.ctx, dailyAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	for _, addr := range []sdk.AccAddress{weeklyAddr, manualAddr, notDueAddr} {
+		budget, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(2), budget.TranchesLeft)
+	}
+
+	failing, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, failingAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), failing.TranchesLeft)
+	suite.Require().Nil(failing.ClaimedAmount)
+}
+
+func (suite *KeeperTestSuite) TestIsEpochIdentifierInUse() {
+	period := time.Hour
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	addr := sdk.AccAddress([]byte("daily_______________"))
+	addrStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	inUse, err := suite.poolKeeper.Hooks().IsEpochIdentifierInUse(suite.ctx, "day")
+	suite.Require().NoError(err)
+	suite.Require().False(inUse)
+
+	err = suite.poolKeeper.BudgetProposal.Set(suite.ctx, addr, types.Budget{
+		RecipientAddress:      addrStr,
+		LastClaimedAt:         &now,
+		TranchesLeft:          2,
+		BudgetPerTranche:      &fooCoin,
+		Period:                &period,
+		AutoDistributionEpoch: "day",
+	})
+	suite.Require().NoError(err)
+
+	inUse, err = suite.poolKeeper.Hooks().IsEpochIdentifierInUse(suite.ctx, "day")
+	suite.Require().NoError(err)
+	suite.Require().True(inUse)
+
+	inUse, err = suite.poolKeeper.Hooks().IsEpochIdentifierInUse(suite.ctx, "week")
+	suite.Require().NoError(err)
+	suite.Require().False(inUse)
+}
@@ -25,6 +25,7 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	epochsKeeper  types.EpochsKeeper
 
 	cdc codec.BinaryCodec
 
@@ -38,13 +39,18 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	Distributions             collections.Map[time.Time, math.Int] // key: time.Time | value: amount
 	LastBalance               collections.Item[math.Int]
+	// MilestoneBudgets key: MilestoneBudgetID | value: MilestoneBudget
+	MilestoneBudgets      collections.Map[uint64, types.MilestoneBudget]
+	NextMilestoneBudgetID collections.Sequence
 }
 
 const (
 	errModuleAccountNotSet = "%s module account has not been set"
 )
 
-func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
+// NewKeeper returns a new protocolpool keeper. The epochs keeper is optional, when nil budgets
+// with an auto distribution epoch are rejected.
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, ek types.EpochsKeeper, authority string,
 ) Keeper {
 	// ensure pool module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -66,6 +72,7 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		authKeeper:                ak,
 		bankKeeper:                bk,
 		stakingKeeper:             sk,
+		epochsKeeper:              ek,
 		cdc:                       cdc,
 		authority:                 authority,
 		BudgetProposal:            collections.NewMap(sb, types.BudgetKey, "budget", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
@@ -73,6 +80,8 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, sdk.IntValue),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", sdk.IntValue),
+		MilestoneBudgets:          collections.NewMap(sb, types.MilestoneBudgetKey, "milestone_budgets", collections.Uint64Key, codec.CollValue[types.MilestoneBudget](cdc)),
+		NextMilestoneBudgetID:     collections.NewSequence(sb, types.NextMilestoneBudgetIDKey, "next_milestone_budget_id"),
 	}
 
 	schema, err := sb.Build()
@@ -473,18 +482,91 @@ func (k Keeper) validateAndUpdateBudgetProposal(ctx context.Context, bp types.Ms
 		return nil, errors.New("invalid budget proposal: period length should be greater than zero")
 	}
 
+	if bp.AutoDistributionEpoch != "" {
+		if k.epochsKeeper == nil {
+			return nil, errors.New("invalid budget proposal: auto distribution is not supported: x/epochs is not available")
+		}
+		if _, err := k.epochsKeeper.GetEpochInfo(ctx, bp.AutoDistributionEpoch); err != nil {
+			return nil, fmt.Errorf("invalid budget proposal: unknown auto distribution epoch %s", bp.AutoDistributionEpoch)
+		}
+	}
+
 	// Create and return an updated budget proposal
 	updatedBudget := types.Budget{
-		RecipientAddress: bp.RecipientAddress,
-		BudgetPerTranche: bp.BudgetPerTranche,
-		LastClaimedAt:    bp.StartTime,
-		TranchesLeft:     bp.Tranches,
-		Period:           bp.Period,
+		RecipientAddress:      bp.RecipientAddress,
+		BudgetPerTranche:      bp.BudgetPerTranche,
+		LastClaimedAt:         bp.StartTime,
+		TranchesLeft:          bp.Tranches,
+		Period:                bp.Period,
+		AutoDistributionEpoch: bp.AutoDistributionEpoch,
 	}
 
 	return &updatedBudget, nil
 }
 
+// isBudgetDue returns true if at least one tranche of the budget can be distributed at the given time.
+func isBudgetDue(budget types.Budget, currentTime time.Time) bool {
+	if budget.TranchesLeft == 0 || budget.LastClaimedAt == nil || budget.Period == nil {
+		return false
+	}
+
+	return !currentTime.Before(budget.LastClaimedAt.Add(*budget.Period))
+}
+
+// releaseMilestone marks the milestone at the given index as released and sends its
+// amount from the community pool to the budget recipient. The milestone budget is removed
+// once all its milestones have been released.
+func (k Keeper) releaseMilestone(ctx context.Context, budgetID uint64, index uint32) (sdk.Coin, error) {
+	mb, err := k.MilestoneBudgets.Get(ctx, budgetID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrMilestoneBudgetNotFound, "id %d", budgetID)
+		}
+		return sdk.Coin{}, err
+	}
+
+	if int(index) >= len(mb.Milestones) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone index %d out of range for budget %d", index, budgetID)
+	}
+
+	milestone := mb.Milestones[index]
+	if milestone.Released {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrMilestoneReleased, "milestone %d of budget %d", index, budgetID)
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(mb.RecipientAddress)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, sdk.NewCoins(milestone.Amount), recipient); err != nil {
+		return sdk.Coin{}, fmt.Errorf("error distributing from community pool: %w", err)
+	}
+
+	releasedAt := k.HeaderService.HeaderInfo(ctx).Time
+	milestone.Released = true
+	milestone.ReleasedAt = &releasedAt
+	mb.Milestones[index] = milestone
+
+	allReleased := true
+	for _, m := range mb.Milestones {
+		if !m.Released {
+			allReleased = false
+			break
+		}
+	}
+
+	if allReleased {
+		if err := k.MilestoneBudgets.Remove(ctx, budgetID); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err := k.MilestoneBudgets.Set(ctx, budgetID, mb); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return milestone.Amount, nil
+}
+
 // validateContinuousFund validates the fields of the CreateContinuousFund message.
 func (k Keeper) validateContinuousFund(ctx context.Context, msg types.MsgCreateContinuousFund) error {
 	// Validate percentage
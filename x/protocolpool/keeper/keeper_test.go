@@ -7,11 +7,13 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	pooltestutil "cosmossdk.io/x/protocolpool/testutil"
 	"cosmossdk.io/x/protocolpool/types"
@@ -41,6 +43,7 @@ type KeeperTestSuite struct {
 	authKeeper    *pooltestutil.MockAccountKeeper
 	bankKeeper    *pooltestutil.MockBankKeeper
 	stakingKeeper *pooltestutil.MockStakingKeeper
+	epochsKeeper  *pooltestutil.MockEpochsKeeper
 
 	msgServer   types.MsgServer
 	queryServer types.QueryServer
@@ -70,6 +73,11 @@ func (s *KeeperTestSuite) SetupTest() {
 	stakingKeeper.EXPECT().BondDenom(ctx).Return("stake", nil).AnyTimes()
 	s.stakingKeeper = stakingKeeper
 
+	epochsKeeper := pooltestutil.NewMockEpochsKeeper(ctrl)
+	epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "day").Return(epochstypes.EpochInfo{Identifier: "day"}, nil).AnyTimes()
+	epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), gomock.Not("day")).Return(epochstypes.EpochInfo{}, collections.ErrNotFound).AnyTimes()
+	s.epochsKeeper = epochsKeeper
+
 	authority, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
 	s.Require().NoError(err)
 
@@ -79,6 +87,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		accountKeeper,
 		bankKeeper,
 		stakingKeeper,
+		epochsKeeper,
 		authority,
 	)
 	s.ctx = ctx
@@ -5,6 +5,7 @@ import (
 	errorspkg "errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
@@ -225,6 +226,107 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreateMilestoneBudget(ctx context.Context, msg *types.MsgCreateMilestoneBudget) (*types.MsgCreateMilestoneBudgetResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.Attestor != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Attestor); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid attestor address: %s", err)
+		}
+	}
+
+	// milestones are always created unreleased
+	milestones := make([]types.Milestone, len(msg.Milestones))
+	for i, m := range msg.Milestones {
+		milestones[i] = types.Milestone{
+			Description: m.Description,
+			Amount:      m.Amount,
+		}
+	}
+
+	if err := types.ValidateMilestones(milestones); err != nil {
+		return nil, err
+	}
+
+	id, err := k.NextMilestoneBudgetID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.MilestoneBudgets.Set(ctx, id, types.MilestoneBudget{
+		Id:               id,
+		RecipientAddress: msg.RecipientAddress,
+		Attestor:         msg.Attestor,
+		Milestones:       milestones,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMilestoneBudgetResponse{Id: id}, nil
+}
+
+func (k MsgServer) AttestMilestone(ctx context.Context, msg *types.MsgAttestMilestone) (*types.MsgAttestMilestoneResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Attestor); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid attestor address: %s", err)
+	}
+
+	mb, err := k.MilestoneBudgets.Get(ctx, msg.BudgetId)
+	if err != nil {
+		if errorspkg.Is(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(types.ErrMilestoneBudgetNotFound, "id %d", msg.BudgetId)
+		}
+		return nil, err
+	}
+
+	// the authority can always attest, the budget attestor only if one was set
+	if msg.Attestor != k.authority && (mb.Attestor == "" || msg.Attestor != mb.Attestor) {
+		return nil, errors.Wrapf(types.ErrUnauthorizedAttestor, "%s cannot attest milestones of budget %d", msg.Attestor, msg.BudgetId)
+	}
+
+	amount, err := k.releaseMilestone(ctx, msg.BudgetId, msg.MilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger.Info("released milestone from the community pool", "budget_id", msg.BudgetId, "milestone", msg.MilestoneIndex, "amount", amount.String(), "recipient", mb.RecipientAddress)
+
+	return &types.MsgAttestMilestoneResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CancelMilestoneBudget(ctx context.Context, msg *types.MsgCancelMilestoneBudget) (*types.MsgCancelMilestoneBudgetResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	mb, err := k.MilestoneBudgets.Get(ctx, msg.BudgetId)
+	if err != nil {
+		if errorspkg.Is(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(types.ErrMilestoneBudgetNotFound, "id %d", msg.BudgetId)
+		}
+		return nil, err
+	}
+
+	unreleased := sdk.NewCoins()
+	for _, m := range mb.Milestones {
+		if !m.Released {
+			unreleased = unreleased.Add(m.Amount)
+		}
+	}
+
+	if err := k.MilestoneBudgets.Remove(ctx, msg.BudgetId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMilestoneBudgetResponse{UnreleasedAmount: unreleased}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -118,6 +118,31 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			expErr:    true,
 			expErrMsg: "period length should be greater than zero",
 		},
+		"unknown auto distribution epoch": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:             suite.poolKeeper.GetAuthority(),
+				RecipientAddress:      recipientStrAddr,
+				BudgetPerTranche:      &fooCoin2,
+				StartTime:             &startTime,
+				Tranches:              2,
+				Period:                &period,
+				AutoDistributionEpoch: "fortnight",
+			},
+			expErr:    true,
+			expErrMsg: "unknown auto distribution epoch fortnight",
+		},
+		"all good with auto distribution epoch": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:             suite.poolKeeper.GetAuthority(),
+				RecipientAddress:      recipientStrAddr,
+				BudgetPerTranche:      &fooCoin2,
+				StartTime:             &startTime,
+				Tranches:              2,
+				Period:                &period,
+				AutoDistributionEpoch: "day",
+			},
+			expErr: false,
+		},
 		"all good": {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
@@ -1039,3 +1064,244 @@ func (suite *KeeperTestSuite) TestCommunityPoolSpend() {
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestCreateMilestoneBudget() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	testCases := map[string]struct {
+		input     *types.MsgCreateMilestoneBudget
+		expErrMsg string
+	}{
+		"invalid authority": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        recipientStrAddr,
+				RecipientAddress: recipientStrAddr,
+				Milestones:       []types.Milestone{{Amount: fooCoin}},
+			},
+			expErrMsg: "invalid authority",
+		},
+		"invalid recipient": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: "invalid",
+				Milestones:       []types.Milestone{{Amount: fooCoin}},
+			},
+			expErrMsg: "invalid recipient address",
+		},
+		"invalid attestor": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				Attestor:         "invalid",
+				Milestones:       []types.Milestone{{Amount: fooCoin}},
+			},
+			expErrMsg: "invalid attestor address",
+		},
+		"no milestones": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+			},
+			expErrMsg: "at least one milestone is required",
+		},
+		"zero milestone amount": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				Milestones:       []types.Milestone{{Amount: sdk.NewInt64Coin("foo", 0)}},
+			},
+			expErrMsg: "amount cannot be zero",
+		},
+		"all good": {
+			input: &types.MsgCreateMilestoneBudget{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				Attestor:         recipientStrAddr,
+				Milestones:       []types.Milestone{{Description: "design", Amount: fooCoin, Released: true}, {Description: "launch", Amount: fooCoin2}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			res, err := suite.msgServer.CreateMilestoneBudget(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			mb, err := suite.poolKeeper.MilestoneBudgets.Get(suite.ctx, res.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.RecipientAddress, mb.RecipientAddress)
+			suite.Require().Equal(tc.input.Attestor, mb.Attestor)
+			suite.Require().Len(mb.Milestones, len(tc.input.Milestones))
+			// milestones are always created unreleased
+			for _, m := range mb.Milestones {
+				suite.Require().False(m.Released)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAttestMilestone() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	attestorStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(sdk.AccAddress([]byte("attestor____________")))
+	suite.Require().NoError(err)
+
+	createBudget := func(attestor string) uint64 {
+		res, err := suite.msgServer.CreateMilestoneBudget(suite.ctx, &types.MsgCreateMilestoneBudget{
+			Authority:        suite.poolKeeper.GetAuthority(),
+			RecipientAddress: recipientStrAddr,
+			Attestor:         attestor,
+			Milestones:       []types.Milestone{{Description: "design", Amount: fooCoin}, {Description: "launch", Amount: fooCoin2}},
+		})
+		suite.Require().NoError(err)
+		return res.Id
+	}
+
+	testCases := map[string]struct {
+		preRun    func() (uint64, uint32)
+		attestor  func() string
+		expErrMsg string
+		expAmount sdk.Coin
+	}{
+		"budget not found": {
+			preRun:    func() (uint64, uint32) { return 42, 0 },
+			attestor:  func() string { return attestorStrAddr },
+			expErrMsg: "milestone budget not found",
+		},
+		"unauthorized attestor": {
+			preRun:    func() (uint64, uint32) { return createBudget(attestorStrAddr), 0 },
+			attestor:  func() string { return recipientStrAddr },
+			expErrMsg: "unauthorized milestone attestor",
+		},
+		"no attestor set": {
+			preRun:    func() (uint64, uint32) { return createBudget(""), 0 },
+			attestor:  func() string { return attestorStrAddr },
+			expErrMsg: "unauthorized milestone attestor",
+		},
+		"index out of range": {
+			preRun:    func() (uint64, uint32) { return createBudget(attestorStrAddr), 2 },
+			attestor:  func() string { return attestorStrAddr },
+			expErrMsg: "out of range",
+		},
+		"already released": {
+			preRun: func() (uint64, uint32) {
+				id := createBudget(attestorStrAddr)
+				suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+				_, err := suite.msgServer.AttestMilestone(suite.ctx, &types.MsgAttestMilestone{Attestor: attestorStrAddr, BudgetId: id, MilestoneIndex: 0})
+				suite.Require().NoError(err)
+				return id, 0
+			},
+			attestor:  func() string { return attestorStrAddr },
+			expErrMsg: "milestone already released",
+		},
+		"attested by attestor": {
+			preRun: func() (uint64, uint32) {
+				suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+				return createBudget(attestorStrAddr), 1
+			},
+			attestor:  func() string { return attestorStrAddr },
+			expAmount: fooCoin2,
+		},
+		"attested by authority": {
+			preRun: func() (uint64, uint32) {
+				suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+				return createBudget(attestorStrAddr), 0
+			},
+			attestor:  func() string { return suite.poolKeeper.GetAuthority() },
+			expAmount: fooCoin,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			id, index := tc.preRun()
+
+			res, err := suite.msgServer.AttestMilestone(suite.ctx, &types.MsgAttestMilestone{
+				Attestor:       tc.attestor(),
+				BudgetId:       id,
+				MilestoneIndex: index,
+			})
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAmount, res.Amount)
+
+			mb, err := suite.poolKeeper.MilestoneBudgets.Get(suite.ctx, id)
+			suite.Require().NoError(err)
+			suite.Require().True(mb.Milestones[index].Released)
+			suite.Require().NotNil(mb.Milestones[index].ReleasedAt)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAttestLastMilestoneRemovesBudget() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateMilestoneBudget(suite.ctx, &types.MsgCreateMilestoneBudget{
+		Authority:        suite.poolKeeper.GetAuthority(),
+		RecipientAddress: recipientStrAddr,
+		Milestones:       []types.Milestone{{Amount: fooCoin}, {Amount: fooCoin2}},
+	})
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, recipientAddr, sdk.NewCoins(fooCoin2)).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+
+	for _, index := range []uint32{1, 0} {
+		_, err = suite.msgServer.AttestMilestone(suite.ctx, &types.MsgAttestMilestone{
+			Attestor:       suite.poolKeeper.GetAuthority(),
+			BudgetId:       res.Id,
+			MilestoneIndex: index,
+		})
+		suite.Require().NoError(err)
+	}
+
+	has, err := suite.poolKeeper.MilestoneBudgets.Has(suite.ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestCancelMilestoneBudget() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateMilestoneBudget(suite.ctx, &types.MsgCreateMilestoneBudget{
+		Authority:        suite.poolKeeper.GetAuthority(),
+		RecipientAddress: recipientStrAddr,
+		Milestones:       []types.Milestone{{Amount: fooCoin}, {Amount: fooCoin2}},
+	})
+	suite.Require().NoError(err)
+
+	suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+	_, err = suite.msgServer.AttestMilestone(suite.ctx, &types.MsgAttestMilestone{
+		Attestor:       suite.poolKeeper.GetAuthority(),
+		BudgetId:       res.Id,
+		MilestoneIndex: 0,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CancelMilestoneBudget(suite.ctx, &types.MsgCancelMilestoneBudget{Authority: recipientStrAddr, BudgetId: res.Id})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = suite.msgServer.CancelMilestoneBudget(suite.ctx, &types.MsgCancelMilestoneBudget{Authority: suite.poolKeeper.GetAuthority(), BudgetId: res.Id + 1})
+	suite.Require().ErrorIs(err, types.ErrMilestoneBudgetNotFound)
+
+	cancelRes, err := suite.msgServer.CancelMilestoneBudget(suite.ctx, &types.MsgCancelMilestoneBudget{Authority: suite.poolKeeper.GetAuthority(), BudgetId: res.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fooCoin2), cancelRes.UnreleasedAmount)
+
+	has, err := suite.poolKeeper.MilestoneBudgets.Has(suite.ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -5,4 +5,9 @@ import "cosmossdk.io/errors"
 var (
 	ErrInvalidSigner    = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
 	ErrNoRecipientFound = errors.Register(ModuleName, 3, "no recipient found")
+
+	ErrMilestoneBudgetNotFound = errors.Register(ModuleName, 4, "milestone budget not found")
+	ErrInvalidMilestone        = errors.Register(ModuleName, 5, "invalid milestone")
+	ErrMilestoneReleased       = errors.Register(ModuleName, 6, "milestone already released")
+	ErrUnauthorizedAttestor    = errors.Register(ModuleName, 7, "unauthorized milestone attestor")
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	epochstypes "cosmossdk.io/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +28,8 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
 }
+
+// EpochsKeeper defines the expected epochs keeper used to validate the auto distribution epoch of budgets.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx context.Context, identifier string) (epochstypes.EpochInfo, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -37,6 +38,46 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+
+	seenIDs := make(map[uint64]struct{}, len(gs.MilestoneBudgets))
+	for _, mb := range gs.MilestoneBudgets {
+		if _, ok := seenIDs[mb.Id]; ok {
+			return fmt.Errorf("duplicate milestone budget id %d", mb.Id)
+		}
+		seenIDs[mb.Id] = struct{}{}
+
+		if mb.Id >= gs.NextMilestoneBudgetId {
+			return fmt.Errorf("milestone budget id %d must be lower than the next milestone budget id %d", mb.Id, gs.NextMilestoneBudgetId)
+		}
+
+		if mb.RecipientAddress == "" {
+			return errors.New("recipient cannot be empty")
+		}
+
+		if err := ValidateMilestones(mb.Milestones); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateMilestones validates the milestones of a milestone budget.
+func ValidateMilestones(milestones []Milestone) error {
+	if len(milestones) == 0 {
+		return errorsmod.Wrap(ErrInvalidMilestone, "at least one milestone is required")
+	}
+
+	for i, m := range milestones {
+		if err := m.Amount.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: %s", i, err)
+		}
+		if m.Amount.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: amount cannot be zero", i)
+		}
+		if m.Released && m.ReleasedAt == nil {
+			return errorsmod.Wrapf(ErrInvalidMilestone, "milestone %d: released milestone must have a release time", i)
+		}
+	}
 	return nil
 }
 
@@ -31,4 +31,6 @@ var (
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
+	MilestoneBudgetKey           = collections.NewPrefix(8)
+	NextMilestoneBudgetIDKey     = collections.NewPrefix(9)
 )
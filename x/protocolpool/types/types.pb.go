@@ -48,6 +48,10 @@ type Budget struct {
 	// For example, if a period is set to 3600, it represents an action that
 	// should occur every hour (3600 seconds).
 	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// auto_distribution_epoch is an optional x/epochs identifier. When set, due tranches are
+	// pushed to the recipient at the end of each epoch with that identifier instead of
+	// waiting for the recipient to claim them.
+	AutoDistributionEpoch string `protobuf:"bytes,7,opt,name=auto_distribution_epoch,json=autoDistributionEpoch,proto3" json:"auto_distribution_epoch,omitempty"`
 }
 
 func (m *Budget) Reset()         { *m = Budget{} }
@@ -125,6 +129,13 @@ func (m *Budget) GetPeriod() *time.Duration {
 	return nil
 }
 
+func (m *Budget) GetAutoDistributionEpoch() string {
+	if m != nil {
+		return m.AutoDistributionEpoch
+	}
+	return ""
+}
+
 // ContinuousFund defines the fields of continuous fund proposal.
 type ContinuousFund struct {
 	// Recipient address of the account receiving funds.
@@ -182,9 +193,159 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// MilestoneBudget defines a budget whose tranches are released one by one once
+// the corresponding milestone has been attested.
+type MilestoneBudget struct {
+	// id is the unique identifier of the milestone budget.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient_address is the address of the account receiving the released tranches.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// attestor is the address allowed to attest milestones, for example an x/group policy
+	// address controlled by a committee. The module authority can always attest.
+	Attestor string `protobuf:"bytes,3,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// milestones is the ordered list of milestones of the budget.
+	Milestones []Milestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones"`
+}
+
+func (m *MilestoneBudget) Reset()         { *m = MilestoneBudget{} }
+func (m *MilestoneBudget) String() string { return proto.CompactTextString(m) }
+func (*MilestoneBudget) ProtoMessage()    {}
+func (*MilestoneBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *MilestoneBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MilestoneBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MilestoneBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MilestoneBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneBudget.Merge(m, src)
+}
+func (m *MilestoneBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MilestoneBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneBudget proto.InternalMessageInfo
+
+func (m *MilestoneBudget) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MilestoneBudget) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MilestoneBudget) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *MilestoneBudget) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+// Milestone defines a single tranche of a milestone budget.
+type Milestone struct {
+	// description is a human readable description of the deliverable.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// amount is the amount released to the recipient once the milestone is attested.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// released is true once the milestone has been attested and its amount released.
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+	// released_at is the block time at which the milestone was released.
+	ReleasedAt *time.Time `protobuf:"bytes,4,opt,name=released_at,json=releasedAt,proto3,stdtime" json:"released_at,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Milestone) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+func (m *Milestone) GetReleasedAt() *time.Time {
+	if m != nil {
+		return m.ReleasedAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*MilestoneBudget)(nil), "cosmos.protocolpool.v1.MilestoneBudget")
+	proto.RegisterType((*Milestone)(nil), "cosmos.protocolpool.v1.Milestone")
 }
 
 func init() {
@@ -192,39 +353,49 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0x13, 0xff, 0xf3, 0x6f, 0x2e, 0xf4, 0x85, 0x53, 0x01, 0x37, 0x48, 0x4e, 0x1a, 0x96,
+	0x2c, 0xb5, 0x95, 0x82, 0x00, 0x89, 0x85, 0xa4, 0x29, 0x65, 0x28, 0x12, 0x98, 0x4e, 0x2c, 0xd6,
+	0xc5, 0x7e, 0xea, 0x9e, 0xb0, 0x7d, 0xd6, 0xdd, 0xb9, 0x6a, 0xbf, 0x45, 0x47, 0x3e, 0x48, 0x27,
+	0x76, 0xa4, 0x8e, 0x55, 0x25, 0x24, 0xc4, 0x50, 0x50, 0x3b, 0xf0, 0x35, 0x90, 0xed, 0x73, 0x9a,
+	0x56, 0x95, 0x1a, 0x36, 0xfb, 0xf9, 0xbd, 0xdc, 0xf3, 0x76, 0x87, 0xba, 0x1e, 0x13, 0x11, 0x13,
+	0x76, 0xc2, 0x99, 0x64, 0x1e, 0x0b, 0x13, 0xc6, 0x42, 0x7b, 0xbf, 0x6f, 0xcb, 0xc3, 0x04, 0x84,
+	0x95, 0x47, 0xf1, 0xc3, 0x82, 0x63, 0x4d, 0x73, 0xac, 0xfd, 0x7e, 0x6b, 0x39, 0x60, 0x01, 0xcb,
+	0x83, 0x76, 0xf6, 0x55, 0xe0, 0xad, 0x95, 0x82, 0xed, 0x16, 0xc0, 0xb4, 0xb4, 0x65, 0xaa, 0xc3,
+	0xc6, 0x44, 0x80, 0xbd, 0xdf, 0x1f, 0x83, 0x24, 0x7d, 0xdb, 0x63, 0x34, 0x56, 0x78, 0x3b, 0x60,
+	0x2c, 0x08, 0xa1, 0x48, 0x66, 0x9c, 0xee, 0xda, 0x92, 0x46, 0x20, 0x24, 0x89, 0x92, 0xd2, 0xe0,
+	0x26, 0xc1, 0x4f, 0x39, 0x91, 0x94, 0x29, 0x83, 0xee, 0xd7, 0x1a, 0xaa, 0x0f, 0x53, 0x3f, 0x00,
+	0x89, 0x37, 0xd1, 0x7d, 0x0e, 0x1e, 0x4d, 0x28, 0xc4, 0xd2, 0x25, 0xbe, 0xcf, 0x41, 0x08, 0x43,
+	0xeb, 0x68, 0xbd, 0xc6, 0xd0, 0x38, 0x3b, 0x5e, 0x5b, 0x56, 0x89, 0x0d, 0x0a, 0xe4, 0xa3, 0xe4,
+	0x34, 0x0e, 0x9c, 0xa5, 0x89, 0x44, 0xc5, 0xf1, 0x6b, 0xb4, 0xe0, 0x85, 0x84, 0x46, 0xe0, 0xbb,
+	0x24, 0x62, 0x69, 0x2c, 0x8d, 0x6a, 0x47, 0xeb, 0x35, 0xd7, 0x57, 0x2c, 0x65, 0x90, 0xd5, 0x62,
+	0xa9, 0x5a, 0xac, 0x0d, 0x46, 0x63, 0x67, 0x5e, 0x09, 0x06, 0x39, 0x1f, 0xbf, 0x45, 0x8b, 0x21,
+	0x11, 0xd2, 0x9d, 0xd8, 0x48, 0xa3, 0x96, 0x5b, 0xb4, 0xac, 0xa2, 0x1a, 0xab, 0xac, 0xc6, 0xda,
+	0x29, 0xcb, 0x1d, 0xea, 0x47, 0xbf, 0xda, 0x9a, 0x33, 0x9f, 0x09, 0x37, 0x94, 0x9b, 0xc4, 0x4f,
+	0xd0, 0xbc, 0xe4, 0x24, 0xf6, 0xf6, 0x40, 0xb8, 0x21, 0xec, 0x4a, 0x43, 0xef, 0x68, 0x3d, 0xdd,
+	0xb9, 0x57, 0x06, 0xb7, 0x61, 0x57, 0xe2, 0x2d, 0x84, 0xc7, 0x79, 0x07, 0xdc, 0x04, 0xb8, 0xab,
+	0x20, 0xe3, 0xbf, 0xbb, 0x92, 0x5e, 0x2a, 0x44, 0xef, 0x81, 0xef, 0x14, 0x12, 0xfc, 0x02, 0xd5,
+	0x13, 0xe0, 0x94, 0xf9, 0x46, 0x5d, 0x89, 0x6f, 0xa6, 0x3b, 0x52, 0xcd, 0x1f, 0xea, 0x5f, 0xb2,
+	0x6c, 0x15, 0x1d, 0x3f, 0x47, 0x8f, 0x48, 0x2a, 0x99, 0xeb, 0x53, 0x21, 0x39, 0x1d, 0xa7, 0x19,
+	0xc5, 0x85, 0x84, 0x79, 0x7b, 0xc6, 0xff, 0x59, 0xff, 0x9d, 0x07, 0x19, 0x3c, 0x9a, 0x42, 0x37,
+	0x33, 0xb0, 0xfb, 0x5d, 0x43, 0x0b, 0x1b, 0x2c, 0x96, 0x34, 0x4e, 0x59, 0x2a, 0xde, 0xa4, 0x71,
+	0x66, 0xd5, 0x98, 0x4c, 0xe4, 0xce, 0xe1, 0x5d, 0x51, 0xf1, 0x07, 0x84, 0x12, 0xe0, 0x1e, 0xc4,
+	0x92, 0x04, 0x90, 0x4f, 0xac, 0x31, 0xec, 0x9f, 0x9c, 0xb7, 0x2b, 0x3f, 0xcf, 0xdb, 0x8f, 0x0b,
+	0xb1, 0xf0, 0x3f, 0x5b, 0x94, 0xd9, 0x11, 0x91, 0x7b, 0xd6, 0x36, 0x04, 0xc4, 0x3b, 0x1c, 0x81,
+	0x77, 0x76, 0xbc, 0x86, 0x94, 0xf7, 0x08, 0x3c, 0x67, 0xca, 0x04, 0xbf, 0x44, 0x75, 0x38, 0x48,
+	0x28, 0x3f, 0x9c, 0x79, 0x7a, 0x8a, 0xdf, 0xfd, 0xa3, 0xa1, 0xc5, 0x77, 0x34, 0x04, 0x21, 0x59,
+	0x0c, 0x6a, 0x3b, 0x17, 0x50, 0x95, 0xfa, 0x79, 0x45, 0xba, 0x53, 0xa5, 0xfe, 0xed, 0xdb, 0x5a,
+	0xfd, 0xe7, 0x6d, 0x7d, 0x86, 0xe6, 0x88, 0x94, 0xd9, 0x49, 0xdc, 0xa8, 0xdd, 0xa1, 0x9e, 0x30,
+	0xf1, 0x16, 0x42, 0x51, 0x99, 0x9f, 0x30, 0xf4, 0x4e, 0xad, 0xd7, 0x5c, 0x5f, 0xb5, 0x6e, 0xbf,
+	0xf4, 0xd6, 0x55, 0x25, 0x7a, 0xd6, 0x50, 0x67, 0x4a, 0xda, 0xfd, 0xa6, 0xa1, 0xc6, 0x04, 0xc7,
+	0x1d, 0xd4, 0xf4, 0x41, 0x78, 0x9c, 0x26, 0xd9, 0x8c, 0x8b, 0xf1, 0x39, 0xd3, 0xa1, 0x6c, 0xc5,
+	0x66, 0xbc, 0x54, 0xea, 0x30, 0x45, 0xc7, 0x2d, 0x34, 0xc7, 0x21, 0x04, 0x22, 0xc0, 0xcf, 0xeb,
+	0x9c, 0x73, 0x26, 0xff, 0x78, 0x80, 0x9a, 0xe5, 0xb7, 0x4b, 0x8a, 0x3b, 0x32, 0xcb, 0xb4, 0x50,
+	0x29, 0x1a, 0xc8, 0xe1, 0xab, 0x93, 0x0b, 0x53, 0x3b, 0xbd, 0x30, 0xb5, 0xdf, 0x17, 0xa6, 0x76,
+	0x74, 0x69, 0x56, 0x4e, 0x2f, 0xcd, 0xca, 0x8f, 0x4b, 0xb3, 0xf2, 0x69, 0xf5, 0xda, 0xf2, 0x1c,
+	0x5c, 0x7f, 0x36, 0xf3, 0x37, 0x73, 0x5c, 0xcf, 0x63, 0x4f, 0xff, 0x0e, 0x00, 0xa1, 0x47, 0xf8,
+	0xfb, 0x5a, 0x05, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -247,6 +418,13 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoDistributionEpoch) > 0 {
+		i -= len(m.AutoDistributionEpoch)
+		copy(dAtA[i:], m.AutoDistributionEpoch)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AutoDistributionEpoch)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Period != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
 		if err1 != nil {
@@ -356,6 +534,122 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MilestoneBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MilestoneBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MilestoneBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReleasedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleasedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -396,6 +690,10 @@ func (m *Budget) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.AutoDistributionEpoch)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -418,6 +716,54 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *MilestoneBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Released {
+		n += 2
+	}
+	if m.ReleasedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleasedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -648,6 +994,38 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDistributionEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoDistributionEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -821,6 +1199,344 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MilestoneBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MilestoneBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MilestoneBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleasedAt == nil {
+				m.ReleasedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReleasedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
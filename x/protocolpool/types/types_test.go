@@ -24,6 +24,9 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateMilestoneBudget{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgAttestMilestone{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelMilestoneBudget{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -81,6 +84,76 @@ func TestValidateGenesis(t *testing.T) {
 	require.EqualError(t, err, "recipient cannot be empty")
 }
 
+func TestValidateGenesisMilestoneBudgets(t *testing.T) {
+	releasedAt := time.Now()
+	recipient := "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4"
+	amount := sdk.NewCoin("stake", math.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		budgets   []MilestoneBudget
+		nextID    uint64
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			budgets: []MilestoneBudget{
+				{Id: 0, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount, Released: true, ReleasedAt: &releasedAt}, {Amount: amount}}},
+				{Id: 3, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount}}},
+			},
+			nextID: 4,
+		},
+		{
+			name: "duplicate id",
+			budgets: []MilestoneBudget{
+				{Id: 1, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount}}},
+				{Id: 1, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount}}},
+			},
+			nextID:    2,
+			expErrMsg: "duplicate milestone budget id 1",
+		},
+		{
+			name:      "id not lower than next id",
+			budgets:   []MilestoneBudget{{Id: 2, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount}}}},
+			nextID:    2,
+			expErrMsg: "must be lower than the next milestone budget id",
+		},
+		{
+			name:      "empty recipient",
+			budgets:   []MilestoneBudget{{Id: 0, Milestones: []Milestone{{Amount: amount}}}},
+			nextID:    1,
+			expErrMsg: "recipient cannot be empty",
+		},
+		{
+			name:      "no milestones",
+			budgets:   []MilestoneBudget{{Id: 0, RecipientAddress: recipient}},
+			nextID:    1,
+			expErrMsg: "at least one milestone is required",
+		},
+		{
+			name:      "released without time",
+			budgets:   []MilestoneBudget{{Id: 0, RecipientAddress: recipient, Milestones: []Milestone{{Amount: amount, Released: true}}}},
+			nextID:    1,
+			expErrMsg: "released milestone must have a release time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.MilestoneBudgets = tc.budgets
+			gs.NextMilestoneBudgetId = tc.nextID
+
+			err := ValidateGenesis(gs)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateBudget(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	schedulerv1 "cosmossdk.io/api/cosmos/scheduler/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: schedulerv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Schedule",
+					Use:            "schedule [id]",
+					Short:          "Query a schedule by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "SchedulesByOwner",
+					Use:            "schedules-by-owner [owner]",
+					Short:          "Query the schedules registered by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "Executions",
+					Use:            "executions [schedule-id]",
+					Short:          "Query the execution history of a schedule",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "schedule_id"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: schedulerv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Schedule",
+					Skip:      true, // skipped because the scheduled messages cannot be provided as flags
+				},
+				{
+					RpcMethod:      "CancelSchedule",
+					Use:            "cancel-schedule [id]",
+					Short:          "Cancel a schedule and refund the fees escrowed for its remaining executions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/scheduler/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	epochstypes "cosmossdk.io/x/epochs/types"
+	"cosmossdk.io/x/scheduler/keeper"
+	"cosmossdk.io/x/scheduler/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(
+		&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Codec       codec.Codec
+	Environment appmodule.Environment
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	EpochsKeeper  types.EpochsKeeper `optional:"true"`
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper     keeper.Keeper
+	Module     appmodule.AppModule
+	EpochHooks epochstypes.EpochHooksWrapper
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := keeper.NewKeeper(
+		in.Codec,
+		in.Environment,
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.EpochsKeeper,
+		in.Config.MaxExecutionsPerBlock,
+		in.Config.MaxGasPerExecution,
+	)
+	m := NewAppModule(in.Codec, k)
+
+	return ModuleOutputs{
+		Keeper:     k,
+		Module:     m,
+		EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()},
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/x/scheduler/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker executes the height and time triggered schedules which are due, then
+// the schedules of the ended epochs. At most maxExecutionsPerBlock schedules are
+// executed per block, the remaining ones are executed in the following blocks.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	limit := int(k.maxExecutionsPerBlock)
+
+	ids := make([]uint64, 0, limit)
+	err := k.HeightQueue.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](headerInfo.Height), func(key collections.Pair[int64, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return len(ids) >= limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(ids) < limit {
+		err = k.TimeQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](headerInfo.Time), func(key collections.Pair[time.Time, uint64]) (bool, error) {
+			ids = append(ids, key.K2())
+			return len(ids) >= limit, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(ids) < limit {
+		epochIDs, err := k.dueEpochSchedules(ctx, limit-len(ids))
+		if err != nil {
+			return err
+		}
+		ids = append(ids, epochIDs...)
+	}
+
+	return k.executeSchedules(ctx, ids)
+}
+
+// dueEpochSchedules returns at most limit schedules of the ended epochs, starting at
+// the cursor of each epoch identifier. The cursors are moved after the returned
+// schedules, and removed once all the schedules of their epoch are returned.
+func (k Keeper) dueEpochSchedules(ctx context.Context, limit int) ([]uint64, error) {
+	var (
+		ids     []uint64
+		cursors []collections.KeyValue[string, uint64]
+	)
+	err := k.EpochCursors.Walk(ctx, nil, func(epochIdentifier string, cursor uint64) (bool, error) {
+		cursors = append(cursors, collections.KeyValue[string, uint64]{Key: epochIdentifier, Value: cursor})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cursor := range cursors {
+		if len(ids) >= limit {
+			break
+		}
+
+		rng := collections.NewPrefixedPairRange[string, uint64](cursor.Key).StartInclusive(cursor.Value)
+		done := true
+		err := k.EpochQueue.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			if len(ids) >= limit {
+				done = false
+				return true, nil
+			}
+			ids = append(ids, key.K2())
+			cursor.Value = key.K2() + 1
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if done {
+			err = k.EpochCursors.Remove(ctx, cursor.Key)
+		} else {
+			err = k.EpochCursors.Set(ctx, cursor.Key, cursor.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// executeSchedules executes the given schedules in order. Schedules removed by the
+// execution of a previous schedule are skipped.
+func (k Keeper) executeSchedules(ctx context.Context, ids []uint64) error {
+	for _, id := range ids {
+		schedule, err := k.GetSchedule(ctx, id)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := k.executeSchedule(ctx, schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeSchedule runs the messages of a schedule, records the outcome in the
+// execution history and pays the execution fee to the fee collector.
+// A failing execution does not return an error, it is recorded instead.
+func (k Keeper) executeSchedule(ctx context.Context, schedule types.Schedule) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	sequence, err := k.nextExecutionSequence(ctx, schedule.Id)
+	if err != nil {
+		return err
+	}
+
+	execution := types.Execution{
+		ScheduleId: schedule.Id,
+		Sequence:   sequence,
+		Height:     headerInfo.Height,
+		Time:       headerInfo.Time,
+		Success:    true,
+	}
+	if execErr := k.runMessages(ctx, schedule); execErr != nil {
+		execution.Success = false
+		execution.Error = execErr.Error()
+		k.Logger.Error(fmt.Sprintf("scheduled execution %d of schedule %d failed", sequence, schedule.Id), "err", execErr)
+	}
+	if err := k.Executions.Set(ctx, collections.Join(schedule.Id, sequence), execution); err != nil {
+		return err
+	}
+
+	if !schedule.FeePerExecution.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.FeeCollectorName, schedule.FeePerExecution); err != nil {
+			return err
+		}
+	}
+
+	schedule.RemainingExecutions--
+	if schedule.RemainingExecutions == 0 {
+		err = k.removeSchedule(ctx, schedule)
+	} else {
+		err = k.Schedules.Set(ctx, schedule.Id, schedule)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeExecution,
+		event.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+		event.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		event.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(execution.Success)),
+		event.NewAttribute(types.AttributeKeyError, execution.Error),
+	)
+}
+
+// runMessages executes all the messages of a schedule atomically within the
+// schedule gas limit.
+func (k Keeper) runMessages(ctx context.Context, schedule types.Schedule) error {
+	msgs, err := schedule.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, schedule.GasLimit, func(ctx context.Context) error {
+		for i, msg := range msgs {
+			if err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
+				return fmt.Errorf("message %d (%s): %w", i, sdk.MsgTypeURL(msg), err)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// safeExecuteHandler executes a scheduled message and recovers from panics.
+func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Service) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("scheduled msg [%s] PANICKED: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+
+	_, err = router.Invoke(ctx, msg)
+	return
+}
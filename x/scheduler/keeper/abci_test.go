@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/scheduler/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestBeginBlockerHeightTrigger() {
+	id := s.schedule(types.Trigger{Height: 12}, 1)
+
+	// not due yet
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.router.invoked)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 12, Time: time.Unix(1_020, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 1)
+
+	execution, err := s.keeper.Executions.Get(s.ctx, collections.Join(id, uint64(1)))
+	s.Require().NoError(err)
+	s.Require().True(execution.Success)
+	s.Require().Equal(int64(12), execution.Height)
+	s.Require().Equal(fee, s.bankKeeper.balances[types.FeeCollectorName])
+
+	// the schedule is removed after its last execution
+	has, err := s.keeper.Schedules.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.keeper.HeightQueue.Has(s.ctx, collections.Join(int64(12), id))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// nothing left to execute
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 13, Time: time.Unix(1_030, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 1)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerTimeTrigger() {
+	target := time.Unix(1_500, 0).UTC()
+	id := s.schedule(types.Trigger{Time: &target}, 1)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_499, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Empty(s.router.invoked)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 12, Time: time.Unix(1_600, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 1)
+
+	has, err := s.keeper.Schedules.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerFailedExecution() {
+	id := s.schedule(types.Trigger{Height: 11}, 1)
+	s.router.err = errors.New("insufficient funds")
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	res, err := s.queryServer.Executions(s.ctx, &types.QueryExecutionsRequest{ScheduleId: id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Executions, 1)
+	s.Require().False(res.Executions[0].Success)
+	s.Require().Contains(res.Executions[0].Error, "insufficient funds")
+
+	// the fee is paid regardless of the outcome
+	s.Require().Equal(fee, s.bankKeeper.balances[types.FeeCollectorName])
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerPanickingExecution() {
+	id := s.schedule(types.Trigger{Height: 11}, 1)
+	s.router.panic = true
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	execution, err := s.keeper.Executions.Get(s.ctx, collections.Join(id, uint64(1)))
+	s.Require().NoError(err)
+	s.Require().False(execution.Success)
+	s.Require().Contains(execution.Error, "PANICKED")
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerMaxExecutionsPerBlock() {
+	target := time.Unix(1_005, 0).UTC()
+	s.schedule(types.Trigger{Height: 11}, 1)
+	s.schedule(types.Trigger{Height: 11}, 1)
+	s.schedule(types.Trigger{Time: &target}, 1)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 2)
+
+	// the remaining schedule is executed in the next block
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 12, Time: time.Unix(1_020, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 3)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), s.bankKeeper.balances[types.FeeCollectorName])
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerScheduleCancelledInSameBlock() {
+	s.schedule(types.Trigger{Height: 11}, 1)
+	cancelled := s.schedule(types.Trigger{Height: 11}, 1)
+
+	// the execution of the first schedule cancels the second one, bypassing the message validation
+	s.router.onInvoke = func(ctx context.Context) error {
+		_, err := s.msgServer.CancelSchedule(ctx, &types.MsgCancelSchedule{Owner: ownerAddr.String(), Id: cancelled})
+		return err
+	}
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 1)
+
+	has, err := s.keeper.Executions.Has(s.ctx, collections.Join(cancelled, uint64(1)))
+	s.Require().NoError(err)
+	s.Require().False(has)
+	s.Require().Equal(fee, s.bankKeeper.balances[types.FeeCollectorName])
+	s.Require().True(s.bankKeeper.balances[types.ModuleName].IsZero())
+}
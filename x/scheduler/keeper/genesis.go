@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/scheduler/types"
+)
+
+// InitGenesis initializes the scheduler module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.NextScheduleID.Set(ctx, gs.NextScheduleId); err != nil {
+		return err
+	}
+
+	for _, schedule := range gs.Schedules {
+		if err := k.setSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("failed to set schedule %d: %w", schedule.Id, err)
+		}
+	}
+
+	for _, execution := range gs.Executions {
+		if err := k.Executions.Set(ctx, collections.Join(execution.ScheduleId, execution.Sequence), execution); err != nil {
+			return err
+		}
+	}
+
+	for _, cursor := range gs.EpochCursors {
+		if err := k.EpochCursors.Set(ctx, cursor.EpochIdentifier, cursor.NextScheduleId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the scheduler module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	nextID, err := k.NextScheduleID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []types.Schedule
+	err = k.Schedules.Walk(ctx, nil, func(_ uint64, schedule types.Schedule) (bool, error) {
+		schedules = append(schedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []types.Execution
+	err = k.Executions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], execution types.Execution) (bool, error) {
+		executions = append(executions, execution)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var cursors []types.EpochCursor
+	err = k.EpochCursors.Walk(ctx, nil, func(epochIdentifier string, nextScheduleID uint64) (bool, error) {
+		cursors = append(cursors, types.EpochCursor{EpochIdentifier: epochIdentifier, NextScheduleId: nextScheduleID})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(schedules, nextID, executions)
+	gs.EpochCursors = cursors
+	return gs, nil
+}
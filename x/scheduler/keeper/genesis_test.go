@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/scheduler/types"
+)
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	first := s.schedule(types.Trigger{EpochIdentifier: "day"}, 2)
+	s.schedule(types.Trigger{Height: 100}, 1)
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 1))
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	// the schedules of the next epoch are pending
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 2))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(exported.Validate())
+	s.Require().Len(exported.Schedules, 2)
+	s.Require().Len(exported.Executions, 1)
+	s.Require().Equal([]types.EpochCursor{{EpochIdentifier: "day"}}, exported.EpochCursors)
+	s.Require().Equal(uint64(3), exported.NextScheduleId)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *exported))
+
+	res, err := s.queryServer.Schedule(s.ctx, &types.QueryScheduleRequest{Id: first})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Schedule.RemainingExecutions)
+
+	reExported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, reExported)
+
+	// new schedules continue from the exported sequence
+	s.Require().Equal(uint64(3), s.schedule(types.Trigger{Height: 100}, 1))
+}
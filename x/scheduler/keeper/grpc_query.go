@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/scheduler/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/scheduler keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier initializes new querier.
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Schedule returns a schedule by its id.
+func (q Querier) Schedule(ctx context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedule, err := q.Schedules.Get(ctx, req.Id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "schedule %d not found", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// SchedulesByOwner returns the schedules registered by an owner.
+func (q Querier) SchedulesByOwner(ctx context.Context, req *types.QuerySchedulesByOwnerRequest) (*types.QuerySchedulesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := q.addressCodec.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err.Error())
+	}
+
+	schedules, pageRes, err := query.CollectionPaginate(ctx, q.ByOwner, req.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Schedule, error) {
+		return q.Schedules.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySchedulesByOwnerResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// Executions returns the execution history of a schedule.
+func (q Querier) Executions(ctx context.Context, req *types.QueryExecutionsRequest) (*types.QueryExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	executions, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Executions, req.Pagination, func(_ collections.Pair[uint64, uint64], execution types.Execution) (types.Execution, error) {
+		return execution, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ScheduleId))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryExecutionsResponse{Executions: executions, Pagination: pageRes}, nil
+}
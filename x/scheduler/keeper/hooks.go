@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+// Hooks wrapper struct for the scheduler keeper.
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ epochstypes.EpochHooks              = Hooks{}
+	_ epochstypes.EpochIdentifierConsumer = Hooks{}
+)
+
+// Hooks returns the epoch hooks executing the epoch triggered schedules.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd schedules the execution of every schedule registered on the ended epoch
+// identifier. They are executed by the BeginBlocker within the maxExecutionsPerBlock limit.
+// If the schedules of the previous epoch of the identifier are still being executed, the
+// ended epoch is merged into it.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	has, err := h.k.EpochCursors.Has(ctx, epochIdentifier)
+	if err != nil || has {
+		return err
+	}
+
+	return h.k.EpochCursors.Set(ctx, epochIdentifier, 0)
+}
+
+// BeforeEpochStart is a no-op for the scheduler.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// IsEpochIdentifierInUse returns true if a schedule is registered on the epoch identifier,
+// preventing its removal from x/epochs.
+func (h Hooks) IsEpochIdentifierInUse(ctx context.Context, epochIdentifier string) (bool, error) {
+	iter, err := h.k.EpochQueue.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](epochIdentifier))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
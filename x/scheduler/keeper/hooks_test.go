@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/scheduler/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAfterEpochEnd() {
+	s.epochsKeeper["week"] = s.epochsKeeper["day"]
+	daily := s.schedule(types.Trigger{EpochIdentifier: "day"}, 2)
+	weekly := s.schedule(types.Trigger{EpochIdentifier: "week"}, 1)
+
+	hooks := s.keeper.Hooks()
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 1))
+	s.Require().Empty(s.router.invoked)
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 1)
+
+	schedule, err := s.keeper.Schedules.Get(s.ctx, daily)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), schedule.RemainingExecutions)
+
+	s.Require().NoError(hooks.AfterEpochEnd(s.ctx, "day", 2))
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 2)
+
+	has, err := s.keeper.Schedules.Has(s.ctx, daily)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	res, err := s.queryServer.Executions(s.ctx, &types.QueryExecutionsRequest{ScheduleId: daily})
+	s.Require().NoError(err)
+	s.Require().Len(res.Executions, 2)
+	s.Require().Equal(uint64(1), res.Executions[0].Sequence)
+	s.Require().Equal(uint64(2), res.Executions[1].Sequence)
+
+	// the weekly schedule is untouched
+	byOwner, err := s.queryServer.SchedulesByOwner(s.ctx, &types.QuerySchedulesByOwnerRequest{Owner: ownerAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byOwner.Schedules, 1)
+	s.Require().Equal(weekly, byOwner.Schedules[0].Id)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), s.bankKeeper.balances[types.FeeCollectorName])
+}
+
+func (s *KeeperTestSuite) TestAfterEpochEndMaxExecutionsPerBlock() {
+	height := s.schedule(types.Trigger{Height: 11}, 1)
+	daily := []uint64{
+		s.schedule(types.Trigger{EpochIdentifier: "day"}, 1),
+		s.schedule(types.Trigger{EpochIdentifier: "day"}, 1),
+		s.schedule(types.Trigger{EpochIdentifier: "day"}, 1),
+	}
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Unix(1_010, 0).UTC()})
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 1))
+
+	// the height triggered schedule and the first epoch schedule share the limit
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 2)
+	for _, id := range []uint64{height, daily[0]} {
+		has, err := s.keeper.Schedules.Has(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().False(has)
+	}
+	cursor, err := s.keeper.EpochCursors.Get(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(daily[1], cursor)
+
+	// the epoch ending again while its schedules are being executed is merged
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 2))
+	cursor, err = s.keeper.EpochCursors.Get(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(daily[1], cursor)
+
+	// the remaining epoch schedules are executed in the next block
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 12, Time: time.Unix(1_020, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 4)
+	has, err := s.keeper.EpochCursors.Has(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 13, Time: time.Unix(1_030, 0).UTC()})
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	s.Require().Len(s.router.invoked, 4)
+}
+
+func (s *KeeperTestSuite) TestIsEpochIdentifierInUse() {
+	hooks := s.keeper.Hooks()
+
+	inUse, err := hooks.IsEpochIdentifierInUse(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(inUse)
+
+	id := s.schedule(types.Trigger{EpochIdentifier: "day"}, 1)
+
+	inUse, err = hooks.IsEpochIdentifierInUse(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().True(inUse)
+
+	_, err = s.msgServer.CancelSchedule(s.ctx, &types.MsgCancelSchedule{Owner: ownerAddr.String(), Id: id})
+	s.Require().NoError(err)
+
+	inUse, err = hooks.IsEpochIdentifierInUse(s.ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(inUse)
+}
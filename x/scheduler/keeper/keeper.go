@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/scheduler/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	appmodule.Environment
+
+	cdc          codec.Codec
+	addressCodec address.Codec
+
+	bankKeeper   types.BankKeeper
+	epochsKeeper types.EpochsKeeper
+
+	maxExecutionsPerBlock uint32
+	maxGasPerExecution    uint64
+
+	// State
+	Schema         collections.Schema
+	NextScheduleID collections.Sequence
+	Schedules      collections.Map[uint64, types.Schedule]
+	// ByOwner key: Owner | ScheduleID
+	ByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// HeightQueue key: Height | ScheduleID
+	HeightQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// TimeQueue key: Time | ScheduleID
+	TimeQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// EpochQueue key: EpochIdentifier | ScheduleID
+	EpochQueue collections.KeySet[collections.Pair[string, uint64]]
+	// Executions key: ScheduleID | Sequence
+	Executions collections.Map[collections.Pair[uint64, uint64], types.Execution]
+	// EpochCursors key: EpochIdentifier | value: ID of the next schedule to execute for the ended epoch
+	EpochCursors collections.Map[string, uint64]
+}
+
+// NewKeeper returns a new scheduler keeper. The epochs keeper is optional, when
+// nil epoch triggers are rejected.
+func NewKeeper(
+	cdc codec.Codec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ek types.EpochsKeeper,
+	maxExecutionsPerBlock uint32,
+	maxGasPerExecution uint64,
+) Keeper {
+	// ensure scheduler module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if maxExecutionsPerBlock == 0 {
+		maxExecutionsPerBlock = types.DefaultMaxExecutionsPerBlock
+	}
+	if maxGasPerExecution == 0 {
+		maxGasPerExecution = types.DefaultMaxGasPerExecution
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:           env,
+		cdc:                   cdc,
+		addressCodec:          ak.AddressCodec(),
+		bankKeeper:            bk,
+		epochsKeeper:          ek,
+		maxExecutionsPerBlock: maxExecutionsPerBlock,
+		maxGasPerExecution:    maxGasPerExecution,
+		NextScheduleID:        collections.NewSequence(sb, types.NextScheduleIDKey, "next_schedule_id"),
+		Schedules:             collections.NewMap(sb, types.SchedulesKey, "schedules", collections.Uint64Key, codec.CollValue[types.Schedule](cdc)),
+		ByOwner:               collections.NewKeySet(sb, types.ByOwnerKey, "by_owner", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		HeightQueue:           collections.NewKeySet(sb, types.HeightQueueKey, "height_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		TimeQueue:             collections.NewKeySet(sb, types.TimeQueueKey, "time_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		EpochQueue:            collections.NewKeySet(sb, types.EpochQueueKey, "epoch_queue", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		Executions:            collections.NewMap(sb, types.ExecutionsKey, "executions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Execution](cdc)),
+		EpochCursors:          collections.NewMap(sb, types.EpochCursorsKey, "epoch_cursors", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetSchedule returns a schedule by its id, unpacking its messages.
+func (k Keeper) GetSchedule(ctx context.Context, id uint64) (types.Schedule, error) {
+	s, err := k.Schedules.Get(ctx, id)
+	if err != nil {
+		return types.Schedule{}, err
+	}
+	if err := s.UnpackInterfaces(k.cdc.InterfaceRegistry()); err != nil {
+		return types.Schedule{}, err
+	}
+	return s, nil
+}
+
+// setSchedule stores a schedule and its owner and trigger indexes.
+func (k Keeper) setSchedule(ctx context.Context, s types.Schedule) error {
+	owner, err := k.addressCodec.StringToBytes(s.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.Schedules.Set(ctx, s.Id, s); err != nil {
+		return err
+	}
+	if err := k.ByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), s.Id)); err != nil {
+		return err
+	}
+
+	switch {
+	case s.Trigger.Height != 0:
+		return k.HeightQueue.Set(ctx, collections.Join(s.Trigger.Height, s.Id))
+	case s.Trigger.Time != nil:
+		return k.TimeQueue.Set(ctx, collections.Join(*s.Trigger.Time, s.Id))
+	case s.Trigger.EpochIdentifier != "":
+		return k.EpochQueue.Set(ctx, collections.Join(s.Trigger.EpochIdentifier, s.Id))
+	default:
+		return types.ErrInvalidTrigger
+	}
+}
+
+// removeSchedule deletes a schedule and its owner and trigger indexes.
+// The execution history is kept.
+func (k Keeper) removeSchedule(ctx context.Context, s types.Schedule) error {
+	owner, err := k.addressCodec.StringToBytes(s.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.Schedules.Remove(ctx, s.Id); err != nil {
+		return err
+	}
+	if err := k.ByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), s.Id)); err != nil {
+		return err
+	}
+
+	switch {
+	case s.Trigger.Height != 0:
+		return k.HeightQueue.Remove(ctx, collections.Join(s.Trigger.Height, s.Id))
+	case s.Trigger.Time != nil:
+		return k.TimeQueue.Remove(ctx, collections.Join(*s.Trigger.Time, s.Id))
+	case s.Trigger.EpochIdentifier != "":
+		return k.EpochQueue.Remove(ctx, collections.Join(s.Trigger.EpochIdentifier, s.Id))
+	default:
+		return types.ErrInvalidTrigger
+	}
+}
+
+// nextExecutionSequence returns the sequence of the next execution of a schedule.
+func (k Keeper) nextExecutionSequence(ctx context.Context, scheduleID uint64) (uint64, error) {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](scheduleID).Descending()
+	iter, err := k.Executions.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 1, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return key.K2() + 1, nil
+}
+
+// escrowedFees returns the fees held in escrow for the remaining executions of a schedule.
+func escrowedFees(s types.Schedule) sdk.Coins {
+	fees := sdk.NewCoins()
+	for _, c := range s.FeePerExecution {
+		fees = fees.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(math.NewIntFromUint64(s.RemainingExecutions))))
+	}
+	return fees
+}
+
+// isNotFound is a shorthand to check for collections.ErrNotFound.
+func isNotFound(err error) bool {
+	return errors.Is(err, collections.ErrNotFound)
+}
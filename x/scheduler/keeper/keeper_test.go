@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	storetypes "cosmossdk.io/store/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
+	"cosmossdk.io/x/scheduler/keeper"
+	"cosmossdk.io/x/scheduler/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	ownerAddr = sdk.AccAddress([]byte("owner_______________"))
+	otherAddr = sdk.AccAddress([]byte("other_______________"))
+
+	fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	msgServer    types.MsgServer
+	queryServer  types.QueryServer
+	bankKeeper   *fakeBankKeeper
+	epochsKeeper fakeEpochsKeeper
+	router       *fakeRouter
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.setup(2)
+}
+
+func (s *KeeperTestSuite) setup(maxExecutionsPerBlock uint32) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1_000, 0).UTC()})
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	s.router = &fakeRouter{}
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	env.MsgRouterService = s.router
+
+	s.bankKeeper = &fakeBankKeeper{balances: map[string]sdk.Coins{
+		ownerAddr.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000)),
+	}}
+	s.epochsKeeper = fakeEpochsKeeper{"day": {}}
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, env, fakeAccountKeeper{}, s.bankKeeper, s.epochsKeeper, maxExecutionsPerBlock, 0)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQuerier(s.keeper)
+
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *types.DefaultGenesis()))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) newMsgSchedule(trigger types.Trigger, executions uint64, msgs ...sdk.Msg) *types.MsgSchedule {
+	if len(msgs) == 0 {
+		msgs = []sdk.Msg{testdata.NewTestMsg(ownerAddr)}
+	}
+	anys, err := sdktx.SetMsgs(msgs)
+	s.Require().NoError(err)
+
+	return &types.MsgSchedule{
+		Owner:           ownerAddr.String(),
+		Messages:        anys,
+		Trigger:         trigger,
+		Executions:      executions,
+		GasLimit:        100_000,
+		FeePerExecution: fee,
+	}
+}
+
+func (s *KeeperTestSuite) schedule(trigger types.Trigger, executions uint64) uint64 {
+	res, err := s.msgServer.Schedule(s.ctx, s.newMsgSchedule(trigger, executions))
+	s.Require().NoError(err)
+	return res.Id
+}
+
+// fakeAccountKeeper returns a module address for any module name.
+type fakeAccountKeeper struct{}
+
+func (fakeAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+func (fakeAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(name)
+}
+
+// fakeBankKeeper keeps balances in memory, keyed by bech32 address or module name.
+type fakeBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (b *fakeBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from], amt)
+	}
+	b.balances[from] = balance
+	b.balances[to] = b.balances[to].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderModule, recipientModule, amt)
+}
+
+// fakeEpochsKeeper knows about a fixed set of epoch identifiers.
+type fakeEpochsKeeper map[string]epochstypes.EpochInfo
+
+func (e fakeEpochsKeeper) GetEpochInfo(_ context.Context, identifier string) (epochstypes.EpochInfo, error) {
+	info, ok := e[identifier]
+	if !ok {
+		return epochstypes.EpochInfo{}, errors.New("not found")
+	}
+	return info, nil
+}
+
+// fakeRouter records invoked messages and fails or panics on demand.
+// The onInvoke hook, if set, is called with the context of every invocation.
+type fakeRouter struct {
+	invoked  []transaction.Msg
+	err      error
+	panic    bool
+	onInvoke func(ctx context.Context) error
+}
+
+func (r *fakeRouter) CanInvoke(_ context.Context, typeURL string) error {
+	if typeURL != sdk.MsgTypeURL(&testdata.TestMsg{}) {
+		return fmt.Errorf("unknown message %s", typeURL)
+	}
+	return nil
+}
+
+func (r *fakeRouter) Invoke(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+	if r.panic {
+		panic("boom")
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.onInvoke != nil {
+		if err := r.onInvoke(ctx); err != nil {
+			return nil, err
+		}
+	}
+	r.invoked = append(r.invoked, msg)
+	return nil, nil
+}
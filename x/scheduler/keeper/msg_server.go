@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/scheduler/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type MsgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = MsgServer{}
+
+// NewMsgServerImpl returns an implementation of the scheduler MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &MsgServer{Keeper: keeper}
+}
+
+// Schedule registers a new schedule and escrows the fees of all its executions.
+func (k MsgServer) Schedule(ctx context.Context, msg *types.MsgSchedule) (*types.MsgScheduleResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	if err := k.validateMessages(ctx, owner, msg); err != nil {
+		return nil, err
+	}
+	if err := k.validateTrigger(ctx, msg.Trigger, msg.Executions); err != nil {
+		return nil, err
+	}
+	if msg.GasLimit == 0 || msg.GasLimit > k.maxGasPerExecution {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit must be between 1 and %d, got %d", k.maxGasPerExecution, msg.GasLimit)
+	}
+	if err := msg.FeePerExecution.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	id, err := k.NextScheduleID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := types.Schedule{
+		Id:                  id,
+		Owner:               msg.Owner,
+		Messages:            msg.Messages,
+		Trigger:             msg.Trigger,
+		RemainingExecutions: msg.Executions,
+		GasLimit:            msg.GasLimit,
+		FeePerExecution:     msg.FeePerExecution,
+	}
+
+	if escrow := escrowedFees(schedule); !escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, escrow); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.setSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSchedule,
+		event.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleResponse{Id: id}, nil
+}
+
+// CancelSchedule removes a schedule and refunds the fees escrowed for its remaining executions.
+func (k MsgServer) CancelSchedule(ctx context.Context, msg *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	schedule, err := k.Schedules.Get(ctx, msg.Id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("schedule %d not found", msg.Id)
+		}
+		return nil, err
+	}
+	if schedule.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of schedule %d", msg.Owner, msg.Id)
+	}
+
+	refund := escrowedFees(schedule)
+	if err := k.removeSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelSchedule,
+		event.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(msg.Id, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduleResponse{Refund: refund}, nil
+}
+
+// validateMessages asserts the owner is the only signer of every scheduled message
+// and that every message can be routed. Scheduler messages are rejected, so that
+// executions can't register or cancel schedules.
+func (k MsgServer) validateMessages(ctx context.Context, owner []byte, msg *types.MsgSchedule) error {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return types.ErrNoMessages
+	}
+
+	for i, m := range msgs {
+		switch m.(type) {
+		case *types.MsgSchedule, *types.MsgCancelSchedule:
+			return errorsmod.Wrapf(types.ErrSchedulerMsg, "message %d", i)
+		}
+
+		if m, ok := m.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(err, "message %d", i)
+			}
+		}
+
+		signers, _, err := k.cdc.GetMsgSigners(m)
+		if err != nil {
+			return err
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], owner) {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, "message %d", i)
+		}
+
+		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(m)); err != nil {
+			return errorsmod.Wrap(types.ErrUnroutableMsg, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateTrigger checks that the trigger is in the future (or that the epoch exists)
+// and that the number of executions matches the trigger kind.
+func (k MsgServer) validateTrigger(ctx context.Context, trigger types.Trigger, executions uint64) error {
+	if err := trigger.Validate(); err != nil {
+		return err
+	}
+	if executions == 0 {
+		return errorsmod.Wrap(types.ErrInvalidExecutions, "executions must be positive")
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	switch {
+	case trigger.Height != 0:
+		if trigger.Height <= headerInfo.Height {
+			return errorsmod.Wrapf(types.ErrInvalidTrigger, "height %d must be after current height %d", trigger.Height, headerInfo.Height)
+		}
+	case trigger.Time != nil:
+		if !trigger.Time.After(headerInfo.Time) {
+			return errorsmod.Wrapf(types.ErrInvalidTrigger, "time %s must be after current block time %s", trigger.Time, headerInfo.Time)
+		}
+	case trigger.EpochIdentifier != "":
+		if k.epochsKeeper == nil {
+			return errorsmod.Wrap(types.ErrInvalidTrigger, "epoch triggers are not supported: x/epochs is not available")
+		}
+		if _, err := k.epochsKeeper.GetEpochInfo(ctx, trigger.EpochIdentifier); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidTrigger, "unknown epoch identifier %s", trigger.EpochIdentifier)
+		}
+	}
+
+	if !trigger.IsRecurring() && executions != 1 {
+		return errorsmod.Wrapf(types.ErrInvalidExecutions, "height and time triggers execute exactly once, got %d", executions)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/scheduler/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestSchedule() {
+	past := time.Unix(999, 0).UTC()
+	future := time.Unix(2_000, 0).UTC()
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgSchedule)
+		expErr   string
+	}{
+		{
+			name:     "valid height trigger",
+			malleate: func(msg *types.MsgSchedule) {},
+		},
+		{
+			name: "valid time trigger",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{Time: &future}
+			},
+		},
+		{
+			name: "valid epoch trigger",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{EpochIdentifier: "day"}
+				msg.Executions = 5
+			},
+		},
+		{
+			name: "invalid owner",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Owner = "invalid"
+			},
+			expErr: "invalid owner address",
+		},
+		{
+			name: "no messages",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Messages = nil
+			},
+			expErr: types.ErrNoMessages.Error(),
+		},
+		{
+			name: "owner is not the signer",
+			malleate: func(msg *types.MsgSchedule) {
+				anys, err := sdktx.SetMsgs([]sdk.Msg{testdata.NewTestMsg(otherAddr)})
+				s.Require().NoError(err)
+				msg.Messages = anys
+			},
+			expErr: types.ErrInvalidSigner.Error(),
+		},
+		{
+			name: "multiple signers",
+			malleate: func(msg *types.MsgSchedule) {
+				anys, err := sdktx.SetMsgs([]sdk.Msg{testdata.NewTestMsg(ownerAddr, otherAddr)})
+				s.Require().NoError(err)
+				msg.Messages = anys
+			},
+			expErr: types.ErrInvalidSigner.Error(),
+		},
+		{
+			name: "unroutable message",
+			malleate: func(msg *types.MsgSchedule) {
+				anys, err := sdktx.SetMsgs([]sdk.Msg{&authtypes.MsgUpdateParams{Authority: ownerAddr.String()}})
+				s.Require().NoError(err)
+				msg.Messages = anys
+			},
+			expErr: types.ErrUnroutableMsg.Error(),
+		},
+		{
+			name: "scheduler message",
+			malleate: func(msg *types.MsgSchedule) {
+				anys, err := sdktx.SetMsgs([]sdk.Msg{&types.MsgCancelSchedule{Owner: ownerAddr.String(), Id: 1}})
+				s.Require().NoError(err)
+				msg.Messages = anys
+			},
+			expErr: types.ErrSchedulerMsg.Error(),
+		},
+		{
+			name: "no trigger",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{}
+			},
+			expErr: "exactly one of height, time or epoch identifier must be set",
+		},
+		{
+			name: "height in the past",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{Height: 10}
+			},
+			expErr: "must be after current height",
+		},
+		{
+			name: "time in the past",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{Time: &past}
+			},
+			expErr: "must be after current block time",
+		},
+		{
+			name: "unknown epoch",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{EpochIdentifier: "week"}
+			},
+			expErr: "unknown epoch identifier week",
+		},
+		{
+			name: "multiple executions with height trigger",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Executions = 2
+			},
+			expErr: "height and time triggers execute exactly once",
+		},
+		{
+			name: "zero executions",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Executions = 0
+			},
+			expErr: types.ErrInvalidExecutions.Error(),
+		},
+		{
+			name: "gas limit above maximum",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.GasLimit = types.DefaultMaxGasPerExecution + 1
+			},
+			expErr: types.ErrInvalidGasLimit.Error(),
+		},
+		{
+			name: "insufficient funds for fees",
+			malleate: func(msg *types.MsgSchedule) {
+				msg.Trigger = types.Trigger{EpochIdentifier: "day"}
+				msg.Executions = 101
+			},
+			expErr: "insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			msg := s.newMsgSchedule(types.Trigger{Height: 20}, 1)
+			tc.malleate(msg)
+
+			res, err := s.msgServer.Schedule(s.ctx, msg)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), res.Id)
+
+			schedule, err := s.keeper.Schedules.Get(s.ctx, res.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(msg.Executions, schedule.RemainingExecutions)
+
+			escrow := sdk.NewCoins(sdk.NewInt64Coin("stake", 10*int64(msg.Executions)))
+			s.Require().Equal(escrow, s.bankKeeper.balances[types.ModuleName])
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelSchedule() {
+	id := s.schedule(types.Trigger{EpochIdentifier: "day"}, 3)
+	s.Require().Equal(int64(970), s.bankKeeper.balances[ownerAddr.String()].AmountOf("stake").Int64())
+
+	_, err := s.msgServer.CancelSchedule(s.ctx, &types.MsgCancelSchedule{Owner: otherAddr.String(), Id: id})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelSchedule(s.ctx, &types.MsgCancelSchedule{Owner: ownerAddr.String(), Id: id + 1})
+	s.Require().ErrorContains(err, "not found")
+
+	// execute once, then cancel: only the two remaining executions are refunded
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 1))
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	res, err := s.msgServer.CancelSchedule(s.ctx, &types.MsgCancelSchedule{Owner: ownerAddr.String(), Id: id})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), res.Refund)
+	s.Require().Equal(int64(990), s.bankKeeper.balances[ownerAddr.String()].AmountOf("stake").Int64())
+	s.Require().True(s.bankKeeper.balances[types.ModuleName].IsZero())
+
+	has, err := s.keeper.Schedules.Has(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the execution history is kept
+	has, err = s.keeper.Executions.Has(s.ctx, collections.Join(id, uint64(1)))
+	s.Require().NoError(err)
+	s.Require().True(has)
+}
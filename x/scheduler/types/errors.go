@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/scheduler module sentinel errors
+var (
+	ErrInvalidTrigger    = errors.Register(ModuleName, 2, "invalid trigger")
+	ErrInvalidSigner     = errors.Register(ModuleName, 3, "expected owner to be the only signer of the scheduled messages")
+	ErrUnroutableMsg     = errors.Register(ModuleName, 4, "scheduled message not recognized by router")
+	ErrNoMessages        = errors.Register(ModuleName, 5, "no messages to schedule")
+	ErrInvalidExecutions = errors.Register(ModuleName, 6, "invalid number of executions")
+	ErrInvalidGasLimit   = errors.Register(ModuleName, 7, "invalid gas limit")
+	ErrUnauthorized      = errors.Register(ModuleName, 8, "unauthorized")
+	ErrSchedulerMsg      = errors.Register(ModuleName, 9, "scheduler messages cannot be scheduled")
+)
@@ -0,0 +1,15 @@
+package types
+
+// scheduler module event types
+const (
+	EventTypeSchedule       = "schedule"
+	EventTypeCancelSchedule = "cancel_schedule"
+	EventTypeExecution      = "scheduled_execution"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeySequence   = "sequence"
+	AttributeKeySuccess    = "success"
+	AttributeKeyError      = "error"
+	AttributeKeyRefund     = "refund"
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	epochstypes "cosmossdk.io/x/epochs/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper used by the scheduler module.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to escrow and pay execution fees.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// EpochsKeeper defines the expected epochs keeper used to validate epoch triggers.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx context.Context, identifier string) (epochstypes.EpochInfo, error)
+}
@@ -0,0 +1,76 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state for the scheduler module.
+func NewGenesisState(schedules []Schedule, nextScheduleID uint64, executions []Execution) *GenesisState {
+	return &GenesisState{
+		Schedules:      schedules,
+		NextScheduleId: nextScheduleID,
+		Executions:     executions,
+	}
+}
+
+// DefaultGenesis returns the default scheduler genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil, 1, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]bool, len(gs.Schedules))
+	for _, s := range gs.Schedules {
+		if s.Id == 0 {
+			return fmt.Errorf("schedule id must be positive")
+		}
+		if ids[s.Id] {
+			return fmt.Errorf("duplicate schedule id %d", s.Id)
+		}
+		ids[s.Id] = true
+
+		if s.Id >= gs.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be lower than next schedule id %d", s.Id, gs.NextScheduleId)
+		}
+		if s.Owner == "" {
+			return fmt.Errorf("schedule %d: owner cannot be empty", s.Id)
+		}
+		if len(s.Messages) == 0 {
+			return fmt.Errorf("schedule %d: %w", s.Id, ErrNoMessages)
+		}
+		if err := s.Trigger.Validate(); err != nil {
+			return fmt.Errorf("schedule %d: %w", s.Id, err)
+		}
+		if s.RemainingExecutions == 0 {
+			return fmt.Errorf("schedule %d: %w", s.Id, ErrInvalidExecutions)
+		}
+		if s.GasLimit == 0 {
+			return fmt.Errorf("schedule %d: %w", s.Id, ErrInvalidGasLimit)
+		}
+		if err := s.FeePerExecution.Validate(); err != nil {
+			return fmt.Errorf("schedule %d: %w", s.Id, err)
+		}
+	}
+
+	type execKey struct{ id, seq uint64 }
+	execs := make(map[execKey]bool, len(gs.Executions))
+	for _, e := range gs.Executions {
+		k := execKey{e.ScheduleId, e.Sequence}
+		if execs[k] {
+			return fmt.Errorf("duplicate execution %d for schedule %d", e.Sequence, e.ScheduleId)
+		}
+		execs[k] = true
+	}
+
+	cursors := make(map[string]bool, len(gs.EpochCursors))
+	for _, c := range gs.EpochCursors {
+		if c.EpochIdentifier == "" {
+			return fmt.Errorf("epoch cursor identifier cannot be empty")
+		}
+		if cursors[c.EpochIdentifier] {
+			return fmt.Errorf("duplicate epoch cursor %s", c.EpochIdentifier)
+		}
+		cursors[c.EpochIdentifier] = true
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/scheduler/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	future := time.Unix(100, 0)
+	validSchedule := func() types.Schedule {
+		return types.Schedule{
+			Id:                  1,
+			Owner:               "cosmos1owner",
+			Messages:            []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+			Trigger:             types.Trigger{Height: 10},
+			RemainingExecutions: 1,
+			GasLimit:            100_000,
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		gs     func() *types.GenesisState
+		expErr string
+	}{
+		{
+			name: "default",
+			gs:   types.DefaultGenesis,
+		},
+		{
+			name: "valid",
+			gs: func() *types.GenesisState {
+				return types.NewGenesisState([]types.Schedule{validSchedule()}, 2, []types.Execution{{ScheduleId: 1, Sequence: 1}})
+			},
+		},
+		{
+			name: "id not lower than next id",
+			gs: func() *types.GenesisState {
+				return types.NewGenesisState([]types.Schedule{validSchedule()}, 1, nil)
+			},
+			expErr: "must be lower than next schedule id",
+		},
+		{
+			name: "duplicate id",
+			gs: func() *types.GenesisState {
+				return types.NewGenesisState([]types.Schedule{validSchedule(), validSchedule()}, 2, nil)
+			},
+			expErr: "duplicate schedule id",
+		},
+		{
+			name: "multiple triggers",
+			gs: func() *types.GenesisState {
+				s := validSchedule()
+				s.Trigger.Time = &future
+				return types.NewGenesisState([]types.Schedule{s}, 2, nil)
+			},
+			expErr: "exactly one of height, time or epoch identifier must be set",
+		},
+		{
+			name: "no trigger",
+			gs: func() *types.GenesisState {
+				s := validSchedule()
+				s.Trigger = types.Trigger{}
+				return types.NewGenesisState([]types.Schedule{s}, 2, nil)
+			},
+			expErr: "exactly one of height, time or epoch identifier must be set",
+		},
+		{
+			name: "no executions left",
+			gs: func() *types.GenesisState {
+				s := validSchedule()
+				s.RemainingExecutions = 0
+				return types.NewGenesisState([]types.Schedule{s}, 2, nil)
+			},
+			expErr: types.ErrInvalidExecutions.Error(),
+		},
+		{
+			name: "duplicate execution",
+			gs: func() *types.GenesisState {
+				return types.NewGenesisState(nil, 1, []types.Execution{{ScheduleId: 1, Sequence: 1}, {ScheduleId: 1, Sequence: 1}})
+			},
+			expErr: "duplicate execution",
+		},
+		{
+			name: "duplicate epoch cursor",
+			gs: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				gs.EpochCursors = []types.EpochCursor{{EpochIdentifier: "day"}, {EpochIdentifier: "day", NextScheduleId: 2}}
+				return gs
+			},
+			expErr: "duplicate epoch cursor",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gs().Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
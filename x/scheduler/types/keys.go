@@ -0,0 +1,34 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "scheduler"
+
+	// StoreKey is the store key string for scheduler
+	StoreKey = ModuleName
+
+	// FeeCollectorName duplicates the auth fee collector module account name.
+	// Execution fees are transferred to it on every execution.
+	FeeCollectorName = "fee_collector"
+
+	// DefaultMaxExecutionsPerBlock is the default number of schedules executed in a
+	// single block.
+	DefaultMaxExecutionsPerBlock = 100
+
+	// DefaultMaxGasPerExecution is the default upper bound of gas a schedule may request
+	// for a single execution.
+	DefaultMaxGasPerExecution = 10_000_000
+)
+
+var (
+	NextScheduleIDKey = collections.NewPrefix(0)
+	SchedulesKey      = collections.NewPrefix(1)
+	ByOwnerKey        = collections.NewPrefix(2)
+	HeightQueueKey    = collections.NewPrefix(3)
+	TimeQueueKey      = collections.NewPrefix(4)
+	EpochQueueKey     = collections.NewPrefix(5)
+	ExecutionsKey     = collections.NewPrefix(6)
+	EpochCursorsKey   = collections.NewPrefix(7)
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ sdk.Msg                              = &MsgSchedule{}
+	_ sdk.Msg                              = &MsgCancelSchedule{}
+	_ gogoprotoany.UnpackInterfacesMessage = &MsgSchedule{}
+	_ gogoprotoany.UnpackInterfacesMessage = &Schedule{}
+	_ gogoprotoany.UnpackInterfacesMessage = &GenesisState{}
+)
+
+// GetMsgs unpacks the scheduled messages.
+func (m *MsgSchedule) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "scheduled messages")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgSchedule) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// GetMsgs unpacks the scheduled messages.
+func (s *Schedule) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(s.Messages, "scheduled messages")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s *Schedule) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, s.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs *GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for i := range gs.Schedules {
+		if err := gs.Schedules[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
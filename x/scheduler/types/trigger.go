@@ -0,0 +1,36 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+// Validate checks that exactly one of the trigger fields is set and that it is well formed.
+func (t Trigger) Validate() error {
+	set := 0
+	if t.Height != 0 {
+		if t.Height < 0 {
+			return errors.Wrapf(ErrInvalidTrigger, "negative height %d", t.Height)
+		}
+		set++
+	}
+	if t.Time != nil {
+		set++
+	}
+	if t.EpochIdentifier != "" {
+		if err := epochstypes.ValidateEpochIdentifierString(t.EpochIdentifier); err != nil {
+			return errors.Wrap(ErrInvalidTrigger, err.Error())
+		}
+		set++
+	}
+	if set != 1 {
+		return errors.Wrap(ErrInvalidTrigger, "exactly one of height, time or epoch identifier must be set")
+	}
+	return nil
+}
+
+// IsRecurring returns true if the trigger fires on every tick of an epoch.
+func (t Trigger) IsRecurring() bool {
+	return t.EpochIdentifier != ""
+}
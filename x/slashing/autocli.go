@@ -34,6 +34,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "MissedBlockWindow",
+					Use:       "missed-blocks <validator-conspub/address>",
+					Short:     "Query a validator's missed block bitmap over the signed blocks window",
+					Example:   fmt.Sprintf(`%s query slashing missed-blocks cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "JailHistory",
+					Use:       "jail-history <validator-conspub/address>",
+					Short:     "Query a validator's jail and unjail records",
+					Example:   fmt.Sprintf(`%s query slashing jail-history cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "Uptime",
+					Use:       "uptime <validator-conspub/address> [range-size...]",
+					Short:     "Query a validator's uptime over the most recent blocks of the signed blocks window",
+					Long:      "Query a validator's uptime over each number of most recent blocks given, at most the signed blocks window. The uptime is computed over the whole signed blocks window if none is given.",
+					Example:   fmt.Sprintf(`%s query slashing uptime cosmosvalcons1nrqsld3aw6lh6t082frdqc84uwxn0t958c 100 1000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+						{ProtoField: "range_sizes", Varargs: true},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +60,19 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, history := range data.JailHistory {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(history.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range history.JailRecords {
+			if err := keeper.ValidatorJailHistory.Set(ctx, collections.Join(address, uint64(record.JailHeight)), record); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -75,6 +89,7 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	}
 	signingInfos := make([]types.SigningInfo, 0)
 	missedBlocks := make([]types.ValidatorMissedBlocks, 0)
+	jailHistory := make([]types.ValidatorJailHistory, 0)
 	err = keeper.ValidatorSigningInfo.Walk(ctx, nil, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool, err error) {
 		bechAddr, err := keeper.sk.ConsensusAddressCodec().BytesToString(address)
 		if err != nil {
@@ -95,10 +110,22 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 			MissedBlocks: localMissedBlocks,
 		})
 
+		jailRecords, err := keeper.GetValidatorJailHistory(ctx, address)
+		if err != nil {
+			return true, err
+		}
+
+		if len(jailRecords) > 0 {
+			jailHistory = append(jailHistory, types.ValidatorJailHistory{
+				Address:     bechAddr,
+				JailRecords: jailRecords,
+			})
+		}
+
 		return false, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+	return types.NewGenesisState(params, signingInfos, missedBlocks, jailHistory), nil
 }
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
@@ -80,3 +81,127 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MissedBlockWindow returns the missed block bitmap of the signed blocks window of a specific validator.
+func (k Querier) MissedBlockWindow(ctx context.Context, req *types.QueryMissedBlockWindowRequest) (*types.QueryMissedBlockWindowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap, err := k.GetMissedBlockBitmap(ctx, consAddr, signedBlocksWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	var index int64
+	if height := k.HeaderService.HeaderInfo(ctx).Height; height >= signingInfo.StartHeight {
+		index = (height - signingInfo.StartHeight) % signedBlocksWindow
+	}
+
+	return &types.QueryMissedBlockWindowResponse{
+		SignedBlocksWindow:  signedBlocksWindow,
+		StartHeight:         signingInfo.StartHeight,
+		Index:               index,
+		MissedBlocksCounter: signingInfo.MissedBlocksCounter,
+		MissedBlocksBitmap:  bitmap,
+	}, nil
+}
+
+// JailHistory returns the jail records of a specific validator.
+func (k Querier) JailHistory(ctx context.Context, req *types.QueryJailHistoryRequest) (*types.QueryJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ValidatorJailHistory,
+		req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], record types.JailRecord) (types.JailRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryJailHistoryResponse{JailRecords: records, Pagination: pageRes}, nil
+}
+
+// Uptime returns the uptime of a specific validator over the requested numbers of most recent blocks.
+func (k Querier) Uptime(ctx context.Context, req *types.QueryUptimeRequest) (*types.QueryUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.RangeSizes) > types.MaxUptimeRanges {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d range sizes can be requested, got %d", types.MaxUptimeRanges, len(req.RangeSizes))
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if has, err := k.ValidatorSigningInfo.Has(ctx, consAddr); err != nil {
+		return nil, err
+	} else if !has {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeSizes := req.RangeSizes
+	if len(rangeSizes) == 0 {
+		rangeSizes = []int64{signedBlocksWindow}
+	}
+
+	for _, rangeSize := range rangeSizes {
+		if rangeSize <= 0 || rangeSize > signedBlocksWindow {
+			return nil, status.Errorf(codes.InvalidArgument, "range size must be between 1 and the signed blocks window %d, got %d", signedBlocksWindow, rangeSize)
+		}
+	}
+
+	uptimes, err := k.GetValidatorUptimes(ctx, consAddr, rangeSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUptimeResponse{Uptimes: uptimes}, nil
+}
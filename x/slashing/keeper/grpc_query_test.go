@@ -4,9 +4,15 @@ import (
 	gocontext "context"
 	"time"
 
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	"cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -92,3 +98,68 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCMissedBlockWindowAndUptime() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 20
+	require.NoError(keeper.Params.Set(ctx, params))
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(nil, nil).AnyTimes()
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	// the validator started at height 10 and missed the blocks at heights 25, 31 and 32
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 10, time.Unix(0, 0), false, 3)))
+	for _, height := range []int64{25, 31, 32} {
+		require.NoError(keeper.SetMissedBlockBitmapValue(ctx, consAddr, (height-10)%params.SignedBlocksWindow, true))
+	}
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 34})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
+	slashingtypes.RegisterQueryServer(queryHelper, slashingkeeper.NewQuerier(keeper))
+	queryClient := slashingtypes.NewQueryClient(queryHelper)
+
+	_, err = queryClient.MissedBlockWindow(gocontext.Background(), &slashingtypes.QueryMissedBlockWindowRequest{})
+	require.ErrorContains(err, "invalid request")
+
+	windowResp, err := queryClient.MissedBlockWindow(gocontext.Background(), &slashingtypes.QueryMissedBlockWindowRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(&slashingtypes.QueryMissedBlockWindowResponse{
+		SignedBlocksWindow:  20,
+		StartHeight:         10,
+		Index:               4,
+		MissedBlocksCounter: 3,
+		MissedBlocksBitmap:  []byte{0b0000_0110, 0b1000_0000, 0},
+	}, windowResp)
+
+	uptimeResp, err := queryClient.Uptime(gocontext.Background(), &slashingtypes.QueryUptimeRequest{ConsAddress: consStr, RangeSizes: []int64{1, 5, 10, 20}})
+	require.NoError(err)
+	require.Equal([]slashingtypes.Uptime{
+		{RangeSize: 1, Blocks: 1, MissedBlocks: 0, Uptime: sdkmath.LegacyOneDec()},
+		{RangeSize: 5, Blocks: 5, MissedBlocks: 2, Uptime: sdkmath.LegacyNewDecWithPrec(6, 1)},
+		{RangeSize: 10, Blocks: 10, MissedBlocks: 3, Uptime: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{RangeSize: 20, Blocks: 20, MissedBlocks: 3, Uptime: sdkmath.LegacyNewDecWithPrec(85, 2)},
+	}, uptimeResp.Uptimes)
+
+	// the uptime is computed over the whole window by default
+	uptimeResp, err = queryClient.Uptime(gocontext.Background(), &slashingtypes.QueryUptimeRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Len(uptimeResp.Uptimes, 1)
+	require.Equal(int64(20), uptimeResp.Uptimes[0].RangeSize)
+
+	_, err = queryClient.Uptime(gocontext.Background(), &slashingtypes.QueryUptimeRequest{ConsAddress: consStr, RangeSizes: []int64{21}})
+	require.ErrorContains(err, "range size must be between 1 and the signed blocks window 20")
+
+	// only the blocks since the start height are counted
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 30, time.Unix(0, 0), false, 0)))
+	require.NoError(keeper.DeleteMissedBlockBitmap(ctx, consAddr))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, consAddr, 1, true))
+	uptimeResp, err = queryClient.Uptime(gocontext.Background(), &slashingtypes.QueryUptimeRequest{ConsAddress: consStr, RangeSizes: []int64{10}})
+	require.NoError(err)
+	require.Equal([]slashingtypes.Uptime{
+		{RangeSize: 10, Blocks: 5, MissedBlocks: 1, Uptime: sdkmath.LegacyNewDecWithPrec(8, 1)},
+	}, uptimeResp.Uptimes)
+}
@@ -175,6 +175,9 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
+			if err := k.recordJail(ctx, consAddr, types.JailReason_JAIL_REASON_DOWNTIME); err != nil {
+				return err
+			}
 			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
 			if err != nil {
 				return err
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordJail adds a jail record at the current height to the validator's jail
+// history, pruning its oldest records beyond MaxJailRecords.
+func (k Keeper) recordJail(ctx context.Context, consAddr sdk.ConsAddress, reason types.JailReason) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	record := types.JailRecord{
+		JailHeight: headerInfo.Height,
+		JailTime:   headerInfo.Time,
+		Reason:     reason,
+	}
+	if err := k.ValidatorJailHistory.Set(ctx, collections.Join(consAddr.Bytes(), uint64(record.JailHeight)), record); err != nil {
+		return err
+	}
+
+	// walk the records from the most recent one and remove the ones beyond the limit
+	var (
+		count  int
+		pruned []collections.Pair[[]byte, uint64]
+	)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](consAddr.Bytes()).Descending()
+	err := k.ValidatorJailHistory.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], _ types.JailRecord) (bool, error) {
+		count++
+		if count > types.MaxJailRecords {
+			pruned = append(pruned, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pruned {
+		if err := k.ValidatorJailHistory.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordUnjail sets the unjail height and time of the validator's most recent
+// jail record, if the validator wasn't already unjailed.
+func (k Keeper) recordUnjail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](consAddr.Bytes()).Descending()
+	iter, err := k.ValidatorJailHistory.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		// the validator was jailed before jail records were kept
+		return nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return err
+	}
+
+	record := kv.Value
+	if record.UnjailHeight != 0 {
+		return nil
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	record.UnjailHeight = headerInfo.Height
+	record.UnjailTime = &headerInfo.Time
+	return k.ValidatorJailHistory.Set(ctx, kv.Key, record)
+}
+
+// GetValidatorJailHistory returns the jail records of a validator ordered by jail height.
+func (k Keeper) GetValidatorJailHistory(ctx context.Context, consAddr sdk.ConsAddress) ([]types.JailRecord, error) {
+	var records []types.JailRecord
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](consAddr.Bytes())
+	err := k.ValidatorJailHistory.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64], record types.JailRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return records, err
+}
+
+// migrateJailHistory moves the jail records of a validator from its old
+// consensus address to its new one after a consensus key rotation.
+func (k Keeper) migrateJailHistory(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	records, err := k.GetValidatorJailHistory(ctx, oldAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := k.ValidatorJailHistory.Set(ctx, collections.Join(newAddr.Bytes(), uint64(record.JailHeight)), record); err != nil {
+			return err
+		}
+	}
+
+	return k.ValidatorJailHistory.Clear(ctx, collections.NewPrefixedPairRange[[]byte, uint64](oldAddr.Bytes()))
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestJailHistory() {
+	keeper := s.slashingKeeper
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	require.NoError(keeper.ValidatorSigningInfo.Set(s.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 1, time.Unix(0, 0), false, 0)))
+
+	// jail the validator for double signing at height 10
+	jailTime := time.Unix(100, 0).UTC()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: jailTime})
+	s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+	require.NoError(keeper.JailWithInfractionReason(ctx, consAddr, st.Infraction_INFRACTION_DOUBLE_SIGN))
+
+	// unjail the validator at height 20
+	unjailTime := time.Unix(200, 0).UTC()
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 20, Time: unjailTime})
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Tokens = sdkmath.NewInt(1000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1)
+	val.Jailed = true
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Delegation(ctx, sdk.AccAddress(addr), valAddr).Return(types.NewDelegation(valStr, valStr, sdkmath.LegacyNewDec(100)), nil)
+	s.stakingKeeper.EXPECT().Unjail(ctx, consAddr).Return(nil)
+	require.NoError(keeper.Unjail(ctx, valAddr))
+
+	// jail the validator again without a reason at height 30
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 30, Time: unjailTime})
+	s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+	require.NoError(keeper.Jail(ctx, consAddr))
+
+	expected := []slashingtypes.JailRecord{
+		{
+			JailHeight:   10,
+			JailTime:     jailTime,
+			Reason:       slashingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN,
+			UnjailHeight: 20,
+			UnjailTime:   &unjailTime,
+		},
+		{
+			JailHeight: 30,
+			JailTime:   unjailTime,
+			Reason:     slashingtypes.JailReason_JAIL_REASON_UNSPECIFIED,
+		},
+	}
+	records, err := keeper.GetValidatorJailHistory(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(expected, records)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
+	slashingtypes.RegisterQueryServer(queryHelper, slashingkeeper.NewQuerier(keeper))
+	queryClient := slashingtypes.NewQueryClient(queryHelper)
+
+	_, err = queryClient.JailHistory(gocontext.Background(), &slashingtypes.QueryJailHistoryRequest{})
+	require.ErrorContains(err, "invalid request")
+
+	res, err := queryClient.JailHistory(gocontext.Background(), &slashingtypes.QueryJailHistoryRequest{
+		ConsAddress: consStr,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(expected[:1], res.JailRecords)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.JailHistory(gocontext.Background(), &slashingtypes.QueryJailHistoryRequest{
+		ConsAddress: consStr,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal(expected[1:], res.JailRecords)
+
+	// the jail history is exported and imported with the genesis
+	genesisState, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal([]slashingtypes.ValidatorJailHistory{{Address: consStr, JailRecords: expected}}, genesisState.JailHistory)
+
+	s.SetupTest()
+	s.stakingKeeper.EXPECT().IterateValidators(s.ctx, gomock.Any()).Return(nil)
+	require.NoError(s.slashingKeeper.InitGenesis(s.ctx, s.stakingKeeper, genesisState))
+	records, err = s.slashingKeeper.GetValidatorJailHistory(s.ctx, consAddr)
+	require.NoError(err)
+	require.Equal(expected, records)
+}
+
+func (s *KeeperTestSuite) TestJailHistoryPruning() {
+	keeper := s.slashingKeeper
+	require := s.Require()
+
+	for height := int64(1); height <= slashingtypes.MaxJailRecords+5; height++ {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height})
+		s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+		require.NoError(keeper.JailWithInfractionReason(ctx, consAddr, st.Infraction_INFRACTION_DOWNTIME))
+	}
+
+	records, err := keeper.GetValidatorJailHistory(s.ctx, consAddr)
+	require.NoError(err)
+	require.Len(records, slashingtypes.MaxJailRecords)
+	require.Equal(int64(6), records[0].JailHeight)
+	require.Equal(int64(slashingtypes.MaxJailRecords+5), records[len(records)-1].JailHeight)
+	require.Equal(slashingtypes.JailReason_JAIL_REASON_DOWNTIME, records[0].Reason)
+}
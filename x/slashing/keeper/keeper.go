@@ -36,6 +36,8 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// ValidatorJailHistory key: ConsAddr | jail height | value: JailRecord
+	ValidatorJailHistory collections.Map[collections.Pair[[]byte, uint64], types.JailRecord]
 }
 
 // NewKeeper creates a slashing keeper
@@ -69,6 +71,13 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		ValidatorJailHistory: collections.NewMap(
+			sb,
+			types.ValidatorJailHistoryKeyPrefix,
+			"validator_jail_history",
+			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
+			codec.CollValue[types.JailRecord](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -126,12 +135,31 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
-// to make the necessary validator changes.
+// to make the necessary validator changes. It specifies no infraction reason.
 func (k Keeper) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return k.JailWithInfractionReason(ctx, consAddr, st.Infraction_INFRACTION_UNSPECIFIED)
+}
+
+// JailWithInfractionReason attempts to jail a validator. The jail is delegated to the
+// staking module to make the necessary validator changes. It specifies an infraction
+// reason, which is recorded in the validator's jail history.
+func (k Keeper) JailWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infraction st.Infraction) error {
 	err := k.sk.Jail(ctx, consAddr)
 	if err != nil {
 		return err
 	}
+
+	reason := types.JailReason_JAIL_REASON_UNSPECIFIED
+	switch infraction {
+	case st.Infraction_INFRACTION_DOUBLE_SIGN:
+		reason = types.JailReason_JAIL_REASON_DOUBLE_SIGN
+	case st.Infraction_INFRACTION_DOWNTIME:
+		reason = types.JailReason_JAIL_REASON_DOWNTIME
+	}
+	if err := k.recordJail(ctx, consAddr, reason); err != nil {
+		return err
+	}
+
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
 	if err != nil {
 		return err
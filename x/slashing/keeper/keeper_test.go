@@ -40,6 +40,7 @@ type KeeperTestSuite struct {
 	queryClient    slashingtypes.QueryClient
 	msgServer      slashingtypes.MsgServer
 	key            *storetypes.KVStoreKey
+	encCfg         moduletestutil.TestEncodingConfig
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -61,6 +62,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	s.ctx = ctx
+	s.encCfg = encCfg
 	s.slashingKeeper = slashingkeeper.NewKeeper(
 		env,
 		encCfg.Codec,
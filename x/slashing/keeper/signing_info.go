@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -236,6 +237,86 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 	return missedBlocks, err
 }
 
+// GetMissedBlockBitmap returns a validator's missed block bitmap over the
+// signed blocks window, where the bit i % 8 of the byte i / 8 is set if the
+// validator missed the block at index i.
+func (k Keeper) GetMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddress, signedBlocksWindow int64) ([]byte, error) {
+	// get the old consensus key if it has rotated, allowing retrieval of missed blocks associated with the old key
+	addr, err := k.getPreviousConsKey(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := make([]byte, (signedBlocksWindow+7)/8)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](addr.Bytes())
+	err = k.ValidatorMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], value []byte) (bool, error) {
+		bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+		if err := bs.UnmarshalBinary(value); err != nil {
+			return true, errorsmod.Wrapf(err, "failed to decode bitmap chunk; index: %v", key)
+		}
+
+		offset := int64(key.K2()) * types.MissedBlockBitmapChunkSize
+		for i, ok := bs.NextSet(0); ok; i, ok = bs.NextSet(i + 1) {
+			index := offset + int64(i)
+			if index >= signedBlocksWindow {
+				break
+			}
+			bitmap[index/8] |= 1 << (index % 8)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bitmap, nil
+}
+
+// GetValidatorUptimes returns the uptimes of a validator over each number of
+// most recent blocks of its signed blocks window, up to the current block. Only
+// the blocks since the validator's start height are counted.
+func (k Keeper) GetValidatorUptimes(ctx context.Context, addr sdk.ConsAddress, rangeSizes []int64) ([]types.Uptime, error) {
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap, err := k.GetMissedBlockBitmap(ctx, addr, signedBlocksWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	uptimes := make([]types.Uptime, 0, len(rangeSizes))
+	for _, rangeSize := range rangeSizes {
+		if rangeSize <= 0 || rangeSize > signedBlocksWindow {
+			return nil, fmt.Errorf("range size must be between 1 and the signed blocks window %d, got %d", signedBlocksWindow, rangeSize)
+		}
+
+		uptime := types.Uptime{RangeSize: rangeSize, Uptime: sdkmath.LegacyZeroDec()}
+		if height >= signInfo.StartHeight {
+			// walk the window backwards from the index of the current block
+			uptime.Blocks = min(rangeSize, height-signInfo.StartHeight+1)
+			index := (height - signInfo.StartHeight) % signedBlocksWindow
+			for i := int64(0); i < uptime.Blocks; i++ {
+				if bitmap[index/8]&(1<<(index%8)) != 0 {
+					uptime.MissedBlocks++
+				}
+				index = (index - 1 + signedBlocksWindow) % signedBlocksWindow
+			}
+			uptime.Uptime = sdkmath.LegacyNewDec(uptime.Blocks - uptime.MissedBlocks).QuoInt64(uptime.Blocks)
+		}
+		uptimes = append(uptimes, uptime)
+	}
+
+	return uptimes, nil
+}
+
 // performConsensusPubKeyUpdate updates the consensus address-pubkey relation
 // and refreshes the signing info by replacing the old key with the new one.
 func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
@@ -260,5 +341,10 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
+	// Migrate the jail history from oldPubKey to newPubKey
+	if err := k.migrateJailHistory(ctx, sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())); err != nil {
+		return err
+	}
+
 	return k.ValidatorSigningInfo.Remove(ctx, sdk.ConsAddress(oldPubKey.Address()))
 }
@@ -67,5 +67,9 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 		}
 	}
 
-	return k.sk.Unjail(ctx, consAddr)
+	if err := k.sk.Unjail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	return k.recordUnjail(ctx, consAddr)
 }
@@ -9,12 +9,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, jailHistory []ValidatorJailHistory,
 ) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		JailHistory:  jailHistory,
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		JailHistory:  []ValidatorJailHistory{},
 	}
 }
 
@@ -62,5 +64,18 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, history := range data.JailHistory {
+		var lastHeight int64
+		for i, record := range history.JailRecords {
+			if i > 0 && record.JailHeight <= lastHeight {
+				return fmt.Errorf("jail records of validator %s must be sorted by unique jail height", history.Address)
+			}
+			if record.UnjailHeight != 0 && record.UnjailHeight < record.JailHeight {
+				return fmt.Errorf("jail record of validator %s at height %d has an unjail height lower than its jail height", history.Address, record.JailHeight)
+			}
+			lastHeight = record.JailHeight
+		}
+	}
+
 	return nil
 }
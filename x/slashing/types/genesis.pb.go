@@ -35,6 +35,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// jail_history represents a map between validator addresses and their
+	// jail records.
+	JailHistory []ValidatorJailHistory `protobuf:"bytes,4,rep,name=jail_history,json=jailHistory,proto3" json:"jail_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +94,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetJailHistory() []ValidatorJailHistory {
+	if m != nil {
+		return m.JailHistory
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -257,11 +267,67 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorJailHistory contains the jail records of corresponding address.
+type ValidatorJailHistory struct {
+	// address is the validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// jail_records are the jail records of the validator, ordered by jail height.
+	JailRecords []JailRecord `protobuf:"bytes,2,rep,name=jail_records,json=jailRecords,proto3" json:"jail_records"`
+}
+
+func (m *ValidatorJailHistory) Reset()         { *m = ValidatorJailHistory{} }
+func (m *ValidatorJailHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailHistory) ProtoMessage()    {}
+func (*ValidatorJailHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorJailHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJailHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailHistory.Merge(m, src)
+}
+func (m *ValidatorJailHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailHistory proto.InternalMessageInfo
+
+func (m *ValidatorJailHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorJailHistory) GetJailRecords() []JailRecord {
+	if m != nil {
+		return m.JailRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorJailHistory)(nil), "cosmos.slashing.v1beta1.ValidatorJailHistory")
 }
 
 func init() {
@@ -269,34 +335,39 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x7b, 0x10, 0xd1, 0xff, 0x15, 0x06, 0x2f, 0x88, 0x95, 0xc4, 0x82, 0x44, 0x0d, 0x31,
-	0xa1, 0x0d, 0x38, 0x38, 0x30, 0x59, 0x07, 0xe3, 0x60, 0x62, 0x0a, 0x71, 0x70, 0xb0, 0x39, 0xe8,
-	0x51, 0x2f, 0xd0, 0x3b, 0xd2, 0xb7, 0x12, 0xfc, 0x06, 0x8e, 0x7e, 0x0c, 0xe3, 0xe4, 0xe0, 0xe4,
-	0x27, 0x60, 0x24, 0x4e, 0x4e, 0xc6, 0xc0, 0xe0, 0xd7, 0x30, 0xde, 0x15, 0xa9, 0x86, 0x86, 0xe1,
-	0xbf, 0x34, 0xbd, 0xbb, 0xdf, 0xf3, 0xbc, 0xef, 0xfb, 0xb4, 0x87, 0xef, 0x4d, 0x25, 0xc4, 0x12,
-	0x5c, 0x58, 0x50, 0x78, 0xc3, 0x45, 0xe4, 0xae, 0xfa, 0x13, 0x96, 0xd2, 0xbe, 0x1b, 0x31, 0xc1,
-	0x80, 0x83, 0xb3, 0x4c, 0x64, 0x2a, 0xc9, 0x4d, 0x8d, 0x39, 0x07, 0xcc, 0xc9, 0xb0, 0x66, 0x3d,
-	0x92, 0x91, 0x54, 0x8c, 0xfb, 0xe7, 0x4d, 0xe3, 0xcd, 0xfb, 0x45, 0xae, 0x7f, 0xf5, 0x9a, 0xbb,
-	0xa5, 0xb9, 0x40, 0x1b, 0x64, 0x35, 0xf4, 0xd1, 0x75, 0x1a, 0x73, 0x21, 0x5d, 0xf5, 0xd4, 0x5b,
-	0x9d, 0xf7, 0x25, 0x5c, 0x7d, 0xaa, 0xdb, 0x1a, 0xa5, 0x34, 0x65, 0xc4, 0xc3, 0x95, 0x25, 0x4d,
-	0x68, 0x0c, 0x16, 0x6a, 0xa3, 0xae, 0x39, 0x68, 0x39, 0x05, 0x6d, 0x3a, 0x2f, 0x14, 0xe6, 0x5d,
-	0x6c, 0x7e, 0xb4, 0x8c, 0x8f, 0xbf, 0x3e, 0x3f, 0x40, 0x7e, 0xa6, 0x24, 0x63, 0x5c, 0x03, 0x1e,
-	0x09, 0x2e, 0xa2, 0x80, 0x8b, 0x99, 0x04, 0xab, 0xd4, 0x2e, 0x77, 0xcd, 0xc1, 0xdd, 0x42, 0xab,
-	0x91, 0xa6, 0x9f, 0x89, 0x99, 0xcc, 0xfb, 0x55, 0xe1, 0xb8, 0x0f, 0xe4, 0x35, 0xae, 0xc5, 0x1c,
-	0x80, 0x85, 0xc1, 0x64, 0x21, 0xa7, 0x73, 0xb0, 0xca, 0xca, 0xd5, 0x29, 0x74, 0x7d, 0x49, 0x17,
-	0x3c, 0xa4, 0xa9, 0x4c, 0x9e, 0x2b, 0x99, 0xa7, 0x54, 0xff, 0xf8, 0xc7, 0xb9, 0x83, 0xce, 0x57,
-	0x84, 0xcd, 0x5c, 0x23, 0x64, 0x88, 0xaf, 0xd2, 0x30, 0x4c, 0x18, 0xe8, 0x28, 0x2e, 0xbc, 0x3b,
-	0xdf, 0xbe, 0xf4, 0x6e, 0x67, 0xc5, 0x9e, 0x48, 0x01, 0x4c, 0xc0, 0x5b, 0x78, 0xac, 0x91, 0x51,
-	0x9a, 0x70, 0x11, 0xf9, 0x07, 0x05, 0x11, 0xb8, 0xb1, 0x3a, 0x94, 0x0f, 0xf2, 0x61, 0x58, 0x25,
-	0x15, 0x6b, 0xef, 0x7c, 0xd7, 0x05, 0xa1, 0xd4, 0x57, 0x27, 0x80, 0xce, 0x27, 0x84, 0x6f, 0x9c,
-	0x9c, 0xf7, 0x72, 0x63, 0x8c, 0xff, 0xcf, 0xfc, 0xdc, 0x97, 0xcc, 0x95, 0x2e, 0x4e, 0x7a, 0x88,
-	0xcd, 0x1c, 0x47, 0xea, 0xf8, 0x0a, 0x17, 0x21, 0x5b, 0xab, 0xfe, 0xca, 0xbe, 0x5e, 0x90, 0x06,
-	0xae, 0x68, 0x91, 0x4a, 0xec, 0x9a, 0x9f, 0xad, 0xbc, 0x47, 0x9b, 0x9d, 0x8d, 0xb6, 0x3b, 0x1b,
-	0xfd, 0xdc, 0xd9, 0xe8, 0xc3, 0xde, 0x36, 0xb6, 0x7b, 0xdb, 0xf8, 0xbe, 0xb7, 0x8d, 0x57, 0xd9,
-	0x50, 0x10, 0xce, 0x1d, 0x2e, 0xdd, 0xf5, 0xf1, 0xa6, 0xa4, 0xef, 0x96, 0x0c, 0x26, 0x15, 0xf5,
-	0xc7, 0x3f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xca, 0xae, 0x5a, 0x9f, 0x03, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x16, 0x0a, 0x73, 0xba, 0x03, 0x56, 0x19, 0x61, 0x12, 0x59, 0x09, 0x2f, 0x9a,
+	0x90, 0x96, 0x68, 0xe3, 0xc0, 0x61, 0x27, 0xc2, 0x81, 0x17, 0x09, 0x09, 0xd2, 0x89, 0x03, 0x48,
+	0x44, 0x6e, 0xe3, 0x65, 0xde, 0x12, 0xbb, 0xca, 0x63, 0xaa, 0xed, 0x5b, 0xf0, 0x29, 0x10, 0xe2,
+	0xc4, 0x81, 0x13, 0x9f, 0x60, 0xc7, 0x89, 0x13, 0x27, 0x84, 0x5a, 0x21, 0xbe, 0x06, 0x9a, 0x9d,
+	0xd0, 0x00, 0x8d, 0x86, 0xd4, 0x4b, 0x14, 0x3f, 0xfe, 0xfd, 0xff, 0xcf, 0x8b, 0x9d, 0xe0, 0x5b,
+	0x43, 0x09, 0x99, 0x04, 0x1f, 0x52, 0x0a, 0x7b, 0x5c, 0x24, 0xfe, 0x78, 0x73, 0xc0, 0x14, 0xdd,
+	0xf4, 0x13, 0x26, 0x18, 0x70, 0xf0, 0x46, 0xb9, 0x54, 0x92, 0x5c, 0x31, 0x98, 0x57, 0x62, 0x5e,
+	0x81, 0xad, 0x76, 0x13, 0x99, 0x48, 0xcd, 0xf8, 0xa7, 0x6f, 0x06, 0x5f, 0xbd, 0x5d, 0xe7, 0xfa,
+	0x5b, 0x6f, 0xb8, 0xab, 0x86, 0x8b, 0x8c, 0x41, 0x91, 0xc3, 0x6c, 0x5d, 0xa2, 0x19, 0x17, 0xd2,
+	0xd7, 0x4f, 0x13, 0x72, 0x7f, 0x34, 0x71, 0xe7, 0xa1, 0x29, 0xab, 0xaf, 0xa8, 0x62, 0x24, 0xc0,
+	0xed, 0x11, 0xcd, 0x69, 0x06, 0x36, 0xea, 0xa1, 0x75, 0x6b, 0x6b, 0xcd, 0xab, 0x29, 0xd3, 0x7b,
+	0xa6, 0xb1, 0x60, 0xe9, 0xf8, 0xdb, 0x5a, 0xe3, 0xfd, 0xcf, 0x8f, 0x77, 0x50, 0x58, 0x28, 0xc9,
+	0x0e, 0x5e, 0x06, 0x9e, 0x08, 0x2e, 0x92, 0x88, 0x8b, 0x5d, 0x09, 0x76, 0xb3, 0xd7, 0x5a, 0xb7,
+	0xb6, 0x6e, 0xd6, 0x5a, 0xf5, 0x0d, 0xfd, 0x58, 0xec, 0xca, 0xaa, 0x5f, 0x07, 0x66, 0x71, 0x20,
+	0xaf, 0xf1, 0x72, 0xc6, 0x01, 0x58, 0x1c, 0x0d, 0x52, 0x39, 0x3c, 0x00, 0xbb, 0xa5, 0x5d, 0xbd,
+	0x5a, 0xd7, 0x17, 0x34, 0xe5, 0x31, 0x55, 0x32, 0x7f, 0xaa, 0x65, 0x81, 0x56, 0xfd, 0xe1, 0x9f,
+	0x55, 0x36, 0xc8, 0x2b, 0xdc, 0xd9, 0xa7, 0x3c, 0x8d, 0xf6, 0x38, 0x28, 0x99, 0x1f, 0xd9, 0xe7,
+	0xb4, 0xfd, 0xc6, 0xd9, 0xf6, 0x4f, 0x28, 0x4f, 0x1f, 0x19, 0x51, 0xd5, 0xdd, 0xda, 0x9f, 0xc5,
+	0xdd, 0xcf, 0x08, 0x5b, 0x95, 0x2e, 0xc9, 0x36, 0xbe, 0x40, 0xe3, 0x38, 0x67, 0x60, 0xe6, 0xbc,
+	0x14, 0x5c, 0xff, 0xf2, 0x69, 0xe3, 0x5a, 0x91, 0xea, 0x81, 0x14, 0xc0, 0x04, 0xbc, 0x81, 0xfb,
+	0x06, 0xe9, 0xab, 0x9c, 0x8b, 0x24, 0x2c, 0x15, 0x44, 0xe0, 0x95, 0x71, 0x99, 0x3c, 0xaa, 0x4e,
+	0xda, 0x6e, 0xf6, 0xd0, 0xff, 0xd5, 0x5c, 0x33, 0xf1, 0xee, 0x78, 0x0e, 0xe0, 0x7e, 0x40, 0xf8,
+	0xf2, 0xdc, 0x61, 0x2e, 0xd6, 0xc6, 0xce, 0xdf, 0x07, 0x7a, 0xd6, 0x35, 0xa9, 0xa4, 0xae, 0x3d,
+	0x46, 0x77, 0x1b, 0x5b, 0x15, 0x8e, 0x74, 0xf1, 0x79, 0x2e, 0x62, 0x76, 0xa8, 0xeb, 0x6b, 0x85,
+	0x66, 0x41, 0x56, 0x70, 0xdb, 0x88, 0xf4, 0xc4, 0x2e, 0x86, 0xc5, 0xca, 0x7d, 0x87, 0x70, 0x77,
+	0xde, 0xb9, 0x2e, 0xd6, 0xe8, 0xf3, 0xe2, 0x66, 0xe5, 0x6c, 0x28, 0xf3, 0xb8, 0xec, 0xf3, 0x46,
+	0x6d, 0x9f, 0xa7, 0x89, 0x43, 0xcd, 0xfe, 0x73, 0x9f, 0x4c, 0x18, 0x82, 0x7b, 0xc7, 0x13, 0x07,
+	0x9d, 0x4c, 0x1c, 0xf4, 0x7d, 0xe2, 0xa0, 0xb7, 0x53, 0xa7, 0x71, 0x32, 0x75, 0x1a, 0x5f, 0xa7,
+	0x4e, 0xe3, 0x65, 0x51, 0x14, 0xc4, 0x07, 0x1e, 0x97, 0xfe, 0xe1, 0xec, 0x7f, 0xa1, 0x8e, 0x46,
+	0x0c, 0x06, 0x6d, 0xfd, 0xdd, 0xdf, 0xfd, 0x35, 0x00, 0xb2, 0x18, 0x09, 0x62, 0xa5, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -319,6 +390,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JailHistory) > 0 {
+		for iNdEx := len(m.JailHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -482,6 +567,50 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorJailHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JailRecords) > 0 {
+		for iNdEx := len(m.JailRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -513,6 +642,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.JailHistory) > 0 {
+		for _, e := range m.JailHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -565,6 +700,25 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorJailHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.JailRecords) > 0 {
+		for _, e := range m.JailRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -701,6 +855,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailHistory = append(m.JailHistory, ValidatorJailHistory{})
+			if err := m.JailHistory[len(m.JailHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1042,6 +1230,122 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorJailHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorJailHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorJailHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailRecords = append(m.JailRecords, JailRecord{})
+			if err := m.JailRecords[len(m.JailRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,6 +36,14 @@ const (
 	// (N - 256) + (N / ChunkSize) * (512 * f)
 	MissedBlockBitmapChunkSize = 1024 // 2^10 bits
 
+	// MaxJailRecords defines the maximum number of jail records kept per
+	// validator, the oldest records are pruned when a validator is jailed.
+	MaxJailRecords = 100
+
+	// MaxUptimeRanges defines the maximum number of ranges of a Query/Uptime
+	// request.
+	MaxUptimeRanges = 10
+
 	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
@@ -50,12 +58,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><jail_height>: JailRecord
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	ValidatorJailHistoryKeyPrefix       = collections.NewPrefix(4) // Prefix for validator jail history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
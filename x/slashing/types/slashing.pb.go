@@ -31,6 +31,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// JailReason defines the reason a validator was jailed by the slashing module.
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines a validator jailed for an unspecified reason.
+	JailReason_JAIL_REASON_UNSPECIFIED JailReason = 0
+	// DOUBLE_SIGN defines a validator jailed for double-signing a block.
+	JailReason_JAIL_REASON_DOUBLE_SIGN JailReason = 1
+	// DOWNTIME defines a validator jailed for missing signing too many blocks.
+	JailReason_JAIL_REASON_DOWNTIME JailReason = 2
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_DOUBLE_SIGN",
+	2: "JAIL_REASON_DOWNTIME",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED": 0,
+	"JAIL_REASON_DOUBLE_SIGN": 1,
+	"JAIL_REASON_DOWNTIME":    2,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -128,6 +160,91 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// JailRecord records a validator being jailed by the slashing module and,
+// once it happened, unjailed.
+type JailRecord struct {
+	// jail_height is the height at which the validator was jailed.
+	JailHeight int64 `protobuf:"varint,1,opt,name=jail_height,json=jailHeight,proto3" json:"jail_height,omitempty"`
+	// jail_time is the block time at which the validator was jailed.
+	JailTime time.Time `protobuf:"bytes,2,opt,name=jail_time,json=jailTime,proto3,stdtime" json:"jail_time"`
+	// reason is the reason the validator was jailed.
+	Reason JailReason `protobuf:"varint,3,opt,name=reason,proto3,enum=cosmos.slashing.v1beta1.JailReason" json:"reason,omitempty"`
+	// unjail_height is the height at which the validator was unjailed, zero while
+	// the validator is still jailed.
+	UnjailHeight int64 `protobuf:"varint,4,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+	// unjail_time is the block time at which the validator was unjailed, unset
+	// while the validator is still jailed.
+	UnjailTime *time.Time `protobuf:"bytes,5,opt,name=unjail_time,json=unjailTime,proto3,stdtime" json:"unjail_time,omitempty"`
+}
+
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+func (m *JailRecord) GetJailHeight() int64 {
+	if m != nil {
+		return m.JailHeight
+	}
+	return 0
+}
+
+func (m *JailRecord) GetJailTime() time.Time {
+	if m != nil {
+		return m.JailTime
+	}
+	return time.Time{}
+}
+
+func (m *JailRecord) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReason_JAIL_REASON_UNSPECIFIED
+}
+
+func (m *JailRecord) GetUnjailHeight() int64 {
+	if m != nil {
+		return m.UnjailHeight
+	}
+	return 0
+}
+
+func (m *JailRecord) GetUnjailTime() *time.Time {
+	if m != nil {
+		return m.UnjailTime
+	}
+	return nil
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -141,7 +258,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +302,9 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.JailReason", JailReason_name, JailReason_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*JailRecord)(nil), "cosmos.slashing.v1beta1.JailRecord")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -194,47 +313,57 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x8f, 0xdb, 0x44,
+	0x1c, 0x8d, 0xd3, 0x74, 0x69, 0x27, 0x29, 0x5a, 0x86, 0x94, 0xb8, 0x29, 0x75, 0xb2, 0x5b, 0x81,
+	0xa2, 0x95, 0xd6, 0xa6, 0x41, 0xe2, 0xd0, 0x3d, 0x25, 0x9b, 0x2c, 0xa4, 0x0a, 0xd9, 0x95, 0xd3,
+	0xa5, 0x12, 0x07, 0xcc, 0xc4, 0x9e, 0x38, 0xc3, 0xda, 0x33, 0x91, 0x67, 0x4c, 0xda, 0xaf, 0x80,
+	0x84, 0xd4, 0x23, 0xe2, 0xc4, 0xb1, 0xc7, 0x1e, 0xfa, 0x15, 0x90, 0x7a, 0xac, 0x7a, 0x42, 0x1c,
+	0x0a, 0xca, 0x1e, 0xca, 0xc7, 0x40, 0x33, 0x63, 0x67, 0xd3, 0x5d, 0x21, 0x04, 0xbd, 0x44, 0xc9,
+	0x7b, 0xef, 0xf7, 0xe7, 0xbd, 0x9f, 0x1d, 0xf0, 0xb1, 0xcf, 0x78, 0xcc, 0xb8, 0xc3, 0x23, 0xc4,
+	0x67, 0x84, 0x86, 0xce, 0xf7, 0x77, 0x26, 0x58, 0xa0, 0x3b, 0x2b, 0xc0, 0x9e, 0x27, 0x4c, 0x30,
+	0x58, 0xd3, 0x3a, 0x7b, 0x05, 0x67, 0xba, 0x7a, 0x35, 0x64, 0x21, 0x53, 0x1a, 0x47, 0x7e, 0xd3,
+	0xf2, 0xba, 0x15, 0x32, 0x16, 0x46, 0xd8, 0x51, 0xbf, 0x26, 0xe9, 0xd4, 0x09, 0xd2, 0x04, 0x09,
+	0xc2, 0x68, 0xc6, 0x37, 0xce, 0xf3, 0x82, 0xc4, 0x98, 0x0b, 0x14, 0xcf, 0x33, 0xc1, 0x0d, 0x3d,
+	0xcf, 0xd3, 0x9d, 0xb3, 0xe1, 0x9a, 0x7a, 0x0f, 0xc5, 0x84, 0x32, 0x47, 0x7d, 0x6a, 0x68, 0xfb,
+	0xd7, 0x22, 0xa8, 0x7e, 0x85, 0x22, 0x12, 0x20, 0xc1, 0x92, 0x31, 0x09, 0x29, 0xa1, 0xe1, 0x80,
+	0x4e, 0x19, 0xdc, 0x03, 0xef, 0xa0, 0x20, 0x48, 0x30, 0xe7, 0xa6, 0xd1, 0x34, 0x5a, 0x57, 0xbb,
+	0x5b, 0x2f, 0x9f, 0xed, 0xde, 0xca, 0xda, 0xed, 0x33, 0xca, 0x31, 0xe5, 0x29, 0xef, 0x68, 0xc9,
+	0x58, 0x24, 0x84, 0x86, 0x6e, 0x5e, 0x01, 0xb7, 0x40, 0x85, 0x0b, 0x94, 0x08, 0x6f, 0x86, 0x49,
+	0x38, 0x13, 0x66, 0xb1, 0x69, 0xb4, 0x2e, 0xb9, 0x65, 0x85, 0x7d, 0xa1, 0x20, 0xf8, 0x11, 0xa8,
+	0x10, 0x1a, 0xe0, 0x87, 0x1e, 0x9b, 0x4e, 0x39, 0x16, 0xe6, 0x25, 0x29, 0xe9, 0x16, 0x4d, 0xc3,
+	0x2d, 0x2b, 0xfc, 0x50, 0xc1, 0x70, 0x08, 0x2a, 0xdf, 0x21, 0x12, 0xe1, 0xc0, 0x4b, 0xa9, 0x20,
+	0x91, 0x59, 0x6a, 0x1a, 0xad, 0x72, 0xbb, 0x6e, 0xeb, 0x14, 0xec, 0x3c, 0x05, 0xfb, 0x7e, 0x9e,
+	0x42, 0xf7, 0xda, 0xf3, 0x57, 0x8d, 0xc2, 0xe3, 0x3f, 0x1a, 0xc6, 0x93, 0xd7, 0x4f, 0x77, 0x0c,
+	0xb7, 0xac, 0xcb, 0x8f, 0x65, 0x35, 0xb4, 0x00, 0x10, 0x2c, 0x9e, 0x70, 0xc1, 0x28, 0x0e, 0xcc,
+	0xcb, 0x4d, 0xa3, 0x75, 0xc5, 0x5d, 0x43, 0x60, 0x1b, 0x5c, 0x8f, 0x09, 0xe7, 0x38, 0xf0, 0x26,
+	0x11, 0xf3, 0x4f, 0xb8, 0xe7, 0xb3, 0x94, 0x0a, 0x9c, 0x98, 0x1b, 0xca, 0xc0, 0xfb, 0x9a, 0xec,
+	0x2a, 0x6e, 0x5f, 0x53, 0x77, 0x4b, 0x7f, 0xfd, 0xd2, 0x30, 0xb6, 0x7f, 0x2e, 0x02, 0x70, 0x0f,
+	0x91, 0xc8, 0xc5, 0x3e, 0x4b, 0x02, 0xd8, 0x00, 0x6a, 0x6e, 0xee, 0xdf, 0x50, 0xe5, 0x40, 0x42,
+	0x99, 0xfd, 0x03, 0x70, 0x55, 0x09, 0xe4, 0xf5, 0xcc, 0xe2, 0x7f, 0x35, 0x75, 0x45, 0xd6, 0x4a,
+	0x16, 0xee, 0x81, 0x8d, 0x04, 0x23, 0xce, 0xa8, 0x0a, 0xf0, 0xdd, 0xf6, 0x6d, 0xfb, 0x1f, 0x1e,
+	0x37, 0x5b, 0x6f, 0x27, 0xa5, 0x6e, 0x56, 0x02, 0x6f, 0x83, 0x6b, 0x29, 0x5d, 0xdf, 0xb3, 0xa4,
+	0xf6, 0xac, 0xa4, 0x74, 0x6d, 0xd3, 0x0e, 0x28, 0xa7, 0xf4, 0x6c, 0xd7, 0xcb, 0xff, 0xba, 0x6b,
+	0x49, 0xee, 0xe9, 0x02, 0x5d, 0x24, 0xe1, 0xed, 0x1f, 0x4b, 0x60, 0xe3, 0x08, 0x25, 0x28, 0xe6,
+	0xf0, 0x13, 0x50, 0xe5, 0x24, 0xa4, 0x67, 0x09, 0x2f, 0x08, 0x0d, 0xd8, 0x22, 0x4b, 0x08, 0x6a,
+	0x4e, 0x07, 0xfc, 0x40, 0x31, 0x90, 0xc8, 0x9b, 0x50, 0x2f, 0xab, 0x9a, 0xe3, 0x24, 0x2f, 0x91,
+	0xa9, 0x55, 0xba, 0x9f, 0xc9, 0x64, 0x7e, 0x7f, 0xd5, 0xb8, 0xa9, 0x7d, 0xf3, 0xe0, 0xc4, 0x26,
+	0xcc, 0x89, 0x91, 0x98, 0xd9, 0x43, 0x1c, 0x22, 0xff, 0x51, 0x0f, 0xfb, 0x2f, 0x9f, 0xed, 0x82,
+	0x2c, 0x96, 0x1e, 0xf6, 0x75, 0x84, 0x30, 0x26, 0x74, 0xac, 0x7a, 0x1e, 0xe1, 0x24, 0x1b, 0xf5,
+	0x0d, 0xf8, 0x20, 0x60, 0x0b, 0x2a, 0x7d, 0x7a, 0xca, 0x71, 0xfe, 0xee, 0xa9, 0x70, 0xcb, 0xed,
+	0x1b, 0x17, 0x5c, 0xf7, 0x32, 0x81, 0x3e, 0xd0, 0x4f, 0xab, 0x03, 0x55, 0xf3, 0x3e, 0x32, 0xf5,
+	0x5c, 0x04, 0x39, 0xa8, 0xab, 0xb3, 0x78, 0xd3, 0x04, 0xf9, 0x12, 0xf1, 0x02, 0x96, 0x4e, 0x22,
+	0xac, 0xcc, 0x99, 0xa5, 0xb7, 0xf2, 0x53, 0x53, 0x9d, 0x0f, 0xb2, 0xc6, 0x3d, 0xd5, 0x57, 0xfa,
+	0x83, 0x14, 0xd4, 0x2e, 0x0c, 0x5d, 0xd0, 0xd5, 0x2d, 0xff, 0xff, 0xc4, 0xeb, 0xe7, 0x26, 0xea,
+	0xa6, 0x77, 0xb7, 0x7e, 0x78, 0xfd, 0x74, 0xe7, 0x43, 0x2d, 0xde, 0xe5, 0xc1, 0x89, 0xf3, 0xf0,
+	0xec, 0x2f, 0x52, 0x3f, 0x04, 0x3b, 0xdf, 0xe6, 0xef, 0x8a, 0x7a, 0x0a, 0x6f, 0x82, 0xda, 0xbd,
+	0xce, 0x60, 0xe8, 0xb9, 0xfd, 0xce, 0xf8, 0x70, 0xe4, 0x1d, 0x8f, 0xc6, 0x47, 0xfd, 0xfd, 0xc1,
+	0xc1, 0xa0, 0xdf, 0xdb, 0x2c, 0x9c, 0x27, 0x7b, 0x87, 0xc7, 0xdd, 0x61, 0xdf, 0x1b, 0x0f, 0x3e,
+	0x1f, 0x6d, 0x1a, 0xd0, 0x04, 0xd5, 0x37, 0xc9, 0x07, 0xa3, 0xfb, 0x83, 0x2f, 0xfb, 0x9b, 0xc5,
+	0xee, 0xde, 0x93, 0xa5, 0x65, 0x3c, 0x5f, 0x5a, 0xc6, 0x8b, 0xa5, 0x65, 0xfc, 0xb9, 0xb4, 0x8c,
+	0xc7, 0xa7, 0x56, 0xe1, 0xc5, 0xa9, 0x55, 0xf8, 0xed, 0xd4, 0x2a, 0x7c, 0x7d, 0xeb, 0x0d, 0xa7,
+	0x6b, 0xfb, 0x89, 0x47, 0x73, 0xcc, 0x27, 0x1b, 0xea, 0xbc, 0x9f, 0xfe, 0x3d, 0x00, 0x19, 0x4b,
+	0xed, 0xd9, 0xe2, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -276,6 +405,46 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *JailRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JailRecord)
+	if !ok {
+		that2, ok := that.(JailRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JailHeight != that1.JailHeight {
+		return false
+	}
+	if !this.JailTime.Equal(that1.JailTime) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.UnjailHeight != that1.UnjailHeight {
+		return false
+	}
+	if that1.UnjailTime == nil {
+		if this.UnjailTime != nil {
+			return false
+		}
+	} else if !this.UnjailTime.Equal(*that1.UnjailTime) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -375,6 +544,62 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnjailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnjailTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintSlashing(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnjailHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnjailHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.JailHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.JailHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -415,12 +640,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -479,6 +704,30 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.JailHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
+	if m.UnjailHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.UnjailHeight))
+	}
+	if m.UnjailTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnjailTime)
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -697,6 +946,182 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHeight", wireType)
+			}
+			m.JailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= JailReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+			}
+			m.UnjailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnjailTime == nil {
+				m.UnjailTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnjailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
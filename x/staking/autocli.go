@@ -132,6 +132,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "LiquidValidator",
+					Use:            "liquid-validator <validator-addr>",
+					Short:          "Query the liquid staking state of a validator",
+					Long:           "Query the delegation of the liquid staking pool of a validator and the supply of its share token.",
+					Example:        fmt.Sprintf("%s query staking liquid-validator [validator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of tokens tokenized into liquid staking share tokens",
+				},
+				{
+					RpcMethod:      "PendingCommissionChange",
+					Use:            "pending-commission-change <validator-addr>",
+					Short:          "Query the scheduled commission change of a validator",
+					Example:        fmt.Sprintf("%s query staking pending-commission-change [validator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod: "PendingCommissionChanges",
+					Use:       "pending-commission-changes",
+					Short:     "Query all the scheduled commission changes",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -175,6 +200,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share <validator-addr> <amount> <owner> --from <delegator_address>",
+					Short:          "Tokenize a delegation into liquid staking share tokens",
+					Long:           "Convert an amount of a delegation to a validator into transferable share tokens of the validator, sent to the owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem liquid staking share tokens for a delegation",
+					Long:           "Burn an amount of liquid staking share tokens of a validator and receive the delegation they represent.",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100liquid/cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
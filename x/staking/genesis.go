@@ -6,6 +6,7 @@ import (
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -54,9 +55,29 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateCommissionChanges(changes []types.CommissionChange) error {
+	seen := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if seen[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+		seen[change.ValidatorAddress] = true
+
+		if change.Rate.IsNil() || change.Rate.IsNegative() || change.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid pending commission change rate in genesis state: validator %s, rate %s", change.ValidatorAddress, change.Rate)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
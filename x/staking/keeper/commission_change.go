@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleCommissionChange schedules a commission rate change of the validator
+// CommissionChangeDelayPeriods periods of CommissionChangePeriodLength blocks after
+// the current block.
+// The new rate is validated against the current commission of the validator, a
+// change replaces the pending change of the validator if any.
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, validator types.Validator, newRate math.LegacyDec) (types.CommissionChange, error) {
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	if err := k.removePendingCommissionChange(ctx, valAddr); err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	delay := int64(params.CommissionChangeDelayPeriods) * int64(params.CommissionChangePeriodLength)
+	change := types.CommissionChange{
+		ValidatorAddress: validator.GetOperator(),
+		Rate:             newRate,
+		ScheduledHeight:  height,
+		EffectiveHeight:  height + delay,
+	}
+
+	if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return types.CommissionChange{}, err
+	}
+	if err := k.CommissionChangeQueue.Set(ctx, collections.Join(change.EffectiveHeight, sdk.ValAddress(valAddr))); err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatInt(change.EffectiveHeight, 10)),
+	); err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	return change, nil
+}
+
+// ApplyMatureCommissionChanges applies the pending commission changes whose
+// effective height has been reached. The rate is raised to the minimum commission
+// rate if the latter was increased after the change was scheduled.
+func (k Keeper) ApplyMatureCommissionChanges(ctx context.Context) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	var matured []sdk.ValAddress
+	rng := collections.NewPrefixUntilPairRange[int64, sdk.ValAddress](headerInfo.Height)
+	err := k.CommissionChangeQueue.Walk(ctx, rng, func(key collections.Pair[int64, sdk.ValAddress]) (bool, error) {
+		matured = append(matured, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	minCommissionRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range matured {
+		change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removePendingCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+
+		// the validator may have been removed since the change was scheduled
+		validator, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = math.LegacyMaxDec(change.Rate, minCommissionRate)
+		validator.Commission.UpdateTime = headerInfo.Time
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCommissionChange,
+			event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removePendingCommissionChange removes the pending commission change of the validator, if any.
+func (k Keeper) removePendingCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.CommissionChangeQueue.Remove(ctx, collections.Join(change.EffectiveHeight, valAddr)); err != nil {
+		return err
+	}
+
+	return k.PendingCommissionChanges.Remove(ctx, valAddr)
+}
+
+// checkMinSelfBondRatio checks that the tokens self-delegated by the operator of
+// the validator are at least MinSelfBondRatio of the tokens of the validator.
+// It must be called after the operations lowering the ratio.
+func (k Keeper) checkMinSelfBondRatio(ctx context.Context, valAddr sdk.ValAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MinSelfBondRatio.IsZero() {
+		return nil
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	selfBond := math.ZeroInt()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), valAddr))
+	if err == nil {
+		selfBond = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if math.LegacyNewDecFromInt(selfBond).LT(params.MinSelfBondRatio.MulInt(validator.Tokens)) {
+		return errorsmod.Wrapf(
+			types.ErrSelfBondRatioTooLow, "self-bond %s of %s tokens, minimum ratio is %s", selfBond, validator.Tokens, params.MinSelfBondRatio,
+		)
+	}
+
+	return nil
+}
+
+// checkMinSelfBondRatioAfterSelfUnbond checks the minimum self-bond ratio after the
+// operator unbonded or redelegated some of its self-delegation. A full self-unbond
+// is exempted, it jails the validator so the operator can always leave.
+func (k Keeper) checkMinSelfBondRatioAfterSelfUnbond(ctx context.Context, valAddr sdk.ValAddress) error {
+	has, err := k.Delegations.Has(ctx, collections.Join(sdk.AccAddress(valAddr), valAddr))
+	if err != nil {
+		return err
+	}
+	if !has {
+		return nil
+	}
+
+	return k.checkMinSelfBondRatio(ctx, valAddr)
+}
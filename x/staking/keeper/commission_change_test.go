@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.CommissionChangePeriodLength = 100
+	params.CommissionChangeDelayPeriods = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+	valStr := s.valAddressToString(valAddr)
+
+	blockTime := ctx.HeaderInfo().Time
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), blockTime.Add(-48*time.Hour),
+	)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 150, Time: blockTime})
+
+	// the change must be allowed by the current commission
+	_, err = keeper.ScheduleCommissionChange(ctx, validator, math.LegacyNewDecWithPrec(3, 1))
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+
+	// the change takes effect two periods after the current block
+	change, err := keeper.ScheduleCommissionChange(ctx, validator, math.LegacyNewDecWithPrec(15, 2))
+	require.NoError(err)
+	require.Equal(int64(350), change.EffectiveHeight)
+
+	// a change scheduled at the end of a period still gets the full notice
+	change, err = keeper.ScheduleCommissionChange(ctx.WithHeaderInfo(header.Info{Height: 199, Time: blockTime}), validator, math.LegacyNewDecWithPrec(15, 2))
+	require.NoError(err)
+	require.Equal(int64(399), change.EffectiveHeight)
+
+	// a new change replaces the pending one
+	change, err = keeper.ScheduleCommissionChange(ctx, validator, math.LegacyNewDecWithPrec(2, 1))
+	require.NoError(err)
+
+	res, err := s.queryClient.PendingCommissionChanges(ctx, &stakingtypes.QueryPendingCommissionChangesRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.CommissionChange{change}, res.CommissionChanges)
+
+	// the commission is left untouched until the effective height
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 349, Time: blockTime.Add(time.Hour)})
+	require.NoError(keeper.ApplyMatureCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 350, Time: blockTime.Add(2 * time.Hour)})
+	require.NoError(keeper.ApplyMatureCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(blockTime.Add(2*time.Hour), validator.Commission.UpdateTime)
+
+	_, err = s.queryClient.PendingCommissionChange(ctx, &stakingtypes.QueryPendingCommissionChangeRequest{ValidatorAddr: valStr})
+	require.ErrorContains(err, "no pending commission change")
+	has, err := keeper.CommissionChangeQueue.Has(ctx, collections.Join(int64(350), valAddr))
+	require.NoError(err)
+	require.False(has)
+}
+
+func (s *KeeperTestSuite) TestMinSelfBondRatio() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinSelfBondRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	addrs, valAddrs := createValAddrs(2)
+	valAddr, delAddr := valAddrs[0], addrs[1]
+	valStr := s.valAddressToString(valAddr)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+
+	tokens := keeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(s.addressToString(addrs[0]), valStr, sdk.NewCoin(sdk.DefaultBondDenom, tokens)))
+	require.NoError(err)
+
+	// delegating as much as the self-bond keeps the ratio at the minimum
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(s.addressToString(delAddr), valStr, sdk.NewCoin(sdk.DefaultBondDenom, tokens)))
+	require.NoError(err)
+
+	// failing messages are run on a cached context as their state changes are discarded by baseapp
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Delegate(cacheCtx, stakingtypes.NewMsgDelegate(s.addressToString(delAddr), valStr, sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt())))
+	require.ErrorIs(err, stakingtypes.ErrSelfBondRatioTooLow)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.Undelegate(cacheCtx, stakingtypes.NewMsgUndelegate(s.addressToString(addrs[0]), valStr, sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt())))
+	require.ErrorIs(err, stakingtypes.ErrSelfBondRatioTooLow)
+
+	// the operator can still fully self-unbond, which jails the validator
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(s.addressToString(addrs[0]), valStr, sdk.NewCoin(sdk.DefaultBondDenom, tokens)))
+	require.NoError(err)
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Jailed)
+}
@@ -107,6 +107,13 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 			return nil, err
 		}
 
+		// index the liquid staking pool delegations
+		if types.LiquidPoolAddress(valAddr).Equals(sdk.AccAddress(delegatorAddress)) {
+			if err := k.LiquidValidators.Set(ctx, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
 		// Call the after-modification hook if not exported
 		if !data.Exported {
 			if err := k.Hooks().AfterDelegationModified(ctx, delegatorAddress, valAddr); err != nil {
@@ -195,6 +202,21 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, change := range data.PendingCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+
+		if err := k.CommissionChangeQueue.Set(ctx, collections.Join(change.EffectiveHeight, sdk.ValAddress(valAddr))); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,17 +353,27 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	pendingCommissionChanges := []types.CommissionChange{}
+	err = k.PendingCommissionChanges.Walk(ctx, nil, func(_ sdk.ValAddress, change types.CommissionChange) (stop bool, err error) {
+		pendingCommissionChanges = append(pendingCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
-		RotationIndexRecords: rotationIndex,
-		RotationHistory:      conspubKeyRotationHistory,
-		RotationQueue:        rotationQueue,
+		Params:                   params,
+		LastTotalPower:           totalPower,
+		LastValidatorPowers:      lastValidatorPowers,
+		Validators:               allValidators,
+		Delegations:              allDelegations,
+		UnbondingDelegations:     unbondingDelegations,
+		Redelegations:            redelegations,
+		Exported:                 true,
+		RotationIndexRecords:     rotationIndex,
+		RotationHistory:          conspubKeyRotationHistory,
+		RotationQueue:            rotationQueue,
+		PendingCommissionChanges: pendingCommissionChanges,
 	}, nil
 }
@@ -513,6 +513,41 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// LiquidValidator queries the liquid staking state of a validator
+func (k Querier) LiquidValidator(ctx context.Context, req *types.QueryLiquidValidatorRequest) (*types.QueryLiquidValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidValidatorResponse{LiquidValidator: liquidValidator}, nil
+}
+
+// TotalLiquidStaked queries the total amount of tokens tokenized into liquid staking share tokens
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.Keeper.TotalLiquidStaked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -664,3 +699,46 @@ func redelegationsToRedelegationResponses(ctx context.Context, k *Keeper, redels
 
 	return resp, nil
 }
+
+// PendingCommissionChange queries the scheduled commission change of a validator
+func (k Querier) PendingCommissionChange(ctx context.Context, req *types.QueryPendingCommissionChangeRequest) (*types.QueryPendingCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no pending commission change for validator %s", req.ValidatorAddr)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingCommissionChangeResponse{CommissionChange: change}, nil
+}
+
+// PendingCommissionChanges queries all the scheduled commission changes
+func (k Querier) PendingCommissionChanges(ctx context.Context, req *types.QueryPendingCommissionChangesRequest) (*types.QueryPendingCommissionChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.PendingCommissionChanges, req.Pagination,
+		func(_ sdk.ValAddress, change types.CommissionChange) (types.CommissionChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCommissionChangesResponse{CommissionChanges: changes, Pagination: pageRes}, nil
+}
@@ -112,6 +112,12 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// LiquidValidators key: valAddr | value: none used (index of the validators with a liquid staking pool delegation)
+	LiquidValidators collections.KeySet[sdk.ValAddress]
+	// PendingCommissionChanges key: valAddr | value: CommissionChange
+	PendingCommissionChanges collections.Map[sdk.ValAddress, types.CommissionChange]
+	// CommissionChangeQueue key: effectiveHeight+valAddr | value: none used (index of the pending commission changes by effective height)
+	CommissionChangeQueue collections.KeySet[collections.Pair[int64, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -289,6 +295,24 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 107 | valAddr
+		LiquidValidators: collections.NewKeySet(sb, types.LiquidValidatorsKey, "liquid_validators", sdk.ValAddressKey),
+
+		// key format is: 108 | valAddr
+		PendingCommissionChanges: collections.NewMap(
+			sb, types.PendingCommissionChangesKey,
+			"pending_commission_changes",
+			sdk.ValAddressKey,
+			codec.CollValue[types.CommissionChange](cdc),
+		),
+
+		// key format is: 109 | effectiveHeight | valAddr
+		CommissionChangeQueue: collections.NewKeySet(
+			sb, types.CommissionChangeQueueKey,
+			"commission_change_queue",
+			collections.PairKeyCodec(collections.Int64Key, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -284,7 +284,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"325753826db3d02a081e11b28570d084b2dcd0bd7b6734c6240141d809492abd",
 	)
 	s.Require().NoError(err)
 
@@ -299,7 +299,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"325753826db3d02a081e11b28570d084b2dcd0bd7b6734c6240141d809492abd",
 	)
 	s.Require().NoError(err)
 }
@@ -317,7 +317,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"4f3aed7272a54572f3fb2d4b9ccb64e93851120588bd9578263b6242120c0f3e",
 	)
 	s.Require().NoError(err)
 
@@ -330,7 +330,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"4f3aed7272a54572f3fb2d4b9ccb64e93851120588bd9578263b6242120c0f3e",
 	)
 
 	s.Require().NoError(err)
@@ -349,7 +349,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"61ad01ed4fc1e3a226843a3251d7e6ad6ae43ec40340fd423be84e3cfb9e8bf3", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -362,7 +362,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"61ad01ed4fc1e3a226843a3251d7e6ad6ae43ec40340fd423be84e3cfb9e8bf3",
 	)
 
 	s.Require().NoError(err)
@@ -393,7 +393,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"1c0d7c9928c628b6056e12a8a863ae8f328ea0636452776504401834db016971",
 	)
 	s.Require().NoError(err)
 
@@ -417,7 +417,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"1c0d7c9928c628b6056e12a8a863ae8f328ea0636452776504401834db016971",
 	)
 	s.Require().NoError(err)
 }
@@ -434,7 +434,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"9afd11a7cc63e61851aa391cb5a6ae7dc7bd12a3c8847048477c2084eb4efaf5",
 	)
 	s.Require().NoError(err)
 
@@ -447,7 +447,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"9afd11a7cc63e61851aa391cb5a6ae7dc7bd12a3c8847048477c2084eb4efaf5",
 	)
 	s.Require().NoError(err)
 }
@@ -481,7 +481,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"55565aebbb67e1de08d0f17634ad168c68eae74f5cc9074e3a1ec4d1fbff16e5",
+		"c94f71111f51f3f36dabdac0af0208dcb8eb81b34eac07d2081255c057b61654",
 	)
 	s.Require().NoError(err)
 
@@ -507,7 +507,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"55565aebbb67e1de08d0f17634ad168c68eae74f5cc9074e3a1ec4d1fbff16e5",
+		"c94f71111f51f3f36dabdac0af0208dcb8eb81b34eac07d2081255c057b61654",
 	)
 	s.Require().NoError(err)
 }
@@ -530,7 +530,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"6cbfde1a03d764b9458f6c13606dff4b4c696931d2356d4198e7c5d89c161df1",
 	)
 	s.Require().NoError(err)
 
@@ -545,7 +545,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"6cbfde1a03d764b9458f6c13606dff4b4c696931d2356d4198e7c5d89c161df1",
 	)
 	s.Require().NoError(err)
 }
@@ -573,7 +573,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"8560a941efe72eb31a49181b83a0ee0742be9519375b013041027c163d850a19",
 	)
 	s.Require().NoError(err)
 
@@ -595,7 +595,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"8560a941efe72eb31a49181b83a0ee0742be9519375b013041027c163d850a19",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizeShares converts the given amount of tokens of a delegation into liquid
+// staking share tokens of the validator, minted to the owner. The delegation shares
+// are moved to the liquid staking pool of the validator, the share tokens represent
+// a claim on the pool delegation, including its rewards and slashes.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolAddr := types.LiquidPoolAddress(valAddr)
+	if bytes.Equal(delAddr, valAddr) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "validator self-delegation cannot be tokenized")
+	}
+	if delAddr.Equals(poolAddr) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "liquid staking pool delegation cannot be tokenized")
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the delegated vesting coins are tracked by the account, they cannot be transferred
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(interface{ GetDelegatedVesting() sdk.Coins }); ok &&
+		acc.GetDelegatedVesting().AmountOf(bondDenom).IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "delegations of vesting accounts cannot be tokenized")
+	}
+
+	// redelegated tokens could not be slashed for infractions of the source validator once tokenized
+	hasRecRedel, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if hasRecRedel {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "delegation has a redelegation in progress")
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.compoundLiquidRewards(ctx, poolAddr, validator); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// compounding the rewards updates the validator
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolShares, err := k.liquidPoolShares(ctx, poolAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, poolShares, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	denom := types.LiquidShareDenom(validator.GetOperator())
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+	// the share tokens are minted at the exchange rate of the pool delegation shares
+	minted := shares.TruncateInt()
+	if supply.IsPositive() && poolShares.IsPositive() {
+		minted = shares.MulInt(supply).QuoTruncate(poolShares).TruncateInt()
+	}
+	if !minted.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyShareTokenAmount
+	}
+
+	if err := k.transferDelegationShares(ctx, delAddr, poolAddr, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(denom, minted)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.LiquidValidators.Set(ctx, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	shareOwner, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeShares,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyShareOwner, shareOwner),
+		event.NewAttribute(sdk.AttributeKeyAmount, validator.TokensFromShares(shares).TruncateInt().String()),
+		event.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns liquid staking share tokens and moves the share of
+// the liquid staking pool delegation they represent to a delegation of the
+// redeemer. The redeemer also receives its share of the other coins held by the
+// pool. It returns the amount of tokens delegated to the validator.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (math.Int, error) {
+	valAddr, err := types.ValidatorFromLiquidShareDenom(shareTokens.Denom, k.validatorAddressCodec)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	poolAddr := types.LiquidPoolAddress(valAddr)
+	if err := k.compoundLiquidRewards(ctx, poolAddr, validator); err != nil {
+		return math.Int{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	poolShares, err := k.liquidPoolShares(ctx, poolAddr, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, shareTokens.Denom).Amount
+	if !poolShares.IsPositive() || shareTokens.Amount.GT(supply) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrInsufficientShares, "%s exceeds the liquid staking pool of the validator", shareTokens)
+	}
+
+	// redeeming the whole supply empties the pool, avoiding dust shares
+	shares := poolShares
+	if shareTokens.Amount.LT(supply) {
+		shares = poolShares.MulInt(shareTokens.Amount).QuoTruncate(math.LegacyNewDecFromInt(supply))
+	}
+	if !shares.IsPositive() {
+		return math.Int{}, types.ErrTinyShareTokenAmount
+	}
+
+	// pay out the share of the coins held by the pool which can't be compounded
+	var payout sdk.Coins
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, poolAddr) {
+		amt := coin.Amount.Mul(shareTokens.Amount).Quo(supply)
+		if amt.IsPositive() {
+			payout = payout.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	if !payout.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, poolAddr, delAddr, payout); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return math.Int{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, poolAddr, delAddr, valAddr, shares); err != nil {
+		return math.Int{}, err
+	}
+
+	if shares.Equal(poolShares) {
+		if err := k.LiquidValidators.Remove(ctx, valAddr); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	amount := validator.TokensFromShares(shares).TruncateInt()
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemShares,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		event.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+	); err != nil {
+		return math.Int{}, err
+	}
+
+	return amount, nil
+}
+
+// GetLiquidValidator returns the liquid staking state of a validator.
+func (k Keeper) GetLiquidValidator(ctx context.Context, valAddr sdk.ValAddress) (types.LiquidValidator, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.LiquidValidator{}, err
+	}
+
+	shares, err := k.liquidPoolShares(ctx, types.LiquidPoolAddress(valAddr), valAddr)
+	if err != nil {
+		return types.LiquidValidator{}, err
+	}
+
+	denom := types.LiquidShareDenom(validator.GetOperator())
+	return types.LiquidValidator{
+		OperatorAddress:  validator.GetOperator(),
+		ShareDenom:       denom,
+		LiquidShares:     shares,
+		LiquidTokens:     validator.TokensFromShares(shares).TruncateInt(),
+		ShareTokenSupply: k.bankKeeper.GetSupply(ctx, denom).Amount,
+	}, nil
+}
+
+// TotalLiquidStaked returns the amount of tokens delegated by the liquid staking
+// pools of all validators.
+func (k Keeper) TotalLiquidStaked(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.LiquidValidators.Walk(ctx, nil, func(valAddr sdk.ValAddress) (bool, error) {
+		liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(liquidValidator.LiquidTokens)
+		return false, nil
+	})
+	return total, err
+}
+
+// checkLiquidStakingCaps checks that adding shares to the liquid staking pool of
+// the validator holding poolShares respects the liquid staking caps of the params.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, poolShares, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if poolShares.Add(shares).Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+		return errorsmod.Wrapf(types.ErrLiquidStakingCapExceeded, "validator liquid staking cap is %s", params.ValidatorLiquidStakingCap)
+	}
+
+	totalLiquid, err := k.TotalLiquidStaked(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalBonded, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if math.LegacyNewDecFromInt(totalLiquid.Add(tokens)).GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+		return errorsmod.Wrapf(types.ErrLiquidStakingCapExceeded, "global liquid staking cap is %s", params.GlobalLiquidStakingCap)
+	}
+
+	return nil
+}
+
+// compoundLiquidRewards withdraws the rewards of the liquid staking pool delegation
+// of the validator to the pool and delegates the withdrawn bond denom rewards, so
+// that they accrue to the share token holders before the pool delegation is modified.
+//
+// Only the withdrawn rewards are delegated: the coins sent to the pool by anyone
+// else must not change the exchange rate of the share token, else the first holder
+// could inflate it with a donation and have the share tokens minted for the next
+// tokenized delegations rounded down to nothing. They are paid out on redemption.
+func (k Keeper) compoundLiquidRewards(ctx context.Context, poolAddr sdk.AccAddress, validator types.Validator) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	has, err := k.Delegations.Has(ctx, collections.Join(poolAddr, sdk.ValAddress(valAddr)))
+	if err != nil || !has {
+		return err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, poolAddr, bondDenom)
+
+	// modifying the delegation withdraws its rewards to the pool
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, poolAddr, valAddr); err != nil {
+		return err
+	}
+	if err := k.Hooks().AfterDelegationModified(ctx, poolAddr, valAddr); err != nil {
+		return err
+	}
+
+	rewards := k.bankKeeper.GetBalance(ctx, poolAddr, bondDenom).Amount.Sub(balance.Amount)
+	if !rewards.IsPositive() || validator.InvalidExRate() {
+		return nil
+	}
+
+	// the pool has no account to track the delegation, the rewards are moved to the
+	// not bonded pool and delegated from it
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, rewards))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, poolAddr, types.NotBondedPoolName, coins); err != nil {
+		return err
+	}
+
+	_, err = k.Delegate(ctx, poolAddr, rewards, types.Unbonded, validator, false)
+	return err
+}
+
+// liquidPoolShares returns the delegation shares of the liquid staking pool of the validator.
+func (k Keeper) liquidPoolShares(ctx context.Context, poolAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(poolAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return delegation.Shares, nil
+}
+
+// transferDelegationShares moves delegation shares of a validator from one delegator
+// to another, leaving the tokens of the validator untouched.
+func (k Keeper) transferDelegationShares(ctx context.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(from, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegation
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, from, valAddr); err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		if err = k.SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+		err = k.Hooks().AfterDelegationModified(ctx, from, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation, err = k.Delegations.Get(ctx, collections.Join(to, valAddr))
+	switch {
+	case err == nil:
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, to, valAddr)
+	case errors.Is(err, collections.ErrNotFound):
+		delegator, err1 := k.authKeeper.AddressCodec().BytesToString(to)
+		if err1 != nil {
+			return err1
+		}
+		validator, err1 := k.validatorAddressCodec.BytesToString(valAddr)
+		if err1 != nil {
+			return err1
+		}
+
+		delegation = types.NewDelegation(delegator, validator, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, to, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, to, valAddr)
+}
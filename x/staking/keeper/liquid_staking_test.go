@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"context"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	valAddr, delAddr, owner := valAddrs[0], addrs[1], addrs[2]
+	valStr := s.valAddressToString(valAddr)
+	denom := stakingtypes.LiquidShareDenom(valStr)
+	poolAddr := stakingtypes.LiquidPoolAddress(valAddr)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delAddr), valStr, issuedShares)))
+
+	// track the supply of the share token
+	supply := math.ZeroInt()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolAddr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).AnyTimes()
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAddr).Return(sdk.NewCoins()).AnyTimes()
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).DoAndReturn(func(_ context.Context, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, supply)
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, amt sdk.Coins) error {
+		supply = supply.Add(amt.AmountOf(denom))
+		return nil
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, owner, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), owner, gomock.Any()).DoAndReturn(func(_ context.Context, _ []byte, amt sdk.Coins) error {
+		supply = supply.Sub(amt.AmountOf(denom))
+		return nil
+	}).AnyTimes()
+
+	tokens := keeper.TokensFromConsensusPower(ctx, 4)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, tokens)
+
+	// the self-delegation of the operator can't be tokenized
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(addrs[0]), valStr, bondCoin, s.addressToString(owner)))
+	require.ErrorIs(err, stakingtypes.ErrTokenizeSharesDisabled)
+
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(delAddr), valStr, sdk.NewCoin("foo", tokens), s.addressToString(owner)))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the validator cap limits the fraction of the shares of the validator in the pool
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(delAddr), valStr, bondCoin, s.addressToString(owner)))
+	require.ErrorIs(err, stakingtypes.ErrLiquidStakingCapExceeded)
+
+	// the global cap limits the fraction of the bonded tokens in the pools
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(3, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(delAddr), valStr, bondCoin, s.addressToString(owner)))
+	require.ErrorIs(err, stakingtypes.ErrLiquidStakingCapExceeded)
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(delAddr), valStr, bondCoin, s.addressToString(owner)))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(denom, tokens), res.Amount)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(err)
+	require.Equal(startTokens.Sub(tokens), delegation.Shares.RoundInt())
+
+	liquidValidator, err := keeper.GetLiquidValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(tokens, liquidValidator.LiquidTokens)
+	require.Equal(tokens, liquidValidator.ShareTokenSupply)
+
+	total, err := keeper.TotalLiquidStaked(ctx)
+	require.NoError(err)
+	require.Equal(tokens, total)
+
+	// the tokens of the validator are left untouched
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(startTokens, validator.Tokens)
+
+	// redeeming moves the pool shares to the redeemer
+	redeemed := keeper.TokensFromConsensusPower(ctx, 1)
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(owner), sdk.NewCoin(denom, redeemed)))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, redeemed), redeemRes.Amount)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(owner, valAddr))
+	require.NoError(err)
+	require.Equal(redeemed, delegation.Shares.RoundInt())
+
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(owner), sdk.NewCoin(denom, tokens)))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientShares)
+
+	// redeeming the whole supply empties the pool
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(owner), sdk.NewCoin(denom, tokens.Sub(redeemed))))
+	require.NoError(err)
+
+	_, err = keeper.Delegations.Get(ctx, collections.Join(poolAddr, valAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+	has, err := keeper.LiquidValidators.Has(ctx, valAddr)
+	require.NoError(err)
+	require.False(has)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(owner, valAddr))
+	require.NoError(err)
+	require.Equal(tokens, delegation.Shares.RoundInt())
+
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(owner), sdk.NewCoin("foo", tokens)))
+	require.ErrorIs(err, stakingtypes.ErrInvalidShareDenom)
+}
@@ -5,6 +5,7 @@ import (
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
 	v6 "cosmossdk.io/x/staking/migrations/v6"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,8 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.Params)
+}
@@ -122,6 +122,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 		return nil, err
 	}
 
+	err = k.ApplyMatureCommissionChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
@@ -0,0 +1,26 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7.
+// It sets the liquid staking caps, commission change and minimum self-bond
+// ratio parameters, introduced in this version, to their default values.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	p.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	p.CommissionChangePeriodLength = types.DefaultCommissionChangePeriodLength
+	p.CommissionChangeDelayPeriods = types.DefaultCommissionChangeDelayPeriods
+	p.MinSelfBondRatio = types.DefaultMinSelfBondRatio
+
+	return params.Set(ctx, p)
+}
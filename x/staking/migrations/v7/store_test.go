@@ -0,0 +1,49 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	ctx := coretesting.Context()
+	kv := coretesting.KVStoreService(ctx, "staking")
+	sb := collections.NewSchemaBuilder(kv)
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// params stored before the new parameters were introduced
+	oldParams := types.Params{
+		UnbondingTime:     time.Hour,
+		MaxValidators:     50,
+		MaxEntries:        types.DefaultMaxEntries,
+		BondDenom:         sdk.DefaultBondDenom,
+		MinCommissionRate: math.LegacyZeroDec(),
+		KeyRotationFee:    types.DefaultKeyRotationFee,
+	}
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v7.MigrateStore(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(50), got.MaxValidators)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, got.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, got.ValidatorLiquidStakingCap)
+	require.Equal(t, types.DefaultCommissionChangePeriodLength, got.CommissionChangePeriodLength)
+	require.Equal(t, types.DefaultCommissionChangeDelayPeriods, got.CommissionChangeDelayPeriods)
+	require.True(t, got.MinSelfBondRatio.IsZero())
+	require.NoError(t, got.Validate())
+}
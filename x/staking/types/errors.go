@@ -50,4 +50,13 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrLiquidStakingCapExceeded = errors.Register(ModuleName, 48, "liquid staking cap exceeded")
+	ErrInvalidShareDenom        = errors.Register(ModuleName, 49, "invalid liquid staking share denom")
+	ErrTokenizeSharesDisabled   = errors.Register(ModuleName, 50, "delegation cannot be tokenized")
+	ErrTinyShareTokenAmount     = errors.Register(ModuleName, 51, "too few tokens to tokenize or redeem (truncates to zero)")
+
+	ErrSelfBondRatioTooLow       = errors.Register(ModuleName, 52, "validator self-bond ratio below the minimum")
+	ErrNoPendingCommissionChange = errors.Register(ModuleName, 53, "no pending commission change found")
 )
@@ -53,6 +53,8 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// pending_commission_changes are the scheduled commission changes of the validators.
+	PendingCommissionChanges []CommissionChange `protobuf:"bytes,12,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +160,13 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetPendingCommissionChanges() []CommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -301,52 +310,54 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x1c, 0xc5, 0x63, 0x08, 0x21, 0x99, 0x04, 0x96, 0x1d, 0x02, 0xf2, 0x46, 0xab, 0x24, 0x1b, 0x71,
+	0x88, 0x58, 0x61, 0x6f, 0xd8, 0xd5, 0x1e, 0x7a, 0x23, 0x54, 0x6a, 0x11, 0xa8, 0x50, 0x03, 0x3d,
+	0x20, 0x55, 0xd1, 0x24, 0x1e, 0x1c, 0x0b, 0xdb, 0x93, 0xce, 0x4c, 0x52, 0xf2, 0x0d, 0x7a, 0xe4,
+	0xde, 0x0b, 0xc7, 0x1e, 0x7b, 0xe0, 0xd6, 0x2f, 0xc0, 0x11, 0x71, 0xaa, 0x7a, 0xa0, 0x15, 0x1c,
+	0xda, 0x8f, 0x51, 0x79, 0xc6, 0x36, 0x4e, 0x13, 0xa3, 0xf6, 0x82, 0x98, 0xfc, 0xdf, 0xfb, 0xbd,
+	0xf9, 0x27, 0xce, 0x0b, 0x58, 0xe9, 0x10, 0xe6, 0x12, 0xa6, 0x33, 0x8e, 0x4e, 0x6c, 0xcf, 0xd2,
+	0x07, 0x8d, 0x36, 0xe6, 0xa8, 0xa1, 0x5b, 0xd8, 0xc3, 0xcc, 0x66, 0x5a, 0x8f, 0x12, 0x4e, 0xe0,
+	0xb2, 0x54, 0x69, 0x81, 0x4a, 0x0b, 0x54, 0xa5, 0xa2, 0x45, 0x2c, 0x22, 0x24, 0xba, 0xff, 0x9f,
+	0x54, 0x97, 0x92, 0x98, 0xa1, 0x5b, 0xaa, 0xfe, 0x90, 0xaa, 0x96, 0xb4, 0x07, 0x01, 0x72, 0xf4,
+	0x3b, 0x72, 0x6d, 0x8f, 0xe8, 0xe2, 0x6f, 0xf0, 0x52, 0xc5, 0x22, 0xc4, 0x72, 0xb0, 0x2e, 0x4e,
+	0xed, 0xfe, 0xb1, 0xce, 0x6d, 0x17, 0x33, 0x8e, 0xdc, 0x9e, 0x14, 0xd4, 0x3e, 0x64, 0x41, 0xe1,
+	0x89, 0xbc, 0xf4, 0x3e, 0x47, 0x1c, 0xc3, 0x0d, 0x90, 0xe9, 0x21, 0x8a, 0x5c, 0xa6, 0x2a, 0x55,
+	0xa5, 0x9e, 0x5f, 0x2f, 0x6b, 0x93, 0x97, 0xd0, 0xf6, 0x84, 0xaa, 0x99, 0xbb, 0xbc, 0xa9, 0xa4,
+	0xde, 0x7d, 0x7d, 0xbf, 0xaa, 0x18, 0x81, 0x11, 0x1e, 0x81, 0x05, 0x07, 0x31, 0xde, 0xe2, 0x84,
+	0x23, 0xa7, 0xd5, 0x23, 0xaf, 0x31, 0x55, 0xa7, 0xaa, 0x4a, 0xbd, 0xd0, 0xfc, 0xc7, 0x17, 0x7f,
+	0xba, 0xa9, 0x2c, 0x49, 0x26, 0x33, 0x4f, 0x34, 0x9b, 0xe8, 0x2e, 0xe2, 0x5d, 0x6d, 0xcb, 0xe3,
+	0xd7, 0x17, 0x6b, 0x20, 0x08, 0xdb, 0xf2, 0xb8, 0x64, 0xce, 0xfb, 0xa4, 0x03, 0x1f, 0xb4, 0xe7,
+	0x73, 0xa0, 0x0d, 0x96, 0x04, 0x7b, 0x80, 0x1c, 0xdb, 0x44, 0x9c, 0x50, 0xc9, 0x67, 0xea, 0x74,
+	0x75, 0xba, 0x9e, 0x5f, 0x5f, 0x4d, 0xba, 0xed, 0x0e, 0x62, 0xfc, 0x45, 0xe8, 0x11, 0xa8, 0xf8,
+	0xcd, 0x17, 0x9d, 0xb1, 0x31, 0x83, 0x3b, 0x00, 0x44, 0x29, 0x4c, 0x4d, 0x0b, 0xfe, 0x5f, 0x49,
+	0xfc, 0xc8, 0x1c, 0xc7, 0xc6, 0xfc, 0x70, 0x17, 0xe4, 0x4d, 0xec, 0x60, 0x0b, 0x71, 0x9b, 0x78,
+	0x4c, 0x9d, 0x11, 0xb8, 0x5a, 0x12, 0xee, 0x71, 0x24, 0x8d, 0xf3, 0xe2, 0x04, 0x78, 0x02, 0x96,
+	0xfa, 0x5e, 0x9b, 0x78, 0xa6, 0xed, 0x59, 0xad, 0x38, 0x3a, 0x23, 0xd0, 0x7f, 0x27, 0xa1, 0x0f,
+	0x43, 0xd3, 0xe4, 0x8c, 0x62, 0x7f, 0x7c, 0xce, 0xe0, 0x21, 0x98, 0xa3, 0x38, 0x1e, 0x32, 0x2b,
+	0x42, 0x56, 0x92, 0x42, 0x0c, 0x6c, 0x4e, 0xa4, 0x8f, 0x52, 0x60, 0x09, 0x64, 0xf1, 0x69, 0x8f,
+	0x50, 0x8e, 0x4d, 0x35, 0x5b, 0x55, 0xea, 0x59, 0x23, 0x3a, 0x43, 0x07, 0x2c, 0x53, 0xc2, 0x85,
+	0xb0, 0x65, 0x7b, 0x26, 0x3e, 0x6d, 0x51, 0xdc, 0x21, 0xd4, 0x64, 0x6a, 0xee, 0xe1, 0x05, 0x8d,
+	0xc0, 0xb5, 0xe5, 0x9b, 0x0c, 0xe1, 0x19, 0x59, 0x90, 0x8e, 0xcf, 0x19, 0xb4, 0xc0, 0x42, 0x94,
+	0xd6, 0xb5, 0x19, 0x27, 0x74, 0xa8, 0x02, 0x91, 0xd3, 0x48, 0xca, 0xd9, 0x24, 0x1e, 0xdb, 0xeb,
+	0xb7, 0xb7, 0xf1, 0x30, 0x4c, 0x7c, 0x2a, 0x8d, 0xf1, 0xb4, 0xdf, 0xe8, 0xe8, 0x0c, 0xbe, 0x04,
+	0xf3, 0x51, 0xd0, 0xab, 0x3e, 0xee, 0x63, 0x35, 0xff, 0x73, 0xeb, 0x3c, 0xf7, 0xc5, 0xe3, 0xeb,
+	0xcc, 0xd1, 0xf8, 0x1c, 0x3a, 0xa0, 0xd4, 0xc3, 0xf2, 0x99, 0xe8, 0x10, 0xd7, 0xb5, 0x19, 0xf3,
+	0x83, 0x3a, 0x5d, 0xe4, 0x59, 0x98, 0xa9, 0x05, 0x11, 0x55, 0x4f, 0xde, 0x28, 0x74, 0x6c, 0x0a,
+	0x43, 0x33, 0xed, 0xe7, 0x18, 0x6a, 0x40, 0xfc, 0x71, 0xcc, 0x6a, 0x5d, 0x00, 0xc7, 0xbf, 0x58,
+	0x70, 0x1d, 0xcc, 0x22, 0xd3, 0xa4, 0x98, 0xc9, 0x0e, 0xc9, 0x35, 0xd5, 0xeb, 0x8b, 0xb5, 0x62,
+	0x90, 0xb9, 0x21, 0x27, 0xfb, 0x9c, 0xda, 0x9e, 0x65, 0x84, 0x42, 0x58, 0x04, 0x33, 0xf7, 0x45,
+	0x31, 0x6d, 0xc8, 0xc3, 0xa3, 0xec, 0x9b, 0xf3, 0x4a, 0xea, 0xdb, 0x79, 0x25, 0x55, 0x23, 0x60,
+	0x71, 0xc2, 0xe7, 0x0a, 0xd5, 0xd1, 0xa8, 0xc2, 0x3d, 0xf0, 0x3f, 0x90, 0xf6, 0xbb, 0x4e, 0xcd,
+	0x88, 0x16, 0x2b, 0x69, 0xb2, 0x08, 0xb5, 0xb0, 0x08, 0xb5, 0x83, 0xb0, 0x08, 0x9b, 0xe9, 0xb3,
+	0xcf, 0x15, 0xc5, 0x10, 0xea, 0x58, 0xe0, 0x5b, 0xe5, 0x3e, 0x31, 0xf6, 0xd6, 0xc3, 0x67, 0x20,
+	0x37, 0x40, 0x4e, 0xcb, 0x8f, 0x09, 0x2b, 0xb2, 0xf1, 0x40, 0x29, 0xf8, 0xeb, 0xb2, 0xdd, 0x63,
+	0x41, 0xc2, 0xa6, 0xff, 0xc8, 0x6c, 0xe3, 0x21, 0x33, 0xb2, 0x83, 0x60, 0x14, 0xdd, 0x73, 0xea,
+	0x57, 0xee, 0xd9, 0xfc, 0xff, 0xf2, 0xb6, 0xac, 0x5c, 0xdd, 0x96, 0x95, 0x2f, 0xb7, 0x65, 0xe5,
+	0xec, 0xae, 0x9c, 0xba, 0xba, 0x2b, 0xa7, 0x3e, 0xde, 0x95, 0x53, 0x47, 0x7f, 0x8e, 0x54, 0xeb,
+	0x69, 0xf4, 0x6b, 0xc2, 0x87, 0x3d, 0xcc, 0xda, 0x19, 0xc1, 0xfd, 0xf7, 0xfb, 0x00, 0x8a, 0x99,
+	0x9a, 0x8a, 0xc0, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +380,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +731,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1152,6 +1183,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, CommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -67,6 +67,11 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	LiquidValidatorsKey = collections.NewPrefix(107) // prefix for the validators with a liquid staking pool delegation
+
+	PendingCommissionChangesKey = collections.NewPrefix(108) // prefix for the scheduled commission changes by validator
+	CommissionChangeQueueKey    = collections.NewPrefix(109) // prefix for the scheduled commission changes by effective height
 )
 
 // Reserved kvstore keys
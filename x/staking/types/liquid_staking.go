@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	addresscodec "cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// LiquidShareDenomPrefix is the prefix of the denom of the liquid staking share
+// token of a validator, the denom is the prefix followed by the validator operator address.
+const LiquidShareDenomPrefix = "liquid/"
+
+// liquidPoolKey is the derivation key of the liquid staking pool addresses.
+var liquidPoolKey = []byte("liquid")
+
+// LiquidShareDenom returns the denom of the liquid staking share token of the validator.
+func LiquidShareDenom(valAddr string) string {
+	return LiquidShareDenomPrefix + valAddr
+}
+
+// ValidatorFromLiquidShareDenom returns the validator of a liquid staking share token denom.
+func ValidatorFromLiquidShareDenom(denom string, valAc addresscodec.Codec) (sdk.ValAddress, error) {
+	valAddr, ok := strings.CutPrefix(denom, LiquidShareDenomPrefix)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidShareDenom, denom)
+	}
+
+	bz, err := valAc.StringToBytes(valAddr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidShareDenom, "%s: %s", denom, err)
+	}
+
+	return bz, nil
+}
+
+// LiquidPoolAddress returns the address of the account holding the delegation
+// backing the liquid staking share tokens of the validator.
+func LiquidPoolAddress(valAddr sdk.ValAddress) sdk.AccAddress {
+	return address.Module(ModuleName, liquidPoolKey, valAddr)
+}
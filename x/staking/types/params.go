@@ -25,6 +25,14 @@ const (
 	// DefaultMaxEntries is the default maximum number of entries
 	// in a UBD (Unbonding Delegation) or RED (Redelegation) pair.
 	DefaultMaxEntries uint32 = 7
+
+	// DefaultCommissionChangePeriodLength is the default number of blocks of a
+	// commission change period, about a day with 6s blocks.
+	DefaultCommissionChangePeriodLength uint64 = 14400
+
+	// DefaultCommissionChangeDelayPeriods is the default number of periods a commission
+	// change is announced ahead, zero applies commission changes immediately.
+	DefaultCommissionChangeDelayPeriods uint32 = 0
 )
 
 var (
@@ -33,6 +41,15 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, meaning liquid staking is not limited
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, meaning liquid staking is not limited
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMinSelfBondRatio is set to 0%, meaning the self-bond ratio is not enforced
+	DefaultMinSelfBondRatio = math.LegacyZeroDec()
 )
 
 // NewParams creates a new Params instance
@@ -49,6 +66,13 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+
+		CommissionChangePeriodLength: DefaultCommissionChangePeriodLength,
+		CommissionChangeDelayPeriods: DefaultCommissionChangeDelayPeriods,
+		MinSelfBondRatio:             DefaultMinSelfBondRatio,
 	}
 }
 
@@ -114,6 +138,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if p.CommissionChangePeriodLength == 0 {
+		return errors.New("commission change period length must be positive")
+	}
+
+	if err := validateMinSelfBondRatio(p.MinSelfBondRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -230,3 +270,31 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateMinSelfBondRatio(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("minimum self-bond ratio cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum self-bond ratio cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("minimum self-bond ratio cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
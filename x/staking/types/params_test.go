@@ -46,4 +46,28 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(-1, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	// validate commission change period length and min self-bond ratio
+	params = types.DefaultParams()
+	params.CommissionChangePeriodLength = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinSelfBondRatio = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.MinSelfBondRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
 }
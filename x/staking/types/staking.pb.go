@@ -999,6 +999,21 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that can be tokenized into liquid staking share tokens.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares
+	// of a validator that can be tokenized into liquid staking share tokens.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// commission_change_period_length is the number of blocks of the periods used to
+	// schedule commission changes. Periods are block counts, unrelated to x/epochs.
+	CommissionChangePeriodLength uint64 `protobuf:"varint,10,opt,name=commission_change_period_length,json=commissionChangePeriodLength,proto3" json:"commission_change_period_length,omitempty"`
+	// commission_change_delay_periods is the number of periods a commission change is
+	// announced ahead of taking effect. Zero applies commission changes immediately.
+	CommissionChangeDelayPeriods uint32 `protobuf:"varint,11,opt,name=commission_change_delay_periods,json=commissionChangeDelayPeriods,proto3" json:"commission_change_delay_periods,omitempty"`
+	// min_self_bond_ratio is the minimum ratio of the tokens of a validator which
+	// must be self-delegated by its operator. Zero disables the check.
+	MinSelfBondRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=min_self_bond_ratio,json=minSelfBondRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_self_bond_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1077,6 +1092,148 @@ func (m *Params) GetKeyRotationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetCommissionChangePeriodLength() uint64 {
+	if m != nil {
+		return m.CommissionChangePeriodLength
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeDelayPeriods() uint32 {
+	if m != nil {
+		return m.CommissionChangeDelayPeriods
+	}
+	return 0
+}
+
+// CommissionChange defines a commission rate change of a validator scheduled
+// to take effect at a later height.
+type CommissionChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the new commission rate of the validator.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// scheduled_height is the height at which the change was announced.
+	ScheduledHeight int64 `protobuf:"varint,3,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// effective_height is the height at which the change takes effect.
+	EffectiveHeight int64 `protobuf:"varint,4,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+}
+
+func (m *CommissionChange) Reset()         { *m = CommissionChange{} }
+func (m *CommissionChange) String() string { return proto.CompactTextString(m) }
+func (*CommissionChange) ProtoMessage()    {}
+func (*CommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *CommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChange.Merge(m, src)
+}
+func (m *CommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChange proto.InternalMessageInfo
+
+func (m *CommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CommissionChange) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *CommissionChange) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+// LiquidValidator defines the liquid staking state of a validator.
+type LiquidValidator struct {
+	// operator_address defines the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// share_denom is the denom of the share token of the validator.
+	ShareDenom string `protobuf:"bytes,2,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty"`
+	// liquid_shares are the delegator shares of the validator held by the liquid staking pool.
+	LiquidShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquid_shares,json=liquidShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_shares"`
+	// liquid_tokens are the tokens backing the liquid shares.
+	LiquidTokens cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquid_tokens,json=liquidTokens,proto3,customtype=cosmossdk.io/math.Int" json:"liquid_tokens"`
+	// share_token_supply is the supply of the share token of the validator.
+	ShareTokenSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=share_token_supply,json=shareTokenSupply,proto3,customtype=cosmossdk.io/math.Int" json:"share_token_supply"`
+}
+
+func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
+func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
+func (*LiquidValidator) ProtoMessage()    {}
+func (*LiquidValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
+}
+func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidValidator.Merge(m, src)
+}
+func (m *LiquidValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidValidator proto.InternalMessageInfo
+
+func (m *LiquidValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *LiquidValidator) GetShareDenom() string {
+	if m != nil {
+		return m.ShareDenom
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1088,7 +1245,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1300,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1348,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,7 +1402,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1286,7 +1443,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +1497,7 @@ func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHis
 func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
 func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
 func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{22}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1379,7 +1536,7 @@ func (m *ValAddrsOfRotatedConsKeys) Reset()         { *m = ValAddrsOfRotatedCons
 func (m *ValAddrsOfRotatedConsKeys) String() string { return proto.CompactTextString(m) }
 func (*ValAddrsOfRotatedConsKeys) ProtoMessage()    {}
 func (*ValAddrsOfRotatedConsKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{23}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *ValAddrsOfRotatedConsKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,6 +1592,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*CommissionChange)(nil), "cosmos.staking.v1beta1.CommissionChange")
+	proto.RegisterType((*LiquidValidator)(nil), "cosmos.staking.v1beta1.LiquidValidator")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1449,142 +1608,158 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x34, 0x25, 0xfe, 0x14, 0x45, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0x37,
-	0x91, 0xdd, 0x98, 0x8c, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xca, 0x36, 0x93, 0x58, 0x52,
-	0x97, 0xa2, 0xfa, 0x40, 0x9b, 0xc5, 0x70, 0x77, 0x48, 0x6d, 0x45, 0xee, 0xb2, 0x3b, 0x43, 0xd9,
-	0xbc, 0xf7, 0x10, 0xb8, 0x28, 0x90, 0x53, 0x11, 0xa0, 0x30, 0x6a, 0xa0, 0x97, 0xf6, 0x96, 0x83,
-	0xd1, 0x7b, 0x6f, 0x69, 0x81, 0x02, 0x86, 0x4f, 0x45, 0x80, 0xba, 0x85, 0x7d, 0x48, 0xd0, 0x5c,
-	0xda, 0x9e, 0x7a, 0x2c, 0xe6, 0xb1, 0x0f, 0x8a, 0xa2, 0x65, 0xc9, 0x41, 0x11, 0xb4, 0x17, 0x62,
-	0x67, 0xe6, 0xff, 0xbf, 0x99, 0xff, 0x3d, 0xf3, 0x13, 0x2e, 0x9a, 0x2e, 0xed, 0xba, 0xb4, 0x4c,
-	0x19, 0xde, 0xb5, 0x9d, 0x76, 0x79, 0x6f, 0xb9, 0x49, 0x18, 0x5e, 0xf6, 0xc7, 0xa5, 0x9e, 0xe7,
-	0x32, 0x17, 0x9d, 0x91, 0x54, 0x25, 0x7f, 0x56, 0x51, 0x15, 0x66, 0x71, 0xd7, 0x76, 0xdc, 0xb2,
-	0xf8, 0x95, 0xa4, 0x85, 0x57, 0x4c, 0xb7, 0x4b, 0x58, 0xb3, 0xc5, 0xca, 0xb8, 0x69, 0xda, 0xe5,
-	0xbd, 0xe5, 0x32, 0x1b, 0xf4, 0x08, 0x55, 0xab, 0xe7, 0x83, 0x55, 0x31, 0xbb, 0x7f, 0x79, 0x5e,
-	0x9d, 0xa6, 0x89, 0x29, 0x09, 0x8e, 0x62, 0xba, 0xb6, 0xa3, 0xd6, 0xe7, 0xe4, 0xba, 0x21, 0x46,
-	0x65, 0x75, 0x28, 0xb9, 0x74, 0xaa, 0xed, 0xb6, 0x5d, 0x39, 0xcf, 0xbf, 0x7c, 0x86, 0xb6, 0xeb,
-	0xb6, 0x3b, 0xa4, 0x2c, 0x46, 0xcd, 0x7e, 0xab, 0x8c, 0x9d, 0x81, 0xbf, 0xd7, 0xfe, 0x25, 0xab,
-	0xef, 0x61, 0x66, 0xbb, 0xfe, 0x5e, 0x0b, 0xfb, 0xd7, 0x99, 0xdd, 0x25, 0x94, 0xe1, 0x6e, 0x4f,
-	0x12, 0x14, 0x3f, 0xd2, 0x60, 0xe6, 0x96, 0x4d, 0x99, 0xeb, 0xd9, 0x26, 0xee, 0xd4, 0x9c, 0x96,
-	0x8b, 0xde, 0x82, 0xe4, 0x0e, 0xc1, 0x16, 0xf1, 0xf2, 0xda, 0xa2, 0xb6, 0x94, 0xbe, 0x3a, 0x57,
-	0xf2, 0xe5, 0x2d, 0x49, 0x31, 0xf7, 0x96, 0x4b, 0xb7, 0x04, 0x41, 0x25, 0xf5, 0xc9, 0x93, 0x85,
-	0x89, 0xdf, 0x7c, 0xf6, 0xf1, 0x65, 0x4d, 0x57, 0x3c, 0xa8, 0x0a, 0xc9, 0x3d, 0xdc, 0xa1, 0x84,
-	0xe5, 0x63, 0x8b, 0xf1, 0xa5, 0xf4, 0xd5, 0x0b, 0xa5, 0x83, 0xd5, 0x5e, 0xda, 0xc6, 0x1d, 0xdb,
-	0xc2, 0xcc, 0x1d, 0x46, 0x91, 0xbc, 0x2b, 0xb1, 0xbc, 0x56, 0xfc, 0x45, 0x0c, 0xb2, 0xab, 0x6e,
-	0xb7, 0x6b, 0x53, 0x6a, 0xbb, 0x8e, 0x8e, 0x19, 0xa1, 0xe8, 0x1d, 0x48, 0x78, 0x98, 0x11, 0x71,
-	0xb2, 0x54, 0xe5, 0x1a, 0x67, 0xfc, 0xf4, 0xc9, 0xc2, 0x39, 0xb9, 0x05, 0xb5, 0x76, 0x4b, 0xb6,
-	0x5b, 0xee, 0x62, 0xb6, 0x53, 0x7a, 0x8f, 0xb4, 0xb1, 0x39, 0xa8, 0x12, 0xf3, 0xf1, 0xc3, 0x2b,
-	0xa0, 0x4e, 0x50, 0x25, 0xa6, 0xdc, 0x45, 0x60, 0xa0, 0xef, 0xc0, 0x54, 0x17, 0xdf, 0x35, 0x04,
-	0x5e, 0xec, 0xa5, 0xf0, 0x26, 0xbb, 0xf8, 0x2e, 0x3f, 0x1f, 0x7a, 0x1f, 0xb2, 0x1c, 0xd2, 0xdc,
-	0xc1, 0x4e, 0x9b, 0x48, 0xe4, 0xf8, 0x4b, 0x21, 0x67, 0xba, 0xf8, 0xee, 0xaa, 0x40, 0xe3, 0xf8,
-	0x2b, 0x89, 0xcf, 0x1f, 0x2c, 0x68, 0xc5, 0xdf, 0x6b, 0x00, 0xa1, 0x62, 0x10, 0x86, 0x9c, 0x19,
-	0x8c, 0xc4, 0xa6, 0x54, 0x59, 0xee, 0xf5, 0x71, 0xba, 0xdf, 0xa7, 0xd6, 0x4a, 0x86, 0x1f, 0xef,
-	0xd1, 0x93, 0x05, 0x4d, 0xee, 0x9a, 0x35, 0x47, 0xd4, 0x9e, 0xee, 0xf7, 0x2c, 0xcc, 0x88, 0xc1,
-	0xfd, 0x47, 0x68, 0x2b, 0x7d, 0xb5, 0x50, 0x92, 0xce, 0x55, 0xf2, 0x9d, 0xab, 0xb4, 0xe5, 0x3b,
-	0x97, 0x04, 0xfc, 0xf0, 0xaf, 0x3e, 0x20, 0x48, 0x6e, 0xbe, 0xae, 0x64, 0xf8, 0xa7, 0x06, 0xe9,
-	0x2a, 0xa1, 0xa6, 0x67, 0xf7, 0xb8, 0xbb, 0xa2, 0x3c, 0x4c, 0x76, 0x5d, 0xc7, 0xde, 0x55, 0x5e,
-	0x97, 0xd2, 0xfd, 0x21, 0x2a, 0xc0, 0x94, 0x6d, 0x11, 0x87, 0xd9, 0x6c, 0x20, 0xcd, 0xa4, 0x07,
-	0x63, 0xce, 0x75, 0x87, 0x34, 0xa9, 0xed, 0xeb, 0x59, 0xf7, 0x87, 0xe8, 0x12, 0xe4, 0x28, 0x31,
-	0xfb, 0x9e, 0xcd, 0x06, 0x86, 0xe9, 0x3a, 0x0c, 0x9b, 0x2c, 0x9f, 0x10, 0x24, 0x59, 0x7f, 0x7e,
-	0x55, 0x4e, 0x73, 0x10, 0x8b, 0x30, 0x6c, 0x77, 0x68, 0xfe, 0x84, 0x04, 0x51, 0x43, 0x74, 0x13,
-	0xa6, 0xba, 0x84, 0x61, 0x0b, 0x33, 0x9c, 0x4f, 0x0a, 0x99, 0x17, 0xc7, 0x69, 0xf4, 0xb6, 0xa2,
-	0x8b, 0x3a, 0x73, 0xc0, 0xac, 0x64, 0x6e, 0xc1, 0x94, 0x4f, 0x86, 0x5e, 0x83, 0x6c, 0xcf, 0x73,
-	0x5b, 0x76, 0x87, 0x18, 0x3d, 0xdb, 0x34, 0xfa, 0x9e, 0xad, 0xe4, 0xce, 0xa8, 0xe9, 0x4d, 0xdb,
-	0x6c, 0x78, 0x36, 0x7a, 0x03, 0x10, 0x75, 0x4d, 0x1b, 0x77, 0x8c, 0x1d, 0xec, 0x58, 0x1d, 0xc2,
-	0x29, 0xa9, 0x08, 0xad, 0x94, 0x9e, 0x93, 0x2b, 0xb7, 0xc4, 0x42, 0xc3, 0xb3, 0xa9, 0xda, 0xe7,
-	0xfe, 0x24, 0xa4, 0x82, 0xe8, 0x42, 0xab, 0x90, 0x73, 0x7b, 0xc4, 0xe3, 0xdf, 0x06, 0xb6, 0x2c,
-	0x8f, 0x50, 0xaa, 0xc2, 0x27, 0xff, 0xf8, 0xe1, 0x95, 0x53, 0x4a, 0x9e, 0xeb, 0x72, 0xa5, 0xce,
-	0x3c, 0xdb, 0x69, 0xeb, 0x59, 0x9f, 0x43, 0x4d, 0xa3, 0xef, 0x73, 0x1f, 0x73, 0x28, 0x71, 0x68,
-	0x9f, 0x1a, 0xbd, 0x7e, 0x73, 0x97, 0x0c, 0x94, 0x17, 0x9c, 0x1a, 0xf1, 0x82, 0xeb, 0xce, 0xa0,
-	0x92, 0xff, 0x63, 0x08, 0x6d, 0x7a, 0x83, 0x1e, 0x73, 0x4b, 0x9b, 0xfd, 0xe6, 0xbb, 0x64, 0xc0,
-	0x7d, 0x4b, 0xe1, 0x6c, 0x0a, 0x18, 0x74, 0x06, 0x92, 0x3f, 0xc6, 0x76, 0x87, 0x58, 0xc2, 0x84,
-	0x53, 0xba, 0x1a, 0xa1, 0x15, 0x48, 0x52, 0x86, 0x59, 0x9f, 0x0a, 0xbb, 0xcd, 0x5c, 0x2d, 0x8e,
-	0x53, 0x7d, 0xc5, 0x75, 0xac, 0xba, 0xa0, 0xd4, 0x15, 0x07, 0x5a, 0x85, 0x24, 0x73, 0x77, 0x89,
-	0xa3, 0x2c, 0x5a, 0xf9, 0xba, 0x0a, 0xbf, 0xd3, 0xa3, 0xe1, 0x57, 0x73, 0x58, 0x24, 0xf0, 0x6a,
-	0x0e, 0xd3, 0x15, 0x2b, 0xfa, 0x21, 0xe4, 0x2c, 0xd2, 0x21, 0x6d, 0xa1, 0x39, 0xba, 0x83, 0x3d,
-	0x42, 0x85, 0x17, 0xa4, 0x2a, 0xcb, 0x47, 0x8e, 0x66, 0x3d, 0x1b, 0x40, 0xd5, 0x05, 0x12, 0xda,
-	0x84, 0xb4, 0x15, 0xfa, 0x7f, 0x7e, 0x52, 0x28, 0xf3, 0xd5, 0x71, 0x32, 0x46, 0x42, 0x25, 0xea,
-	0x61, 0x51, 0x08, 0xee, 0xf2, 0x7d, 0xa7, 0xe9, 0x3a, 0x96, 0xed, 0xb4, 0x8d, 0x1d, 0x62, 0xb7,
-	0x77, 0x58, 0x7e, 0x6a, 0x51, 0x5b, 0x8a, 0xeb, 0xd9, 0x60, 0xfe, 0x96, 0x98, 0x46, 0x9b, 0x30,
-	0x13, 0x92, 0x8a, 0x90, 0x4e, 0x1d, 0x35, 0xa4, 0x33, 0x01, 0x00, 0x27, 0x41, 0xb7, 0x01, 0xc2,
-	0xa4, 0x91, 0x07, 0x81, 0x56, 0x3c, 0x3c, 0xfd, 0x44, 0x85, 0x89, 0x00, 0x20, 0x07, 0x4e, 0x76,
-	0x6d, 0xc7, 0xa0, 0xa4, 0xd3, 0x32, 0x94, 0xe6, 0x38, 0x6e, 0x5a, 0xa8, 0xff, 0xed, 0x23, 0x58,
-	0xf3, 0xd3, 0x87, 0x57, 0xb2, 0x72, 0x74, 0x85, 0x5a, 0xbb, 0x8b, 0x6f, 0x96, 0xbe, 0xf9, 0x2d,
-	0x7d, 0xb6, 0x6b, 0x3b, 0x75, 0xd2, 0x69, 0x55, 0x03, 0x60, 0xf4, 0x16, 0x9c, 0x0b, 0x15, 0xe2,
-	0x3a, 0xc6, 0x8e, 0xdb, 0xb1, 0x0c, 0x8f, 0xb4, 0x0c, 0xd3, 0xed, 0x3b, 0x2c, 0x3f, 0x2d, 0xd4,
-	0x78, 0x36, 0x20, 0xd9, 0x70, 0x6e, 0xb9, 0x1d, 0x4b, 0x27, 0xad, 0x55, 0xbe, 0x8c, 0x5e, 0x85,
-	0x50, 0x1b, 0x86, 0x6d, 0xd1, 0x7c, 0x66, 0x31, 0xbe, 0x94, 0xd0, 0xa7, 0x83, 0xc9, 0x9a, 0x45,
-	0x57, 0xa6, 0x3e, 0x78, 0xb0, 0x30, 0xf1, 0xf9, 0x83, 0x85, 0x89, 0xe2, 0x0d, 0x98, 0xde, 0xc6,
-	0x1d, 0x15, 0x5a, 0x84, 0xa2, 0x6b, 0x90, 0xc2, 0xfe, 0x20, 0xaf, 0xf1, 0xd0, 0x7e, 0x4e, 0x68,
-	0x86, 0xa4, 0xc5, 0xdf, 0x6a, 0x90, 0xac, 0x6e, 0x6f, 0x62, 0xdb, 0x43, 0x6b, 0x30, 0x1b, 0xfa,
-	0xea, 0x8b, 0x46, 0x79, 0xe8, 0xde, 0x7e, 0x98, 0xaf, 0xc3, 0xec, 0x9e, 0x9f, 0x38, 0x02, 0x18,
-	0x59, 0x1b, 0x2f, 0x3c, 0x7e, 0x78, 0xe5, 0xbc, 0x82, 0x09, 0x92, 0xcb, 0x3e, 0xbc, 0xbd, 0x7d,
-	0xf3, 0x11, 0x99, 0xdf, 0x81, 0x49, 0x79, 0x54, 0x8a, 0xbe, 0x0d, 0x27, 0x7a, 0xfc, 0x43, 0x88,
-	0x9a, 0xbe, 0x3a, 0x3f, 0xd6, 0xe7, 0x05, 0x7d, 0xd4, 0x43, 0x24, 0x5f, 0xf1, 0x67, 0x31, 0x80,
-	0xea, 0xf6, 0xf6, 0x96, 0x67, 0xf7, 0x3a, 0x84, 0x7d, 0x59, 0xb2, 0x37, 0xe0, 0x74, 0x28, 0x3b,
-	0xf5, 0xcc, 0xa3, 0xcb, 0x7f, 0x32, 0xe0, 0xaf, 0x7b, 0xe6, 0x81, 0xb0, 0x16, 0x65, 0x01, 0x6c,
-	0xfc, 0xe8, 0xb0, 0x55, 0xca, 0x46, 0x35, 0xfb, 0x3d, 0x48, 0x87, 0xca, 0xa0, 0xa8, 0x06, 0x53,
-	0x4c, 0x7d, 0x2b, 0x05, 0x17, 0xc7, 0x2b, 0xd8, 0x67, 0x1b, 0xaa, 0x5a, 0x3e, 0x7b, 0xf1, 0xdf,
-	0x1a, 0x40, 0x24, 0x46, 0xbe, 0x9a, 0x3e, 0x86, 0x6a, 0x90, 0x54, 0xc9, 0x39, 0x7e, 0xdc, 0xe4,
-	0xac, 0x00, 0x22, 0x4a, 0xfd, 0x79, 0x0c, 0x4e, 0x36, 0xfc, 0xe8, 0xfd, 0xea, 0xeb, 0xa0, 0x01,
-	0x93, 0xc4, 0x61, 0x9e, 0x2d, 0x94, 0xc0, 0x6d, 0xfe, 0xe6, 0x38, 0x9b, 0x1f, 0x20, 0xd4, 0x9a,
-	0xc3, 0xbc, 0x41, 0xd4, 0x03, 0x7c, 0xac, 0x88, 0x3e, 0x7e, 0x19, 0x87, 0xfc, 0x38, 0x56, 0xf4,
-	0x3a, 0x64, 0x4d, 0x8f, 0x88, 0x09, 0xbf, 0xee, 0x68, 0x22, 0x61, 0xce, 0xf8, 0xd3, 0xaa, 0xec,
-	0xe8, 0xc0, 0x6f, 0x96, 0xdc, 0xb9, 0x38, 0xe9, 0xf1, 0xae, 0x92, 0x33, 0x21, 0x82, 0x28, 0x3c,
-	0x5b, 0x90, 0xb5, 0x1d, 0x9b, 0xf1, 0x1b, 0x52, 0x13, 0x77, 0xb0, 0x63, 0xfa, 0x57, 0xee, 0x23,
-	0xd5, 0xfc, 0x19, 0x85, 0x51, 0x91, 0x10, 0x68, 0x0d, 0x26, 0x7d, 0xb4, 0xc4, 0xd1, 0xd1, 0x7c,
-	0x5e, 0x74, 0x01, 0xa6, 0xa3, 0x85, 0x41, 0xdc, 0x46, 0x12, 0x7a, 0x3a, 0x52, 0x17, 0x0e, 0xab,
-	0x3c, 0xc9, 0xe7, 0x56, 0x1e, 0x75, 0xe1, 0xfb, 0x55, 0x1c, 0x66, 0x75, 0x62, 0xfd, 0xef, 0x9b,
-	0x65, 0x13, 0x40, 0x86, 0x2a, 0xcf, 0xa4, 0xca, 0x32, 0xc7, 0x88, 0xf7, 0x94, 0x04, 0xa9, 0x52,
-	0xf6, 0xdf, 0xb2, 0xd0, 0x5f, 0x62, 0x30, 0x1d, 0xb5, 0xd0, 0xff, 0x65, 0xd1, 0x42, 0xeb, 0x61,
-	0x9a, 0x4a, 0x88, 0x34, 0x75, 0x69, 0x5c, 0x9a, 0x1a, 0xf1, 0xe6, 0x43, 0xf2, 0xd3, 0x17, 0x71,
-	0x48, 0x6e, 0x62, 0x0f, 0x77, 0x29, 0xda, 0x18, 0xb9, 0xdb, 0xfa, 0x6d, 0x8c, 0xfd, 0xce, 0x5c,
-	0x55, 0xbd, 0x12, 0xe9, 0xcb, 0x1f, 0x8d, 0xbb, 0xda, 0x7e, 0x0d, 0x66, 0xf8, 0xa3, 0x3e, 0x10,
-	0x48, 0x2a, 0x37, 0x23, 0xde, 0xe6, 0x81, 0xf4, 0x14, 0x2d, 0x40, 0x9a, 0x93, 0x85, 0x79, 0x98,
-	0xd3, 0x40, 0x17, 0xdf, 0x5d, 0x93, 0x33, 0x68, 0x19, 0xd0, 0x4e, 0xd0, 0x69, 0x31, 0x42, 0x45,
-	0x68, 0x4b, 0x99, 0x4a, 0x2c, 0xaf, 0xe9, 0xb3, 0xe1, 0xaa, 0xcf, 0x72, 0x1e, 0x80, 0x9f, 0xc4,
-	0xb0, 0x88, 0xe3, 0x76, 0xd5, 0xeb, 0x34, 0xc5, 0x67, 0xaa, 0x7c, 0x02, 0xfd, 0x54, 0x93, 0xd7,
-	0xe4, 0x7d, 0xcf, 0x7f, 0xf5, 0x4a, 0xd9, 0x7a, 0x81, 0xc0, 0xf8, 0xd7, 0x93, 0x85, 0xc2, 0x00,
-	0x77, 0x3b, 0x2b, 0xc5, 0x03, 0x70, 0x8a, 0x07, 0x75, 0x24, 0xf8, 0xe5, 0x79, 0xb8, 0x7d, 0x80,
-	0x6a, 0x90, 0xdb, 0x25, 0x03, 0xc3, 0x73, 0x99, 0x4c, 0x36, 0x2d, 0x42, 0xd4, 0x7b, 0x66, 0xce,
-	0xb7, 0x6f, 0x13, 0x53, 0x12, 0xb9, 0xfe, 0xdb, 0x4e, 0x25, 0xc1, 0x4f, 0xa7, 0xcf, 0xec, 0x92,
-	0x81, 0xae, 0xf8, 0x6e, 0x10, 0xb2, 0x72, 0x91, 0x47, 0xcb, 0xbd, 0xcf, 0x3e, 0xbe, 0x7c, 0x2e,
-	0xbc, 0xb4, 0x97, 0xef, 0x06, 0x8d, 0x3d, 0x69, 0x62, 0x7e, 0xf1, 0x45, 0x61, 0x11, 0xd2, 0x09,
-	0xed, 0xf1, 0x37, 0x25, 0x7f, 0x83, 0x44, 0xde, 0x0a, 0xda, 0xf3, 0xdf, 0x20, 0x21, 0xff, 0xd0,
-	0x1b, 0x24, 0x12, 0xa2, 0x6f, 0x87, 0x35, 0x20, 0x76, 0x98, 0x34, 0x51, 0xef, 0x54, 0x4c, 0x22,
-	0xf2, 0x27, 0x8a, 0x7f, 0xd2, 0x60, 0x6e, 0xc4, 0x9b, 0x83, 0x23, 0x9b, 0x80, 0xbc, 0xc8, 0xa2,
-	0xf0, 0x8a, 0x81, 0x3a, 0xfa, 0xf1, 0x82, 0x63, 0xd6, 0x1b, 0x29, 0x04, 0x5f, 0x4e, 0x31, 0x53,
-	0x99, 0xec, 0x0f, 0x1a, 0x9c, 0x8a, 0x1e, 0x20, 0x10, 0xa5, 0x0e, 0xd3, 0xd1, 0xad, 0x95, 0x10,
-	0x17, 0x5f, 0x44, 0x88, 0xe8, 0xf9, 0x87, 0x40, 0xd0, 0x76, 0x98, 0x31, 0x64, 0x3b, 0x71, 0xf9,
-	0x85, 0x95, 0xe2, 0x1f, 0xec, 0xc0, 0xcc, 0x21, 0x6d, 0xf3, 0x85, 0x06, 0x89, 0x4d, 0xd7, 0xed,
-	0xa0, 0x9f, 0xc0, 0xac, 0xe3, 0x32, 0x83, 0x47, 0x16, 0xb1, 0x0c, 0xd5, 0x3a, 0x90, 0xd9, 0x78,
-	0xed, 0xb9, 0xba, 0xfa, 0xfb, 0x93, 0x85, 0x51, 0xce, 0x61, 0x05, 0xaa, 0x96, 0x9a, 0xe3, 0xb2,
-	0x8a, 0x20, 0xda, 0x92, 0xdd, 0x85, 0x16, 0x64, 0x86, 0xb7, 0x93, 0x19, 0xfb, 0xfa, 0x61, 0xdb,
-	0x65, 0x0e, 0xdd, 0x6a, 0xba, 0x19, 0xd9, 0x67, 0x65, 0x8a, 0x5b, 0xed, 0x1f, 0xdc, 0x72, 0xef,
-	0x43, 0x2e, 0x48, 0x57, 0x0d, 0xd1, 0x8f, 0xa3, 0xe8, 0x06, 0x4c, 0xca, 0xd6, 0x9c, 0xff, 0x58,
-	0xb8, 0x10, 0x36, 0x7b, 0x71, 0xd3, 0xb4, 0x4b, 0x7b, 0x91, 0x46, 0xad, 0x64, 0x1a, 0xd2, 0xa7,
-	0x62, 0x16, 0xfd, 0xda, 0x47, 0x31, 0x98, 0x5b, 0x75, 0x1d, 0xaa, 0x1a, 0x3d, 0x2a, 0xaa, 0x65,
-	0x73, 0x79, 0x80, 0x2e, 0x8d, 0x69, 0x43, 0x4d, 0x8f, 0x36, 0x9b, 0xb6, 0x21, 0xcb, 0x4b, 0xac,
-	0xe9, 0x3a, 0x2f, 0xd9, 0x6b, 0xca, 0xb8, 0x1d, 0x4b, 0x9d, 0x68, 0x97, 0x0c, 0x38, 0xae, 0x43,
-	0xee, 0x0c, 0xe1, 0xc6, 0x8f, 0x87, 0xeb, 0x90, 0x3b, 0x11, 0xdc, 0x33, 0x90, 0x54, 0xf7, 0xab,
-	0x84, 0xb8, 0x3d, 0xa8, 0x11, 0xba, 0x06, 0x71, 0x9e, 0x0a, 0x4f, 0x1c, 0x21, 0x79, 0x70, 0x86,
-	0x48, 0x59, 0xab, 0xc3, 0x9c, 0xea, 0x14, 0xd0, 0x8d, 0x96, 0xd0, 0x28, 0x11, 0x02, 0xbd, 0x4b,
-	0x06, 0x07, 0xb4, 0x0d, 0xa6, 0x5f, 0xa8, 0x6d, 0x70, 0xf9, 0x77, 0x1a, 0x40, 0xd8, 0x33, 0x43,
-	0x6f, 0xc0, 0xd9, 0xca, 0xc6, 0x7a, 0xd5, 0xa8, 0x6f, 0x5d, 0xdf, 0x6a, 0xd4, 0x8d, 0xc6, 0x7a,
-	0x7d, 0x73, 0x6d, 0xb5, 0x76, 0xa3, 0xb6, 0x56, 0xcd, 0x4d, 0x14, 0xb2, 0xf7, 0xee, 0x2f, 0xa6,
-	0x1b, 0x0e, 0xed, 0x11, 0xd3, 0x6e, 0xd9, 0xc4, 0x42, 0xaf, 0xc1, 0xa9, 0x61, 0x6a, 0x3e, 0x5a,
-	0xab, 0xe6, 0xb4, 0xc2, 0xf4, 0xbd, 0xfb, 0x8b, 0x53, 0xf2, 0x8d, 0x40, 0x2c, 0xb4, 0x04, 0xa7,
-	0x47, 0xe9, 0x6a, 0xeb, 0x37, 0x73, 0xb1, 0x42, 0xe6, 0xde, 0xfd, 0xc5, 0x54, 0xf0, 0x98, 0x40,
-	0x45, 0x40, 0x51, 0x4a, 0x85, 0x17, 0x2f, 0xc0, 0xbd, 0xfb, 0x8b, 0x49, 0x19, 0x32, 0x85, 0xc4,
-	0x07, 0xbf, 0x9e, 0x9f, 0xb8, 0xfc, 0x23, 0x80, 0x9a, 0xd3, 0xf2, 0xb0, 0x29, 0x52, 0x43, 0x01,
-	0xce, 0xd4, 0xd6, 0x6f, 0xe8, 0xd7, 0x57, 0xb7, 0x6a, 0x1b, 0xeb, 0xc3, 0xc7, 0xde, 0xb7, 0x56,
-	0xdd, 0x68, 0x54, 0xde, 0x5b, 0x33, 0xea, 0xb5, 0x9b, 0xeb, 0x39, 0x0d, 0x9d, 0x85, 0x93, 0x43,
-	0x6b, 0xdf, 0x5d, 0xdf, 0xaa, 0xdd, 0x5e, 0xcb, 0xc5, 0x2a, 0xd7, 0x3e, 0x79, 0x3a, 0xaf, 0x3d,
-	0x7a, 0x3a, 0xaf, 0xfd, 0xed, 0xe9, 0xbc, 0xf6, 0xe1, 0xb3, 0xf9, 0x89, 0x47, 0xcf, 0xe6, 0x27,
-	0xfe, 0xfc, 0x6c, 0x7e, 0xe2, 0x07, 0xaf, 0x0c, 0x05, 0x63, 0x58, 0x8e, 0xc4, 0xdf, 0x21, 0xcd,
-	0xa4, 0xf0, 0x9a, 0x6f, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x85, 0x67, 0xcc, 0xc5, 0x86, 0x1a,
-	0x00, 0x00,
+	// 2412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3e, 0x8a, 0x22, 0x35, 0x76, 0x1c, 0x4a, 0xb6, 0x45, 0x99, 0x71,
+	0x13, 0xd9, 0x8d, 0x29, 0xcb, 0x2d, 0x5c, 0x40, 0x08, 0x52, 0x98, 0xa2, 0x6c, 0x33, 0xb1, 0x25,
+	0x75, 0x29, 0xa9, 0xff, 0x5e, 0x0c, 0x77, 0x87, 0xd4, 0x56, 0xcb, 0x5d, 0x7a, 0x67, 0x29, 0x9b,
+	0xf7, 0x1c, 0x02, 0x17, 0x05, 0x72, 0x28, 0x8a, 0x00, 0x85, 0x51, 0x03, 0xbd, 0xb4, 0xb7, 0x1c,
+	0x8c, 0xde, 0x7b, 0x4b, 0x8b, 0x16, 0x30, 0x7c, 0x2a, 0x02, 0xd4, 0x2d, 0xec, 0x43, 0x82, 0xf6,
+	0xd2, 0xf6, 0xd4, 0x63, 0x31, 0x3f, 0xfb, 0xc3, 0x3f, 0xeb, 0xc7, 0x46, 0x11, 0xb4, 0x17, 0x82,
+	0x33, 0xf3, 0xde, 0x37, 0xf3, 0xde, 0xbc, 0xbf, 0x79, 0x0b, 0xe7, 0x74, 0x87, 0xb6, 0x1c, 0xba,
+	0x48, 0x3d, 0xbc, 0x6b, 0xda, 0xcd, 0xc5, 0xbd, 0xa5, 0x3a, 0xf1, 0xf0, 0x92, 0x3f, 0x2e, 0xb5,
+	0x5d, 0xc7, 0x73, 0xd0, 0x49, 0x41, 0x55, 0xf2, 0x67, 0x25, 0xd5, 0xec, 0x34, 0x6e, 0x99, 0xb6,
+	0xb3, 0xc8, 0x7f, 0x05, 0xe9, 0xec, 0x69, 0xdd, 0x69, 0x11, 0xaf, 0xde, 0xf0, 0x16, 0x71, 0x5d,
+	0x37, 0x17, 0xf7, 0x96, 0x16, 0xbd, 0x6e, 0x9b, 0x50, 0xb9, 0x7a, 0x26, 0x58, 0xe5, 0xb3, 0xfd,
+	0xcb, 0x73, 0xf2, 0x34, 0x75, 0x4c, 0x49, 0x70, 0x14, 0xdd, 0x31, 0x6d, 0xb9, 0x3e, 0x23, 0xd6,
+	0x35, 0x3e, 0x5a, 0x94, 0x87, 0x12, 0x4b, 0x27, 0x9a, 0x4e, 0xd3, 0x11, 0xf3, 0xec, 0x9f, 0xcf,
+	0xd0, 0x74, 0x9c, 0xa6, 0x45, 0x16, 0xf9, 0xa8, 0xde, 0x69, 0x2c, 0x62, 0xbb, 0xeb, 0xef, 0xd5,
+	0xbf, 0x64, 0x74, 0x5c, 0xec, 0x99, 0x8e, 0xbf, 0x57, 0xa1, 0x7f, 0xdd, 0x33, 0x5b, 0x84, 0x7a,
+	0xb8, 0xd5, 0x16, 0x04, 0xc5, 0x8f, 0x15, 0x98, 0xba, 0x61, 0x52, 0xcf, 0x71, 0x4d, 0x1d, 0x5b,
+	0x55, 0xbb, 0xe1, 0xa0, 0x77, 0x20, 0xb9, 0x43, 0xb0, 0x41, 0xdc, 0xbc, 0x32, 0xaf, 0x2c, 0xa4,
+	0x2f, 0xcf, 0x94, 0x7c, 0x79, 0x4b, 0x42, 0xcc, 0xbd, 0xa5, 0xd2, 0x0d, 0x4e, 0x50, 0x4e, 0x7d,
+	0xfa, 0xb4, 0x30, 0xf6, 0xab, 0xcf, 0x3f, 0xb9, 0xa0, 0xa8, 0x92, 0x07, 0x55, 0x20, 0xb9, 0x87,
+	0x2d, 0x4a, 0xbc, 0x7c, 0x6c, 0x3e, 0xbe, 0x90, 0xbe, 0x7c, 0xb6, 0x34, 0x5c, 0xed, 0xa5, 0x6d,
+	0x6c, 0x99, 0x06, 0xf6, 0x9c, 0x5e, 0x14, 0xc1, 0xbb, 0x1c, 0xcb, 0x2b, 0xc5, 0x9f, 0xc5, 0x20,
+	0xbb, 0xe2, 0xb4, 0x5a, 0x26, 0xa5, 0xa6, 0x63, 0xab, 0xd8, 0x23, 0x14, 0xbd, 0x07, 0x09, 0x17,
+	0x7b, 0x84, 0x9f, 0x2c, 0x55, 0xbe, 0xc2, 0x18, 0x3f, 0x7b, 0x5a, 0x38, 0x25, 0xb6, 0xa0, 0xc6,
+	0x6e, 0xc9, 0x74, 0x16, 0x5b, 0xd8, 0xdb, 0x29, 0xdd, 0x24, 0x4d, 0xac, 0x77, 0x2b, 0x44, 0x7f,
+	0xf2, 0xe8, 0x22, 0xc8, 0x13, 0x54, 0x88, 0x2e, 0x76, 0xe1, 0x18, 0xe8, 0x5b, 0x30, 0xd1, 0xc2,
+	0xf7, 0x34, 0x8e, 0x17, 0x7b, 0x29, 0xbc, 0xf1, 0x16, 0xbe, 0xc7, 0xce, 0x87, 0x6e, 0x43, 0x96,
+	0x41, 0xea, 0x3b, 0xd8, 0x6e, 0x12, 0x81, 0x1c, 0x7f, 0x29, 0xe4, 0x4c, 0x0b, 0xdf, 0x5b, 0xe1,
+	0x68, 0x0c, 0x7f, 0x39, 0xf1, 0xc5, 0xc3, 0x82, 0x52, 0xfc, 0xad, 0x02, 0x10, 0x2a, 0x06, 0x61,
+	0xc8, 0xe9, 0xc1, 0x88, 0x6f, 0x4a, 0xe5, 0xcd, 0xbd, 0x35, 0x4a, 0xf7, 0x7d, 0x6a, 0x2d, 0x67,
+	0xd8, 0xf1, 0x1e, 0x3f, 0x2d, 0x28, 0x62, 0xd7, 0xac, 0x3e, 0xa0, 0xf6, 0x74, 0xa7, 0x6d, 0x60,
+	0x8f, 0x68, 0xcc, 0x7e, 0xb8, 0xb6, 0xd2, 0x97, 0x67, 0x4b, 0xc2, 0xb8, 0x4a, 0xbe, 0x71, 0x95,
+	0x36, 0x7d, 0xe3, 0x12, 0x80, 0x1f, 0xfd, 0xc5, 0x07, 0x04, 0xc1, 0xcd, 0xd6, 0xa5, 0x0c, 0xff,
+	0x54, 0x20, 0x5d, 0x21, 0x54, 0x77, 0xcd, 0x36, 0x33, 0x57, 0x94, 0x87, 0xf1, 0x96, 0x63, 0x9b,
+	0xbb, 0xd2, 0xea, 0x52, 0xaa, 0x3f, 0x44, 0xb3, 0x30, 0x61, 0x1a, 0xc4, 0xf6, 0x4c, 0xaf, 0x2b,
+	0xae, 0x49, 0x0d, 0xc6, 0x8c, 0xeb, 0x2e, 0xa9, 0x53, 0xd3, 0xd7, 0xb3, 0xea, 0x0f, 0xd1, 0x79,
+	0xc8, 0x51, 0xa2, 0x77, 0x5c, 0xd3, 0xeb, 0x6a, 0xba, 0x63, 0x7b, 0x58, 0xf7, 0xf2, 0x09, 0x4e,
+	0x92, 0xf5, 0xe7, 0x57, 0xc4, 0x34, 0x03, 0x31, 0x88, 0x87, 0x4d, 0x8b, 0xe6, 0x8f, 0x09, 0x10,
+	0x39, 0x44, 0xd7, 0x61, 0xa2, 0x45, 0x3c, 0x6c, 0x60, 0x0f, 0xe7, 0x93, 0x5c, 0xe6, 0xf9, 0x51,
+	0x1a, 0xbd, 0x25, 0xe9, 0xa2, 0xc6, 0x1c, 0x30, 0x4b, 0x99, 0x1b, 0x30, 0xe1, 0x93, 0xa1, 0x37,
+	0x21, 0xdb, 0x76, 0x9d, 0x86, 0x69, 0x11, 0xad, 0x6d, 0xea, 0x5a, 0xc7, 0x35, 0xa5, 0xdc, 0x19,
+	0x39, 0xbd, 0x61, 0xea, 0x5b, 0xae, 0x89, 0xde, 0x06, 0x44, 0x1d, 0xdd, 0xc4, 0x96, 0xb6, 0x83,
+	0x6d, 0xc3, 0x22, 0x8c, 0x92, 0x72, 0xd7, 0x4a, 0xa9, 0x39, 0xb1, 0x72, 0x83, 0x2f, 0x6c, 0xb9,
+	0x26, 0x95, 0xfb, 0x3c, 0x18, 0x87, 0x54, 0xe0, 0x5d, 0x68, 0x05, 0x72, 0x4e, 0x9b, 0xb8, 0xec,
+	0xbf, 0x86, 0x0d, 0xc3, 0x25, 0x94, 0x4a, 0xf7, 0xc9, 0x3f, 0x79, 0x74, 0xf1, 0x84, 0x94, 0xe7,
+	0xaa, 0x58, 0xa9, 0x79, 0xae, 0x69, 0x37, 0xd5, 0xac, 0xcf, 0x21, 0xa7, 0xd1, 0x77, 0x99, 0x8d,
+	0xd9, 0x94, 0xd8, 0xb4, 0x43, 0xb5, 0x76, 0xa7, 0xbe, 0x4b, 0xba, 0xd2, 0x0a, 0x4e, 0x0c, 0x58,
+	0xc1, 0x55, 0xbb, 0x5b, 0xce, 0xff, 0x3e, 0x84, 0xd6, 0xdd, 0x6e, 0xdb, 0x73, 0x4a, 0x1b, 0x9d,
+	0xfa, 0xfb, 0xa4, 0xab, 0x66, 0x03, 0x9c, 0x0d, 0x0e, 0x83, 0x4e, 0x42, 0xf2, 0x47, 0xd8, 0xb4,
+	0x88, 0xc1, 0xaf, 0x70, 0x42, 0x95, 0x23, 0xb4, 0x0c, 0x49, 0xea, 0x61, 0xaf, 0x43, 0xf9, 0xbd,
+	0x4d, 0x5d, 0x2e, 0x8e, 0x52, 0x7d, 0xd9, 0xb1, 0x8d, 0x1a, 0xa7, 0x54, 0x25, 0x07, 0x5a, 0x81,
+	0xa4, 0xe7, 0xec, 0x12, 0x5b, 0xde, 0x68, 0xf9, 0xab, 0xd2, 0xfd, 0x5e, 0x1b, 0x74, 0xbf, 0xaa,
+	0xed, 0x45, 0x1c, 0xaf, 0x6a, 0x7b, 0xaa, 0x64, 0x45, 0x3f, 0x80, 0x9c, 0x41, 0x2c, 0xd2, 0xe4,
+	0x9a, 0xa3, 0x3b, 0xd8, 0x25, 0x94, 0x5b, 0x41, 0xaa, 0xbc, 0x74, 0x68, 0x6f, 0x56, 0xb3, 0x01,
+	0x54, 0x8d, 0x23, 0xa1, 0x0d, 0x48, 0x1b, 0xa1, 0xfd, 0xe7, 0xc7, 0xb9, 0x32, 0xdf, 0x18, 0x25,
+	0x63, 0xc4, 0x55, 0xa2, 0x16, 0x16, 0x85, 0x60, 0x26, 0xdf, 0xb1, 0xeb, 0x8e, 0x6d, 0x98, 0x76,
+	0x53, 0xdb, 0x21, 0x66, 0x73, 0xc7, 0xcb, 0x4f, 0xcc, 0x2b, 0x0b, 0x71, 0x35, 0x1b, 0xcc, 0xdf,
+	0xe0, 0xd3, 0x68, 0x03, 0xa6, 0x42, 0x52, 0xee, 0xd2, 0xa9, 0xc3, 0xba, 0x74, 0x26, 0x00, 0x60,
+	0x24, 0xe8, 0x16, 0x40, 0x18, 0x34, 0xf2, 0xc0, 0xd1, 0x8a, 0xfb, 0x87, 0x9f, 0xa8, 0x30, 0x11,
+	0x00, 0x64, 0xc3, 0xf1, 0x96, 0x69, 0x6b, 0x94, 0x58, 0x0d, 0x4d, 0x6a, 0x8e, 0xe1, 0xa6, 0xb9,
+	0xfa, 0xdf, 0x3d, 0xc4, 0x6d, 0x7e, 0xf6, 0xe8, 0x62, 0x56, 0x8c, 0x2e, 0x52, 0x63, 0x77, 0xfe,
+	0x52, 0xe9, 0xeb, 0xdf, 0x50, 0xa7, 0x5b, 0xa6, 0x5d, 0x23, 0x56, 0xa3, 0x12, 0x00, 0xa3, 0x77,
+	0xe0, 0x54, 0xa8, 0x10, 0xc7, 0xd6, 0x76, 0x1c, 0xcb, 0xd0, 0x5c, 0xd2, 0xd0, 0x74, 0xa7, 0x63,
+	0x7b, 0xf9, 0x49, 0xae, 0xc6, 0xd7, 0x03, 0x92, 0x75, 0xfb, 0x86, 0x63, 0x19, 0x2a, 0x69, 0xac,
+	0xb0, 0x65, 0xf4, 0x06, 0x84, 0xda, 0xd0, 0x4c, 0x83, 0xe6, 0x33, 0xf3, 0xf1, 0x85, 0x84, 0x3a,
+	0x19, 0x4c, 0x56, 0x0d, 0xba, 0x3c, 0xf1, 0xe1, 0xc3, 0xc2, 0xd8, 0x17, 0x0f, 0x0b, 0x63, 0xc5,
+	0x6b, 0x30, 0xb9, 0x8d, 0x2d, 0xe9, 0x5a, 0x84, 0xa2, 0x2b, 0x90, 0xc2, 0xfe, 0x20, 0xaf, 0xcc,
+	0xc7, 0x5f, 0xe8, 0x9a, 0x21, 0x69, 0xf1, 0xd7, 0x0a, 0x24, 0x2b, 0xdb, 0x1b, 0xd8, 0x74, 0xd1,
+	0x2a, 0x4c, 0x87, 0xb6, 0x7a, 0x50, 0x2f, 0x0f, 0xcd, 0xdb, 0x77, 0xf3, 0x35, 0x98, 0xde, 0xf3,
+	0x03, 0x47, 0x00, 0x23, 0x72, 0xe3, 0xd9, 0x27, 0x8f, 0x2e, 0x9e, 0x91, 0x30, 0x41, 0x70, 0xe9,
+	0xc3, 0xdb, 0xeb, 0x9b, 0x8f, 0xc8, 0xfc, 0x1e, 0x8c, 0x8b, 0xa3, 0x52, 0xf4, 0x4d, 0x38, 0xd6,
+	0x66, 0x7f, 0xb8, 0xa8, 0xe9, 0xcb, 0x73, 0x23, 0x6d, 0x9e, 0xd3, 0x47, 0x2d, 0x44, 0xf0, 0x15,
+	0x7f, 0x1c, 0x03, 0xa8, 0x6c, 0x6f, 0x6f, 0xba, 0x66, 0xdb, 0x22, 0xde, 0xab, 0x92, 0x7d, 0x0b,
+	0x5e, 0x0b, 0x65, 0xa7, 0xae, 0x7e, 0x78, 0xf9, 0x8f, 0x07, 0xfc, 0x35, 0x57, 0x1f, 0x0a, 0x6b,
+	0x50, 0x2f, 0x80, 0x8d, 0x1f, 0x1e, 0xb6, 0x42, 0xbd, 0x41, 0xcd, 0x7e, 0x07, 0xd2, 0xa1, 0x32,
+	0x28, 0xaa, 0xc2, 0x84, 0x27, 0xff, 0x4b, 0x05, 0x17, 0x47, 0x2b, 0xd8, 0x67, 0xeb, 0xc9, 0x5a,
+	0x3e, 0x7b, 0xf1, 0xdf, 0x0a, 0x40, 0xc4, 0x47, 0xbe, 0x9c, 0x36, 0x86, 0xaa, 0x90, 0x94, 0xc1,
+	0x39, 0x7e, 0xd4, 0xe0, 0x2c, 0x01, 0x22, 0x4a, 0xfd, 0x49, 0x0c, 0x8e, 0x6f, 0xf9, 0xde, 0xfb,
+	0xe5, 0xd7, 0xc1, 0x16, 0x8c, 0x13, 0xdb, 0x73, 0x4d, 0xae, 0x04, 0x76, 0xe7, 0x97, 0x46, 0xdd,
+	0xf9, 0x10, 0xa1, 0x56, 0x6d, 0xcf, 0xed, 0x46, 0x2d, 0xc0, 0xc7, 0x8a, 0xe8, 0xe3, 0xe7, 0x71,
+	0xc8, 0x8f, 0x62, 0x45, 0x6f, 0x41, 0x56, 0x77, 0x09, 0x9f, 0xf0, 0xf3, 0x8e, 0xc2, 0x03, 0xe6,
+	0x94, 0x3f, 0x2d, 0xd3, 0x8e, 0x0a, 0xac, 0xb2, 0x64, 0xc6, 0xc5, 0x48, 0x8f, 0x56, 0x4a, 0x4e,
+	0x85, 0x08, 0x3c, 0xf1, 0x6c, 0x42, 0xd6, 0xb4, 0x4d, 0x8f, 0x55, 0x48, 0x75, 0x6c, 0x61, 0x5b,
+	0xf7, 0x4b, 0xee, 0x43, 0xe5, 0xfc, 0x29, 0x89, 0x51, 0x16, 0x10, 0x68, 0x15, 0xc6, 0x7d, 0xb4,
+	0xc4, 0xe1, 0xd1, 0x7c, 0x5e, 0x74, 0x16, 0x26, 0xa3, 0x89, 0x81, 0x57, 0x23, 0x09, 0x35, 0x1d,
+	0xc9, 0x0b, 0xfb, 0x65, 0x9e, 0xe4, 0x0b, 0x33, 0x8f, 0x2c, 0xf8, 0x7e, 0x11, 0x87, 0x69, 0x95,
+	0x18, 0xff, 0xfb, 0xd7, 0xb2, 0x01, 0x20, 0x5c, 0x95, 0x45, 0xd2, 0x7c, 0xe2, 0xa8, 0xfe, 0x9e,
+	0x12, 0x20, 0x15, 0xea, 0xfd, 0xb7, 0x6e, 0xe8, 0xcf, 0x31, 0x98, 0x8c, 0xde, 0xd0, 0xff, 0x65,
+	0xd2, 0x42, 0x6b, 0x61, 0x98, 0x4a, 0xf0, 0x30, 0x75, 0x7e, 0x54, 0x98, 0x1a, 0xb0, 0xe6, 0x7d,
+	0xe2, 0xd3, 0x1f, 0xc6, 0x21, 0xb9, 0x81, 0x5d, 0xdc, 0xa2, 0x68, 0x7d, 0xa0, 0xb6, 0xf5, 0xdb,
+	0x18, 0xfd, 0xc6, 0x5c, 0x91, 0xbd, 0x12, 0x61, 0xcb, 0x1f, 0x8f, 0x2a, 0x6d, 0xbf, 0x02, 0x53,
+	0xec, 0x51, 0x1f, 0x08, 0x24, 0x94, 0x9b, 0xe1, 0x6f, 0xf3, 0x40, 0x7a, 0x8a, 0x0a, 0x90, 0x66,
+	0x64, 0x61, 0x1c, 0x66, 0x34, 0xd0, 0xc2, 0xf7, 0x56, 0xc5, 0x0c, 0x5a, 0x02, 0xb4, 0x13, 0x74,
+	0x5a, 0xb4, 0x50, 0x11, 0xca, 0x42, 0xa6, 0x1c, 0xcb, 0x2b, 0xea, 0x74, 0xb8, 0xea, 0xb3, 0x9c,
+	0x01, 0x60, 0x27, 0xd1, 0x0c, 0x62, 0x3b, 0x2d, 0xf9, 0x3a, 0x4d, 0xb1, 0x99, 0x0a, 0x9b, 0x40,
+	0x1f, 0x28, 0xa2, 0x4c, 0xee, 0x7b, 0xfe, 0xcb, 0x57, 0xca, 0xe6, 0x01, 0x1c, 0xe3, 0x5f, 0x4f,
+	0x0b, 0xb3, 0x5d, 0xdc, 0xb2, 0x96, 0x8b, 0x43, 0x70, 0x8a, 0xc3, 0x3a, 0x12, 0xac, 0x78, 0xee,
+	0x6d, 0x1f, 0xa0, 0x2a, 0xe4, 0x76, 0x49, 0x57, 0x73, 0x1d, 0x4f, 0x04, 0x9b, 0x06, 0x21, 0xf9,
+	0xf1, 0xa0, 0x75, 0xc4, 0xd9, 0x59, 0x2f, 0x2c, 0x52, 0xfe, 0x9b, 0x76, 0x39, 0xc1, 0x4e, 0xa7,
+	0x4e, 0xed, 0x92, 0xae, 0x2a, 0xf9, 0xae, 0x11, 0x82, 0xee, 0xc0, 0x4c, 0xd3, 0x72, 0xea, 0xd8,
+	0xd2, 0x2c, 0xf3, 0x4e, 0xc7, 0x34, 0x34, 0x69, 0x18, 0x9a, 0x8e, 0xdb, 0xf9, 0x89, 0x97, 0x6a,
+	0xa5, 0x9c, 0x14, 0xc0, 0x37, 0x39, 0x6e, 0x4d, 0xc0, 0xae, 0xe0, 0x36, 0xba, 0x0b, 0xa7, 0x43,
+	0x5b, 0x1f, 0xb2, 0x6b, 0xea, 0xa5, 0x76, 0x9d, 0x09, 0xb0, 0x07, 0x36, 0x5e, 0x85, 0x42, 0x44,
+	0xe1, 0xb2, 0x67, 0xd4, 0x26, 0xae, 0xe9, 0x18, 0x9a, 0x45, 0xec, 0xa6, 0xb7, 0xc3, 0xdf, 0x51,
+	0x09, 0xf5, 0x74, 0x48, 0x26, 0x7a, 0x41, 0x1b, 0x9c, 0xe8, 0x26, 0xa7, 0x19, 0x0e, 0x63, 0x10,
+	0x0b, 0x77, 0x25, 0x18, 0xe5, 0xcf, 0xa6, 0xcc, 0x20, 0x4c, 0x85, 0x11, 0x09, 0x2c, 0x8a, 0x48,
+	0xe4, 0xc5, 0xc5, 0x6d, 0x8e, 0xfb, 0x06, 0x7f, 0xf9, 0x1c, 0x5d, 0xfa, 0x9c, 0x7c, 0x69, 0xb1,
+	0x27, 0xba, 0xca, 0xf0, 0x96, 0xcf, 0xb1, 0x70, 0x78, 0xff, 0xf3, 0x4f, 0x2e, 0x9c, 0x0a, 0x5f,
+	0x65, 0x8b, 0xf7, 0x82, 0xce, 0xad, 0xf0, 0xe1, 0xe2, 0x07, 0x31, 0xc8, 0xad, 0xf4, 0x9d, 0x76,
+	0x78, 0xd1, 0xa4, 0x1c, 0xbd, 0x68, 0xf2, 0x7b, 0x89, 0xb1, 0x57, 0xd0, 0x4b, 0x64, 0xed, 0x26,
+	0x7d, 0x87, 0x18, 0x1d, 0x8b, 0x18, 0x7e, 0xb2, 0x8d, 0x8b, 0xb7, 0x77, 0x30, 0x2f, 0xb3, 0xed,
+	0x79, 0xc8, 0x91, 0x46, 0x83, 0xe8, 0x9e, 0xb9, 0x47, 0x7c, 0xd2, 0x84, 0x20, 0x0d, 0xe6, 0x05,
+	0x69, 0xf1, 0xa7, 0x71, 0xc8, 0x0a, 0xb3, 0x09, 0xdb, 0x39, 0x37, 0x47, 0xb6, 0x73, 0x0e, 0xa0,
+	0x84, 0x81, 0xbe, 0x4e, 0x01, 0xd2, 0x3c, 0x17, 0xca, 0x08, 0x23, 0xfa, 0x6b, 0x22, 0xc7, 0x8a,
+	0x10, 0xf3, 0x7d, 0xc8, 0xf8, 0x3e, 0x11, 0x2d, 0xb2, 0x8f, 0xaa, 0xad, 0x49, 0x01, 0x26, 0x7b,
+	0x20, 0x5b, 0x01, 0xb8, 0xec, 0xd6, 0x88, 0x8c, 0x7e, 0xe9, 0x10, 0x25, 0x42, 0x0f, 0xec, 0x26,
+	0x47, 0x41, 0xb7, 0x01, 0x09, 0xa1, 0x38, 0xaa, 0x46, 0x3b, 0xed, 0xb6, 0xd5, 0xcd, 0x1f, 0x3b,
+	0x22, 0x76, 0x8e, 0x63, 0x71, 0xe8, 0x1a, 0x47, 0x62, 0xef, 0x6e, 0x14, 0xd6, 0xc0, 0x2a, 0xa1,
+	0x6d, 0xc7, 0xa6, 0xbc, 0x05, 0x12, 0x69, 0x55, 0x28, 0x2f, 0x6e, 0x81, 0x84, 0xfc, 0x3d, 0x2d,
+	0x90, 0x10, 0x00, 0xbd, 0x1b, 0x96, 0xa0, 0xb1, 0xfd, 0x82, 0x69, 0x34, 0x39, 0x4a, 0x26, 0x5e,
+	0x78, 0x8c, 0x15, 0xff, 0xa8, 0xc0, 0xcc, 0x40, 0x32, 0x0d, 0x8e, 0xac, 0x03, 0x72, 0x23, 0x8b,
+	0x3c, 0x29, 0x75, 0xe5, 0xd1, 0x8f, 0x96, 0x9b, 0xa7, 0xdd, 0xfe, 0xd5, 0x57, 0x54, 0x4b, 0xcb,
+	0x42, 0xea, 0x77, 0x0a, 0x9c, 0x88, 0x1e, 0x20, 0x10, 0xa5, 0x06, 0x93, 0xd1, 0xad, 0xa5, 0x10,
+	0xe7, 0x0e, 0x22, 0x44, 0xf4, 0xfc, 0x3d, 0x20, 0x68, 0x3b, 0x2c, 0x58, 0xc4, 0xd7, 0x8c, 0xa5,
+	0x03, 0x2b, 0xc5, 0x3f, 0xd8, 0xd0, 0xc2, 0x45, 0xdc, 0xcd, 0xdf, 0x15, 0x48, 0x6c, 0x38, 0x8e,
+	0x85, 0xee, 0xc0, 0xb4, 0xed, 0x78, 0x3c, 0xec, 0x92, 0xc0, 0x17, 0x84, 0x53, 0xaf, 0xbe, 0x50,
+	0x57, 0x7f, 0x7b, 0x5a, 0x18, 0xe4, 0x1c, 0x66, 0xc4, 0x59, 0xdb, 0xf1, 0xca, 0x9c, 0x48, 0xfa,
+	0x48, 0x03, 0x32, 0xbd, 0xdb, 0x89, 0x28, 0x78, 0x75, 0xbf, 0xed, 0x32, 0xfb, 0x6e, 0x35, 0x59,
+	0x8f, 0xec, 0xb3, 0x3c, 0xc1, 0x6e, 0xed, 0x1f, 0xec, 0xe6, 0x6e, 0x43, 0x2e, 0x88, 0x4a, 0x5b,
+	0xfc, 0x73, 0x00, 0x45, 0xd7, 0x60, 0x5c, 0x7c, 0x19, 0xf0, 0x7b, 0x15, 0x67, 0xc3, 0x6f, 0x4d,
+	0xec, 0xcb, 0x1b, 0xfb, 0xd4, 0xd4, 0xc7, 0xd4, 0xa3, 0x4f, 0xc9, 0xcc, 0x3f, 0x17, 0x3d, 0x8e,
+	0xc1, 0xcc, 0x8a, 0x63, 0x53, 0xd9, 0x67, 0x96, 0x45, 0x85, 0xf8, 0xb6, 0xd5, 0x65, 0x51, 0x77,
+	0x68, 0xd8, 0x9c, 0x1c, 0x8c, 0x89, 0xdb, 0x90, 0x65, 0x15, 0xbe, 0xee, 0xd8, 0x2f, 0xd9, 0xea,
+	0xce, 0x38, 0x96, 0x21, 0x4f, 0xc4, 0x1a, 0xdd, 0xdb, 0x90, 0xb5, 0xc9, 0xdd, 0x1e, 0xdc, 0xf8,
+	0xd1, 0x70, 0x6d, 0x72, 0x37, 0x82, 0x7b, 0x92, 0x7d, 0xaf, 0x0b, 0xd2, 0x48, 0x42, 0x95, 0x23,
+	0x74, 0x05, 0xe2, 0xac, 0x12, 0x3b, 0x76, 0x88, 0xe0, 0xc1, 0x18, 0x22, 0x55, 0x75, 0x0d, 0x66,
+	0x64, 0xa3, 0x92, 0xae, 0x37, 0xb8, 0x46, 0x09, 0x17, 0xe8, 0x7d, 0xd2, 0x1d, 0xd2, 0xb5, 0x9c,
+	0x3c, 0x50, 0xd7, 0xf2, 0xc2, 0x6f, 0x14, 0x80, 0xb0, 0x65, 0x8f, 0xde, 0x86, 0xd7, 0xcb, 0xeb,
+	0x6b, 0x15, 0xad, 0xb6, 0x79, 0x75, 0x73, 0xab, 0xa6, 0x6d, 0xad, 0xd5, 0x36, 0x56, 0x57, 0xaa,
+	0xd7, 0xaa, 0xab, 0x95, 0xdc, 0xd8, 0x6c, 0xf6, 0xfe, 0x83, 0xf9, 0xf4, 0x96, 0x4d, 0xdb, 0x44,
+	0x37, 0x1b, 0x26, 0x31, 0xd0, 0x9b, 0x70, 0xa2, 0x97, 0x9a, 0x8d, 0x56, 0x2b, 0x39, 0x65, 0x76,
+	0xf2, 0xfe, 0x83, 0xf9, 0x09, 0xd1, 0xa2, 0x20, 0x06, 0x5a, 0x80, 0xd7, 0x06, 0xe9, 0xaa, 0x6b,
+	0xd7, 0x73, 0xb1, 0xd9, 0xcc, 0xfd, 0x07, 0xf3, 0xa9, 0xa0, 0x97, 0x81, 0x8a, 0x80, 0xa2, 0x94,
+	0x12, 0x2f, 0x3e, 0x0b, 0xf7, 0x1f, 0xcc, 0x27, 0x85, 0xcb, 0xcc, 0x26, 0x3e, 0xfc, 0xe5, 0xdc,
+	0xd8, 0x85, 0x1f, 0x02, 0x54, 0xed, 0x86, 0x8b, 0x75, 0x1e, 0x1a, 0x66, 0xe1, 0x64, 0x75, 0xed,
+	0x9a, 0x7a, 0x75, 0x65, 0xb3, 0xba, 0xbe, 0xd6, 0x7b, 0xec, 0xbe, 0xb5, 0xca, 0xfa, 0x56, 0xf9,
+	0xe6, 0xaa, 0x56, 0xab, 0x5e, 0x5f, 0xcb, 0x29, 0xe8, 0x75, 0x38, 0xde, 0xb3, 0xf6, 0xed, 0xb5,
+	0xcd, 0xea, 0xad, 0xd5, 0x5c, 0xac, 0x7c, 0xe5, 0xd3, 0x67, 0x73, 0xca, 0xe3, 0x67, 0x73, 0xca,
+	0x5f, 0x9f, 0xcd, 0x29, 0x1f, 0x3d, 0x9f, 0x1b, 0x7b, 0xfc, 0x7c, 0x6e, 0xec, 0x4f, 0xcf, 0xe7,
+	0xc6, 0xbe, 0x77, 0xba, 0xc7, 0x19, 0xc3, 0x62, 0x89, 0x7f, 0x8d, 0xad, 0x27, 0xb9, 0xd5, 0x7c,
+	0xed, 0x3f, 0x03, 0x00, 0x8f, 0x5e, 0x37, 0x65, 0x05, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1593,750 +1768,832 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11876 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x94, 0x24, 0xd7,
-		0x59, 0xd8, 0x56, 0x77, 0x4f, 0x4f, 0xf7, 0xd7, 0xaf, 0x9a, 0x3b, 0xb3, 0xbb, 0xb3, 0xb3, 0xd2,
-		0xce, 0x6c, 0xad, 0xa4, 0x5d, 0xad, 0xa4, 0x59, 0xed, 0x4a, 0xbb, 0x92, 0x5a, 0x96, 0x44, 0xf7,
-		0x4c, 0xef, 0x6c, 0xaf, 0xe6, 0xe5, 0xea, 0x9e, 0xb5, 0x24, 0x1e, 0x45, 0x4d, 0xf5, 0x9d, 0x99,
-		0xd2, 0x76, 0x57, 0xb5, 0xab, 0xaa, 0x77, 0x77, 0x94, 0x73, 0x72, 0x4c, 0xc0, 0x8e, 0x11, 0x8f,
-		0x98, 0x40, 0xc0, 0xd8, 0x5e, 0x23, 0x20, 0x60, 0x1b, 0x02, 0x81, 0xd8, 0x10, 0x1c, 0x4e, 0x1e,
-		0xe4, 0x24, 0x04, 0xc8, 0x09, 0x71, 0xf8, 0x11, 0x38, 0x9c, 0x83, 0x02, 0x36, 0xe7, 0xe0, 0x60,
-		0x43, 0x80, 0x18, 0x0e, 0x27, 0x3e, 0xc9, 0xc9, 0xb9, 0xaf, 0x7a, 0x74, 0x57, 0x4f, 0xf7, 0xac,
-		0x24, 0x43, 0x20, 0x7f, 0x76, 0xa7, 0xee, 0xfd, 0xbe, 0xef, 0xde, 0xfb, 0xdd, 0xef, 0x7e, 0xaf,
-		0xfb, 0x68, 0xf8, 0xbd, 0xab, 0xb0, 0xb0, 0x6b, 0xdb, 0xbb, 0x6d, 0x7c, 0xa1, 0xeb, 0xd8, 0x9e,
-		0xbd, 0xdd, 0xdb, 0xb9, 0xd0, 0xc2, 0xae, 0xe1, 0x98, 0x5d, 0xcf, 0x76, 0x16, 0x69, 0x19, 0x2a,
-		0x31, 0x88, 0x45, 0x01, 0xa1, 0xac, 0xc1, 0xd4, 0x55, 0xb3, 0x8d, 0x97, 0x7d, 0xc0, 0x06, 0xf6,
-		0xd0, 0xd3, 0x90, 0xda, 0x31, 0xdb, 0x78, 0x56, 0x5a, 0x48, 0x9e, 0xcb, 0x5d, 0x7a, 0x60, 0xb1,
-		0x0f, 0x69, 0x31, 0x8a, 0xb1, 0x49, 0x8a, 0x55, 0x8a, 0xa1, 0xfc, 0x9f, 0x14, 0x4c, 0xc7, 0xd4,
-		0x22, 0x04, 0x29, 0x4b, 0xef, 0x10, 0x8a, 0xd2, 0xb9, 0xac, 0x4a, 0xff, 0x46, 0xb3, 0x30, 0xd9,
-		0xd5, 0x8d, 0x9b, 0xfa, 0x2e, 0x9e, 0x4d, 0xd0, 0x62, 0xf1, 0x89, 0x4e, 0x01, 0xb4, 0x70, 0x17,
-		0x5b, 0x2d, 0x6c, 0x19, 0xfb, 0xb3, 0xc9, 0x85, 0xe4, 0xb9, 0xac, 0x1a, 0x2a, 0x41, 0x8f, 0xc0,
-		0x54, 0xb7, 0xb7, 0xdd, 0x36, 0x0d, 0x2d, 0x04, 0x06, 0x0b, 0xc9, 0x73, 0x13, 0xaa, 0xcc, 0x2a,
-		0x96, 0x03, 0xe0, 0xb3, 0x50, 0xba, 0x8d, 0xf5, 0x9b, 0x61, 0xd0, 0x1c, 0x05, 0x2d, 0x92, 0xe2,
-		0x10, 0xe0, 0x12, 0xe4, 0x3b, 0xd8, 0x75, 0xf5, 0x5d, 0xac, 0x79, 0xfb, 0x5d, 0x3c, 0x9b, 0xa2,
-		0xa3, 0x5f, 0x18, 0x18, 0x7d, 0xff, 0xc8, 0x73, 0x1c, 0xab, 0xb9, 0xdf, 0xc5, 0xa8, 0x02, 0x59,
-		0x6c, 0xf5, 0x3a, 0x8c, 0xc2, 0xc4, 0x10, 0xfe, 0xd5, 0xac, 0x5e, 0xa7, 0x9f, 0x4a, 0x86, 0xa0,
-		0x71, 0x12, 0x93, 0x2e, 0x76, 0x6e, 0x99, 0x06, 0x9e, 0x4d, 0x53, 0x02, 0x67, 0x07, 0x08, 0x34,
-		0x58, 0x7d, 0x3f, 0x0d, 0x81, 0x87, 0x96, 0x20, 0x8b, 0xef, 0x78, 0xd8, 0x72, 0x4d, 0xdb, 0x9a,
-		0x9d, 0xa4, 0x44, 0x1e, 0x8c, 0x99, 0x45, 0xdc, 0x6e, 0xf5, 0x93, 0x08, 0xf0, 0xd0, 0x15, 0x98,
-		0xb4, 0xbb, 0x9e, 0x69, 0x5b, 0xee, 0x6c, 0x66, 0x41, 0x3a, 0x97, 0xbb, 0x74, 0x5f, 0xac, 0x20,
-		0x6c, 0x30, 0x18, 0x55, 0x00, 0xa3, 0x3a, 0xc8, 0xae, 0xdd, 0x73, 0x0c, 0xac, 0x19, 0x76, 0x0b,
-		0x6b, 0xa6, 0xb5, 0x63, 0xcf, 0x66, 0x29, 0x81, 0xf9, 0xc1, 0x81, 0x50, 0xc0, 0x25, 0xbb, 0x85,
-		0xeb, 0xd6, 0x8e, 0xad, 0x16, 0xdd, 0xc8, 0x37, 0x3a, 0x06, 0x69, 0x77, 0xdf, 0xf2, 0xf4, 0x3b,
-		0xb3, 0x79, 0x2a, 0x21, 0xfc, 0x8b, 0x88, 0x0e, 0x6e, 0x99, 0xa4, 0xb9, 0xd9, 0x02, 0x13, 0x1d,
-		0xfe, 0xa9, 0x7c, 0x36, 0x0d, 0xa5, 0x71, 0x84, 0xef, 0x59, 0x98, 0xd8, 0x21, 0xe3, 0x9f, 0x4d,
-		0x1c, 0x86, 0x3b, 0x0c, 0x27, 0xca, 0xde, 0xf4, 0x3d, 0xb2, 0xb7, 0x02, 0x39, 0x0b, 0xbb, 0x1e,
-		0x6e, 0x31, 0x59, 0x49, 0x8e, 0x29, 0x6d, 0xc0, 0x90, 0x06, 0x85, 0x2d, 0x75, 0x4f, 0xc2, 0xf6,
-		0x12, 0x94, 0xfc, 0x2e, 0x69, 0x8e, 0x6e, 0xed, 0x0a, 0xa9, 0xbd, 0x30, 0xaa, 0x27, 0x8b, 0x35,
-		0x81, 0xa7, 0x12, 0x34, 0xb5, 0x88, 0x23, 0xdf, 0x68, 0x19, 0xc0, 0xb6, 0xb0, 0xbd, 0xa3, 0xb5,
-		0xb0, 0xd1, 0x9e, 0xcd, 0x0c, 0xe1, 0xd2, 0x06, 0x01, 0x19, 0xe0, 0x92, 0xcd, 0x4a, 0x8d, 0x36,
-		0x7a, 0x26, 0x10, 0xc2, 0xc9, 0x21, 0x32, 0xb4, 0xc6, 0x96, 0xdf, 0x80, 0x1c, 0x6e, 0x41, 0xd1,
-		0xc1, 0x64, 0x45, 0xe0, 0x16, 0x1f, 0x59, 0x96, 0x76, 0x62, 0x71, 0xe4, 0xc8, 0x54, 0x8e, 0xc6,
-		0x06, 0x56, 0x70, 0xc2, 0x9f, 0xe8, 0x0c, 0xf8, 0x05, 0x1a, 0x15, 0x2b, 0xa0, 0xfa, 0x29, 0x2f,
-		0x0a, 0xd7, 0xf5, 0x0e, 0x9e, 0x7b, 0x0d, 0x8a, 0x51, 0xf6, 0xa0, 0x19, 0x98, 0x70, 0x3d, 0xdd,
-		0xf1, 0xa8, 0x14, 0x4e, 0xa8, 0xec, 0x03, 0xc9, 0x90, 0xc4, 0x56, 0x8b, 0xea, 0xbf, 0x09, 0x95,
-		0xfc, 0x89, 0xbe, 0x2e, 0x18, 0x70, 0x92, 0x0e, 0xf8, 0xa1, 0xc1, 0x19, 0x8d, 0x50, 0xee, 0x1f,
-		0xf7, 0xdc, 0x53, 0x50, 0x88, 0x0c, 0x60, 0xdc, 0xa6, 0x95, 0x9f, 0x4a, 0xc1, 0xd1, 0x58, 0xda,
-		0xe8, 0x25, 0x98, 0xe9, 0x59, 0xa6, 0xe5, 0x61, 0xa7, 0xeb, 0x60, 0x22, 0xb2, 0xac, 0xad, 0xd9,
-		0x3f, 0x98, 0x1c, 0x22, 0x74, 0x5b, 0x61, 0x68, 0x46, 0x45, 0x9d, 0xee, 0x0d, 0x16, 0xa2, 0x97,
-		0x21, 0x47, 0xe4, 0x43, 0x77, 0x74, 0x4a, 0x90, 0xad, 0xc6, 0x4b, 0xe3, 0x0d, 0x79, 0x71, 0x39,
-		0xc0, 0xac, 0x26, 0x3f, 0x28, 0x25, 0xd4, 0x30, 0x2d, 0xb4, 0x07, 0xf9, 0x5b, 0xd8, 0x31, 0x77,
-		0x4c, 0x83, 0xd1, 0x26, 0xec, 0x2c, 0x5e, 0x7a, 0x7a, 0x4c, 0xda, 0x37, 0x42, 0xa8, 0x0d, 0x4f,
-		0xf7, 0x70, 0x19, 0xb6, 0xd6, 0x6f, 0xd4, 0xd4, 0xfa, 0xd5, 0x7a, 0x6d, 0x59, 0x8d, 0x50, 0x9e,
-		0xfb, 0xb4, 0x04, 0xb9, 0x50, 0x5f, 0x88, 0xda, 0xb2, 0x7a, 0x9d, 0x6d, 0xec, 0x70, 0x8e, 0xf3,
-		0x2f, 0x74, 0x12, 0xb2, 0x3b, 0xbd, 0x76, 0x9b, 0x89, 0x0d, 0xb3, 0x79, 0x19, 0x52, 0x40, 0x44,
-		0x86, 0x68, 0x29, 0xae, 0x08, 0xa8, 0x96, 0x22, 0x7f, 0xa3, 0x33, 0x90, 0x33, 0x5d, 0xcd, 0xc1,
-		0x5d, 0xac, 0x7b, 0xb8, 0x35, 0x9b, 0x5a, 0x90, 0xce, 0x65, 0xaa, 0x89, 0x59, 0x49, 0x05, 0xd3,
-		0x55, 0x79, 0x29, 0x9a, 0x83, 0x8c, 0x90, 0xbd, 0xd9, 0x09, 0x02, 0xa1, 0xfa, 0xdf, 0xac, 0x8e,
-		0x63, 0xa7, 0x45, 0x1d, 0xfb, 0x56, 0x9e, 0x84, 0xa9, 0x81, 0x41, 0xa2, 0x12, 0xe4, 0x96, 0x6b,
-		0x4b, 0xab, 0x15, 0xb5, 0xd2, 0xac, 0x6f, 0xac, 0xcb, 0x47, 0x50, 0x11, 0x42, 0xe3, 0x96, 0xa5,
-		0xf3, 0xd9, 0xcc, 0x17, 0x27, 0xe5, 0xf7, 0xbd, 0xef, 0x7d, 0xef, 0x4b, 0x28, 0xbf, 0x94, 0x86,
-		0x99, 0x38, 0x2d, 0x17, 0xab, 0x70, 0x03, 0x9e, 0x24, 0x23, 0x3c, 0xa9, 0xc0, 0x44, 0x5b, 0xdf,
-		0xc6, 0x6d, 0x3a, 0xb8, 0xe2, 0xa5, 0x47, 0xc6, 0xd2, 0xa3, 0x8b, 0xab, 0x04, 0x45, 0x65, 0x98,
-		0xe8, 0x79, 0xce, 0xb9, 0x09, 0x4a, 0xe1, 0xfc, 0x78, 0x14, 0x88, 0xf6, 0xe3, 0x5c, 0x3e, 0x09,
-		0x59, 0xf2, 0x3f, 0x9b, 0x96, 0x34, 0x9b, 0x16, 0x52, 0x40, 0xa7, 0x65, 0x0e, 0x32, 0x54, 0xb1,
-		0xb5, 0xb0, 0x3f, 0x65, 0xe2, 0x9b, 0xa8, 0x82, 0x16, 0xde, 0xd1, 0x7b, 0x6d, 0x4f, 0xbb, 0xa5,
-		0xb7, 0x7b, 0x98, 0xaa, 0xa8, 0xac, 0x9a, 0xe7, 0x85, 0x37, 0x48, 0x19, 0x9a, 0x87, 0x1c, 0xd3,
-		0x83, 0xa6, 0xd5, 0xc2, 0x77, 0xa8, 0x25, 0x9c, 0x50, 0x99, 0x6a, 0xac, 0x93, 0x12, 0xd2, 0xfc,
-		0xab, 0xae, 0x6d, 0x09, 0x65, 0x42, 0x9b, 0x20, 0x05, 0xb4, 0xf9, 0xa7, 0xfa, 0x8d, 0xf0, 0xfd,
-		0xf1, 0xc3, 0x1b, 0xd0, 0x7e, 0x67, 0xa1, 0x44, 0x21, 0x9e, 0xe0, 0x6b, 0x55, 0x6f, 0xcf, 0x4e,
-		0x51, 0x01, 0x28, 0xb2, 0xe2, 0x0d, 0x5e, 0xaa, 0xfc, 0x7c, 0x02, 0x52, 0xd4, 0x14, 0x94, 0x20,
-		0xd7, 0x7c, 0x79, 0xb3, 0xa6, 0x2d, 0x6f, 0x6c, 0x55, 0x57, 0x6b, 0xb2, 0x44, 0xa6, 0x9e, 0x16,
-		0x5c, 0x5d, 0xdd, 0xa8, 0x34, 0xe5, 0x84, 0xff, 0x5d, 0x5f, 0x6f, 0x5e, 0x79, 0x52, 0x4e, 0xfa,
-		0x08, 0x5b, 0xac, 0x20, 0x15, 0x06, 0x78, 0xe2, 0x92, 0x3c, 0x81, 0x64, 0xc8, 0x33, 0x02, 0xf5,
-		0x97, 0x6a, 0xcb, 0x57, 0x9e, 0x94, 0xd3, 0xd1, 0x92, 0x27, 0x2e, 0xc9, 0x93, 0xa8, 0x00, 0x59,
-		0x5a, 0x52, 0xdd, 0xd8, 0x58, 0x95, 0x33, 0x3e, 0xcd, 0x46, 0x53, 0xad, 0xaf, 0xaf, 0xc8, 0x59,
-		0x9f, 0xe6, 0x8a, 0xba, 0xb1, 0xb5, 0x29, 0x83, 0x4f, 0x61, 0xad, 0xd6, 0x68, 0x54, 0x56, 0x6a,
-		0x72, 0xce, 0x87, 0xa8, 0xbe, 0xdc, 0xac, 0x35, 0xe4, 0x7c, 0xa4, 0x5b, 0x4f, 0x5c, 0x92, 0x0b,
-		0x7e, 0x13, 0xb5, 0xf5, 0xad, 0x35, 0xb9, 0x88, 0xa6, 0xa0, 0xc0, 0x9a, 0x10, 0x9d, 0x28, 0xf5,
-		0x15, 0x5d, 0x79, 0x52, 0x96, 0x83, 0x8e, 0x30, 0x2a, 0x53, 0x91, 0x82, 0x2b, 0x4f, 0xca, 0x48,
-		0x59, 0x82, 0x09, 0x2a, 0x86, 0x08, 0x41, 0x71, 0xb5, 0x52, 0xad, 0xad, 0x6a, 0x1b, 0x9b, 0x64,
-		0xd1, 0x54, 0x56, 0x65, 0x29, 0x28, 0x53, 0x6b, 0xef, 0xde, 0xaa, 0xab, 0xb5, 0x65, 0x39, 0x11,
-		0x2e, 0xdb, 0xac, 0x55, 0x9a, 0xb5, 0x65, 0x39, 0xa9, 0x18, 0x30, 0x13, 0x67, 0x02, 0x63, 0x97,
-		0x50, 0x48, 0x16, 0x12, 0x43, 0x64, 0x81, 0xd2, 0xea, 0x97, 0x05, 0xe5, 0x0b, 0x09, 0x98, 0x8e,
-		0x71, 0x03, 0x62, 0x1b, 0x79, 0x01, 0x26, 0x98, 0x2c, 0x33, 0x55, 0xfc, 0x70, 0xac, 0x3f, 0x41,
-		0x25, 0x7b, 0xc0, 0x39, 0xa2, 0x78, 0x61, 0xb7, 0x31, 0x39, 0xc4, 0x6d, 0x24, 0x24, 0x06, 0x04,
-		0xf6, 0x1b, 0x07, 0xcc, 0x35, 0xf3, 0x68, 0xae, 0x8c, 0xe3, 0xd1, 0xd0, 0xb2, 0xc3, 0x99, 0xed,
-		0x89, 0x18, 0xb3, 0xfd, 0x2c, 0x4c, 0x0d, 0x10, 0x1a, 0xdb, 0x7c, 0x7e, 0xab, 0x04, 0xb3, 0xc3,
-		0x98, 0x33, 0x42, 0x25, 0x26, 0x22, 0x2a, 0xf1, 0xd9, 0x7e, 0x0e, 0x9e, 0x1e, 0x3e, 0x09, 0x03,
-		0x73, 0xfd, 0x09, 0x09, 0x8e, 0xc5, 0x87, 0x07, 0xb1, 0x7d, 0x78, 0x1e, 0xd2, 0x1d, 0xec, 0xed,
-		0xd9, 0xc2, 0x11, 0x7e, 0x28, 0xc6, 0xbd, 0x22, 0xd5, 0xfd, 0x93, 0xcd, 0xb1, 0xc2, 0xfe, 0x59,
-		0x72, 0x98, 0x8f, 0xcf, 0x7a, 0x33, 0xd0, 0xd3, 0x6f, 0x4f, 0xc0, 0xd1, 0x58, 0xe2, 0xb1, 0x1d,
-		0xbd, 0x1f, 0xc0, 0xb4, 0xba, 0x3d, 0x8f, 0x39, 0xbb, 0x4c, 0x13, 0x67, 0x69, 0x09, 0x55, 0x5e,
-		0x44, 0xcb, 0xf6, 0x3c, 0xbf, 0x9e, 0x19, 0x51, 0x60, 0x45, 0x14, 0xe0, 0xe9, 0xa0, 0xa3, 0x29,
-		0xda, 0xd1, 0x53, 0x43, 0x46, 0x3a, 0x20, 0x98, 0x8f, 0x83, 0x6c, 0xb4, 0x4d, 0x6c, 0x79, 0x9a,
-		0xeb, 0x39, 0x58, 0xef, 0x98, 0xd6, 0x2e, 0xb3, 0xb3, 0xe5, 0x89, 0x1d, 0xbd, 0xed, 0x62, 0xb5,
-		0xc4, 0xaa, 0x1b, 0xa2, 0x96, 0x60, 0x50, 0x01, 0x72, 0x42, 0x18, 0xe9, 0x08, 0x06, 0xab, 0xf6,
-		0x31, 0x94, 0xef, 0xc9, 0x42, 0x2e, 0x14, 0x4c, 0xa1, 0xd3, 0x90, 0x7f, 0x55, 0xbf, 0xa5, 0x6b,
-		0x22, 0x40, 0x66, 0x9c, 0xc8, 0x91, 0xb2, 0x4d, 0x1e, 0x24, 0x3f, 0x0e, 0x33, 0x14, 0xc4, 0xee,
-		0x79, 0xd8, 0xd1, 0x8c, 0xb6, 0xee, 0xba, 0x94, 0x69, 0x19, 0x0a, 0x8a, 0x48, 0xdd, 0x06, 0xa9,
-		0x5a, 0x12, 0x35, 0xe8, 0x32, 0x4c, 0x53, 0x8c, 0x4e, 0xaf, 0xed, 0x99, 0xdd, 0x36, 0xd6, 0x48,
-		0xc8, 0xee, 0x52, 0x93, 0xe3, 0xf7, 0x6c, 0x8a, 0x40, 0xac, 0x71, 0x00, 0xd2, 0x23, 0x17, 0x2d,
-		0xc3, 0xfd, 0x14, 0x6d, 0x17, 0x5b, 0xd8, 0xd1, 0x3d, 0xac, 0xe1, 0xf7, 0xf6, 0xf4, 0xb6, 0xab,
-		0xe9, 0x56, 0x4b, 0xdb, 0xd3, 0xdd, 0xbd, 0xd9, 0x19, 0xdf, 0x2d, 0x39, 0x41, 0x00, 0x57, 0x38,
-		0x5c, 0x8d, 0x82, 0x55, 0xac, 0xd6, 0x35, 0xdd, 0xdd, 0x43, 0x65, 0x38, 0x46, 0xa9, 0xb8, 0x9e,
-		0x63, 0x5a, 0xbb, 0x9a, 0xb1, 0x87, 0x8d, 0x9b, 0x5a, 0xcf, 0xdb, 0x79, 0x7a, 0xf6, 0x64, 0xb8,
-		0x7d, 0xda, 0xc3, 0x06, 0x85, 0x59, 0x22, 0x20, 0x5b, 0xde, 0xce, 0xd3, 0xa8, 0x01, 0x79, 0x32,
-		0x19, 0x1d, 0xf3, 0x35, 0xac, 0xed, 0xd8, 0x0e, 0xb5, 0xa1, 0xc5, 0x18, 0xd5, 0x14, 0xe2, 0xe0,
-		0xe2, 0x06, 0x47, 0x58, 0xb3, 0x5b, 0xb8, 0x3c, 0xd1, 0xd8, 0xac, 0xd5, 0x96, 0xd5, 0x9c, 0xa0,
-		0x72, 0xd5, 0x76, 0x88, 0x40, 0xed, 0xda, 0x3e, 0x83, 0x73, 0x4c, 0xa0, 0x76, 0x6d, 0xc1, 0xde,
-		0xcb, 0x30, 0x6d, 0x18, 0x6c, 0xcc, 0xa6, 0xa1, 0xf1, 0xc0, 0xda, 0x9d, 0x95, 0x23, 0xcc, 0x32,
-		0x8c, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x45, 0xcf, 0xc0, 0xd1, 0x80, 0x59, 0x61, 0xc4, 0xa9, 0x81,
-		0x51, 0xf6, 0xa3, 0x5e, 0x86, 0xe9, 0xee, 0xfe, 0x20, 0x22, 0x8a, 0xb4, 0xd8, 0xdd, 0xef, 0x47,
-		0x7b, 0x0a, 0x66, 0xba, 0x7b, 0xdd, 0x41, 0xbc, 0xf3, 0x61, 0x3c, 0xd4, 0xdd, 0xeb, 0xf6, 0x23,
-		0x3e, 0x48, 0xb3, 0x2c, 0x0e, 0x36, 0xa8, 0x77, 0x78, 0x3c, 0x0c, 0x1e, 0xaa, 0x40, 0x8b, 0x20,
-		0x1b, 0x86, 0x86, 0x2d, 0x7d, 0xbb, 0x8d, 0x35, 0xdd, 0xc1, 0x96, 0xee, 0xce, 0xce, 0x53, 0xe0,
-		0x94, 0xe7, 0xf4, 0xb0, 0x5a, 0x34, 0x8c, 0x1a, 0xad, 0xac, 0xd0, 0x3a, 0x74, 0x1e, 0xa6, 0xec,
-		0xed, 0x57, 0x0d, 0x26, 0x91, 0x5a, 0xd7, 0xc1, 0x3b, 0xe6, 0x9d, 0xd9, 0x07, 0x28, 0x7b, 0x4b,
-		0xa4, 0x82, 0xca, 0xe3, 0x26, 0x2d, 0x46, 0x0f, 0x83, 0x6c, 0xb8, 0x7b, 0xba, 0xd3, 0xa5, 0x2a,
-		0xd9, 0xed, 0xea, 0x06, 0x9e, 0x7d, 0x90, 0x81, 0xb2, 0xf2, 0x75, 0x51, 0x4c, 0x56, 0x84, 0x7b,
-		0xdb, 0xdc, 0xf1, 0x04, 0xc5, 0xb3, 0x6c, 0x45, 0xd0, 0x32, 0x4e, 0xed, 0x1c, 0xc8, 0x84, 0x13,
-		0x91, 0x86, 0xcf, 0x51, 0xb0, 0x62, 0x77, 0xaf, 0x1b, 0x6e, 0xf7, 0x0c, 0x14, 0x08, 0x64, 0xd0,
-		0xe8, 0xc3, 0xcc, 0x71, 0xeb, 0xee, 0x85, 0x5a, 0x7c, 0x12, 0x8e, 0x11, 0xa0, 0x0e, 0xf6, 0xf4,
-		0x96, 0xee, 0xe9, 0x21, 0xe8, 0x47, 0x29, 0x34, 0x61, 0xfb, 0x1a, 0xaf, 0x8c, 0xf4, 0xd3, 0xe9,
-		0x6d, 0xef, 0xfb, 0x82, 0xf5, 0x18, 0xeb, 0x27, 0x29, 0x13, 0xa2, 0xf5, 0x8e, 0x45, 0x53, 0x4a,
-		0x19, 0xf2, 0x61, 0xb9, 0x47, 0x59, 0x60, 0x92, 0x2f, 0x4b, 0xc4, 0x09, 0x5a, 0xda, 0x58, 0x26,
-		0xee, 0xcb, 0x2b, 0x35, 0x39, 0x41, 0xdc, 0xa8, 0xd5, 0x7a, 0xb3, 0xa6, 0xa9, 0x5b, 0xeb, 0xcd,
-		0xfa, 0x5a, 0x4d, 0x4e, 0x86, 0x1c, 0xfb, 0xeb, 0xa9, 0xcc, 0x43, 0xf2, 0x59, 0xe5, 0x17, 0x93,
-		0x50, 0x8c, 0xc6, 0xd6, 0xe8, 0x5d, 0x70, 0x5c, 0xa4, 0xc8, 0x5c, 0xec, 0x69, 0xb7, 0x4d, 0x87,
-		0x2e, 0xc8, 0x8e, 0xce, 0x8c, 0xa3, 0x2f, 0x3f, 0x33, 0x1c, 0xaa, 0x81, 0xbd, 0xf7, 0x98, 0x0e,
-		0x59, 0x6e, 0x1d, 0xdd, 0x43, 0xab, 0x30, 0x6f, 0xd9, 0x9a, 0xeb, 0xe9, 0x56, 0x4b, 0x77, 0x5a,
-		0x5a, 0x90, 0x9c, 0xd4, 0x74, 0xc3, 0xc0, 0xae, 0x6b, 0x33, 0x43, 0xe8, 0x53, 0xb9, 0xcf, 0xb2,
-		0x1b, 0x1c, 0x38, 0xb0, 0x10, 0x15, 0x0e, 0xda, 0x27, 0xbe, 0xc9, 0x61, 0xe2, 0x7b, 0x12, 0xb2,
-		0x1d, 0xbd, 0xab, 0x61, 0xcb, 0x73, 0xf6, 0xa9, 0x7f, 0x9e, 0x51, 0x33, 0x1d, 0xbd, 0x5b, 0x23,
-		0xdf, 0xe8, 0x06, 0x3c, 0x14, 0x80, 0x6a, 0x6d, 0xbc, 0xab, 0x1b, 0xfb, 0x1a, 0x75, 0xc6, 0x69,
-		0xa2, 0x47, 0x33, 0x6c, 0x6b, 0xa7, 0x6d, 0x1a, 0x9e, 0x4b, 0xf5, 0x03, 0xd3, 0x71, 0x4a, 0x80,
-		0xb1, 0x4a, 0x11, 0xae, 0xbb, 0xb6, 0x45, 0x7d, 0xf0, 0x25, 0x01, 0xfd, 0xce, 0xcd, 0x70, 0x74,
-		0x96, 0x52, 0xf2, 0xc4, 0xf5, 0x54, 0x66, 0x42, 0x4e, 0x5f, 0x4f, 0x65, 0xd2, 0xf2, 0xe4, 0xf5,
-		0x54, 0x26, 0x23, 0x67, 0xaf, 0xa7, 0x32, 0x59, 0x19, 0x94, 0xf7, 0x67, 0x21, 0x1f, 0x8e, 0x0c,
-		0x48, 0xa0, 0x65, 0x50, 0xdb, 0x28, 0x51, 0xed, 0x79, 0xe6, 0xc0, 0x38, 0x62, 0x71, 0x89, 0x18,
-		0xcd, 0x72, 0x9a, 0xb9, 0xe1, 0x2a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b, 0xcc, 0xdc, 0x9e, 0x8c,
-		0xca, 0xbf, 0xd0, 0x0a, 0xa4, 0x5f, 0x75, 0x29, 0xed, 0x34, 0xa5, 0xfd, 0xc0, 0xc1, 0xb4, 0xaf,
-		0x37, 0x28, 0xf1, 0xec, 0xf5, 0x86, 0xb6, 0xbe, 0xa1, 0xae, 0x55, 0x56, 0x55, 0x8e, 0x8e, 0x4e,
-		0x40, 0xaa, 0xad, 0xbf, 0xb6, 0x1f, 0x35, 0xaf, 0xb4, 0x08, 0x2d, 0x42, 0xa9, 0x67, 0xb1, 0xa8,
-		0x9b, 0x4c, 0x15, 0x81, 0x2a, 0x85, 0xa1, 0x8a, 0x41, 0xed, 0x2a, 0x81, 0x1f, 0x53, 0x3c, 0x4e,
-		0x40, 0xea, 0x36, 0xd6, 0x6f, 0x46, 0x8d, 0x20, 0x2d, 0x42, 0xe7, 0x20, 0xdf, 0xc2, 0xdb, 0xbd,
-		0x5d, 0xcd, 0xc1, 0x2d, 0xdd, 0xf0, 0xa2, 0xaa, 0x3f, 0x47, 0xab, 0x54, 0x5a, 0x83, 0x5e, 0x84,
-		0x2c, 0x99, 0x23, 0x8b, 0xce, 0xf1, 0x14, 0x65, 0xc1, 0x63, 0x07, 0xb3, 0x80, 0x4f, 0xb1, 0x40,
-		0x52, 0x03, 0x7c, 0x74, 0x1d, 0xd2, 0x9e, 0xee, 0xec, 0x62, 0x8f, 0x6a, 0xfe, 0x62, 0x4c, 0xba,
-		0x2a, 0x86, 0x52, 0x93, 0x62, 0x10, 0xb6, 0x52, 0x19, 0xe5, 0x14, 0xd0, 0x35, 0x98, 0x64, 0x7f,
-		0xb9, 0xb3, 0xd3, 0x0b, 0xc9, 0xc3, 0x13, 0x53, 0x05, 0xfa, 0x3b, 0xa8, 0xb3, 0x2e, 0xc0, 0x04,
-		0x15, 0x36, 0x04, 0xc0, 0xc5, 0x4d, 0x3e, 0x82, 0x32, 0x90, 0x5a, 0xda, 0x50, 0x89, 0xde, 0x92,
-		0x21, 0xcf, 0x4a, 0xb5, 0xcd, 0x7a, 0x6d, 0xa9, 0x26, 0x27, 0x94, 0xcb, 0x90, 0x66, 0x12, 0x44,
-		0x74, 0x9a, 0x2f, 0x43, 0xf2, 0x11, 0xfe, 0xc9, 0x69, 0x48, 0xa2, 0x76, 0x6b, 0xad, 0x5a, 0x53,
-		0xe5, 0x84, 0xb2, 0x05, 0xa5, 0x3e, 0xae, 0xa3, 0xa3, 0x30, 0xa5, 0xd6, 0x9a, 0xb5, 0x75, 0x12,
-		0xb5, 0x69, 0x5b, 0xeb, 0x2f, 0xae, 0x6f, 0xbc, 0x67, 0x5d, 0x3e, 0x12, 0x2d, 0x16, 0x0a, 0x52,
-		0x42, 0x33, 0x20, 0x07, 0xc5, 0x8d, 0x8d, 0x2d, 0x95, 0xf6, 0xe6, 0x3b, 0x13, 0x20, 0xf7, 0xb3,
-		0x0d, 0x1d, 0x87, 0xe9, 0x66, 0x45, 0x5d, 0xa9, 0x35, 0x35, 0x16, 0x89, 0xfa, 0xa4, 0x67, 0x40,
-		0x0e, 0x57, 0x5c, 0xad, 0xd3, 0x40, 0x7b, 0x1e, 0x4e, 0x86, 0x4b, 0x6b, 0x2f, 0x35, 0x6b, 0xeb,
-		0x0d, 0xda, 0x78, 0x65, 0x7d, 0x85, 0x68, 0xeb, 0x3e, 0x7a, 0x22, 0xf6, 0x4d, 0x92, 0xae, 0x46,
-		0xe9, 0xd5, 0x56, 0x97, 0xe5, 0x54, 0x7f, 0xf1, 0xc6, 0x7a, 0x6d, 0xe3, 0xaa, 0x3c, 0xd1, 0xdf,
-		0x3a, 0x8d, 0x87, 0xd3, 0x68, 0x0e, 0x8e, 0xf5, 0x97, 0x6a, 0xb5, 0xf5, 0xa6, 0xfa, 0xb2, 0x3c,
-		0xd9, 0xdf, 0x70, 0xa3, 0xa6, 0xde, 0xa8, 0x2f, 0xd5, 0xe4, 0x0c, 0x3a, 0x06, 0x28, 0xda, 0xa3,
-		0xe6, 0xb5, 0x8d, 0x65, 0x39, 0x3b, 0xa0, 0x9f, 0x14, 0x17, 0xf2, 0xe1, 0xa0, 0xf4, 0x6b, 0xa2,
-		0x1a, 0x95, 0x0f, 0x27, 0x20, 0x17, 0x0a, 0x32, 0x49, 0x74, 0xa0, 0xb7, 0xdb, 0xf6, 0x6d, 0x4d,
-		0x6f, 0x9b, 0xba, 0xcb, 0xb5, 0x17, 0xd0, 0xa2, 0x0a, 0x29, 0x19, 0x57, 0x5b, 0x8c, 0x6f, 0x2f,
-		0xd2, 0x7f, 0x1d, 0xed, 0xc5, 0x84, 0x9c, 0x56, 0x3e, 0x2e, 0x81, 0xdc, 0x1f, 0x3d, 0xf6, 0x0d,
-		0x5f, 0x1a, 0x36, 0xfc, 0xaf, 0xc9, 0xdc, 0x7d, 0x4c, 0x82, 0x62, 0x34, 0x64, 0xec, 0xeb, 0xde,
-		0xe9, 0xbf, 0xd2, 0xee, 0xfd, 0x6e, 0x02, 0x0a, 0x91, 0x40, 0x71, 0xdc, 0xde, 0xbd, 0x17, 0xa6,
-		0xcc, 0x16, 0xee, 0x74, 0x6d, 0x0f, 0x5b, 0xc6, 0xbe, 0xd6, 0xc6, 0xb7, 0x70, 0x7b, 0x56, 0xa1,
-		0x2a, 0xfe, 0xc2, 0xc1, 0xa1, 0xe8, 0x62, 0x3d, 0xc0, 0x5b, 0x25, 0x68, 0xe5, 0xe9, 0xfa, 0x72,
-		0x6d, 0x6d, 0x73, 0xa3, 0x59, 0x5b, 0x5f, 0x7a, 0x59, 0x68, 0x17, 0x55, 0x36, 0xfb, 0xc0, 0xde,
-		0x41, 0xa5, 0xbd, 0x09, 0x72, 0x7f, 0xa7, 0x88, 0xae, 0x88, 0xe9, 0x96, 0x7c, 0x04, 0x4d, 0x43,
-		0x69, 0x7d, 0x43, 0x6b, 0xd4, 0x97, 0x6b, 0x5a, 0xed, 0xea, 0xd5, 0xda, 0x52, 0xb3, 0xc1, 0x92,
-		0x8b, 0x3e, 0x74, 0x53, 0x4e, 0x84, 0x59, 0xfc, 0x91, 0x24, 0x4c, 0xc7, 0xf4, 0x04, 0x55, 0x78,
-		0x5a, 0x80, 0x65, 0x2a, 0x1e, 0x1b, 0xa7, 0xf7, 0x8b, 0xc4, 0x31, 0xdf, 0xd4, 0x1d, 0x8f, 0x67,
-		0x11, 0x1e, 0x06, 0xc2, 0x25, 0xcb, 0x23, 0x7e, 0x82, 0xc3, 0x93, 0xb6, 0x2c, 0x57, 0x50, 0x0a,
-		0xca, 0x59, 0xde, 0xf6, 0x51, 0x40, 0x5d, 0xdb, 0x35, 0x3d, 0xf3, 0x16, 0xd6, 0x4c, 0x4b, 0x64,
-		0x78, 0x53, 0x0b, 0xd2, 0xb9, 0x94, 0x2a, 0x8b, 0x9a, 0xba, 0xe5, 0xf9, 0xd0, 0x16, 0xde, 0xd5,
-		0xfb, 0xa0, 0x89, 0x1f, 0x93, 0x54, 0x65, 0x51, 0xe3, 0x43, 0x9f, 0x86, 0x7c, 0xcb, 0xee, 0x91,
-		0x80, 0x8a, 0xc1, 0x11, 0x6d, 0x21, 0xa9, 0x39, 0x56, 0xe6, 0x83, 0xf0, 0x50, 0x39, 0x48, 0x2d,
-		0xe7, 0xd5, 0x1c, 0x2b, 0x63, 0x20, 0x67, 0xa1, 0xa4, 0xef, 0xee, 0x3a, 0x84, 0xb8, 0x20, 0xc4,
-		0x82, 0xff, 0xa2, 0x5f, 0x4c, 0x01, 0xe7, 0xae, 0x43, 0x46, 0xf0, 0x81, 0xf8, 0xc3, 0x84, 0x13,
-		0x5a, 0x97, 0x65, 0xb4, 0x12, 0xe7, 0xb2, 0x6a, 0xc6, 0x12, 0x95, 0xa7, 0x21, 0x6f, 0xba, 0x5a,
-		0xb0, 0xb7, 0x99, 0x58, 0x48, 0x9c, 0xcb, 0xa8, 0x39, 0xd3, 0xf5, 0xf7, 0x48, 0x94, 0x4f, 0x24,
-		0xa0, 0x18, 0xdd, 0xb5, 0x45, 0xcb, 0x90, 0x69, 0xdb, 0x7c, 0x93, 0x85, 0x1d, 0x19, 0x38, 0x37,
-		0x62, 0xa3, 0x77, 0x71, 0x95, 0xc3, 0xab, 0x3e, 0xe6, 0xdc, 0xaf, 0x4b, 0x90, 0x11, 0xc5, 0xe8,
-		0x18, 0xa4, 0xba, 0xba, 0xb7, 0x47, 0xc9, 0x4d, 0x54, 0x13, 0xb2, 0xa4, 0xd2, 0x6f, 0x52, 0xee,
-		0x76, 0x75, 0xb6, 0x4f, 0xc4, 0xcb, 0xc9, 0x37, 0x99, 0xd7, 0x36, 0xd6, 0x5b, 0x34, 0xb3, 0x60,
-		0x77, 0x3a, 0xd8, 0xf2, 0x5c, 0x31, 0xaf, 0xbc, 0x7c, 0x89, 0x17, 0xa3, 0x47, 0x60, 0xca, 0x73,
-		0x74, 0xb3, 0x1d, 0x81, 0x4d, 0x51, 0x58, 0x59, 0x54, 0xf8, 0xc0, 0x65, 0x38, 0x21, 0xe8, 0xb6,
-		0xb0, 0xa7, 0x1b, 0x7b, 0xb8, 0x15, 0x20, 0xa5, 0x69, 0x06, 0xf1, 0x38, 0x07, 0x58, 0xe6, 0xf5,
-		0x02, 0x57, 0xf9, 0x5c, 0x02, 0xa6, 0x44, 0x2e, 0xa4, 0xe5, 0x33, 0x6b, 0x0d, 0x40, 0xb7, 0x2c,
-		0xdb, 0x0b, 0xb3, 0x6b, 0x50, 0x94, 0x07, 0xf0, 0x16, 0x2b, 0x3e, 0x92, 0x1a, 0x22, 0x30, 0xf7,
-		0x25, 0x09, 0x20, 0xa8, 0x1a, 0xca, 0xb7, 0x79, 0xc8, 0xf1, 0x3d, 0x79, 0x7a, 0xb0, 0x83, 0xa5,
-		0xcf, 0x80, 0x15, 0x5d, 0x35, 0xdb, 0x34, 0xc9, 0xb9, 0x8d, 0x77, 0x4d, 0x8b, 0xef, 0xce, 0xb0,
-		0x0f, 0x91, 0xe4, 0x4c, 0x05, 0xdb, 0x93, 0x2a, 0x64, 0x5c, 0xdc, 0xd1, 0x2d, 0xcf, 0x34, 0xf8,
-		0x7e, 0xcb, 0x95, 0x43, 0x75, 0x7e, 0xb1, 0xc1, 0xb1, 0x55, 0x9f, 0x8e, 0x72, 0x0e, 0x32, 0xa2,
-		0x94, 0x38, 0x7e, 0xeb, 0x1b, 0xeb, 0x35, 0xf9, 0x08, 0x9a, 0x84, 0x64, 0xa3, 0xd6, 0x94, 0x25,
-		0x12, 0xc4, 0x56, 0x56, 0xeb, 0x95, 0x86, 0x9c, 0xa8, 0xfe, 0x5d, 0x98, 0x36, 0xec, 0x4e, 0x7f,
-		0x83, 0x55, 0xb9, 0x2f, 0x81, 0xe8, 0x5e, 0x93, 0x5e, 0x79, 0x8c, 0x03, 0xed, 0xda, 0x6d, 0xdd,
-		0xda, 0x5d, 0xb4, 0x9d, 0xdd, 0xe0, 0x58, 0x0c, 0x89, 0x35, 0xdc, 0xd0, 0xe1, 0x98, 0xee, 0xf6,
-		0x5f, 0x4a, 0xd2, 0x8f, 0x24, 0x92, 0x2b, 0x9b, 0xd5, 0x9f, 0x48, 0xcc, 0xad, 0x30, 0xc4, 0x4d,
-		0x31, 0x1c, 0x15, 0xef, 0xb4, 0xb1, 0x41, 0x3a, 0x0f, 0x1f, 0x4d, 0xc1, 0x94, 0xde, 0x31, 0x2d,
-		0xfb, 0x02, 0xfd, 0x97, 0x1f, 0xaa, 0x99, 0xa0, 0x1f, 0x73, 0x23, 0x4f, 0xdf, 0x94, 0xaf, 0x30,
-		0x05, 0x86, 0x46, 0xed, 0x61, 0xcf, 0xfe, 0xe9, 0x77, 0xfe, 0xf8, 0x44, 0x90, 0xfb, 0x2c, 0xaf,
-		0x81, 0x2c, 0xc2, 0x6e, 0x6c, 0x19, 0x36, 0x91, 0xb6, 0xd1, 0x34, 0xfe, 0x4c, 0xd0, 0x28, 0x71,
-		0xdc, 0x1a, 0x47, 0x2d, 0xbf, 0x0b, 0x32, 0x3e, 0x99, 0x83, 0xb7, 0x93, 0x66, 0xff, 0xa7, 0x20,
-		0xe2, 0x63, 0x94, 0x5f, 0x00, 0x60, 0xce, 0x0e, 0x4b, 0xcb, 0x1e, 0x8c, 0xff, 0x15, 0x81, 0x9f,
-		0xa5, 0x38, 0x44, 0x0b, 0x95, 0x57, 0xa0, 0xd8, 0xb2, 0x2d, 0x4f, 0xb3, 0x3b, 0xa6, 0x87, 0x3b,
-		0x5d, 0x6f, 0x7f, 0x14, 0x91, 0x3f, 0x67, 0x44, 0x32, 0x6a, 0x81, 0xe0, 0x6d, 0x08, 0x34, 0xd2,
-		0x13, 0xb6, 0xb3, 0x36, 0x4e, 0x4f, 0xfe, 0xc2, 0xef, 0x09, 0xc5, 0x21, 0x3d, 0xa9, 0xd6, 0x7e,
-		0xe5, 0xf3, 0xa7, 0xa4, 0xcf, 0x7d, 0xfe, 0x94, 0xf4, 0xbb, 0x9f, 0x3f, 0x25, 0x7d, 0xe8, 0x0b,
-		0xa7, 0x8e, 0x7c, 0xee, 0x0b, 0xa7, 0x8e, 0xfc, 0xd6, 0x17, 0x4e, 0x1d, 0x79, 0xe5, 0x91, 0x5d,
-		0xd3, 0xdb, 0xeb, 0x6d, 0x2f, 0x1a, 0x76, 0xe7, 0x82, 0x61, 0xbb, 0x1d, 0xdb, 0xe5, 0xff, 0x3d,
-		0xe6, 0xb6, 0x6e, 0x72, 0xf9, 0xf1, 0xee, 0x30, 0x29, 0xd8, 0x4e, 0xb3, 0x1d, 0x35, 0xf8, 0xa3,
-		0x47, 0x60, 0x66, 0xd7, 0xde, 0xb5, 0xe9, 0xe7, 0x05, 0xf2, 0x17, 0x17, 0x90, 0xac, 0x5f, 0x3a,
-		0x86, 0x90, 0xac, 0xc3, 0x34, 0x07, 0xd6, 0xe8, 0xe1, 0x0e, 0x96, 0xc8, 0x42, 0x07, 0xee, 0xa2,
-		0xcc, 0xfe, 0xec, 0xef, 0x53, 0x9f, 0x55, 0x9d, 0xe2, 0xa8, 0xa4, 0x8e, 0xe5, 0xba, 0xca, 0x2a,
-		0x1c, 0x8d, 0xd0, 0x63, 0x16, 0x04, 0x3b, 0x23, 0x28, 0xfe, 0x7b, 0x4e, 0x71, 0x3a, 0x44, 0xb1,
-		0xc1, 0x51, 0xcb, 0x4b, 0x50, 0x38, 0x0c, 0xad, 0x5f, 0xe6, 0xb4, 0xf2, 0x38, 0x4c, 0x64, 0x05,
-		0x4a, 0x94, 0x88, 0xd1, 0x73, 0x3d, 0xbb, 0x43, 0xe7, 0xf0, 0x60, 0x32, 0xff, 0xe1, 0xf7, 0x99,
-		0x4a, 0x2f, 0x12, 0xb4, 0x25, 0x1f, 0xab, 0x5c, 0x06, 0x7a, 0x9e, 0xa5, 0x85, 0x8d, 0xf6, 0x08,
-		0x0a, 0xbf, 0xc2, 0x3b, 0xe2, 0xc3, 0x97, 0x6f, 0xc0, 0x0c, 0xf9, 0x9b, 0x5a, 0xcf, 0x70, 0x4f,
-		0x46, 0x6f, 0xb9, 0xcc, 0xfe, 0x97, 0x6f, 0x65, 0x56, 0x63, 0xda, 0x27, 0x10, 0xea, 0x53, 0x68,
-		0x16, 0x77, 0xb1, 0xe7, 0x61, 0xc7, 0xd5, 0xf4, 0x76, 0x5c, 0xf7, 0x42, 0x39, 0xeb, 0xd9, 0x1f,
-		0xfc, 0x72, 0x74, 0x16, 0x57, 0x18, 0x66, 0xa5, 0xdd, 0x2e, 0x6f, 0xc1, 0xf1, 0x18, 0xa9, 0x18,
-		0x83, 0xe6, 0x47, 0x38, 0xcd, 0x99, 0x01, 0xc9, 0x20, 0x64, 0x37, 0x41, 0x94, 0xfb, 0x73, 0x39,
-		0x06, 0xcd, 0x8f, 0x72, 0x9a, 0x88, 0xe3, 0x8a, 0x29, 0x25, 0x14, 0xaf, 0xc3, 0xd4, 0x2d, 0xec,
-		0x6c, 0xdb, 0x2e, 0xdf, 0x27, 0x18, 0x83, 0xdc, 0xc7, 0x38, 0xb9, 0x12, 0x47, 0xa4, 0x1b, 0x07,
-		0x84, 0xd6, 0x33, 0x90, 0xd9, 0xd1, 0x0d, 0x3c, 0x06, 0x89, 0xbb, 0x9c, 0xc4, 0x24, 0x81, 0x27,
-		0xa8, 0x15, 0xc8, 0xef, 0xda, 0xdc, 0x81, 0x1a, 0x8d, 0xfe, 0x71, 0x8e, 0x9e, 0x13, 0x38, 0x9c,
-		0x44, 0xd7, 0xee, 0xf6, 0xda, 0xc4, 0xbb, 0x1a, 0x4d, 0xe2, 0x87, 0x04, 0x09, 0x81, 0xc3, 0x49,
-		0x1c, 0x82, 0xad, 0x6f, 0x08, 0x12, 0x6e, 0x88, 0x9f, 0x2f, 0x40, 0xce, 0xb6, 0xda, 0xfb, 0xb6,
-		0x35, 0x4e, 0x27, 0x7e, 0x98, 0x53, 0x00, 0x8e, 0x42, 0x08, 0x3c, 0x0b, 0xd9, 0x71, 0x27, 0xe2,
-		0xc7, 0xbe, 0x2c, 0x96, 0x87, 0x98, 0x81, 0x15, 0x28, 0x09, 0x05, 0x65, 0xda, 0xd6, 0x18, 0x24,
-		0x7e, 0x9c, 0x93, 0x28, 0x86, 0xd0, 0xf8, 0x30, 0x3c, 0xec, 0x7a, 0xbb, 0x78, 0x1c, 0x22, 0x9f,
-		0x10, 0xc3, 0xe0, 0x28, 0x9c, 0x95, 0xdb, 0xd8, 0x32, 0xf6, 0xc6, 0xa3, 0xf0, 0x49, 0xc1, 0x4a,
-		0x81, 0x43, 0x48, 0x2c, 0x41, 0xa1, 0xa3, 0x3b, 0xee, 0x9e, 0xde, 0x1e, 0x6b, 0x3a, 0x3e, 0xc5,
-		0x69, 0xe4, 0x7d, 0x24, 0xce, 0x91, 0x9e, 0x75, 0x18, 0x32, 0x3f, 0x21, 0x38, 0x12, 0x42, 0xe3,
-		0x4b, 0xcf, 0xf5, 0xe8, 0xa6, 0xca, 0x61, 0xa8, 0xfd, 0xa4, 0x58, 0x7a, 0x0c, 0x77, 0x2d, 0x4c,
-		0xf1, 0x59, 0xc8, 0xba, 0xe6, 0x6b, 0x63, 0x91, 0xf9, 0x27, 0x62, 0xa6, 0x29, 0x02, 0x41, 0x7e,
-		0x19, 0x4e, 0xc4, 0x9a, 0x89, 0x31, 0x88, 0xfd, 0x14, 0x27, 0x76, 0x2c, 0xc6, 0x54, 0x70, 0x95,
-		0x70, 0x58, 0x92, 0x3f, 0x2d, 0x54, 0x02, 0xee, 0xa3, 0xb5, 0x49, 0x42, 0x5a, 0x57, 0xdf, 0x39,
-		0x1c, 0xd7, 0xfe, 0xa9, 0xe0, 0x1a, 0xc3, 0x8d, 0x70, 0xad, 0x09, 0xc7, 0x38, 0xc5, 0xc3, 0xcd,
-		0xeb, 0xcf, 0x08, 0xc5, 0xca, 0xb0, 0xb7, 0xa2, 0xb3, 0xfb, 0xf5, 0x30, 0xe7, 0xb3, 0x53, 0xc4,
-		0x4e, 0xae, 0xd6, 0xd1, 0xbb, 0x63, 0x50, 0xfe, 0x59, 0x4e, 0x59, 0x68, 0x7c, 0x3f, 0xf8, 0x72,
-		0xd7, 0xf4, 0x2e, 0x21, 0xfe, 0x12, 0xcc, 0x0a, 0xe2, 0x3d, 0xcb, 0xc1, 0x86, 0xbd, 0x6b, 0x99,
-		0xaf, 0xe1, 0xd6, 0x18, 0xa4, 0xff, 0x59, 0xdf, 0x54, 0x6d, 0x85, 0xd0, 0x09, 0xe5, 0x3a, 0xc8,
-		0xbe, 0xaf, 0xa2, 0x99, 0x9d, 0xae, 0xed, 0x78, 0x23, 0x28, 0x7e, 0x5a, 0xcc, 0x94, 0x8f, 0x57,
-		0xa7, 0x68, 0xe5, 0x1a, 0xb0, 0x93, 0x46, 0xe3, 0x8a, 0xe4, 0x67, 0x38, 0xa1, 0x42, 0x80, 0xc5,
-		0x15, 0x87, 0x61, 0x77, 0xba, 0xba, 0x33, 0x8e, 0xfe, 0xfb, 0x39, 0xa1, 0x38, 0x38, 0x0a, 0x57,
-		0x1c, 0xc4, 0x5f, 0x23, 0xd6, 0x7e, 0x0c, 0x0a, 0x3f, 0x2f, 0x14, 0x87, 0xc0, 0xe1, 0x24, 0x84,
-		0xc3, 0x30, 0x06, 0x89, 0x7f, 0x2e, 0x48, 0x08, 0x1c, 0x42, 0xe2, 0xdd, 0x81, 0xa1, 0x75, 0xf0,
-		0xae, 0xe9, 0x7a, 0xfc, 0xa8, 0xe0, 0xc1, 0xa4, 0x7e, 0xe1, 0xcb, 0x51, 0x27, 0x4c, 0x0d, 0xa1,
-		0x12, 0x4d, 0xc4, 0x3d, 0x7b, 0x1a, 0xd0, 0x8f, 0xee, 0xd8, 0x67, 0x85, 0x26, 0x0a, 0xa1, 0x91,
-		0xbe, 0x85, 0x3c, 0x44, 0xc2, 0x76, 0x83, 0x84, 0xb1, 0x63, 0x90, 0xfb, 0x17, 0x7d, 0x9d, 0x6b,
-		0x08, 0x5c, 0x42, 0x33, 0xe4, 0xff, 0xf4, 0xac, 0x9b, 0x78, 0x7f, 0x2c, 0xe9, 0xfc, 0xc5, 0x3e,
-		0xff, 0x67, 0x8b, 0x61, 0x32, 0x1d, 0x52, 0xea, 0xf3, 0xa7, 0x46, 0x47, 0x40, 0xdf, 0xf2, 0x15,
-		0x3e, 0xde, 0xa8, 0x3b, 0x55, 0x5e, 0x25, 0x42, 0x1e, 0x75, 0x7a, 0x46, 0x13, 0xfb, 0xd6, 0xaf,
-		0xf8, 0x72, 0x1e, 0xf1, 0x79, 0xca, 0x57, 0xa1, 0x10, 0x71, 0x78, 0x46, 0x93, 0xfa, 0x36, 0x4e,
-		0x2a, 0x1f, 0xf6, 0x77, 0xca, 0x97, 0x21, 0x45, 0x9c, 0x97, 0xd1, 0xe8, 0xef, 0xe7, 0xe8, 0x14,
-		0xbc, 0xfc, 0x1c, 0x64, 0x84, 0xd3, 0x32, 0x1a, 0xf5, 0x03, 0x1c, 0xd5, 0x47, 0x21, 0xe8, 0xc2,
-		0x61, 0x19, 0x8d, 0xfe, 0xf7, 0x05, 0xba, 0x40, 0x21, 0xe8, 0xe3, 0xb3, 0xf0, 0xdf, 0x7c, 0x47,
-		0x8a, 0x1b, 0x1d, 0xc1, 0xbb, 0x67, 0x61, 0x92, 0x7b, 0x2a, 0xa3, 0xb1, 0xbf, 0x9d, 0x37, 0x2e,
-		0x30, 0xca, 0x4f, 0xc1, 0xc4, 0x98, 0x0c, 0xff, 0x2e, 0x8e, 0xca, 0xe0, 0xcb, 0x4b, 0x90, 0x0b,
-		0x79, 0x27, 0xa3, 0xd1, 0xbf, 0x9b, 0xa3, 0x87, 0xb1, 0x48, 0xd7, 0xb9, 0x77, 0x32, 0x9a, 0xc0,
-		0x3f, 0x10, 0x5d, 0xe7, 0x18, 0x84, 0x6d, 0xc2, 0x31, 0x19, 0x8d, 0xfd, 0x21, 0xc1, 0x75, 0x81,
-		0x52, 0x7e, 0x01, 0xb2, 0xbe, 0xb1, 0x19, 0x8d, 0xff, 0x3d, 0x1c, 0x3f, 0xc0, 0x21, 0x1c, 0x08,
-		0x19, 0xbb, 0xd1, 0x24, 0xfe, 0xa1, 0xe0, 0x40, 0x08, 0x8b, 0x2c, 0xa3, 0x7e, 0x07, 0x66, 0x34,
-		0xa5, 0xef, 0x15, 0xcb, 0xa8, 0xcf, 0x7f, 0x21, 0xb3, 0x49, 0x75, 0xfe, 0x68, 0x12, 0xdf, 0x27,
-		0x66, 0x93, 0xc2, 0x93, 0x6e, 0xf4, 0x7b, 0x04, 0xa3, 0x69, 0xfc, 0x80, 0xe8, 0x46, 0x9f, 0x43,
-		0x50, 0xde, 0x04, 0x34, 0xe8, 0x0d, 0x8c, 0xa6, 0xf7, 0x61, 0x4e, 0x6f, 0x6a, 0xc0, 0x19, 0x28,
-		0xbf, 0x07, 0x8e, 0xc5, 0x7b, 0x02, 0xa3, 0xa9, 0xfe, 0xe0, 0x57, 0xfa, 0x62, 0xb7, 0xb0, 0x23,
-		0x50, 0x6e, 0x06, 0x26, 0x25, 0xec, 0x05, 0x8c, 0x26, 0xfb, 0x91, 0xaf, 0x44, 0x15, 0x77, 0xd8,
-		0x09, 0x28, 0x57, 0x00, 0x02, 0x03, 0x3c, 0x9a, 0xd6, 0xc7, 0x38, 0xad, 0x10, 0x12, 0x59, 0x1a,
-		0xdc, 0xfe, 0x8e, 0xc6, 0xbf, 0x2b, 0x96, 0x06, 0xc7, 0x20, 0x4b, 0x43, 0x98, 0xde, 0xd1, 0xd8,
-		0x1f, 0x17, 0x4b, 0x43, 0xa0, 0x10, 0xc9, 0x0e, 0x59, 0xb7, 0xd1, 0x14, 0x7e, 0x58, 0x48, 0x76,
-		0x08, 0xab, 0xbc, 0x0e, 0x53, 0x03, 0x06, 0x71, 0x34, 0xa9, 0x1f, 0xe1, 0xa4, 0xe4, 0x7e, 0x7b,
-		0x18, 0x36, 0x5e, 0xdc, 0x18, 0x8e, 0xa6, 0xf6, 0xa3, 0x7d, 0xc6, 0x8b, 0xdb, 0xc2, 0xf2, 0xb3,
-		0x90, 0xb1, 0x7a, 0xed, 0x36, 0x59, 0x3c, 0xa3, 0x52, 0x5e, 0xff, 0xfd, 0xab, 0x9c, 0x3b, 0x02,
-		0xa1, 0x7c, 0x19, 0x26, 0x70, 0x67, 0x1b, 0xb7, 0x46, 0x61, 0xfe, 0xe1, 0x57, 0x85, 0xc2, 0x24,
-		0xd0, 0xe5, 0x17, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0xd2, 0x18, 0x81, 0xfb, 0xa5, 0xaf, 0xf2, 0xc3,
-		0x97, 0x01, 0x4a, 0x40, 0x60, 0x9c, 0x4c, 0xdd, 0x97, 0xa3, 0x04, 0xe8, 0x8c, 0x3c, 0x03, 0x93,
-		0xaf, 0xba, 0xb6, 0xe5, 0xe9, 0x23, 0x33, 0x96, 0x7f, 0xc4, 0xb1, 0x05, 0x3c, 0x61, 0x58, 0xc7,
-		0x76, 0xb0, 0xa7, 0xef, 0xba, 0xa3, 0x70, 0xff, 0x98, 0xe3, 0xfa, 0x08, 0x04, 0xd9, 0xd0, 0x5d,
-		0x6f, 0x9c, 0x71, 0xff, 0x0f, 0x81, 0x2c, 0x10, 0x48, 0xa7, 0xc9, 0xdf, 0x37, 0xf1, 0xc8, 0x0c,
-		0xe7, 0x9f, 0x88, 0x4e, 0x73, 0xf8, 0xf2, 0x73, 0x90, 0x25, 0x7f, 0xb2, 0x13, 0xd5, 0x23, 0x90,
-		0xff, 0x94, 0x23, 0x07, 0x18, 0xa4, 0x65, 0xd7, 0x6b, 0x79, 0xe6, 0x68, 0x66, 0xff, 0x19, 0x9f,
-		0x69, 0x01, 0x5f, 0xae, 0x40, 0xce, 0xf5, 0x5a, 0xad, 0x1e, 0xf7, 0x4f, 0x47, 0xe5, 0x87, 0xbf,
-		0xea, 0xa7, 0x2c, 0x7c, 0x1c, 0x32, 0xdb, 0xb7, 0x6f, 0x7a, 0x5d, 0x9b, 0xee, 0xc6, 0x8d, 0xcc,
-		0x10, 0x73, 0x0a, 0x21, 0x94, 0xf2, 0x12, 0xe4, 0xc9, 0x58, 0xc4, 0x4d, 0x95, 0x91, 0xf9, 0x61,
-		0xce, 0x80, 0x08, 0x52, 0xf5, 0x9b, 0x87, 0x25, 0x77, 0xe3, 0xf7, 0x10, 0x60, 0xc5, 0x5e, 0xb1,
-		0xd9, 0xee, 0xc1, 0x2b, 0x0f, 0x0e, 0x66, 0x7f, 0xa3, 0x79, 0x5d, 0xfa, 0x17, 0xfc, 0x2f, 0x09,
-		0xee, 0x37, 0xec, 0x0e, 0xf6, 0xb6, 0x77, 0xbc, 0x0b, 0x86, 0xb3, 0xdf, 0xf5, 0xec, 0x0b, 0xb7,
-		0x2e, 0x5e, 0xb8, 0x89, 0xf7, 0x5d, 0x9e, 0xf8, 0x45, 0xa2, 0x7a, 0x91, 0x55, 0x2f, 0xde, 0xba,
-		0x38, 0x17, 0x9b, 0x22, 0x56, 0x5e, 0x82, 0xec, 0x26, 0xbd, 0xb9, 0xfa, 0x22, 0xde, 0x47, 0x73,
-		0x30, 0x89, 0x5b, 0x97, 0x2e, 0x5f, 0xbe, 0xf8, 0x0c, 0xdd, 0x8b, 0xcf, 0x5f, 0x3b, 0xa2, 0x8a,
-		0x02, 0x74, 0x0a, 0xb2, 0x2e, 0x36, 0xba, 0x97, 0x2e, 0x5f, 0xb9, 0x79, 0x91, 0xee, 0xe3, 0x90,
-		0xda, 0xa0, 0xa8, 0x9c, 0xf9, 0xe2, 0x1b, 0xf3, 0xd2, 0x17, 0x7f, 0x78, 0x5e, 0xaa, 0x4e, 0x40,
-		0xd2, 0xed, 0x75, 0xaa, 0x6b, 0x43, 0x93, 0xdc, 0x4f, 0x44, 0x86, 0x29, 0xc6, 0x21, 0xfe, 0xd0,
-		0xbb, 0xe6, 0x85, 0xc1, 0xd1, 0xf9, 0xc9, 0xee, 0x4f, 0xa5, 0xe0, 0x54, 0xcc, 0xe0, 0xbb, 0x8e,
-		0x6d, 0xef, 0x1c, 0x7a, 0xf4, 0x3b, 0x30, 0xb1, 0x49, 0x10, 0xd1, 0x0c, 0x4c, 0x78, 0xb6, 0xa7,
-		0xb7, 0xe9, 0xb8, 0x93, 0x2a, 0xfb, 0x20, 0xa5, 0xec, 0xf2, 0x4c, 0x82, 0x95, 0x9a, 0xe2, 0xde,
-		0x4c, 0x1b, 0xeb, 0x3b, 0xec, 0x0c, 0x72, 0x92, 0x6e, 0x8f, 0x66, 0x48, 0x01, 0x3d, 0x6e, 0x3c,
-		0x03, 0x13, 0x7a, 0x8f, 0xed, 0xec, 0x25, 0xcf, 0xe5, 0x55, 0xf6, 0xa1, 0xac, 0xc2, 0x24, 0x4f,
-		0xe1, 0x22, 0x19, 0x92, 0x37, 0xf1, 0x3e, 0xe3, 0xaf, 0x4a, 0xfe, 0x44, 0x17, 0x60, 0x82, 0xf6,
-		0x9e, 0x5f, 0xae, 0x38, 0xb1, 0x38, 0xd8, 0xfd, 0x45, 0xda, 0x4b, 0x95, 0xc1, 0x29, 0xd7, 0x21,
-		0xb3, 0x6c, 0x77, 0x4c, 0xcb, 0x8e, 0x92, 0xcb, 0x32, 0x72, 0xb4, 0xd3, 0xdd, 0x9e, 0xc7, 0x37,
-		0xdb, 0xd8, 0x07, 0x3a, 0x06, 0x69, 0x76, 0x28, 0x9d, 0x6f, 0x4f, 0xf2, 0x2f, 0x65, 0x09, 0x26,
-		0x29, 0xed, 0x8d, 0xae, 0x7f, 0x11, 0x4c, 0x0a, 0x5d, 0x04, 0xe3, 0xe4, 0x13, 0x41, 0x6f, 0x11,
-		0xa4, 0x5a, 0xba, 0xa7, 0xf3, 0x81, 0xd3, 0xbf, 0x95, 0x17, 0x20, 0xc3, 0x89, 0xb8, 0xe8, 0x09,
-		0x48, 0xda, 0x5d, 0x97, 0x6f, 0x30, 0x9e, 0x1c, 0x3a, 0x96, 0x8d, 0x6e, 0x35, 0xf5, 0x2b, 0x6f,
-		0xce, 0x1f, 0x51, 0x09, 0xf4, 0x3b, 0x25, 0x2b, 0xdf, 0x9b, 0x80, 0x53, 0x03, 0xfb, 0x1e, 0x5c,
-		0x5b, 0x0c, 0xbb, 0x98, 0x5e, 0x86, 0xcc, 0xb2, 0x50, 0x42, 0xb3, 0x30, 0xe9, 0x62, 0xc3, 0xb6,
-		0x5a, 0x2e, 0x97, 0x0b, 0xf1, 0x49, 0x98, 0x6c, 0xe9, 0x96, 0xed, 0xf2, 0x1b, 0x14, 0xec, 0xa3,
-		0xfa, 0x51, 0xe9, 0x70, 0x6b, 0xbf, 0x20, 0x5a, 0xa2, 0xeb, 0x7f, 0x53, 0x7a, 0xe5, 0xe2, 0xc8,
-		0xcd, 0xc3, 0x9b, 0x96, 0x7d, 0xdb, 0xf2, 0x07, 0x11, 0xd9, 0x40, 0x3c, 0xd5, 0xbf, 0x81, 0xf8,
-		0x1e, 0xdc, 0x6e, 0xbf, 0x48, 0xe0, 0x9b, 0x04, 0xd5, 0xe7, 0xca, 0xc7, 0x53, 0x83, 0x5c, 0xb9,
-		0xed, 0xe8, 0xdd, 0x2e, 0x76, 0xdc, 0x61, 0x5c, 0x39, 0x03, 0xb9, 0xe5, 0xd0, 0x21, 0x81, 0x19,
-		0x71, 0x59, 0x47, 0xa2, 0x07, 0x08, 0xd8, 0x87, 0xa2, 0x00, 0x5c, 0x6d, 0xdb, 0xba, 0x17, 0x03,
-		0x93, 0x08, 0xc1, 0xd4, 0x2d, 0xef, 0xca, 0x93, 0x31, 0x30, 0x49, 0x01, 0x73, 0x06, 0x72, 0x5b,
-		0xc3, 0x80, 0x52, 0x51, 0x42, 0x4f, 0x5c, 0x8a, 0x81, 0x99, 0xe8, 0x23, 0x14, 0x0b, 0x54, 0x10,
-		0x40, 0xa7, 0x21, 0x5b, 0xb5, 0xed, 0x76, 0x0c, 0x48, 0x26, 0x44, 0xa7, 0x11, 0x3a, 0xff, 0x10,
-		0x01, 0xca, 0x86, 0x3a, 0x54, 0xdd, 0xf7, 0xb0, 0x1b, 0x03, 0x93, 0xe7, 0x30, 0x87, 0x17, 0x90,
-		0xf7, 0xf0, 0x79, 0x39, 0xac, 0x80, 0x88, 0xf9, 0xbc, 0x27, 0x01, 0xf9, 0x81, 0x7c, 0x48, 0xc5,
-		0x32, 0xaa, 0x44, 0xc3, 0xea, 0x8e, 0xde, 0x11, 0x02, 0x32, 0xe5, 0xaf, 0x6b, 0x5a, 0x3f, 0x54,
-		0xc3, 0xce, 0x8d, 0x58, 0x7f, 0x73, 0x23, 0x24, 0x51, 0xf9, 0x6c, 0x12, 0x4a, 0x4b, 0xb6, 0xe5,
-		0x62, 0xcb, 0xed, 0xb9, 0x9b, 0xb4, 0x0b, 0xe8, 0x49, 0x98, 0xd8, 0x6e, 0xdb, 0xc6, 0x4d, 0xca,
-		0xdb, 0xdc, 0xa5, 0x53, 0x8b, 0x03, 0x9d, 0x59, 0xac, 0x92, 0x7a, 0x06, 0xae, 0x32, 0x60, 0xf4,
-		0x1c, 0x64, 0xf0, 0x2d, 0xb3, 0x85, 0x2d, 0x03, 0x73, 0x4d, 0x7b, 0x3a, 0x06, 0xb1, 0xc6, 0x41,
-		0x38, 0xae, 0x8f, 0x82, 0xbe, 0x0e, 0xb2, 0xb7, 0xf4, 0xb6, 0xd9, 0xd2, 0x3d, 0xdb, 0xe1, 0x57,
-		0x8e, 0x94, 0x18, 0xfc, 0x1b, 0x02, 0x86, 0x13, 0x08, 0x90, 0x50, 0x19, 0x26, 0x6f, 0x61, 0x87,
-		0x9e, 0x6f, 0x61, 0x37, 0x81, 0x16, 0xe2, 0xf0, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe8, 0x32, 0xa4,
-		0xf4, 0x6d, 0xc3, 0xa4, 0x47, 0x1f, 0x72, 0x97, 0xee, 0x8f, 0x41, 0xac, 0x54, 0x97, 0xea, 0x0c,
-		0x8b, 0x9e, 0xfe, 0xa3, 0xe0, 0xa4, 0xd3, 0xee, 0xbe, 0x65, 0xec, 0x39, 0xb6, 0xb5, 0x4f, 0x0f,
-		0xfb, 0xc4, 0x77, 0xba, 0x21, 0x60, 0x44, 0xa7, 0x7d, 0x24, 0xd2, 0xe9, 0x1d, 0xac, 0x7b, 0x3d,
-		0x07, 0xf3, 0x7b, 0xf0, 0x71, 0x9d, 0xbe, 0xca, 0x20, 0x44, 0xa7, 0x39, 0x82, 0x52, 0x87, 0x5c,
-		0x68, 0x1e, 0xd8, 0x89, 0xf8, 0x3b, 0xda, 0x36, 0x59, 0x24, 0x7c, 0xc1, 0x67, 0x3a, 0xfa, 0x1d,
-		0xba, 0x68, 0xd0, 0x71, 0x98, 0x24, 0x95, 0xbb, 0xfc, 0x94, 0x64, 0x52, 0x4d, 0x77, 0xf4, 0x3b,
-		0x2b, 0xba, 0x7b, 0x3d, 0x95, 0x49, 0xca, 0x29, 0xe5, 0x53, 0x12, 0x14, 0xa3, 0x53, 0x83, 0x1e,
-		0x01, 0x44, 0x30, 0xf4, 0x5d, 0xac, 0x59, 0xbd, 0x8e, 0x46, 0x27, 0x59, 0xd0, 0x2d, 0x75, 0xf4,
-		0x3b, 0x95, 0x5d, 0xbc, 0xde, 0xeb, 0xd0, 0x0e, 0xb8, 0x68, 0x0d, 0x64, 0x01, 0x2c, 0x04, 0xd0,
-		0x37, 0xb7, 0x03, 0xb7, 0xf2, 0x39, 0x40, 0x35, 0x43, 0x0c, 0xd4, 0x87, 0xff, 0xdb, 0xbc, 0xa4,
-		0x16, 0x19, 0x3d, 0xdf, 0x30, 0x44, 0x86, 0x92, 0x8c, 0x0e, 0x45, 0x79, 0x01, 0x4a, 0x7d, 0x52,
-		0x80, 0x14, 0x28, 0x74, 0x7b, 0xdb, 0xda, 0x4d, 0xbc, 0x4f, 0xaf, 0x89, 0x31, 0xf3, 0x98, 0x55,
-		0x73, 0xdd, 0xde, 0xf6, 0x8b, 0x78, 0x9f, 0xae, 0xbe, 0x72, 0xe6, 0x17, 0x88, 0x03, 0xf5, 0xc6,
-		0xbc, 0xa4, 0x3c, 0x02, 0x85, 0x88, 0x18, 0x10, 0x2b, 0xac, 0x77, 0xbb, 0x5c, 0xff, 0x91, 0x3f,
-		0x43, 0xc0, 0xaf, 0x40, 0x9e, 0x38, 0x1e, 0xb8, 0xc5, 0x61, 0x1f, 0x82, 0x12, 0x65, 0x85, 0xd6,
-		0xcf, 0xeb, 0x02, 0x2d, 0x5e, 0x13, 0x0c, 0x57, 0xa0, 0x10, 0xc0, 0x05, 0x6c, 0xcf, 0x09, 0xa8,
-		0x15, 0xdd, 0x55, 0xbe, 0x5f, 0x82, 0x52, 0x9f, 0x6c, 0xa0, 0xe7, 0x20, 0xdb, 0x75, 0xb0, 0x61,
-		0xba, 0xec, 0x18, 0xd1, 0x08, 0x16, 0xa6, 0x28, 0xfb, 0x02, 0x0c, 0xb4, 0x0c, 0x05, 0x71, 0xa4,
-		0xa4, 0x85, 0xdb, 0xfa, 0xfe, 0xe8, 0x59, 0x60, 0x24, 0xc4, 0x13, 0x29, 0xcb, 0x04, 0x49, 0xf9,
-		0x65, 0x09, 0x0a, 0x11, 0xa1, 0x43, 0x2d, 0xb8, 0xff, 0x96, 0xed, 0xe1, 0x70, 0xaa, 0x83, 0x5f,
-		0x1d, 0xda, 0xc3, 0xe6, 0xee, 0x9e, 0xc7, 0xbb, 0x7a, 0x72, 0xa0, 0x9d, 0xc0, 0xd0, 0x50, 0x87,
-		0x44, 0x52, 0xe7, 0x08, 0x9d, 0x20, 0xe3, 0xc1, 0xee, 0x18, 0x5d, 0xa3, 0x44, 0xd0, 0x06, 0xa0,
-		0xee, 0xb6, 0xd7, 0x4f, 0x3a, 0x31, 0x2e, 0x69, 0x99, 0x20, 0x87, 0x09, 0x2a, 0x0d, 0x80, 0x60,
-		0xe1, 0xa2, 0xca, 0x38, 0x83, 0x48, 0x1e, 0xd4, 0xc3, 0x72, 0x62, 0x56, 0xaa, 0x6e, 0x7e, 0xf2,
-		0xf3, 0xa7, 0x86, 0x1a, 0x9a, 0x57, 0x2e, 0x8d, 0xef, 0x51, 0x09, 0xdd, 0xef, 0x5b, 0x86, 0xbf,
-		0x48, 0xc3, 0xe9, 0x41, 0xcb, 0xe0, 0xab, 0xb8, 0xc3, 0x1a, 0x87, 0x83, 0xa3, 0x18, 0xe5, 0x33,
-		0x12, 0xe4, 0xfd, 0x95, 0xd4, 0xc0, 0x1e, 0x7a, 0x17, 0x80, 0xdf, 0x96, 0x70, 0x31, 0xef, 0x3b,
-		0x48, 0x09, 0xab, 0x21, 0x78, 0xf4, 0x34, 0x64, 0xba, 0x8e, 0xdd, 0xb5, 0x5d, 0x7e, 0xf1, 0x75,
-		0x14, 0xae, 0x0f, 0x8d, 0x1e, 0x05, 0x44, 0x03, 0x02, 0xed, 0x96, 0xed, 0x99, 0xd6, 0xae, 0xd6,
-		0xb5, 0x6f, 0xf3, 0xf7, 0x04, 0x92, 0xaa, 0x4c, 0x6b, 0x6e, 0xd0, 0x8a, 0x4d, 0x52, 0xae, 0x7c,
-		0x5a, 0x82, 0xac, 0x4f, 0x85, 0xf8, 0x90, 0x7a, 0xab, 0xe5, 0x60, 0xd7, 0xe5, 0xae, 0x80, 0xf8,
-		0x44, 0xef, 0x82, 0x49, 0xae, 0x14, 0xfc, 0x6b, 0xd5, 0x71, 0xde, 0xb2, 0x88, 0xce, 0xb8, 0xbf,
-		0x9c, 0x66, 0x3a, 0x03, 0x9d, 0x86, 0x7c, 0x4c, 0x6f, 0x72, 0xb7, 0x82, 0x8e, 0xd0, 0xe7, 0x8a,
-		0xf8, 0x10, 0xb4, 0xae, 0x63, 0xda, 0x8e, 0xe9, 0xed, 0x53, 0xd3, 0x93, 0x54, 0x65, 0x51, 0xb1,
-		0xc9, 0xcb, 0x95, 0x36, 0x94, 0x1a, 0x66, 0xa7, 0x4b, 0x3d, 0x3c, 0xde, 0xf5, 0x2b, 0x41, 0x07,
-		0xa5, 0x31, 0x3a, 0x38, 0xb4, 0x6b, 0x89, 0x81, 0xae, 0x9d, 0xff, 0x4d, 0x89, 0xdb, 0x86, 0xfa,
-		0xf2, 0xd5, 0xb6, 0xbe, 0x8b, 0x2e, 0xc2, 0xd1, 0xea, 0xea, 0xc6, 0xd2, 0x8b, 0x5a, 0x7d, 0x59,
-		0xbb, 0xba, 0x5a, 0x59, 0x09, 0x4e, 0xf1, 0xce, 0x1d, 0x7b, 0xfd, 0xee, 0x02, 0x0a, 0xc1, 0x6e,
-		0x59, 0xd4, 0xc5, 0x41, 0x17, 0x60, 0x26, 0x8a, 0x52, 0xa9, 0x36, 0x6a, 0xeb, 0x4d, 0x59, 0x9a,
-		0x3b, 0xfa, 0xfa, 0xdd, 0x85, 0xa9, 0x10, 0x46, 0x65, 0xdb, 0xc5, 0x96, 0x37, 0x88, 0xb0, 0xb4,
-		0xb1, 0xb6, 0x56, 0x6f, 0xca, 0x89, 0x01, 0x84, 0x25, 0xbb, 0xd3, 0x31, 0x3d, 0xf4, 0x30, 0x4c,
-		0x45, 0x11, 0xd6, 0xeb, 0xab, 0x72, 0x72, 0x0e, 0xbd, 0x7e, 0x77, 0xa1, 0x18, 0x82, 0x5e, 0x37,
-		0xdb, 0x73, 0x99, 0x0f, 0xfe, 0xe8, 0xa9, 0x23, 0x9f, 0xfc, 0xc7, 0xa7, 0xa4, 0xea, 0xea, 0x3b,
-		0xb2, 0xf0, 0x7e, 0x20, 0x01, 0xf3, 0xfd, 0x9e, 0x92, 0x67, 0x76, 0xb0, 0xeb, 0xe9, 0x9d, 0xee,
-		0x30, 0xa7, 0xfd, 0x59, 0xc8, 0x36, 0x05, 0xcc, 0xa1, 0x63, 0x99, 0xbb, 0x87, 0x74, 0x55, 0x8b,
-		0x7e, 0x53, 0xc2, 0x57, 0xbd, 0x34, 0xa6, 0xaf, 0xea, 0x8f, 0xe3, 0x9e, 0x9c, 0xd5, 0xdf, 0x69,
-		0xc0, 0x7d, 0x01, 0x13, 0xb7, 0x0d, 0x93, 0x28, 0x11, 0xb6, 0x9a, 0x19, 0x5b, 0x64, 0x5f, 0x66,
-		0x49, 0x2d, 0x51, 0x46, 0x07, 0xab, 0x9d, 0xb9, 0x11, 0xe9, 0x85, 0xb9, 0x11, 0xbe, 0xf1, 0xdc,
-		0x68, 0x0d, 0x39, 0x44, 0x1d, 0x8e, 0x9a, 0x61, 0xe5, 0x3f, 0x67, 0x61, 0x52, 0xc5, 0xef, 0xed,
-		0x61, 0xd7, 0x43, 0x4f, 0x40, 0x0a, 0x1b, 0x7b, 0xf6, 0xe0, 0xca, 0xe4, 0xa3, 0x5c, 0xac, 0x19,
-		0x7b, 0x36, 0x07, 0xbe, 0x76, 0x44, 0xa5, 0xc0, 0xe8, 0x0a, 0x4c, 0xec, 0xb4, 0x7b, 0xee, 0x1e,
-		0x57, 0x38, 0xa7, 0x06, 0xb1, 0xae, 0x92, 0xea, 0x00, 0x8d, 0x81, 0x93, 0xc6, 0xe8, 0x73, 0x5a,
-		0xc9, 0x61, 0x8d, 0xd1, 0x57, 0xb4, 0x82, 0xc6, 0x08, 0x30, 0x5a, 0x02, 0x30, 0x2d, 0xd3, 0xd3,
-		0x8c, 0x3d, 0xdd, 0xb4, 0xb8, 0xe7, 0xaa, 0xc4, 0xa1, 0x9a, 0xde, 0x12, 0x01, 0x09, 0xf0, 0xb3,
-		0xa6, 0x28, 0x23, 0x3d, 0x7e, 0x6f, 0x0f, 0x3b, 0xc2, 0x7b, 0x8d, 0xe9, 0xf1, 0xbb, 0x49, 0x75,
-		0xa8, 0xc7, 0x14, 0x9c, 0x78, 0xfb, 0xec, 0xaa, 0xb7, 0x77, 0x87, 0x3f, 0x60, 0xb2, 0x30, 0x88,
-		0x4a, 0x6f, 0x7a, 0x37, 0xef, 0x04, 0xc8, 0x93, 0x06, 0x2b, 0x41, 0xcf, 0x40, 0xda, 0xa0, 0x4a,
-		0x80, 0x5e, 0xc0, 0xcc, 0x5d, 0x9a, 0x8f, 0x41, 0xa6, 0xf5, 0x01, 0x2e, 0x47, 0x40, 0x1b, 0x50,
-		0x6c, 0x9b, 0xae, 0xa7, 0xb9, 0x96, 0xde, 0x75, 0xf7, 0x6c, 0xcf, 0xa5, 0x6f, 0x88, 0xe5, 0x2e,
-		0x3d, 0x34, 0x48, 0x62, 0xd5, 0x74, 0xbd, 0x86, 0x00, 0x0b, 0x28, 0x15, 0xda, 0xe1, 0x72, 0x42,
-		0xd0, 0xde, 0xd9, 0xc1, 0x8e, 0x4f, 0x91, 0xbe, 0x3d, 0x16, 0x4b, 0x70, 0x83, 0xc0, 0x09, 0xcc,
-		0x10, 0x41, 0x3b, 0x5c, 0x8e, 0xbe, 0x01, 0xa6, 0xdb, 0xb6, 0xde, 0xf2, 0xe9, 0x69, 0xc6, 0x5e,
-		0xcf, 0xba, 0x39, 0x5b, 0xa4, 0x54, 0xcf, 0xc7, 0x74, 0xd3, 0xd6, 0x5b, 0x02, 0x79, 0x89, 0x80,
-		0x06, 0x94, 0xa7, 0xda, 0xfd, 0x75, 0x48, 0x83, 0x19, 0xbd, 0xdb, 0x6d, 0xef, 0xf7, 0x93, 0x2f,
-		0x51, 0xf2, 0x8f, 0x0c, 0x92, 0xaf, 0x10, 0xe8, 0x21, 0xf4, 0x91, 0x3e, 0x50, 0x89, 0xb6, 0x40,
-		0xee, 0x3a, 0x98, 0x9e, 0xcc, 0x60, 0x56, 0x4c, 0x6f, 0xd3, 0x3b, 0x92, 0xb9, 0x4b, 0xe7, 0x06,
-		0x89, 0x6f, 0x32, 0xc8, 0x4d, 0x0e, 0x18, 0x50, 0x2e, 0x75, 0xa3, 0x35, 0x8c, 0xac, 0x6d, 0x60,
-		0x7a, 0x87, 0x9b, 0x93, 0x9d, 0x1a, 0x4e, 0x96, 0x42, 0xc6, 0x92, 0x8d, 0xd4, 0xa0, 0xab, 0x90,
-		0x63, 0xef, 0xf6, 0x10, 0xe7, 0x01, 0xd3, 0xbb, 0x95, 0xb9, 0x4b, 0x67, 0x62, 0x96, 0x2b, 0x05,
-		0xba, 0x61, 0x7b, 0x38, 0x20, 0x06, 0xd8, 0x2f, 0x44, 0xdb, 0x70, 0x94, 0xde, 0x33, 0xdd, 0xd7,
-		0xa2, 0x2e, 0xe2, 0xec, 0x34, 0xa5, 0xf8, 0xe8, 0x20, 0x45, 0xfa, 0xc8, 0xd2, 0xfe, 0x8d, 0xb0,
-		0xaf, 0x18, 0x90, 0x9e, 0xbe, 0x35, 0x58, 0x4b, 0x24, 0x6d, 0xc7, 0xb4, 0xf4, 0xb6, 0xf9, 0x1a,
-		0x66, 0xf1, 0x14, 0x7d, 0x62, 0x21, 0x56, 0xd2, 0xae, 0x72, 0x38, 0x6a, 0x07, 0x43, 0x92, 0xb6,
-		0x13, 0x2e, 0xaf, 0x4e, 0xf2, 0x2c, 0x88, 0x7f, 0x67, 0x78, 0x52, 0xce, 0xb0, 0x7b, 0xc2, 0xd7,
-		0x53, 0x19, 0x90, 0x73, 0xca, 0x59, 0xc8, 0x85, 0xf4, 0x14, 0x31, 0x52, 0xdc, 0xcf, 0xe7, 0xb9,
-		0x15, 0xf1, 0xa9, 0x14, 0x21, 0x1f, 0x56, 0x4d, 0xca, 0x87, 0x24, 0xc8, 0x85, 0x94, 0x0e, 0xc1,
-		0x14, 0xc1, 0x35, 0xc7, 0x14, 0xa1, 0xf3, 0x19, 0x11, 0xe8, 0x88, 0xfa, 0x04, 0x0d, 0xa3, 0xf2,
-		0xb4, 0x90, 0xc7, 0x59, 0x68, 0x1e, 0x72, 0xdd, 0x4b, 0x5d, 0x1f, 0x24, 0x49, 0x41, 0xa0, 0x7b,
-		0xa9, 0x2b, 0x00, 0x4e, 0x43, 0x9e, 0x0c, 0x5d, 0x0b, 0x47, 0xf0, 0x59, 0x35, 0x47, 0xca, 0x38,
-		0x88, 0xf2, 0xeb, 0x09, 0x90, 0xfb, 0x95, 0x19, 0x7a, 0x1a, 0x52, 0x44, 0x8b, 0x73, 0x35, 0x3d,
-		0x37, 0x10, 0x23, 0xf8, 0x56, 0x93, 0x45, 0x9b, 0x1f, 0x22, 0xb1, 0x0e, 0xc5, 0x40, 0x27, 0x88,
-		0x06, 0xd3, 0x4d, 0x4b, 0x33, 0x5b, 0xe2, 0x9d, 0x4a, 0xfa, 0x5d, 0x6f, 0x91, 0x68, 0xd6, 0x10,
-		0x39, 0x11, 0x8d, 0xd9, 0x9e, 0x03, 0x52, 0x12, 0x7d, 0xe9, 0x13, 0xb5, 0x64, 0xf4, 0xe5, 0x53,
-		0x56, 0x22, 0x6e, 0x35, 0x7b, 0xfd, 0xe6, 0x74, 0x8c, 0x3c, 0x09, 0x98, 0xad, 0x6e, 0x4b, 0xf7,
-		0x30, 0xf7, 0x47, 0xc3, 0x1e, 0xf6, 0x43, 0x50, 0xd2, 0xbb, 0x5d, 0xcd, 0xf5, 0x74, 0x0f, 0xf3,
-		0xd8, 0x73, 0x82, 0xfa, 0xbc, 0x05, 0xbd, 0xdb, 0xa5, 0xef, 0x7c, 0xb1, 0xd8, 0xf3, 0x41, 0x28,
-		0x12, 0x0d, 0x6f, 0xea, 0x6d, 0x11, 0xd8, 0xa4, 0x59, 0x88, 0xca, 0x4b, 0x79, 0x70, 0xd4, 0x82,
-		0x7c, 0x58, 0xb9, 0xfb, 0xa9, 0x67, 0x29, 0x48, 0x3d, 0x93, 0x32, 0x7a, 0xf1, 0x84, 0x71, 0x48,
-		0x5c, 0xd6, 0x49, 0x73, 0xb2, 0xcc, 0x29, 0xe6, 0x5f, 0xc4, 0xd1, 0xe9, 0x3a, 0xf6, 0x2d, 0x76,
-		0x99, 0x2a, 0xa3, 0xb2, 0x0f, 0xe5, 0x65, 0x28, 0x46, 0xed, 0x00, 0x2a, 0x42, 0xc2, 0xbb, 0xc3,
-		0x5b, 0x49, 0x78, 0x77, 0xd0, 0xc5, 0xd0, 0x0b, 0x69, 0xc5, 0x38, 0xeb, 0xc7, 0xf1, 0x83, 0xa7,
-		0xbd, 0xae, 0xa7, 0x32, 0x09, 0x39, 0xa9, 0x94, 0xa0, 0x10, 0xb1, 0x12, 0xca, 0x31, 0x98, 0x89,
-		0xd3, 0xf9, 0x8a, 0x09, 0x33, 0x71, 0xaa, 0x1b, 0x5d, 0x81, 0x8c, 0xaf, 0xf4, 0x85, 0x04, 0x0d,
-		0xb4, 0xee, 0x23, 0xf9, 0xb0, 0x44, 0x76, 0xc8, 0x44, 0xd0, 0x1d, 0x8a, 0x04, 0x8f, 0x3a, 0xba,
-		0xdd, 0x6b, 0xba, 0xbb, 0xa7, 0x7c, 0x33, 0xcc, 0x0e, 0xd3, 0xe7, 0x21, 0xc6, 0xb1, 0x54, 0x83,
-		0x60, 0xdc, 0x31, 0x48, 0xf3, 0xd7, 0x16, 0x12, 0x34, 0x73, 0xca, 0xbf, 0x08, 0x43, 0x99, 0x6e,
-		0x4f, 0xb2, 0x84, 0x2a, 0xfd, 0x50, 0x34, 0x38, 0x31, 0x54, 0xa5, 0x07, 0x5b, 0x2a, 0x3c, 0x07,
-		0xcb, 0xb6, 0x54, 0x7c, 0x42, 0xac, 0xb3, 0xec, 0x83, 0xbe, 0xc2, 0x89, 0xad, 0x16, 0x0f, 0x6e,
-		0xb2, 0x2a, 0xff, 0x52, 0x3e, 0x92, 0x84, 0x63, 0xf1, 0x7a, 0x1d, 0x2d, 0x40, 0xbe, 0xa3, 0xdf,
-		0xd1, 0xbc, 0x68, 0xea, 0x03, 0x3a, 0xfa, 0x9d, 0x26, 0xcf, 0x7b, 0xc8, 0x90, 0xf4, 0xee, 0xb8,
-		0xf4, 0x22, 0x57, 0x5e, 0x25, 0x7f, 0xa2, 0x1b, 0x30, 0xd5, 0xb6, 0x0d, 0xbd, 0xad, 0xb5, 0x75,
-		0xd7, 0xd3, 0xb8, 0xd9, 0x67, 0xcb, 0xe9, 0x81, 0x61, 0x7a, 0x9a, 0x5d, 0xb7, 0x32, 0x3d, 0xa2,
-		0x82, 0xf8, 0x42, 0x28, 0x51, 0x22, 0xab, 0xba, 0xeb, 0xf1, 0xf0, 0xa1, 0x06, 0xb9, 0x8e, 0xe9,
-		0x6e, 0xe3, 0x3d, 0xfd, 0x96, 0x69, 0x3b, 0x7c, 0x5d, 0xc5, 0x48, 0xcf, 0x5a, 0x00, 0xc4, 0x49,
-		0x85, 0xf1, 0x42, 0x93, 0x32, 0x11, 0x91, 0x66, 0xa1, 0x59, 0xd2, 0x87, 0xd6, 0x2c, 0x8f, 0xc3,
-		0x8c, 0x85, 0xef, 0xd0, 0xbb, 0x82, 0x7c, 0xe5, 0x32, 0x49, 0x61, 0x57, 0xfd, 0x10, 0xa9, 0xf3,
-		0xd7, 0xba, 0x4b, 0x77, 0xb5, 0x1e, 0x06, 0x3f, 0x60, 0xd4, 0x44, 0x34, 0x9b, 0xa1, 0xd0, 0x25,
-		0x51, 0x5e, 0x61, 0xc5, 0xca, 0xeb, 0x74, 0x72, 0xe2, 0xac, 0xa3, 0x60, 0xbd, 0x14, 0xb0, 0xbe,
-		0x09, 0x33, 0x1c, 0xbf, 0x15, 0xe1, 0xfe, 0x40, 0x78, 0x1e, 0x75, 0xba, 0x42, 0x5c, 0x47, 0x02,
-		0x7f, 0x38, 0xe3, 0x93, 0xf7, 0xc8, 0x78, 0x04, 0x29, 0xca, 0x96, 0x14, 0x53, 0x37, 0xe4, 0xef,
-		0xff, 0xd7, 0x26, 0xe3, 0xfd, 0x49, 0x98, 0x1a, 0x70, 0x2c, 0xfc, 0x81, 0x49, 0xb1, 0x03, 0x4b,
-		0xc4, 0x0e, 0x2c, 0x79, 0xe8, 0x81, 0xf1, 0xd9, 0x4e, 0x8d, 0x9e, 0xed, 0x89, 0xb7, 0x73, 0xb6,
-		0xd3, 0xf7, 0x38, 0xdb, 0xef, 0xe8, 0x3c, 0x7c, 0x4c, 0x82, 0xb9, 0xe1, 0xee, 0x58, 0xec, 0x84,
-		0x3c, 0x02, 0x53, 0x7e, 0x57, 0x7c, 0xf2, 0x4c, 0x3d, 0xca, 0x7e, 0x05, 0xa7, 0x3f, 0xd4, 0xe2,
-		0x3d, 0x08, 0xc5, 0x3e, 0x6f, 0x91, 0x09, 0x73, 0x21, 0x92, 0x41, 0x54, 0x3e, 0x90, 0x84, 0x99,
-		0x38, 0x87, 0x2e, 0x66, 0xc5, 0xaa, 0x30, 0xdd, 0xc2, 0x86, 0xd9, 0xba, 0xe7, 0x05, 0x3b, 0xc5,
-		0xd1, 0xff, 0xff, 0x7a, 0x8d, 0x91, 0x93, 0x1f, 0x03, 0xc8, 0xa8, 0xd8, 0xed, 0x12, 0x07, 0x8d,
-		0xbd, 0xf6, 0x6c, 0xe0, 0xae, 0x17, 0x64, 0xda, 0x63, 0xe3, 0x06, 0x0e, 0x22, 0xf0, 0x48, 0xfc,
-		0xec, 0xe3, 0xa1, 0x27, 0x79, 0x9a, 0x60, 0x68, 0xc0, 0xcf, 0xdc, 0x6f, 0x1f, 0x95, 0xe5, 0x09,
-		0x9e, 0x12, 0x79, 0x82, 0xe4, 0xb0, 0xe8, 0x97, 0x3b, 0xe3, 0x3e, 0x1e, 0x4f, 0x14, 0x3c, 0xc9,
-		0x13, 0x05, 0xa9, 0x61, 0xcd, 0x31, 0x9f, 0x3d, 0x68, 0xce, 0x64, 0x17, 0xb9, 0xc3, 0x99, 0x82,
-		0xf4, 0xb0, 0xa1, 0x86, 0x9c, 0xeb, 0x60, 0xa8, 0x41, 0xaa, 0xe0, 0x29, 0x91, 0x2a, 0x98, 0x1c,
-		0xd6, 0x69, 0xee, 0x4d, 0x06, 0x9d, 0x66, 0xb9, 0x82, 0xe7, 0x43, 0xb9, 0x82, 0x6c, 0xff, 0xce,
-		0xe0, 0x40, 0xae, 0xc0, 0xc7, 0xf6, 0x93, 0x05, 0x65, 0x3f, 0x59, 0x90, 0x1f, 0x9a, 0x69, 0xe0,
-		0x6e, 0xa0, 0x8f, 0x2c, 0xb2, 0x05, 0x9b, 0x03, 0xd9, 0x02, 0x16, 0xdc, 0x9f, 0x1d, 0x99, 0x2d,
-		0xf0, 0x49, 0xf5, 0xa5, 0x0b, 0x36, 0x07, 0xd2, 0x05, 0xc5, 0x61, 0x14, 0xfb, 0x7c, 0xce, 0x80,
-		0x62, 0x34, 0x5f, 0xf0, 0x8d, 0xf1, 0xf9, 0x82, 0xa1, 0x01, 0x7d, 0x8c, 0x7f, 0xe9, 0x93, 0x8e,
-		0x49, 0x18, 0x7c, 0xf3, 0x90, 0x84, 0x81, 0x3c, 0x2c, 0xb0, 0x8d, 0xf3, 0x2e, 0xfd, 0x06, 0xe2,
-		0x32, 0x06, 0x37, 0x62, 0x32, 0x06, 0x2c, 0xb4, 0x7f, 0x78, 0x8c, 0x8c, 0x81, 0x4f, 0x7a, 0x20,
-		0x65, 0x70, 0x23, 0x26, 0x65, 0x80, 0x86, 0xd3, 0xed, 0x73, 0x8a, 0xc2, 0x74, 0xa3, 0x39, 0x83,
-		0x95, 0x68, 0xce, 0x60, 0xfa, 0x60, 0x5f, 0x94, 0x99, 0x76, 0x9f, 0x5a, 0x38, 0x69, 0x60, 0x0c,
-		0x4b, 0x1a, 0xb0, 0xb8, 0xfe, 0xb1, 0x31, 0x93, 0x06, 0x3e, 0xed, 0xd8, 0xac, 0xc1, 0xe6, 0x40,
-		0xd6, 0xe0, 0xe8, 0x30, 0x81, 0xeb, 0x33, 0x32, 0x81, 0xc0, 0x0d, 0x4d, 0x1b, 0xb0, 0x47, 0xc6,
-		0xd8, 0xf3, 0x62, 0x20, 0xe7, 0xae, 0xa7, 0x32, 0x39, 0x39, 0xaf, 0x3c, 0x4c, 0xdc, 0x9a, 0x3e,
-		0xbd, 0x47, 0x82, 0x08, 0xec, 0x38, 0xb6, 0x23, 0x8e, 0x65, 0xd0, 0x0f, 0xe5, 0x1c, 0xe4, 0xc3,
-		0x2a, 0xee, 0x80, 0x14, 0x43, 0x09, 0x0a, 0x11, 0xad, 0xa6, 0xfc, 0x82, 0x04, 0xf9, 0xb0, 0xbe,
-		0x8a, 0x04, 0xa0, 0x59, 0x1e, 0x80, 0x86, 0x12, 0x0f, 0x89, 0x68, 0xe2, 0x61, 0x1e, 0x72, 0x24,
-		0x08, 0xeb, 0xcb, 0x29, 0xe8, 0x5d, 0x3f, 0xa7, 0x70, 0x1e, 0xa6, 0xa8, 0x0d, 0x65, 0xe9, 0x09,
-		0x6e, 0xa7, 0xd8, 0xfe, 0x4c, 0x89, 0x54, 0x50, 0x66, 0xf0, 0x9d, 0xc7, 0xc7, 0x60, 0x3a, 0x04,
-		0xeb, 0x07, 0x77, 0x2c, 0xbc, 0x96, 0x7d, 0xe8, 0x0a, 0x8f, 0xf2, 0x7e, 0x59, 0x82, 0xa9, 0x01,
-		0x75, 0x19, 0x9b, 0x37, 0x90, 0xde, 0xae, 0xbc, 0x41, 0xe2, 0xde, 0xf3, 0x06, 0xe1, 0x70, 0x35,
-		0x19, 0x0d, 0x57, 0xff, 0x52, 0x82, 0x42, 0x44, 0x6d, 0x93, 0x49, 0x30, 0xec, 0x96, 0x38, 0xc4,
-		0x43, 0xff, 0x26, 0x7e, 0x4a, 0xdb, 0xde, 0xe5, 0x61, 0x22, 0xf9, 0x93, 0x40, 0xf9, 0x86, 0x28,
-		0xcb, 0xcd, 0x8c, 0x1f, 0x7b, 0x4e, 0x84, 0x8f, 0xf3, 0xf1, 0x23, 0x6e, 0xe9, 0xe0, 0x88, 0x9b,
-		0x7f, 0x76, 0x67, 0x32, 0x74, 0x76, 0x07, 0x3d, 0x03, 0x59, 0xba, 0x0b, 0xa0, 0xd9, 0xdd, 0xe0,
-		0x87, 0x29, 0x86, 0x1f, 0x6f, 0x73, 0xe9, 0xfe, 0x21, 0x3b, 0x13, 0x17, 0x78, 0x21, 0xd9, 0x88,
-		0x17, 0x72, 0x1f, 0x64, 0x49, 0xf7, 0xd9, 0xe3, 0x8e, 0xc0, 0x0f, 0xd3, 0x8a, 0x02, 0xe5, 0x27,
-		0x13, 0x50, 0xea, 0xb3, 0x3a, 0xb1, 0x83, 0x17, 0x52, 0x99, 0x08, 0xa5, 0x45, 0xc6, 0x63, 0xc8,
-		0x29, 0x80, 0x5d, 0xdd, 0xd5, 0x6e, 0xeb, 0x96, 0xc7, 0xdf, 0x70, 0x4f, 0xaa, 0xa1, 0x12, 0x34,
-		0x07, 0x19, 0xf2, 0xd5, 0x73, 0xf9, 0x2b, 0xee, 0x49, 0xd5, 0xff, 0x46, 0x75, 0x48, 0xe3, 0x5b,
-		0xf4, 0x39, 0x12, 0xf6, 0xa8, 0xcf, 0xf1, 0x18, 0xf5, 0x44, 0xea, 0xab, 0xb3, 0x64, 0xba, 0xff,
-		0xf0, 0xcd, 0x79, 0x99, 0x81, 0x3f, 0xea, 0x3f, 0xbf, 0xa0, 0x72, 0x02, 0x51, 0x36, 0x64, 0xfa,
-		0xd8, 0x40, 0xd3, 0x85, 0x79, 0x11, 0xfb, 0x13, 0xa6, 0xb2, 0x0d, 0x4b, 0xb5, 0xd0, 0xc1, 0x9d,
-		0xae, 0x6d, 0xb7, 0x35, 0xb6, 0xce, 0x2b, 0x50, 0x8c, 0x1a, 0x59, 0xf6, 0xf2, 0xb2, 0xa7, 0x9b,
-		0x96, 0x16, 0xf1, 0x8d, 0xf3, 0xac, 0x90, 0xad, 0xab, 0xeb, 0xa9, 0x8c, 0x24, 0x27, 0x78, 0xba,
-		0xe6, 0xdd, 0x70, 0x34, 0xd6, 0xc6, 0xa2, 0xa7, 0x21, 0x1b, 0xd8, 0x67, 0xb6, 0xed, 0x7c, 0x50,
-		0x1e, 0x26, 0x00, 0x56, 0x6e, 0xc0, 0xd1, 0x58, 0x23, 0x8b, 0x9e, 0x83, 0xb4, 0x83, 0xdd, 0x5e,
-		0xdb, 0xe3, 0xcf, 0x22, 0x3e, 0x38, 0xda, 0x3a, 0xf7, 0xda, 0x9e, 0xca, 0x91, 0x94, 0x8b, 0x70,
-		0x62, 0xa8, 0x95, 0x0d, 0xb2, 0x29, 0x52, 0x28, 0x9b, 0xa2, 0xfc, 0xb4, 0x04, 0x73, 0xc3, 0x2d,
-		0x27, 0xaa, 0xf6, 0x75, 0xe8, 0xfc, 0x98, 0x76, 0x37, 0xd4, 0x2b, 0x12, 0x6e, 0x38, 0x78, 0x07,
-		0x7b, 0xc6, 0x1e, 0x33, 0xe1, 0x4c, 0x29, 0x14, 0xd4, 0x02, 0x2f, 0xa5, 0x38, 0x2e, 0x03, 0x7b,
-		0x15, 0x1b, 0x9e, 0xc6, 0x26, 0xd5, 0xe5, 0x3f, 0xb5, 0x53, 0x60, 0xa5, 0x0d, 0x56, 0xa8, 0x3c,
-		0x02, 0xc7, 0x87, 0xd8, 0xe2, 0xc1, 0xb8, 0x44, 0x79, 0x85, 0x00, 0xc7, 0x1a, 0x58, 0xf4, 0x02,
-		0xa4, 0x5d, 0x4f, 0xf7, 0x7a, 0x2e, 0x1f, 0xd9, 0xd9, 0x91, 0xb6, 0xb9, 0x41, 0xc1, 0x55, 0x8e,
-		0xa6, 0x3c, 0x0b, 0x68, 0xd0, 0xd2, 0xc6, 0xc4, 0x56, 0x52, 0x5c, 0x6c, 0xb5, 0x0d, 0x27, 0x0f,
-		0xb0, 0xa9, 0x68, 0xa9, 0xaf, 0x73, 0x8f, 0x8c, 0x65, 0x92, 0xfb, 0x3a, 0xf8, 0xc7, 0x09, 0x38,
-		0x1a, 0x6b, 0x5a, 0x43, 0xab, 0x54, 0x7a, 0xab, 0xab, 0xf4, 0x39, 0x00, 0xef, 0x8e, 0xc6, 0x66,
-		0x5a, 0x68, 0xfb, 0xb8, 0x78, 0xe2, 0x0e, 0x36, 0xa8, 0xc2, 0x22, 0x82, 0x91, 0xf5, 0xf8, 0x5f,
-		0x24, 0xf8, 0x0f, 0xc5, 0xb3, 0x3d, 0x6a, 0x09, 0x5c, 0x1e, 0xea, 0x8d, 0x6d, 0x33, 0x82, 0xc0,
-		0x97, 0x15, 0xbb, 0xe8, 0x15, 0x38, 0xde, 0x67, 0xd1, 0x7c, 0xda, 0xa9, 0xb1, 0x0d, 0xdb, 0xd1,
-		0xa8, 0x61, 0x13, 0xb4, 0xc3, 0x56, 0x69, 0x22, 0x6a, 0x95, 0x5e, 0x01, 0x08, 0x02, 0x5b, 0xb2,
-		0xde, 0x1c, 0xbb, 0x67, 0xb5, 0xc4, 0xe1, 0x53, 0xfa, 0x81, 0xae, 0xc0, 0x04, 0x91, 0x04, 0xc1,
-		0xaa, 0x18, 0x85, 0x41, 0xa6, 0x34, 0x14, 0x19, 0x33, 0x70, 0xe5, 0x55, 0x21, 0x6d, 0xe1, 0x1c,
-		0xe3, 0x90, 0x36, 0x9e, 0x8f, 0xb6, 0xa1, 0x0c, 0x4f, 0x57, 0xc6, 0xb7, 0xf5, 0x77, 0x60, 0x82,
-		0x4e, 0x7f, 0xec, 0xd9, 0xef, 0x6f, 0x02, 0xd0, 0x3d, 0xcf, 0x31, 0xb7, 0x7b, 0x41, 0x0b, 0x0b,
-		0x43, 0xe4, 0xa7, 0x22, 0x00, 0xab, 0xf7, 0x71, 0x41, 0x9a, 0x09, 0x70, 0x43, 0xc2, 0x14, 0xa2,
-		0xa8, 0xac, 0x43, 0x31, 0x8a, 0x1b, 0x7f, 0x98, 0x5d, 0xfc, 0x2a, 0x40, 0x70, 0xd4, 0x36, 0x30,
-		0xe4, 0xfc, 0xb6, 0x10, 0xfd, 0x50, 0xbe, 0x25, 0x01, 0xf9, 0xb0, 0xf4, 0xfd, 0x2d, 0x34, 0x96,
-		0xca, 0x07, 0x24, 0xc8, 0xf8, 0xe3, 0x8f, 0xa6, 0xf3, 0x23, 0xfb, 0x20, 0xc1, 0xb5, 0x06, 0x3f,
-		0x07, 0xcf, 0x76, 0x3d, 0x92, 0xfe, 0xae, 0xc7, 0xbb, 0x7c, 0x83, 0x30, 0x34, 0x98, 0x0f, 0x73,
-		0x5b, 0x1c, 0x4f, 0xe2, 0x06, 0xea, 0xd9, 0xf1, 0xce, 0x40, 0xcd, 0xc0, 0x44, 0xf8, 0xf8, 0x12,
-		0xfb, 0x50, 0x70, 0xe8, 0x04, 0x25, 0x5b, 0x8d, 0xe1, 0xc3, 0x52, 0xd2, 0xe1, 0x0f, 0x4b, 0xf9,
-		0xcd, 0x24, 0xc2, 0xcd, 0xfc, 0x23, 0x09, 0x32, 0x62, 0x5d, 0xa0, 0x17, 0xc2, 0xe7, 0x7b, 0xc5,
-		0x61, 0xc1, 0xe1, 0x7a, 0x89, 0x37, 0x10, 0x3a, 0xde, 0x5b, 0x15, 0xfb, 0x8c, 0x66, 0x4b, 0xdb,
-		0x69, 0xeb, 0xbb, 0x7c, 0xbb, 0x68, 0xe8, 0xe9, 0x64, 0x76, 0x78, 0x88, 0x1f, 0xb8, 0xac, 0xb7,
-		0xc8, 0x07, 0xf7, 0x43, 0xfe, 0x5c, 0x02, 0xb9, 0x7f, 0xdd, 0xbe, 0xf5, 0xfe, 0x0d, 0xda, 0xab,
-		0x64, 0x8c, 0xbd, 0x42, 0x17, 0x60, 0x3a, 0xf8, 0x61, 0x2e, 0xd7, 0xdc, 0xb5, 0xd8, 0xe1, 0x5f,
-		0x96, 0x54, 0x43, 0x7e, 0x55, 0x43, 0xd4, 0x0c, 0x8e, 0x7b, 0xe2, 0x5e, 0xc7, 0xfd, 0xfe, 0x04,
-		0xe4, 0x42, 0x39, 0x3e, 0x74, 0x39, 0xa4, 0x94, 0x8a, 0x71, 0x56, 0x22, 0x04, 0x1c, 0xfa, 0x59,
-		0x9d, 0x08, 0xa7, 0x12, 0xf7, 0xc0, 0xa9, 0x61, 0xd9, 0x54, 0x91, 0x34, 0x4c, 0x1d, 0x3a, 0x69,
-		0x18, 0x7f, 0x80, 0x70, 0x62, 0xc8, 0x01, 0xc2, 0xbf, 0x27, 0x41, 0xc6, 0x4f, 0xbe, 0x1c, 0x76,
-		0x4f, 0xee, 0x18, 0xa4, 0xb9, 0xef, 0xc5, 0x36, 0xe5, 0xf8, 0x57, 0x6c, 0x76, 0x74, 0x0e, 0x32,
-		0xe2, 0x95, 0x79, 0x6e, 0xe1, 0xfc, 0xef, 0xf3, 0xdb, 0x90, 0x0b, 0x6d, 0x6b, 0xa2, 0x13, 0x70,
-		0x74, 0xe9, 0x5a, 0x6d, 0xe9, 0x45, 0xad, 0xf9, 0x52, 0xff, 0xdb, 0xc2, 0x03, 0x55, 0x6a, 0x8d,
-		0x7e, 0xcb, 0x12, 0x3a, 0x0e, 0xd3, 0xd1, 0x2a, 0x56, 0x91, 0x98, 0x4b, 0x7d, 0xf0, 0x47, 0x4f,
-		0x1d, 0x39, 0xff, 0xe7, 0x12, 0x4c, 0xc7, 0x78, 0xb9, 0xe8, 0x34, 0xdc, 0xbf, 0x71, 0xf5, 0x6a,
-		0x4d, 0xd5, 0x1a, 0xeb, 0x95, 0xcd, 0xc6, 0xb5, 0x8d, 0xa6, 0xa6, 0xd6, 0x1a, 0x5b, 0xab, 0xcd,
-		0x50, 0xa3, 0x0b, 0x70, 0x5f, 0x3c, 0x48, 0x65, 0x69, 0xa9, 0xb6, 0xd9, 0x64, 0x8f, 0x1b, 0x0f,
-		0x81, 0xa8, 0x6e, 0xa8, 0x4d, 0x39, 0x31, 0x9c, 0x84, 0x5a, 0xbb, 0x5e, 0x5b, 0x6a, 0xca, 0x49,
-		0x74, 0x16, 0xce, 0x1c, 0x04, 0xa1, 0x5d, 0xdd, 0x50, 0xd7, 0x2a, 0x4d, 0x39, 0x35, 0x12, 0xb0,
-		0x51, 0x5b, 0x5f, 0xae, 0xa9, 0xf2, 0x04, 0x1f, 0xf7, 0x1b, 0x09, 0x98, 0x1d, 0xe6, 0x4c, 0x13,
-		0x5a, 0x95, 0xcd, 0xcd, 0xd5, 0x97, 0x03, 0x5a, 0x4b, 0xd7, 0xb6, 0xd6, 0x5f, 0x1c, 0x64, 0xc1,
-		0x43, 0xa0, 0x1c, 0x04, 0xe8, 0x33, 0xe2, 0x41, 0x38, 0x7d, 0x20, 0x1c, 0x67, 0xc7, 0x08, 0x30,
-		0xb5, 0xd6, 0x54, 0x5f, 0x96, 0x93, 0x68, 0x11, 0xce, 0x8f, 0x04, 0xf3, 0xeb, 0xe4, 0x14, 0xba,
-		0x00, 0x8f, 0x1c, 0x0c, 0xcf, 0x18, 0x24, 0x10, 0x04, 0x8b, 0x5e, 0x97, 0xe0, 0x68, 0xac, 0x57,
-		0x8e, 0xce, 0xc0, 0xfc, 0xa6, 0xba, 0xb1, 0x54, 0x6b, 0x34, 0xb4, 0x4d, 0x75, 0x63, 0x73, 0xa3,
-		0x51, 0x59, 0xd5, 0x1a, 0xcd, 0x4a, 0x73, 0xab, 0x11, 0xe2, 0x8d, 0x02, 0xa7, 0x86, 0x01, 0xf9,
-		0x7c, 0x39, 0x00, 0x86, 0x4b, 0x80, 0x90, 0xd3, 0xbb, 0x12, 0x9c, 0x18, 0xea, 0x85, 0xa3, 0x73,
-		0xf0, 0x00, 0xfd, 0x9d, 0xb2, 0x97, 0xb5, 0x1b, 0x1b, 0xcd, 0xf0, 0x2b, 0xda, 0x03, 0xbd, 0x3a,
-		0x0b, 0x67, 0x0e, 0x84, 0xf4, 0xbb, 0x36, 0x0a, 0xb0, 0xaf, 0x7f, 0xdf, 0x26, 0x41, 0xa9, 0x4f,
-		0x17, 0xa2, 0xfb, 0x60, 0x76, 0xad, 0xde, 0xa8, 0xd6, 0xae, 0x55, 0x6e, 0xd4, 0x37, 0xd4, 0xfe,
-		0x35, 0x7b, 0x06, 0xe6, 0x07, 0x6a, 0x97, 0xb7, 0x36, 0x57, 0xeb, 0x4b, 0x95, 0x66, 0x8d, 0x36,
-		0x2a, 0x4b, 0x64, 0x60, 0x03, 0x40, 0xab, 0xf5, 0x95, 0x6b, 0x4d, 0x6d, 0x69, 0xb5, 0x5e, 0x5b,
-		0x6f, 0x6a, 0x95, 0x66, 0xb3, 0x12, 0x2c, 0xe7, 0xea, 0x8b, 0x43, 0x8f, 0xbe, 0x5e, 0x1c, 0xff,
-		0xe8, 0x2b, 0x3f, 0xc2, 0x19, 0xdc, 0x56, 0x4b, 0xc0, 0xbc, 0x5f, 0xc9, 0x73, 0x69, 0xfd, 0x47,
-		0x3c, 0xa7, 0x7d, 0xed, 0xce, 0x01, 0x86, 0xdf, 0xf8, 0x7c, 0x0e, 0x92, 0x95, 0x6e, 0x97, 0x68,
-		0x3e, 0xfa, 0x6d, 0xd8, 0x6d, 0xae, 0x57, 0xfd, 0x6f, 0x52, 0xe7, 0xda, 0x3b, 0xde, 0x6d, 0xdd,
-		0xf1, 0x7f, 0x79, 0x4d, 0x7c, 0x2b, 0xcf, 0x40, 0xd6, 0x8f, 0x1e, 0xe8, 0xdb, 0xa5, 0xfe, 0x3d,
-		0xa4, 0x94, 0xb8, 0x67, 0xc4, 0x2f, 0x6b, 0x24, 0x82, 0xcb, 0x1a, 0xa9, 0x2f, 0xbe, 0x31, 0x2f,
-		0x55, 0xd7, 0x87, 0x72, 0xe7, 0xc9, 0xf1, 0xb9, 0x13, 0x30, 0xc0, 0x67, 0xd0, 0xf7, 0xdd, 0x1f,
-		0xba, 0x0d, 0xec, 0x9f, 0x38, 0x0d, 0xb3, 0x27, 0xe6, 0x3c, 0xfe, 0xa8, 0x33, 0xae, 0x63, 0x9c,
-		0x61, 0x1d, 0x35, 0x2b, 0xf7, 0x7a, 0xc8, 0xf5, 0x19, 0x28, 0x6c, 0xea, 0x8e, 0xd7, 0xc0, 0xde,
-		0x35, 0xac, 0xb7, 0xb0, 0x13, 0xbd, 0x9b, 0x5b, 0x10, 0x77, 0x73, 0x85, 0x3d, 0x4b, 0x04, 0xf6,
-		0x4c, 0x31, 0x21, 0x45, 0x9f, 0x13, 0x1e, 0x7a, 0xc8, 0x84, 0x1d, 0x0a, 0xe1, 0x87, 0x4c, 0xe8,
-		0x07, 0xba, 0x2c, 0x6e, 0xdf, 0x26, 0x47, 0xdc, 0xbe, 0x15, 0x91, 0x13, 0xbb, 0x83, 0xdb, 0x81,
-		0x49, 0xee, 0xcd, 0xc4, 0xee, 0xde, 0xae, 0x43, 0xa9, 0xab, 0x3b, 0x1e, 0xfd, 0xb5, 0x92, 0x3d,
-		0x3a, 0x0c, 0xee, 0x89, 0xc4, 0x5d, 0x9f, 0x8a, 0x0c, 0x97, 0x37, 0x53, 0xe8, 0x86, 0x0b, 0x95,
-		0x2f, 0xa6, 0x20, 0xcd, 0xd9, 0xf1, 0x7c, 0xf4, 0xa4, 0x5b, 0xc4, 0x31, 0x0f, 0xc4, 0x3f, 0x08,
-		0x72, 0x39, 0x41, 0x3f, 0x2d, 0xfd, 0x50, 0xff, 0xb9, 0xb2, 0x6a, 0xee, 0xf3, 0x6f, 0xce, 0x4f,
-		0xd2, 0x4c, 0x71, 0x7d, 0x39, 0x38, 0x64, 0xf6, 0xf6, 0x7b, 0x41, 0xcb, 0x50, 0x08, 0xe5, 0xb0,
-		0xcd, 0x16, 0xdf, 0xf8, 0x9f, 0x1b, 0xee, 0x29, 0x8a, 0x6d, 0x5e, 0x3f, 0xbf, 0x5d, 0x6f, 0xa1,
-		0x73, 0x20, 0x87, 0x76, 0x9e, 0x59, 0x78, 0xce, 0x92, 0xb7, 0xc5, 0xb6, 0xbf, 0xa7, 0x4c, 0x37,
-		0x5e, 0x4f, 0x42, 0x96, 0xfe, 0x80, 0x4e, 0x68, 0x7f, 0x36, 0x43, 0x0a, 0x68, 0xe5, 0x59, 0x28,
-		0xf5, 0x6f, 0xe1, 0xb2, 0x4d, 0xd9, 0xe2, 0xad, 0xe8, 0xf6, 0xed, 0xb0, 0x0d, 0xdf, 0xec, 0xd0,
-		0x0d, 0xdf, 0x07, 0xa1, 0x18, 0x24, 0x25, 0x28, 0x2c, 0x30, 0x4f, 0xdb, 0x2f, 0xa5, 0x60, 0xe1,
-		0xfc, 0x42, 0x2e, 0x92, 0x5f, 0xf0, 0x77, 0x06, 0x78, 0xb6, 0x85, 0xc1, 0xe4, 0xd9, 0x9e, 0x31,
-		0xa9, 0xe0, 0x49, 0x15, 0x0a, 0x7b, 0x06, 0x0a, 0xe2, 0x92, 0x22, 0x83, 0x2b, 0x50, 0xb8, 0xbc,
-		0x28, 0x1c, 0xba, 0x07, 0x5d, 0x8c, 0xdf, 0x83, 0x9e, 0x85, 0xd4, 0x32, 0x8f, 0x8a, 0xfb, 0x72,
-		0x6c, 0x9f, 0x4d, 0x42, 0x8a, 0x6e, 0x2b, 0x3d, 0x19, 0x71, 0xcc, 0xe3, 0x44, 0x9a, 0x84, 0x07,
-		0xb8, 0xb5, 0xe6, 0xee, 0x86, 0xfc, 0xf2, 0x61, 0x47, 0x4c, 0xfc, 0xd4, 0x46, 0x32, 0x9c, 0xda,
-		0xb8, 0x0a, 0x19, 0x5f, 0x4e, 0x52, 0x23, 0xe5, 0xa4, 0x44, 0xe4, 0x84, 0x88, 0x31, 0x2f, 0x50,
-		0x27, 0x79, 0x78, 0x81, 0xaa, 0x90, 0xf5, 0x35, 0x8c, 0x2f, 0x70, 0xe3, 0xc8, 0x6c, 0x80, 0x16,
-		0x7f, 0x16, 0x23, 0x3d, 0xe4, 0x2c, 0x46, 0x58, 0xb0, 0xf8, 0x6f, 0x6f, 0x4e, 0xd2, 0x81, 0x05,
-		0x82, 0xc5, 0x7e, 0x7f, 0xf3, 0x3e, 0xc8, 0x06, 0xf1, 0x15, 0x93, 0xbd, 0xa0, 0x80, 0xd4, 0x06,
-		0x91, 0x1a, 0x93, 0xb5, 0xd0, 0x8f, 0x38, 0x0f, 0x89, 0xd2, 0x60, 0x58, 0x94, 0xa6, 0xfc, 0x5b,
-		0x09, 0xd2, 0xfc, 0xb8, 0xc5, 0x01, 0x69, 0x01, 0x36, 0x0f, 0x89, 0x61, 0xf3, 0x90, 0x7c, 0x4b,
-		0xf3, 0x00, 0x7e, 0x3f, 0xc5, 0x21, 0xd3, 0xfb, 0x62, 0x93, 0x73, 0xa4, 0x93, 0x0d, 0x73, 0x57,
-		0xec, 0x13, 0x05, 0x58, 0xca, 0x9b, 0x12, 0x31, 0xbf, 0xbc, 0x7e, 0x30, 0xf0, 0x94, 0x0e, 0x1d,
-		0x78, 0x1e, 0xee, 0x94, 0x4d, 0x44, 0x94, 0x92, 0xf7, 0x26, 0x4a, 0x91, 0x49, 0x4f, 0xf5, 0x4d,
-		0xba, 0xf2, 0x05, 0x89, 0xff, 0x7e, 0xb3, 0x9f, 0xfc, 0xfb, 0x2b, 0x9a, 0xad, 0xaf, 0xe7, 0xf2,
-		0xd5, 0xc2, 0x2d, 0x6d, 0x60, 0xda, 0x1e, 0x88, 0xbb, 0x37, 0x1d, 0xe9, 0x75, 0x30, 0x7d, 0x48,
-		0x90, 0x69, 0x04, 0xd3, 0xf8, 0x73, 0x09, 0x71, 0x2a, 0x2d, 0x04, 0xff, 0x37, 0x70, 0x3a, 0xa3,
-		0x6b, 0x78, 0x62, 0xcc, 0x35, 0x9c, 0x1e, 0xba, 0x86, 0x7f, 0x2e, 0x41, 0xdf, 0xd9, 0x60, 0x67,
-		0x04, 0xbe, 0x16, 0x3a, 0xf8, 0x24, 0x64, 0xbb, 0x76, 0x5b, 0x63, 0x35, 0xec, 0x31, 0xfe, 0x4c,
-		0xd7, 0x6e, 0xab, 0x03, 0xa2, 0x36, 0xf1, 0x76, 0x29, 0xe8, 0xf4, 0xdb, 0x30, 0x0d, 0x93, 0xfd,
-		0xab, 0xca, 0x83, 0x3c, 0xe3, 0x05, 0xf7, 0xa0, 0x2e, 0x12, 0x26, 0x50, 0x9f, 0x4c, 0xea, 0xf7,
-		0xf9, 0xfc, 0x7e, 0x33, 0x50, 0x95, 0x03, 0x12, 0x94, 0xc8, 0x49, 0xb7, 0x13, 0x43, 0x35, 0x97,
-		0x38, 0xd9, 0xa3, 0x7c, 0x58, 0x02, 0x58, 0x25, 0xcc, 0xa5, 0x23, 0x26, 0xce, 0x8f, 0x4b, 0x3b,
-		0xa1, 0x45, 0xda, 0x9e, 0x1f, 0x3a, 0x71, 0xbc, 0x07, 0x79, 0x37, 0xdc, 0xf5, 0x65, 0x28, 0x04,
-		0x02, 0xee, 0x62, 0xd1, 0x9d, 0xf9, 0x83, 0x2e, 0xb2, 0x36, 0xb0, 0xa7, 0xe6, 0x6f, 0x85, 0xbe,
-		0x94, 0x7f, 0x27, 0x41, 0x96, 0xf6, 0x6a, 0x0d, 0x7b, 0x7a, 0x64, 0x22, 0xa5, 0xb7, 0x30, 0x91,
-		0xf7, 0x03, 0x30, 0x3a, 0xae, 0xf9, 0x1a, 0xe6, 0xf2, 0x95, 0xa5, 0x25, 0x0d, 0xf3, 0x35, 0x8c,
-		0x9e, 0xf2, 0xb9, 0x9e, 0x1c, 0xc1, 0x75, 0x91, 0xbc, 0xe5, 0xbc, 0x3f, 0x0e, 0x93, 0x56, 0xaf,
-		0xa3, 0xb1, 0xc3, 0xa4, 0x54, 0x68, 0xad, 0x5e, 0xa7, 0x79, 0xc7, 0x55, 0x6e, 0xc2, 0x64, 0xf3,
-		0x0e, 0x7b, 0xbf, 0xe7, 0x24, 0x64, 0x1d, 0xdb, 0xe6, 0xde, 0x20, 0x73, 0xc4, 0x33, 0xa4, 0x80,
-		0x3a, 0x3f, 0x71, 0x39, 0xff, 0x0b, 0xe3, 0xba, 0xfd, 0xdc, 0xe1, 0x3f, 0xff, 0x9b, 0x12, 0x14,
-		0x22, 0x2b, 0x0a, 0x3d, 0x0a, 0xc7, 0x1b, 0xf5, 0x95, 0xf5, 0xda, 0xb2, 0xb6, 0xd6, 0x58, 0xe9,
-		0x0b, 0xb0, 0xe7, 0x4a, 0xaf, 0xdf, 0x5d, 0xc8, 0xf1, 0xab, 0xaa, 0xc3, 0xa0, 0x37, 0xd5, 0x1a,
-		0x8b, 0xb4, 0x19, 0xf4, 0xa6, 0x83, 0x6f, 0xd9, 0x1e, 0xa6, 0xd0, 0x8f, 0xc3, 0x89, 0x18, 0x68,
-		0xff, 0xc2, 0xea, 0xd4, 0xeb, 0x77, 0x17, 0x0a, 0x9b, 0x0e, 0x66, 0xa2, 0x46, 0x31, 0x16, 0x61,
-		0x76, 0x10, 0x83, 0x65, 0x35, 0xe4, 0x85, 0x39, 0xf9, 0xf5, 0xbb, 0x0b, 0x79, 0xa1, 0x3b, 0x08,
-		0xfc, 0x3b, 0x7e, 0x63, 0xf5, 0xa3, 0x59, 0x38, 0xc1, 0xde, 0xb0, 0xd2, 0x58, 0x0c, 0xc8, 0x3e,
-		0x78, 0x48, 0x9a, 0x0f, 0x57, 0x8d, 0xfe, 0x71, 0x02, 0xe5, 0x45, 0x98, 0xae, 0x5b, 0x1e, 0x76,
-		0x76, 0xf4, 0xf0, 0xcf, 0x0b, 0xc7, 0xfe, 0x60, 0xef, 0x42, 0xe4, 0x95, 0x4d, 0x1e, 0xc1, 0x87,
-		0x8b, 0x94, 0x6f, 0x91, 0x40, 0x6e, 0x18, 0x7a, 0x5b, 0x77, 0xde, 0x2a, 0x29, 0xf4, 0x94, 0xf8,
-		0x51, 0x0a, 0x7e, 0x41, 0x24, 0x79, 0xae, 0x78, 0x69, 0x76, 0x31, 0x3c, 0xb8, 0x45, 0xd6, 0x12,
-		0xd5, 0xc1, 0xec, 0xc7, 0x28, 0xc8, 0x9f, 0xe7, 0x5f, 0x02, 0x08, 0x2a, 0xd0, 0x49, 0x38, 0xde,
-		0x58, 0xaa, 0xac, 0x56, 0xfc, 0x3c, 0x4d, 0x63, 0xb3, 0xb6, 0xc4, 0x7e, 0xf9, 0xfe, 0x08, 0x3a,
-		0x06, 0x28, 0x5c, 0xe9, 0xff, 0xce, 0xdc, 0x51, 0x98, 0x0a, 0x97, 0xb3, 0x9f, 0x21, 0x4f, 0x94,
-		0xaf, 0x41, 0x89, 0xfd, 0x46, 0x32, 0x31, 0x80, 0xb8, 0xa5, 0x99, 0x16, 0x1a, 0xf1, 0x93, 0xc3,
-		0xb3, 0xbf, 0xfa, 0x5f, 0xd9, 0x4f, 0x54, 0x14, 0x18, 0x62, 0x85, 0xe0, 0xd5, 0xad, 0x72, 0x13,
-		0x66, 0xe8, 0x8d, 0x70, 0xfa, 0xb3, 0x32, 0x9a, 0x29, 0xf8, 0x3f, 0xfa, 0x0d, 0x41, 0x42, 0x2f,
-		0x79, 0x2e, 0xab, 0x4e, 0x07, 0xe8, 0xfe, 0xec, 0x95, 0x5f, 0x0c, 0x7e, 0x54, 0xc4, 0xef, 0xe0,
-		0x48, 0x8a, 0xbf, 0xc6, 0x7b, 0x28, 0x9e, 0x10, 0x16, 0x5d, 0x5c, 0x85, 0x29, 0xdd, 0x30, 0x70,
-		0x37, 0xd2, 0xbf, 0x11, 0xcf, 0xb6, 0x89, 0xd1, 0xca, 0x1c, 0x33, 0xe8, 0xda, 0x53, 0x90, 0x76,
-		0xe9, 0xa4, 0x8c, 0x22, 0x21, 0xba, 0xc3, 0xc1, 0xcb, 0x35, 0x28, 0x32, 0x31, 0xf0, 0x47, 0x34,
-		0x82, 0xc0, 0x7f, 0xe4, 0x04, 0xf2, 0x14, 0x4d, 0x8c, 0xc6, 0x82, 0xa9, 0x16, 0x36, 0xda, 0xba,
-		0x83, 0x43, 0xa3, 0x39, 0xf8, 0xe9, 0xe2, 0x7f, 0xf9, 0xe9, 0xc7, 0xfd, 0x3d, 0xf4, 0x90, 0xd0,
-		0xc5, 0x2c, 0x16, 0x55, 0xe6, 0xb4, 0x83, 0xf1, 0x62, 0x28, 0x8a, 0xf6, 0xf8, 0xb8, 0x0f, 0x6e,
-		0xec, 0x5f, 0xf1, 0xc6, 0x4e, 0xc5, 0x49, 0x78, 0xa8, 0xa5, 0x02, 0xa7, 0xca, 0x2a, 0xca, 0x55,
-		0x28, 0xec, 0x98, 0xed, 0xd0, 0x74, 0x1f, 0xdc, 0xca, 0xbf, 0xfe, 0xf4, 0xe3, 0x6c, 0xa1, 0x11,
-		0x24, 0xce, 0x9a, 0x7b, 0xfa, 0xc9, 0x14, 0x4a, 0xfd, 0xd9, 0x70, 0x57, 0x7d, 0xed, 0xf4, 0x89,
-		0x24, 0x9c, 0xe2, 0xc0, 0xdb, 0xba, 0x8b, 0x89, 0xe2, 0xc2, 0x9e, 0x7e, 0xf1, 0x82, 0x61, 0x9b,
-		0x56, 0x90, 0x54, 0xa4, 0x0a, 0x8b, 0xd4, 0x2f, 0xf2, 0xfa, 0x21, 0x39, 0xad, 0xe1, 0x8a, 0x6e,
-		0x6e, 0xf0, 0x67, 0x7b, 0x94, 0x36, 0xa4, 0x96, 0x6c, 0xd3, 0x22, 0x3e, 0x57, 0x0b, 0x5b, 0x76,
-		0x47, 0x9c, 0x57, 0xa4, 0x1f, 0xe8, 0x1a, 0xa4, 0xf5, 0x8e, 0xdd, 0xb3, 0xf8, 0xfb, 0x6d, 0xd5,
-		0xc7, 0x89, 0x2d, 0xfc, 0xed, 0x37, 0xe7, 0x8f, 0x32, 0xb2, 0x6e, 0xeb, 0xe6, 0xa2, 0x69, 0x5f,
-		0xe8, 0xe8, 0xde, 0x1e, 0x99, 0xe4, 0xdf, 0xf8, 0xcc, 0x63, 0xc0, 0xdb, 0xab, 0x5b, 0xde, 0x27,
-		0xff, 0xe0, 0x67, 0xce, 0x4b, 0x2a, 0xc7, 0x67, 0x69, 0x47, 0xa5, 0x0b, 0x93, 0xcb, 0xd8, 0x38,
-		0xa0, 0xc1, 0x7a, 0x5f, 0x83, 0x17, 0x79, 0x83, 0x27, 0x07, 0x1b, 0x64, 0xbf, 0x23, 0xb8, 0x8c,
-		0x8d, 0x50, 0xb3, 0xcb, 0xd8, 0x88, 0xb6, 0x58, 0x5d, 0xfe, 0xad, 0xdf, 0x3b, 0x75, 0xe4, 0x7d,
-		0x9f, 0x3f, 0x75, 0x64, 0xe8, 0x94, 0x29, 0xa3, 0x7f, 0xe5, 0xc6, 0x9f, 0xa9, 0xff, 0x2d, 0xc1,
-		0x89, 0x7e, 0xf3, 0xa0, 0x5b, 0xfb, 0xc3, 0xde, 0x3c, 0xb8, 0x02, 0xc9, 0x8a, 0xb5, 0x8f, 0x4e,
-		0xb0, 0xd7, 0x5c, 0xb5, 0x9e, 0xd3, 0x16, 0xc7, 0x3c, 0xc9, 0xf7, 0x96, 0xd3, 0x8e, 0x1e, 0x29,
-		0xf0, 0x5f, 0xe6, 0xfa, 0xee, 0x43, 0x3e, 0x77, 0x90, 0xa9, 0x58, 0xfb, 0xe2, 0xa1, 0x83, 0x47,
-		0xc7, 0x7c, 0xe8, 0x40, 0xb7, 0xf6, 0xbb, 0xdb, 0x87, 0x7d, 0xdf, 0xe0, 0x03, 0x97, 0xe1, 0x01,
-		0xce, 0x23, 0xd7, 0xd3, 0x6f, 0x9a, 0xd6, 0xae, 0x2f, 0xac, 0xfc, 0x9b, 0xb3, 0xe2, 0x18, 0x9f,
-		0x10, 0x51, 0x2a, 0x44, 0x76, 0x50, 0x02, 0xe7, 0x0e, 0x7c, 0x30, 0x61, 0xee, 0xe0, 0x6c, 0xf2,
-		0xdc, 0x88, 0x75, 0x73, 0xd0, 0x62, 0x18, 0xb2, 0x7a, 0x86, 0x4e, 0xef, 0xc8, 0xd7, 0xc3, 0x46,
-		0x26, 0x93, 0x3f, 0x2c, 0x41, 0xf1, 0x9a, 0xe9, 0x7a, 0xb6, 0x63, 0x1a, 0x7a, 0x9b, 0x6e, 0xa4,
-		0xbf, 0x6b, 0x6c, 0xef, 0xbf, 0x9a, 0x25, 0x4b, 0x81, 0x2f, 0xaa, 0x3d, 0xe1, 0x80, 0xa7, 0x6f,
-		0xe9, 0x6d, 0xe6, 0x79, 0x87, 0xf5, 0x6e, 0x3f, 0xdb, 0x43, 0xfb, 0xcb, 0x61, 0x2a, 0x0c, 0xb7,
-		0x9c, 0x98, 0x95, 0x94, 0xef, 0x4f, 0x40, 0x89, 0x86, 0x0c, 0x2e, 0x3d, 0x10, 0x46, 0x8f, 0x1c,
-		0x5d, 0x87, 0x94, 0xa3, 0x7b, 0xdc, 0x09, 0xa9, 0x5e, 0x39, 0xf4, 0x4a, 0x64, 0xad, 0x50, 0x1a,
-		0xe8, 0xdd, 0x90, 0xe9, 0xe8, 0x77, 0x34, 0x4a, 0x2f, 0xf1, 0x96, 0xe8, 0x4d, 0x76, 0xf4, 0x3b,
-		0xa4, 0x7f, 0xe8, 0x9b, 0xa0, 0x44, 0x48, 0x1a, 0x7b, 0xba, 0xb5, 0x8b, 0x19, 0xe5, 0xe4, 0x5b,
-		0xa2, 0x5c, 0xe8, 0xe8, 0x77, 0x96, 0x28, 0x35, 0x42, 0x9f, 0x6b, 0xac, 0x5f, 0x92, 0xf8, 0xe9,
-		0x2a, 0xca, 0x18, 0xa4, 0x83, 0x6c, 0xf8, 0x5f, 0xb4, 0x51, 0x71, 0x68, 0xf9, 0xec, 0x30, 0xde,
-		0xf7, 0xb1, 0xb5, 0x5a, 0x20, 0xdd, 0xfb, 0xdc, 0x9b, 0xf3, 0x12, 0x6b, 0xb5, 0x64, 0x0c, 0xb0,
-		0x3d, 0xc7, 0x4e, 0x8d, 0x69, 0x34, 0xb3, 0x9d, 0x18, 0x19, 0x84, 0x16, 0x44, 0x10, 0xca, 0x08,
-		0x02, 0xc3, 0x26, 0xf5, 0x7c, 0x0c, 0x7f, 0x2a, 0x41, 0x6e, 0x39, 0xe4, 0x27, 0xce, 0xc2, 0x64,
-		0xc7, 0xb6, 0xcc, 0x9b, 0xd8, 0xf1, 0x4f, 0x9d, 0xb3, 0x4f, 0x34, 0x07, 0x19, 0xf6, 0x0b, 0x90,
-		0xde, 0xbe, 0xd8, 0x6d, 0x12, 0xdf, 0x04, 0xeb, 0x36, 0xde, 0x76, 0x4d, 0xc1, 0x67, 0x55, 0x7c,
-		0xa2, 0x87, 0x41, 0x76, 0xb1, 0xd1, 0x73, 0x4c, 0x6f, 0x5f, 0x33, 0x6c, 0xcb, 0xd3, 0x0d, 0x8f,
-		0x1f, 0x56, 0x2a, 0x89, 0xf2, 0x25, 0x56, 0x4c, 0x88, 0xb4, 0xb0, 0xa7, 0x9b, 0x6d, 0x76, 0x19,
-		0x3b, 0xab, 0x8a, 0x4f, 0xb4, 0x12, 0xda, 0xfe, 0x4f, 0xfb, 0xbb, 0x13, 0xb1, 0x1c, 0x15, 0xbf,
-		0x37, 0x1f, 0x16, 0x66, 0x1f, 0x99, 0x8f, 0x79, 0x07, 0x32, 0x02, 0x0c, 0x3d, 0x04, 0xa5, 0xae,
-		0x63, 0x53, 0xab, 0xdf, 0x35, 0x0d, 0xad, 0xe7, 0x98, 0x7c, 0xdc, 0x05, 0x5e, 0xbc, 0x69, 0x1a,
-		0x5b, 0x8e, 0x89, 0x1e, 0x05, 0xe4, 0xda, 0x06, 0xbd, 0x08, 0xae, 0x5b, 0xad, 0x36, 0x51, 0xd8,
-		0x26, 0x3b, 0x6b, 0x96, 0x55, 0x65, 0x56, 0x73, 0x8d, 0x56, 0x6c, 0x39, 0xa6, 0xcb, 0xdb, 0xb9,
-		0x3b, 0x19, 0x3e, 0x5b, 0xb4, 0x04, 0xb2, 0xdd, 0xc5, 0x4e, 0x24, 0xe3, 0xc3, 0x96, 0xcf, 0xec,
-		0x6f, 0x7c, 0xe6, 0xb1, 0x19, 0x3e, 0x1e, 0x9e, 0xf3, 0x61, 0xaf, 0x38, 0xaa, 0x25, 0x81, 0x21,
-		0x52, 0x41, 0x2f, 0x47, 0x0e, 0xc6, 0xf7, 0xb6, 0x83, 0x37, 0x99, 0x66, 0x06, 0xa4, 0xa0, 0x62,
-		0xed, 0x57, 0x67, 0x7f, 0x2d, 0x20, 0xcd, 0xe3, 0xc5, 0x4d, 0x7a, 0xd2, 0x28, 0x7c, 0x48, 0x9e,
-		0x92, 0x41, 0xc7, 0x20, 0xfd, 0xaa, 0x6e, 0xb6, 0xc5, 0xaf, 0xfb, 0xaa, 0xfc, 0x0b, 0x95, 0xfd,
-		0x83, 0x9f, 0x29, 0x9a, 0xc1, 0x51, 0x86, 0xb1, 0xbe, 0x6a, 0x5b, 0xad, 0xe8, 0x79, 0x4f, 0xb4,
-		0x04, 0x69, 0xcf, 0xbe, 0x89, 0x2d, 0x3e, 0xa3, 0xd5, 0x47, 0x0e, 0xe1, 0x23, 0xa8, 0x1c, 0x15,
-		0x7d, 0x03, 0xc8, 0x2d, 0xdc, 0xc6, 0xbb, 0x2c, 0x95, 0xb0, 0xa7, 0x3b, 0x98, 0xe5, 0xb4, 0xef,
-		0xc9, 0x03, 0x28, 0xf9, 0xa4, 0x1a, 0x94, 0x12, 0xda, 0x8c, 0x86, 0x4e, 0x93, 0xfe, 0x9d, 0xae,
-		0xd8, 0x31, 0x86, 0x96, 0x4a, 0x58, 0xc2, 0x22, 0xa1, 0xd6, 0xc3, 0x20, 0xf7, 0xac, 0x6d, 0xdb,
-		0xa2, 0x3f, 0x8a, 0xc9, 0x93, 0x58, 0x19, 0x76, 0x59, 0xc2, 0x2f, 0xe7, 0x97, 0x25, 0x36, 0xa1,
-		0x18, 0x80, 0xd2, 0x25, 0x9d, 0x3d, 0xec, 0x92, 0x2e, 0xf8, 0x04, 0x08, 0x08, 0x5a, 0x03, 0x08,
-		0x94, 0x06, 0x4d, 0xb3, 0xe7, 0x86, 0xcf, 0x58, 0xa0, 0x7e, 0xc2, 0x83, 0x09, 0x11, 0x40, 0x16,
-		0x4c, 0x77, 0x4c, 0x4b, 0x73, 0x71, 0x7b, 0x47, 0xe3, 0x9c, 0x23, 0x74, 0x73, 0x94, 0xfd, 0xcf,
-		0x1f, 0x62, 0x36, 0x7f, 0xfb, 0x33, 0x8f, 0x95, 0x02, 0xd7, 0x69, 0xe1, 0xf1, 0xc5, 0x27, 0x9f,
-		0x52, 0xa7, 0x3a, 0xa6, 0xd5, 0xc0, 0xed, 0x9d, 0x65, 0x9f, 0x30, 0x7a, 0x17, 0x9c, 0x0c, 0x18,
-		0x62, 0x5b, 0xda, 0x9e, 0xdd, 0x6e, 0x69, 0x0e, 0xde, 0xd1, 0x0c, 0xea, 0xf8, 0xe5, 0x29, 0x1b,
-		0x8f, 0xfb, 0x20, 0x1b, 0xd6, 0x35, 0xbb, 0xdd, 0x52, 0xf1, 0xce, 0x12, 0xa9, 0x46, 0x67, 0x20,
-		0xe0, 0x86, 0x66, 0xb6, 0xdc, 0xd9, 0xc2, 0x42, 0xf2, 0x5c, 0x4a, 0xcd, 0xfb, 0x85, 0xf5, 0x96,
-		0x5b, 0xce, 0x7c, 0xf0, 0x8d, 0xf9, 0x23, 0x5f, 0x7c, 0x63, 0xfe, 0x88, 0x72, 0x95, 0xbe, 0xda,
-		0xc6, 0x97, 0x16, 0x76, 0xd1, 0x15, 0xc8, 0xea, 0xe2, 0x83, 0x3d, 0x7c, 0x78, 0xc0, 0xd2, 0x0c,
-		0x40, 0x95, 0x4f, 0x49, 0x90, 0x5e, 0xbe, 0xb1, 0xa9, 0x9b, 0x0e, 0xaa, 0x91, 0xc0, 0x48, 0xc8,
-		0xea, 0xb8, 0xab, 0x3c, 0x10, 0x6f, 0xb1, 0xcc, 0xd7, 0x87, 0xa5, 0x87, 0xb3, 0xd5, 0xd3, 0xbf,
-		0xf1, 0x99, 0xc7, 0xee, 0xe7, 0x64, 0x6e, 0xf4, 0x65, 0x8a, 0x05, 0xbd, 0xfe, 0x0c, 0x72, 0x68,
-		0xcc, 0xd7, 0x61, 0x92, 0x75, 0xd5, 0x45, 0x2f, 0xc0, 0x44, 0x97, 0xfc, 0xc1, 0x4f, 0x5c, 0x9f,
-		0x1a, 0x2a, 0xf3, 0x14, 0x3e, 0x2c, 0x21, 0x0c, 0x4f, 0xf9, 0x8e, 0x04, 0xc0, 0xf2, 0x8d, 0x1b,
-		0x4d, 0xc7, 0xec, 0xb6, 0xb1, 0xf7, 0x76, 0x8d, 0x7d, 0x0b, 0x8e, 0x86, 0x32, 0x87, 0x8e, 0x71,
-		0xf8, 0xf1, 0x4f, 0x07, 0x39, 0x44, 0xc7, 0x88, 0x25, 0xdb, 0x72, 0x3d, 0x9f, 0x6c, 0xf2, 0xf0,
-		0x64, 0x97, 0x5d, 0x6f, 0x90, 0xb3, 0x2f, 0x41, 0x2e, 0x60, 0x86, 0x8b, 0xea, 0x90, 0xf1, 0xf8,
-		0xdf, 0x9c, 0xc1, 0xca, 0x70, 0x06, 0x0b, 0xb4, 0x88, 0xd5, 0x12, 0xe8, 0xca, 0x5f, 0x4a, 0x00,
-		0xa1, 0x35, 0xf2, 0xd7, 0x53, 0xc6, 0x48, 0x78, 0xc6, 0x95, 0x73, 0xf2, 0x9e, 0xc3, 0x33, 0x46,
-		0x20, 0xc4, 0xd4, 0xef, 0x4a, 0xc0, 0xf4, 0x96, 0x58, 0xbd, 0x7f, 0xfd, 0x79, 0xb0, 0x05, 0x93,
-		0xd8, 0xf2, 0x1c, 0xd3, 0xbf, 0x31, 0xf0, 0xf8, 0xb0, 0x39, 0x8f, 0x19, 0x54, 0xcd, 0xf2, 0x9c,
-		0xfd, 0xb0, 0x04, 0x08, 0x5a, 0x21, 0x7e, 0x7c, 0x34, 0x09, 0xb3, 0xc3, 0x50, 0xd1, 0x59, 0x28,
-		0x19, 0x0e, 0xa6, 0x05, 0xd1, 0x77, 0x38, 0x8b, 0xa2, 0x98, 0x9b, 0x1d, 0x15, 0x88, 0x67, 0x49,
-		0x84, 0x8b, 0x80, 0xde, 0x9b, 0x2b, 0x59, 0x0c, 0x28, 0x50, 0xc3, 0xd3, 0x84, 0x92, 0x78, 0x2a,
-		0x67, 0x5b, 0x6f, 0xeb, 0x96, 0x21, 0x5c, 0xee, 0x43, 0xd9, 0x7c, 0xf1, 0xdc, 0x4e, 0x95, 0x91,
-		0x40, 0x35, 0x98, 0x14, 0xd4, 0x52, 0x87, 0xa7, 0x26, 0x70, 0xd1, 0x69, 0xc8, 0x87, 0x0d, 0x03,
-		0xf5, 0x46, 0x52, 0x6a, 0x2e, 0x64, 0x17, 0x46, 0x59, 0x9e, 0xf4, 0x81, 0x96, 0x87, 0x3b, 0x7c,
-		0x3f, 0x94, 0x84, 0x29, 0x15, 0xb7, 0xfe, 0xe6, 0x4f, 0xcb, 0x26, 0x00, 0x5b, 0xaa, 0x44, 0x93,
-		0xf2, 0x99, 0xb9, 0x87, 0xf5, 0x9e, 0x65, 0x44, 0x96, 0x5d, 0xef, 0x6b, 0x35, 0x43, 0xbf, 0x93,
-		0x80, 0x7c, 0x78, 0x86, 0xfe, 0x56, 0x1a, 0x2d, 0xb4, 0x1e, 0xa8, 0x29, 0xb6, 0x51, 0xfe, 0xf0,
-		0x30, 0x35, 0x35, 0x20, 0xcd, 0x23, 0xf4, 0xd3, 0x97, 0x92, 0x90, 0xe6, 0x97, 0x6e, 0x37, 0x06,
-		0x7c, 0xdb, 0x91, 0x8f, 0x30, 0x17, 0xc4, 0x3b, 0xd6, 0xb1, 0xae, 0xed, 0x83, 0x50, 0x24, 0x41,
-		0x7d, 0xe4, 0x26, 0xaf, 0x74, 0xae, 0x40, 0x63, 0xf3, 0xe0, 0x60, 0x13, 0x9a, 0x87, 0x1c, 0x01,
-		0x0b, 0xf4, 0x30, 0x81, 0x81, 0x8e, 0x7e, 0xa7, 0xc6, 0x4a, 0xd0, 0x45, 0x40, 0x7b, 0x7e, 0xa6,
-		0x45, 0x0b, 0x18, 0x21, 0x9d, 0x2b, 0xd0, 0x17, 0xc9, 0xa7, 0x82, 0x5a, 0x81, 0x72, 0x3f, 0x00,
-		0xe9, 0x89, 0xc6, 0xb2, 0x92, 0xfc, 0x67, 0xb2, 0x49, 0xc9, 0x32, 0xcd, 0x4c, 0x7e, 0x9b, 0xc4,
-		0xdc, 0xe4, 0xbe, 0xf0, 0x9f, 0x47, 0x29, 0xcd, 0x31, 0x16, 0xc6, 0x9f, 0xbd, 0x39, 0x3f, 0xb7,
-		0xaf, 0x77, 0xda, 0x65, 0x25, 0x86, 0x8e, 0x12, 0x97, 0x91, 0x20, 0xce, 0x73, 0x34, 0x7d, 0x80,
-		0xea, 0x20, 0xdf, 0xc4, 0xfb, 0x9a, 0xc3, 0x7f, 0x49, 0x5e, 0xdb, 0xc1, 0xe2, 0x2d, 0xf4, 0x13,
-		0x8b, 0x31, 0x39, 0xe2, 0xc5, 0x25, 0xdb, 0xb4, 0xf8, 0x16, 0x66, 0xf1, 0x26, 0xde, 0x57, 0x39,
-		0xde, 0x55, 0x8c, 0xcb, 0x0f, 0x90, 0xd5, 0xf2, 0xfa, 0x1f, 0xfc, 0xcc, 0xf9, 0x93, 0xa1, 0x7c,
-		0xe7, 0x1d, 0x3f, 0xb1, 0xc7, 0xa6, 0x98, 0x38, 0xbe, 0x28, 0x30, 0x42, 0xa1, 0xdb, 0xdb, 0x10,
-		0x8a, 0x15, 0xa4, 0x83, 0x63, 0x90, 0x00, 0x3f, 0x12, 0x83, 0x84, 0x96, 0xe8, 0xf3, 0x81, 0x0d,
-		0x48, 0x8c, 0x1a, 0x4d, 0x58, 0x3a, 0x39, 0x12, 0x5d, 0xf9, 0x47, 0x94, 0xff, 0x24, 0xc1, 0x89,
-		0x01, 0x69, 0xf6, 0xbb, 0x6c, 0x00, 0x72, 0x42, 0x95, 0x54, 0x2a, 0xc4, 0x05, 0x9e, 0x7b, 0x5b,
-		0x1c, 0x53, 0xce, 0x80, 0x21, 0x78, 0x7b, 0x8c, 0x19, 0xd7, 0x64, 0xbf, 0x2a, 0xc1, 0x4c, 0xb8,
-		0x03, 0xfe, 0x50, 0x1a, 0x90, 0x0f, 0x37, 0xcd, 0x07, 0xf1, 0xc0, 0x38, 0x83, 0x08, 0xf7, 0x3f,
-		0x42, 0x04, 0xdd, 0x08, 0x34, 0x06, 0x4b, 0x27, 0x5e, 0x1c, 0x9b, 0x29, 0xa2, 0x63, 0xb1, 0x9a,
-		0x83, 0xcd, 0xcd, 0x97, 0x24, 0x48, 0x6d, 0xda, 0x76, 0x1b, 0xbd, 0x17, 0xa6, 0x2c, 0xdb, 0xd3,
-		0xc8, 0xca, 0xc2, 0x2d, 0x8d, 0xa7, 0x0e, 0x98, 0x36, 0xae, 0x1d, 0xc8, 0xab, 0x3f, 0x7c, 0x73,
-		0x7e, 0x10, 0x33, 0x6e, 0xcf, 0xa1, 0x64, 0xd9, 0x5e, 0x95, 0x02, 0x35, 0x59, 0x76, 0x61, 0x07,
-		0x0a, 0xd1, 0xe6, 0x98, 0xc6, 0xae, 0x8c, 0x6a, 0xae, 0x30, 0xb2, 0xa9, 0xfc, 0x76, 0xa8, 0x1d,
-		0xf6, 0xb3, 0x43, 0x7f, 0x42, 0x66, 0xee, 0x9b, 0x40, 0xbe, 0xd1, 0x7f, 0x3d, 0xf4, 0x2a, 0x4c,
-		0x8a, 0xeb, 0xa0, 0xd2, 0xb8, 0x57, 0x4d, 0xc3, 0xfc, 0xe4, 0xc8, 0x34, 0x5f, 0xfb, 0xb9, 0x04,
-		0x9c, 0x58, 0xb2, 0x2d, 0x97, 0x27, 0x7a, 0xf8, 0xaa, 0x66, 0xc9, 0xe5, 0x7d, 0xf4, 0xf0, 0x90,
-		0x34, 0x54, 0x7e, 0x30, 0xd9, 0x74, 0x03, 0x4a, 0xc4, 0xc4, 0x1a, 0xb6, 0xf5, 0x16, 0x73, 0x4d,
-		0x05, 0xbb, 0xdd, 0xe2, 0x3d, 0xba, 0x89, 0xf7, 0x09, 0x5d, 0x0b, 0xdf, 0x8e, 0xd0, 0x4d, 0xde,
-		0x1b, 0x5d, 0x0b, 0xdf, 0x0e, 0xd1, 0x0d, 0xce, 0x0c, 0xa5, 0x22, 0x37, 0x90, 0xae, 0x40, 0x92,
-		0xa8, 0xc2, 0x89, 0x43, 0x28, 0x0f, 0x82, 0x10, 0x32, 0x6b, 0x0d, 0x38, 0xc1, 0x33, 0x05, 0xee,
-		0xc6, 0x0e, 0xe5, 0x28, 0xa6, 0x03, 0x7a, 0x11, 0xef, 0xc7, 0xa4, 0x0d, 0xf2, 0x63, 0xa5, 0x0d,
-		0xce, 0xff, 0xbc, 0x04, 0x10, 0xe4, 0xcc, 0xd0, 0xa3, 0x70, 0xbc, 0xba, 0xb1, 0xbe, 0x1c, 0x5c,
-		0xc6, 0x08, 0x6d, 0xad, 0x8b, 0x53, 0x1a, 0x6e, 0x17, 0x1b, 0xe6, 0x8e, 0x89, 0x5b, 0xe8, 0x21,
-		0x98, 0x89, 0x42, 0x93, 0xaf, 0xda, 0xb2, 0x2c, 0xcd, 0xe5, 0x5f, 0xbf, 0xbb, 0x90, 0x61, 0x31,
-		0x02, 0x6e, 0xa1, 0x73, 0x70, 0x74, 0x10, 0xae, 0xbe, 0xbe, 0x22, 0x27, 0xe6, 0x0a, 0xaf, 0xdf,
-		0x5d, 0xc8, 0xfa, 0xc1, 0x04, 0x52, 0x00, 0x85, 0x21, 0x39, 0xbd, 0xe4, 0x1c, 0xbc, 0x7e, 0x77,
-		0x21, 0xcd, 0x96, 0x0c, 0xbf, 0xc5, 0xf1, 0x8d, 0x00, 0x75, 0x6b, 0xc7, 0xd1, 0x0d, 0xaa, 0x1a,
-		0xe6, 0xe0, 0x58, 0x7d, 0xfd, 0xaa, 0x5a, 0x59, 0x6a, 0xd6, 0x37, 0xd6, 0xfb, 0x4e, 0x04, 0x44,
-		0xeb, 0x96, 0x37, 0xb6, 0xaa, 0xab, 0x35, 0xad, 0x51, 0x5f, 0x59, 0x67, 0x57, 0xae, 0x22, 0x75,
-		0xef, 0x59, 0x6f, 0xd6, 0xd7, 0x6a, 0x72, 0xa2, 0x7a, 0x65, 0xe8, 0x76, 0xdc, 0x7d, 0x91, 0xc5,
-		0x18, 0x98, 0xa3, 0xc8, 0x46, 0xdc, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x2e, 0x6f, 0x47,
-		0xe8, 0xa7, 0x00, 0x00,
+		// 13197 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x90, 0x1b, 0xc9,
+		0x75, 0x18, 0xce, 0x01, 0xb0, 0xf8, 0x78, 0xf8, 0x9a, 0xed, 0x5d, 0x92, 0xe0, 0xf2, 0x8e, 0xe4,
+		0xcd, 0x7d, 0xf1, 0x78, 0xba, 0xe5, 0x91, 0x77, 0xc7, 0x3b, 0xee, 0x49, 0x3a, 0x03, 0x0b, 0x70,
+		0x09, 0xde, 0x72, 0x77, 0x35, 0xc0, 0xf2, 0x3e, 0x6c, 0x6b, 0x34, 0x0b, 0xcc, 0xee, 0xce, 0x11,
+		0x98, 0x81, 0x30, 0x03, 0x92, 0xab, 0x5f, 0x95, 0x4b, 0xb6, 0x25, 0xff, 0xe4, 0x93, 0xe5, 0xc8,
+		0xb1, 0x62, 0x9f, 0x2d, 0x53, 0x96, 0xed, 0xd8, 0x92, 0x3f, 0xe3, 0x0f, 0xd9, 0xb1, 0x9c, 0xca,
+		0x87, 0x53, 0x89, 0x63, 0x3b, 0x8e, 0x23, 0xbb, 0x2a, 0x89, 0xcb, 0x55, 0xb9, 0x24, 0x92, 0xcb,
+		0x3a, 0xcb, 0xf2, 0x97, 0x22, 0x27, 0xaa, 0x52, 0xa5, 0x94, 0x7a, 0xfd, 0x31, 0x1f, 0xc0, 0x60,
+		0x81, 0xe5, 0x9d, 0x9c, 0x8b, 0x9d, 0x7f, 0x76, 0xd1, 0xdd, 0xef, 0xbd, 0xee, 0x7e, 0xfd, 0xfa,
+		0xf5, 0xeb, 0xd7, 0xaf, 0x7b, 0xe0, 0x4b, 0xef, 0x82, 0x53, 0x3b, 0xb6, 0xbd, 0xd3, 0x31, 0xce,
+		0xf6, 0xfa, 0xb6, 0x6b, 0x6f, 0x0d, 0xb6, 0xcf, 0xb6, 0x0d, 0xa7, 0xd5, 0x37, 0x7b, 0xae, 0xdd,
+		0x5f, 0xa4, 0x79, 0xa4, 0xc8, 0x20, 0x16, 0x05, 0x84, 0x72, 0x15, 0x66, 0x2f, 0x99, 0x1d, 0xa3,
+		0xea, 0x01, 0x36, 0x0c, 0x97, 0x3c, 0x05, 0x89, 0x6d, 0xb3, 0x63, 0x94, 0xa4, 0x53, 0xf1, 0xd3,
+		0xd9, 0xf3, 0xf7, 0x2d, 0x0e, 0x21, 0x2d, 0x86, 0x31, 0x36, 0x30, 0x5b, 0xa5, 0x18, 0xca, 0x2b,
+		0x33, 0x30, 0x17, 0x51, 0x4a, 0x08, 0x24, 0x2c, 0xbd, 0x8b, 0x14, 0xa5, 0xd3, 0x19, 0x95, 0xfe,
+		0x26, 0x25, 0x48, 0xf5, 0xf4, 0xd6, 0x75, 0x7d, 0xc7, 0x28, 0xc5, 0x68, 0xb6, 0x48, 0x92, 0x13,
+		0x00, 0x6d, 0xa3, 0x67, 0x58, 0x6d, 0xc3, 0x6a, 0xed, 0x95, 0xe2, 0xa7, 0xe2, 0xa7, 0x33, 0x6a,
+		0x20, 0x87, 0x3c, 0x0c, 0xb3, 0xbd, 0xc1, 0x56, 0xc7, 0x6c, 0x69, 0x01, 0x30, 0x38, 0x15, 0x3f,
+		0x3d, 0xa3, 0xca, 0xac, 0xa0, 0xea, 0x03, 0x3f, 0x08, 0xc5, 0x9b, 0x86, 0x7e, 0x3d, 0x08, 0x9a,
+		0xa5, 0xa0, 0x05, 0xcc, 0x0e, 0x00, 0x2e, 0x43, 0xae, 0x6b, 0x38, 0x8e, 0xbe, 0x63, 0x68, 0xee,
+		0x5e, 0xcf, 0x28, 0x25, 0x68, 0xef, 0x4f, 0x8d, 0xf4, 0x7e, 0xb8, 0xe7, 0x59, 0x8e, 0xd5, 0xdc,
+		0xeb, 0x19, 0xa4, 0x0c, 0x19, 0xc3, 0x1a, 0x74, 0x19, 0x85, 0x99, 0x31, 0xfc, 0xab, 0x59, 0x83,
+		0xee, 0x30, 0x95, 0x34, 0xa2, 0x71, 0x12, 0x29, 0xc7, 0xe8, 0xdf, 0x30, 0x5b, 0x46, 0x29, 0x49,
+		0x09, 0x3c, 0x38, 0x42, 0xa0, 0xc1, 0xca, 0x87, 0x69, 0x08, 0x3c, 0xb2, 0x0c, 0x19, 0xe3, 0x96,
+		0x6b, 0x58, 0x8e, 0x69, 0x5b, 0xa5, 0x14, 0x25, 0x72, 0x7f, 0xc4, 0x28, 0x1a, 0x9d, 0xf6, 0x30,
+		0x09, 0x1f, 0x8f, 0x5c, 0x80, 0x94, 0xdd, 0x73, 0x4d, 0xdb, 0x72, 0x4a, 0xe9, 0x53, 0xd2, 0xe9,
+		0xec, 0xf9, 0xbb, 0x22, 0x05, 0x61, 0x9d, 0xc1, 0xa8, 0x02, 0x98, 0xd4, 0x41, 0x76, 0xec, 0x41,
+		0xbf, 0x65, 0x68, 0x2d, 0xbb, 0x6d, 0x68, 0xa6, 0xb5, 0x6d, 0x97, 0x32, 0x94, 0xc0, 0xc9, 0xd1,
+		0x8e, 0x50, 0xc0, 0x65, 0xbb, 0x6d, 0xd4, 0xad, 0x6d, 0x5b, 0x2d, 0x38, 0xa1, 0x34, 0x39, 0x02,
+		0x49, 0x67, 0xcf, 0x72, 0xf5, 0x5b, 0xa5, 0x1c, 0x95, 0x10, 0x9e, 0x22, 0xe7, 0x21, 0x65, 0xb4,
+		0x4d, 0xac, 0xae, 0x54, 0x38, 0x25, 0x9d, 0x2e, 0x9c, 0x2f, 0x8d, 0xf2, 0x98, 0x95, 0xab, 0x02,
+		0x50, 0xf9, 0x74, 0x12, 0x8a, 0xd3, 0x88, 0xe5, 0xd3, 0x30, 0xb3, 0x8d, 0x9c, 0x29, 0xc5, 0x0e,
+		0xc2, 0x37, 0x86, 0x13, 0x66, 0x7c, 0xf2, 0x0e, 0x19, 0x5f, 0x86, 0xac, 0x65, 0x38, 0xae, 0xd1,
+		0x66, 0x52, 0x14, 0x9f, 0x52, 0x0e, 0x81, 0x21, 0x8d, 0x8a, 0x61, 0xe2, 0x8e, 0xc4, 0xf0, 0x79,
+		0x28, 0x7a, 0x4d, 0xd2, 0xfa, 0xba, 0xb5, 0x23, 0xe4, 0xf9, 0xec, 0xa4, 0x96, 0x2c, 0xd6, 0x04,
+		0x9e, 0x8a, 0x68, 0x6a, 0xc1, 0x08, 0xa5, 0x49, 0x15, 0xc0, 0xb6, 0x0c, 0x7b, 0x5b, 0x6b, 0x1b,
+		0xad, 0x4e, 0x29, 0x3d, 0x86, 0x4b, 0xeb, 0x08, 0x32, 0xc2, 0x25, 0x9b, 0xe5, 0xb6, 0x3a, 0xe4,
+		0xa2, 0x2f, 0x9e, 0xa9, 0x31, 0xd2, 0x75, 0x95, 0x4d, 0xcc, 0x11, 0x09, 0xdd, 0x84, 0x42, 0xdf,
+		0xc0, 0xb9, 0x62, 0xb4, 0x79, 0xcf, 0x32, 0xb4, 0x11, 0x8b, 0x13, 0x7b, 0xa6, 0x72, 0x34, 0xd6,
+		0xb1, 0x7c, 0x3f, 0x98, 0x24, 0xf7, 0x82, 0x97, 0xa1, 0x51, 0xb1, 0x02, 0xaa, 0xb9, 0x72, 0x22,
+		0x73, 0x4d, 0xef, 0x1a, 0x0b, 0xef, 0x81, 0x42, 0x98, 0x3d, 0x64, 0x1e, 0x66, 0x1c, 0x57, 0xef,
+		0xbb, 0x54, 0x0a, 0x67, 0x54, 0x96, 0x20, 0x32, 0xc4, 0x0d, 0xab, 0x4d, 0x35, 0xe3, 0x8c, 0x8a,
+		0x3f, 0xc9, 0x37, 0xf8, 0x1d, 0x8e, 0xd3, 0x0e, 0x3f, 0x30, 0x3a, 0xa2, 0x21, 0xca, 0xc3, 0xfd,
+		0x5e, 0x78, 0x12, 0xf2, 0xa1, 0x0e, 0x4c, 0x5b, 0xb5, 0xf2, 0x3b, 0x09, 0x38, 0x1c, 0x49, 0x9b,
+		0x3c, 0x0f, 0xf3, 0x03, 0xcb, 0xb4, 0x5c, 0xa3, 0xdf, 0xeb, 0x1b, 0x28, 0xb2, 0xac, 0xae, 0xd2,
+		0xe7, 0x53, 0x63, 0x84, 0x6e, 0x33, 0x08, 0xcd, 0xa8, 0xa8, 0x73, 0x83, 0xd1, 0x4c, 0xf2, 0x02,
+		0x64, 0x51, 0x3e, 0xf4, 0xbe, 0x4e, 0x09, 0xb2, 0xd9, 0x78, 0x7e, 0xba, 0x2e, 0x2f, 0x56, 0x7d,
+		0xcc, 0x4a, 0xfc, 0x03, 0x52, 0x4c, 0x0d, 0xd2, 0x22, 0x4f, 0x42, 0x7a, 0xdb, 0xd0, 0xdd, 0x41,
+		0xdf, 0x70, 0x4a, 0xe7, 0x29, 0x2b, 0x8f, 0x8f, 0x4e, 0x52, 0x06, 0xd0, 0x30, 0x5c, 0xd5, 0x03,
+		0x26, 0x5d, 0xc8, 0xdd, 0x30, 0xfa, 0xe6, 0xb6, 0xd9, 0x62, 0x8d, 0x8a, 0x53, 0xe5, 0xf3, 0xd4,
+		0x94, 0x8d, 0xba, 0x16, 0x40, 0x6d, 0xb8, 0xba, 0x6b, 0x2c, 0xc1, 0xe6, 0xda, 0xb5, 0x9a, 0x5a,
+		0xbf, 0x54, 0xaf, 0x55, 0x59, 0x33, 0x43, 0xe4, 0x17, 0x3e, 0x22, 0x41, 0x36, 0xd0, 0x13, 0x54,
+		0x87, 0xd6, 0xa0, 0xbb, 0x65, 0xf4, 0xf9, 0x78, 0xf1, 0x14, 0x39, 0x0e, 0x99, 0xed, 0x41, 0xa7,
+		0xc3, 0x84, 0x8e, 0xad, 0xa5, 0x69, 0xcc, 0x40, 0x81, 0x43, 0x1d, 0xc7, 0xd5, 0x08, 0xd5, 0x71,
+		0xf8, 0x9b, 0x2c, 0x40, 0x5a, 0x08, 0x65, 0x69, 0xe6, 0x94, 0x74, 0x3a, 0xad, 0x7a, 0x69, 0x56,
+		0xd6, 0x33, 0x74, 0xd7, 0x68, 0x97, 0x92, 0xa2, 0x8c, 0xa5, 0xaf, 0x24, 0xd2, 0x09, 0x79, 0x46,
+		0x79, 0x1c, 0x66, 0x47, 0xba, 0x42, 0x8a, 0x90, 0xad, 0xd6, 0x96, 0x57, 0xcb, 0x6a, 0xb9, 0x59,
+		0x5f, 0x5f, 0x93, 0x0f, 0x91, 0x02, 0x04, 0x7a, 0x27, 0x4b, 0x67, 0x32, 0xe9, 0xd7, 0x52, 0xf2,
+		0x7b, 0xdf, 0xfb, 0xde, 0xf7, 0xc6, 0x94, 0x5f, 0x4f, 0xc2, 0x7c, 0x94, 0x12, 0x8c, 0xd4, 0xc7,
+		0x7e, 0xa7, 0xe3, 0xa1, 0x4e, 0x97, 0x61, 0xa6, 0xa3, 0x6f, 0x19, 0x9d, 0x52, 0x82, 0x0e, 0xc2,
+		0xc3, 0x53, 0xa9, 0xd9, 0xc5, 0x55, 0x44, 0x51, 0x19, 0x26, 0x79, 0x3b, 0x67, 0xcd, 0x0c, 0xa5,
+		0x70, 0x66, 0x3a, 0x0a, 0xa8, 0x1c, 0x39, 0x1b, 0x8f, 0x43, 0x06, 0xff, 0x33, 0xbe, 0x27, 0x19,
+		0xdf, 0x31, 0x83, 0xf2, 0x7d, 0x01, 0xd2, 0x54, 0xef, 0xb5, 0x0d, 0x6f, 0x4c, 0x44, 0x1a, 0x35,
+		0x45, 0xdb, 0xd8, 0xd6, 0x07, 0x1d, 0x57, 0xbb, 0xa1, 0x77, 0x06, 0x06, 0xd5, 0x60, 0x19, 0x35,
+		0xc7, 0x33, 0xaf, 0x61, 0x1e, 0x39, 0x09, 0x59, 0xa6, 0x26, 0x4d, 0xab, 0x6d, 0xdc, 0xa2, 0x4b,
+		0xe8, 0x8c, 0xca, 0x34, 0x67, 0x1d, 0x73, 0xb0, 0xfa, 0x97, 0x1c, 0xdb, 0x12, 0xba, 0x86, 0x56,
+		0x81, 0x19, 0xb4, 0xfa, 0x27, 0x87, 0x57, 0xef, 0xbb, 0xa3, 0xbb, 0x37, 0xa2, 0x1c, 0x1f, 0x84,
+		0x22, 0x85, 0x78, 0x8c, 0x4f, 0x65, 0xbd, 0x53, 0x9a, 0xa5, 0x62, 0x50, 0x60, 0xd9, 0xeb, 0x3c,
+		0x57, 0xf9, 0x95, 0x18, 0x24, 0xe8, 0x4a, 0x51, 0x84, 0x6c, 0xf3, 0x85, 0x8d, 0x9a, 0x56, 0x5d,
+		0xdf, 0xac, 0xac, 0xd6, 0x64, 0x09, 0x87, 0x9e, 0x66, 0x5c, 0x5a, 0x5d, 0x2f, 0x37, 0xe5, 0x98,
+		0x97, 0xae, 0xaf, 0x35, 0x2f, 0x3c, 0x2e, 0xc7, 0x3d, 0x84, 0x4d, 0x96, 0x91, 0x08, 0x02, 0x3c,
+		0x76, 0x5e, 0x9e, 0x21, 0x32, 0xe4, 0x18, 0x81, 0xfa, 0xf3, 0xb5, 0xea, 0x85, 0xc7, 0xe5, 0x64,
+		0x38, 0xe7, 0xb1, 0xf3, 0x72, 0x8a, 0xe4, 0x21, 0x43, 0x73, 0x2a, 0xeb, 0xeb, 0xab, 0x72, 0xda,
+		0xa3, 0xd9, 0x68, 0xaa, 0xf5, 0xb5, 0x15, 0x39, 0xe3, 0xd1, 0x5c, 0x51, 0xd7, 0x37, 0x37, 0x64,
+		0xf0, 0x28, 0x5c, 0xad, 0x35, 0x1a, 0xe5, 0x95, 0x9a, 0x9c, 0xf5, 0x20, 0x2a, 0x2f, 0x34, 0x6b,
+		0x0d, 0x39, 0x17, 0x6a, 0xd6, 0x63, 0xe7, 0xe5, 0xbc, 0x57, 0x45, 0x6d, 0x6d, 0xf3, 0xaa, 0x5c,
+		0x20, 0xb3, 0x90, 0x67, 0x55, 0x88, 0x46, 0x14, 0x87, 0xb2, 0x2e, 0x3c, 0x2e, 0xcb, 0x7e, 0x43,
+		0x18, 0x95, 0xd9, 0x50, 0xc6, 0x85, 0xc7, 0x65, 0xa2, 0x2c, 0xc3, 0x0c, 0x15, 0x43, 0x42, 0xa0,
+		0xb0, 0x5a, 0xae, 0xd4, 0x56, 0xb5, 0xf5, 0x0d, 0x9c, 0x34, 0xe5, 0x55, 0x59, 0xf2, 0xf3, 0xd4,
+		0xda, 0x46, 0xad, 0xdc, 0xac, 0x55, 0xe5, 0x78, 0x30, 0xef, 0x1d, 0x9b, 0x75, 0xb5, 0x56, 0x95,
+		0x63, 0x4a, 0x0b, 0xe6, 0xa3, 0x56, 0xc8, 0xc8, 0x29, 0x14, 0x90, 0x85, 0xd8, 0x18, 0x59, 0xa0,
+		0xb4, 0x86, 0x65, 0x41, 0xf9, 0x5c, 0x0c, 0xe6, 0x22, 0xac, 0x84, 0xc8, 0x4a, 0x9e, 0x81, 0x19,
+		0x26, 0xcb, 0x4c, 0x53, 0x3f, 0x14, 0x69, 0x6e, 0x50, 0xc9, 0x1e, 0xb1, 0x9d, 0x28, 0x5e, 0xd0,
+		0xde, 0x8c, 0x8f, 0xb1, 0x37, 0x91, 0xc4, 0x88, 0xc0, 0x7e, 0xf3, 0xc8, 0x6a, 0xce, 0x0c, 0x9e,
+		0x0b, 0xd3, 0x18, 0x3c, 0x34, 0xef, 0x60, 0xab, 0xfa, 0x4c, 0xc4, 0xaa, 0xfe, 0x34, 0xcc, 0x8e,
+		0x10, 0x9a, 0x7a, 0x75, 0xfd, 0x76, 0x09, 0x4a, 0xe3, 0x98, 0x33, 0x41, 0x25, 0xc6, 0x42, 0x2a,
+		0xf1, 0xe9, 0x61, 0x0e, 0xde, 0x33, 0x7e, 0x10, 0x46, 0xc6, 0xfa, 0x13, 0x12, 0x1c, 0x89, 0xde,
+		0x57, 0x44, 0xb6, 0xe1, 0xed, 0x90, 0xec, 0x1a, 0xee, 0xae, 0x2d, 0xec, 0xe4, 0x07, 0x22, 0xac,
+		0x2f, 0x2c, 0x1e, 0x1e, 0x6c, 0x8e, 0x45, 0x2e, 0x0e, 0xb7, 0xf5, 0xe4, 0xb8, 0x5d, 0xce, 0x48,
+		0x4b, 0xbf, 0x33, 0x06, 0x87, 0x23, 0x89, 0x47, 0x36, 0xf4, 0x6e, 0x00, 0xd3, 0xea, 0x0d, 0x5c,
+		0x66, 0x0b, 0x33, 0x4d, 0x9c, 0xa1, 0x39, 0x54, 0x79, 0xa1, 0x96, 0x1d, 0xb8, 0x5e, 0x39, 0x5b,
+		0x25, 0x81, 0x65, 0x51, 0x80, 0xa7, 0xfc, 0x86, 0x26, 0x68, 0x43, 0x4f, 0x8c, 0xe9, 0xe9, 0x88,
+		0x60, 0x3e, 0x0a, 0x72, 0xab, 0x63, 0x1a, 0x96, 0xab, 0x39, 0x6e, 0xdf, 0xd0, 0xbb, 0xa6, 0xb5,
+		0xc3, 0x56, 0xdb, 0xa5, 0x99, 0x6d, 0xbd, 0xe3, 0x18, 0x6a, 0x91, 0x15, 0x37, 0x44, 0x29, 0x62,
+		0x50, 0x01, 0xea, 0x07, 0x30, 0x92, 0x21, 0x0c, 0x56, 0xec, 0x61, 0x28, 0x3f, 0x9b, 0x81, 0x6c,
+		0x60, 0x17, 0x46, 0xee, 0x81, 0xdc, 0x4b, 0xfa, 0x0d, 0x5d, 0x13, 0x3b, 0x6b, 0xc6, 0x89, 0x2c,
+		0xe6, 0x6d, 0xb0, 0x2c, 0xf2, 0x28, 0xcc, 0x53, 0x10, 0x7b, 0xe0, 0x1a, 0x7d, 0xad, 0xd5, 0xd1,
+		0x1d, 0x87, 0x32, 0x2d, 0x4d, 0x41, 0x09, 0x96, 0xad, 0x63, 0xd1, 0xb2, 0x28, 0x21, 0x4f, 0xc0,
+		0x1c, 0xc5, 0xe8, 0x0e, 0x3a, 0xae, 0xd9, 0xeb, 0x18, 0x1a, 0xee, 0xf5, 0x9d, 0x12, 0x04, 0x5b,
+		0x36, 0x8b, 0x10, 0x57, 0x39, 0x00, 0xb6, 0xc8, 0x21, 0x55, 0xb8, 0x9b, 0xa2, 0xed, 0x18, 0x96,
+		0xd1, 0xd7, 0x5d, 0x43, 0x33, 0xde, 0x3d, 0xd0, 0x3b, 0x8e, 0xa6, 0x5b, 0x6d, 0x6d, 0x57, 0x77,
+		0x76, 0x4b, 0xf3, 0x48, 0xa0, 0x12, 0x2b, 0x49, 0xea, 0x31, 0x04, 0x5c, 0xe1, 0x70, 0x35, 0x0a,
+		0x56, 0xb6, 0xda, 0x97, 0x75, 0x67, 0x97, 0x2c, 0xc1, 0x11, 0x4a, 0xc5, 0x71, 0xfb, 0xa6, 0xb5,
+		0xa3, 0xb5, 0x76, 0x8d, 0xd6, 0x75, 0x6d, 0xe0, 0x6e, 0x3f, 0x55, 0x3a, 0x1e, 0xac, 0x9f, 0xb6,
+		0xb0, 0x41, 0x61, 0x96, 0x11, 0x64, 0xd3, 0xdd, 0x7e, 0x8a, 0x34, 0x20, 0x87, 0x83, 0xd1, 0x35,
+		0xdf, 0x63, 0x68, 0xdb, 0x76, 0x9f, 0xae, 0xa1, 0x85, 0x08, 0xd5, 0x14, 0xe0, 0xe0, 0xe2, 0x3a,
+		0x47, 0xb8, 0x6a, 0xb7, 0x8d, 0xa5, 0x99, 0xc6, 0x46, 0xad, 0x56, 0x55, 0xb3, 0x82, 0xca, 0x25,
+		0xbb, 0x8f, 0x02, 0xb5, 0x63, 0x7b, 0x0c, 0xce, 0x32, 0x81, 0xda, 0xb1, 0x05, 0x7b, 0x9f, 0x80,
+		0xb9, 0x56, 0x8b, 0xf5, 0xd9, 0x6c, 0x69, 0x7c, 0x47, 0xee, 0x94, 0xe4, 0x10, 0xb3, 0x5a, 0xad,
+		0x15, 0x06, 0xc0, 0x65, 0xdc, 0x21, 0x17, 0xe1, 0xb0, 0xcf, 0xac, 0x20, 0xe2, 0xec, 0x48, 0x2f,
+		0x87, 0x51, 0x9f, 0x80, 0xb9, 0xde, 0xde, 0x28, 0x22, 0x09, 0xd5, 0xd8, 0xdb, 0x1b, 0x46, 0xbb,
+		0x9f, 0x7a, 0x59, 0xfa, 0x46, 0x8b, 0x9a, 0x7a, 0x47, 0x83, 0xd0, 0x81, 0x02, 0xb2, 0x08, 0x72,
+		0xab, 0xa5, 0x19, 0x96, 0xbe, 0xd5, 0x31, 0x34, 0xbd, 0x6f, 0x58, 0xba, 0x53, 0x3a, 0x49, 0x81,
+		0x13, 0x6e, 0x7f, 0x60, 0xa8, 0x85, 0x56, 0xab, 0x46, 0x0b, 0xcb, 0xb4, 0x8c, 0x9c, 0x81, 0x59,
+		0x7b, 0xeb, 0xa5, 0x16, 0x13, 0x2c, 0xad, 0xd7, 0x37, 0xb6, 0xcd, 0x5b, 0xa5, 0xfb, 0x28, 0x97,
+		0x8a, 0x58, 0x40, 0xc5, 0x6a, 0x83, 0x66, 0x93, 0x87, 0x40, 0x6e, 0x39, 0xbb, 0x7a, 0xbf, 0x47,
+		0x35, 0xab, 0xd3, 0xd3, 0x5b, 0x46, 0xe9, 0x7e, 0x06, 0xca, 0xf2, 0xd7, 0x44, 0x36, 0x0a, 0xb6,
+		0x73, 0xd3, 0xdc, 0x76, 0x05, 0xc5, 0x07, 0x99, 0x60, 0xd3, 0x3c, 0x4e, 0xed, 0x34, 0xc8, 0xbd,
+		0xdd, 0x5e, 0xb8, 0xe2, 0xd3, 0x14, 0xac, 0xd0, 0xdb, 0xed, 0x05, 0xeb, 0xbd, 0x17, 0xf2, 0xbd,
+		0xdd, 0x60, 0xa5, 0x0f, 0x31, 0xfb, 0xab, 0xb7, 0x1b, 0xa8, 0xf1, 0x71, 0x38, 0x82, 0x40, 0x5d,
+		0xc3, 0xd5, 0xdb, 0xba, 0xab, 0x07, 0xa0, 0xdf, 0x42, 0xa1, 0xe7, 0x7b, 0xbb, 0xbd, 0xab, 0xbc,
+		0x30, 0xd4, 0xce, 0xfe, 0x60, 0x6b, 0xcf, 0x93, 0x8f, 0x47, 0x58, 0x3b, 0x31, 0x4f, 0x48, 0xc8,
+		0x1d, 0x6f, 0x3f, 0xbe, 0x6e, 0x9b, 0x2d, 0x65, 0x09, 0x72, 0x41, 0xb9, 0x27, 0x19, 0x60, 0x92,
+		0x2f, 0x4b, 0x68, 0x04, 0x2d, 0xaf, 0x57, 0xd1, 0x7c, 0x79, 0xb1, 0x26, 0xc7, 0xd0, 0x8c, 0x5a,
+		0xad, 0x37, 0x6b, 0x9a, 0xba, 0xb9, 0xd6, 0xac, 0x5f, 0xad, 0xc9, 0xf1, 0x80, 0x61, 0x7f, 0x25,
+		0x91, 0x3e, 0x23, 0x3f, 0x7c, 0x25, 0x91, 0x7e, 0x40, 0x7e, 0x90, 0xb2, 0x67, 0x44, 0x28, 0x95,
+		0x2f, 0xc7, 0xa1, 0x10, 0xde, 0x96, 0x93, 0xb7, 0xc2, 0x51, 0xe1, 0x77, 0x73, 0x0c, 0x57, 0xbb,
+		0x69, 0xf6, 0xe9, 0x64, 0xed, 0xea, 0x6c, 0xe1, 0xf4, 0x84, 0x72, 0x9e, 0x43, 0x35, 0x0c, 0xf7,
+		0x39, 0xb3, 0x8f, 0x53, 0xb1, 0xab, 0xbb, 0x64, 0x15, 0x4e, 0x5a, 0xb6, 0xe6, 0xb8, 0xba, 0xd5,
+		0xd6, 0xfb, 0x6d, 0xcd, 0xf7, 0x78, 0x6a, 0x7a, 0xab, 0x65, 0x38, 0x8e, 0xcd, 0x16, 0x49, 0x8f,
+		0xca, 0x5d, 0x96, 0xdd, 0xe0, 0xc0, 0xfe, 0xea, 0x51, 0xe6, 0xa0, 0x43, 0x73, 0x22, 0x3e, 0x6e,
+		0x4e, 0x1c, 0x87, 0x4c, 0x57, 0xef, 0x69, 0x86, 0xe5, 0xf6, 0xf7, 0xa8, 0xed, 0x9e, 0x56, 0xd3,
+		0x5d, 0xbd, 0x57, 0xc3, 0x34, 0xb9, 0x06, 0x0f, 0xf8, 0xa0, 0x5a, 0xc7, 0xd8, 0xd1, 0x5b, 0x7b,
+		0x1a, 0x35, 0xd4, 0xa9, 0x8f, 0x48, 0x6b, 0xd9, 0xd6, 0x76, 0xc7, 0x6c, 0xb9, 0x4e, 0x29, 0xeb,
+		0xe9, 0x3f, 0xc5, 0xc7, 0x58, 0xa5, 0x08, 0x57, 0x1c, 0xdb, 0xa2, 0xf6, 0xf9, 0xb2, 0x80, 0x0e,
+		0x89, 0x4d, 0xee, 0x4d, 0x21, 0x36, 0xe1, 0xa1, 0x4f, 0xc8, 0x33, 0x57, 0x12, 0xe9, 0x19, 0x39,
+		0x79, 0x25, 0x91, 0x4e, 0xca, 0xa9, 0x2b, 0x89, 0x74, 0x5a, 0xce, 0x5c, 0x49, 0xa4, 0x33, 0x32,
+		0x28, 0xb7, 0xf3, 0x90, 0x0b, 0x6e, 0x37, 0x70, 0xf7, 0xd6, 0xa2, 0x0b, 0xae, 0x44, 0x55, 0xf2,
+		0xbd, 0xfb, 0x6e, 0x4e, 0x16, 0x97, 0x71, 0x25, 0x5e, 0x4a, 0x32, 0xdb, 0x5e, 0x65, 0x98, 0x68,
+		0x05, 0xe1, 0x24, 0x33, 0x98, 0x2d, 0x95, 0x56, 0x79, 0x8a, 0xac, 0x40, 0xf2, 0x25, 0x87, 0xd2,
+		0x4e, 0x52, 0xda, 0xf7, 0xed, 0x4f, 0xfb, 0x4a, 0x83, 0x12, 0xcf, 0x5c, 0x69, 0x68, 0x6b, 0xeb,
+		0xea, 0xd5, 0xf2, 0xaa, 0xca, 0xd1, 0xc9, 0x31, 0x48, 0x74, 0xf4, 0xf7, 0xec, 0x85, 0xd7, 0x6c,
+		0x9a, 0x45, 0x16, 0xa1, 0x38, 0xb0, 0xd8, 0x5e, 0x1d, 0xc7, 0x18, 0xa1, 0x8a, 0x41, 0xa8, 0x82,
+		0x5f, 0xba, 0x8a, 0xf0, 0x53, 0xca, 0xd5, 0x31, 0x48, 0xa0, 0x53, 0x3a, 0xbc, 0xb2, 0xd2, 0x2c,
+		0x72, 0x1a, 0x72, 0x6d, 0x63, 0x6b, 0xb0, 0xa3, 0xf5, 0x8d, 0xb6, 0xde, 0x72, 0xc3, 0xeb, 0x49,
+		0x96, 0x16, 0xa9, 0xb4, 0x84, 0x3c, 0x0b, 0x19, 0x1c, 0x23, 0x8b, 0x8e, 0xf1, 0x2c, 0x65, 0xc1,
+		0x23, 0xfb, 0xb3, 0x80, 0x0f, 0xb1, 0x40, 0x52, 0x7d, 0x7c, 0x72, 0x19, 0x52, 0xae, 0xde, 0xdf,
+		0x31, 0x5c, 0xa7, 0x34, 0x77, 0x2a, 0x7e, 0xba, 0x70, 0x7e, 0x71, 0x1a, 0x52, 0x4d, 0x8a, 0x42,
+		0x77, 0xca, 0x02, 0x9d, 0x3c, 0x07, 0x32, 0x77, 0xc5, 0x6a, 0x7c, 0x9b, 0xeb, 0x94, 0xe6, 0xa9,
+		0x00, 0xbe, 0x65, 0x7f, 0x92, 0xdc, 0x93, 0x5b, 0x65, 0x48, 0x6a, 0xd1, 0x08, 0xa5, 0xc3, 0xf3,
+		0xe2, 0xf0, 0x41, 0xe6, 0xc5, 0x26, 0x14, 0xf9, 0x6f, 0xcd, 0x19, 0xf4, 0x7a, 0x76, 0xdf, 0x2d,
+		0x1d, 0x39, 0x25, 0x4d, 0x6e, 0x90, 0x20, 0xc6, 0x70, 0xd4, 0xc2, 0x76, 0x28, 0xfd, 0xf5, 0x9b,
+		0x6e, 0x0b, 0x2f, 0x42, 0x21, 0xcc, 0x8c, 0xa0, 0x23, 0x3c, 0x3e, 0xa5, 0x23, 0x1c, 0xb7, 0x25,
+		0x62, 0xa3, 0x86, 0x4b, 0x13, 0x4b, 0x2c, 0x7c, 0x5f, 0x0c, 0x0a, 0xe1, 0x8e, 0x91, 0x15, 0x20,
+		0x62, 0xc4, 0x4c, 0xcb, 0xed, 0xdb, 0xed, 0x41, 0xcb, 0x68, 0x97, 0xa4, 0x09, 0xf5, 0xcc, 0x72,
+		0x9c, 0xba, 0x87, 0x12, 0x24, 0x14, 0x98, 0x05, 0xb1, 0x29, 0x09, 0x55, 0xfd, 0xf9, 0x71, 0x16,
+		0xe6, 0x04, 0x01, 0x24, 0x76, 0x53, 0xef, 0x5b, 0x68, 0x22, 0x33, 0xa3, 0x9d, 0x04, 0x8a, 0x9e,
+		0x63, 0x25, 0xa4, 0x0c, 0x42, 0x5c, 0xb4, 0xbe, 0xd1, 0xb5, 0xd1, 0xdf, 0x95, 0x98, 0x50, 0x6d,
+		0x81, 0x23, 0xa8, 0x0c, 0x5e, 0x39, 0x0b, 0x33, 0x54, 0xfd, 0x10, 0x00, 0xae, 0x80, 0xe4, 0x43,
+		0x24, 0x0d, 0x89, 0xe5, 0x75, 0x15, 0x97, 0x47, 0x19, 0x72, 0x2c, 0x57, 0xdb, 0xa8, 0xd7, 0x96,
+		0x6b, 0x72, 0x4c, 0x79, 0x02, 0x92, 0x4c, 0xa7, 0xe0, 0xd2, 0xe9, 0x69, 0x15, 0xf9, 0x10, 0x4f,
+		0x72, 0x1a, 0x92, 0x28, 0xdd, 0xbc, 0x5a, 0xa9, 0xa9, 0x72, 0x4c, 0xd9, 0x84, 0xe2, 0xd0, 0x3c,
+		0x24, 0x87, 0x61, 0x56, 0xad, 0x35, 0x6b, 0x6b, 0xe8, 0x1c, 0xd0, 0x36, 0xd7, 0x9e, 0x5d, 0x5b,
+		0x7f, 0x0e, 0x3d, 0x6b, 0xa1, 0x6c, 0xb1, 0x0e, 0x4b, 0x64, 0x1e, 0x64, 0x3f, 0xbb, 0xb1, 0xbe,
+		0xa9, 0xd2, 0xd6, 0x7c, 0x57, 0x0c, 0xe4, 0xe1, 0x49, 0x49, 0x8e, 0xc2, 0x5c, 0xb3, 0xac, 0xae,
+		0xd4, 0x9a, 0x1a, 0x73, 0x78, 0x78, 0xa4, 0xe7, 0x41, 0x0e, 0x16, 0x5c, 0xaa, 0x53, 0x7f, 0xce,
+		0x49, 0x38, 0x1e, 0xcc, 0xad, 0x3d, 0xdf, 0xac, 0xad, 0x35, 0x68, 0xe5, 0xe5, 0xb5, 0x15, 0x34,
+		0x0a, 0x86, 0xe8, 0x09, 0x17, 0x4b, 0x1c, 0x9b, 0x1a, 0xa6, 0x57, 0x5b, 0xad, 0xca, 0x89, 0xe1,
+		0xec, 0xf5, 0xb5, 0xda, 0xfa, 0x25, 0x79, 0x66, 0xb8, 0x76, 0xea, 0x76, 0x49, 0x92, 0x05, 0x38,
+		0x32, 0x9c, 0xab, 0xd5, 0xd6, 0x9a, 0xea, 0x0b, 0x72, 0x6a, 0xb8, 0xe2, 0x46, 0x4d, 0xbd, 0x56,
+		0x5f, 0xae, 0xc9, 0x69, 0x72, 0x04, 0x48, 0xb8, 0x45, 0xcd, 0xcb, 0xeb, 0x55, 0x39, 0x13, 0xb5,
+		0x62, 0x11, 0x79, 0x4e, 0xf9, 0x19, 0x09, 0x72, 0x41, 0x17, 0x48, 0x48, 0xa9, 0x48, 0x6f, 0xb6,
+		0xc5, 0x56, 0xf9, 0xbd, 0x18, 0x64, 0x03, 0xbe, 0x10, 0xdc, 0xc4, 0xea, 0x9d, 0x8e, 0x7d, 0x53,
+		0xd3, 0x3b, 0xa6, 0xee, 0xf0, 0xf5, 0x10, 0x68, 0x56, 0x19, 0x73, 0xa6, 0x5d, 0x7f, 0xa6, 0x37,
+		0x5d, 0x92, 0x77, 0x6c, 0xba, 0xa4, 0xde, 0x84, 0xa6, 0xcb, 0x8c, 0x9c, 0x54, 0xfe, 0x20, 0x06,
+		0xf2, 0xb0, 0x77, 0x64, 0x88, 0x6f, 0xd2, 0x38, 0xbe, 0x05, 0xfb, 0x17, 0x3b, 0x48, 0xff, 0x86,
+		0x57, 0xf5, 0xf8, 0xd8, 0x55, 0x3d, 0x62, 0xb1, 0x4a, 0xbc, 0x99, 0x17, 0xab, 0xa0, 0xb8, 0xfe,
+		0x07, 0x09, 0x0a, 0x61, 0x67, 0x4e, 0x88, 0x63, 0xca, 0x41, 0x38, 0x16, 0x1e, 0x91, 0x7b, 0xc6,
+		0x8d, 0xc8, 0xdf, 0x48, 0xbf, 0x7e, 0x20, 0x0e, 0xf9, 0x90, 0xef, 0x67, 0xda, 0xd6, 0xbd, 0x1b,
+		0x66, 0xcd, 0xb6, 0xd1, 0xed, 0xd9, 0x2e, 0x46, 0x1e, 0x68, 0x1d, 0xe3, 0x86, 0xd1, 0xa1, 0x6c,
+		0x28, 0x44, 0x9c, 0xae, 0x86, 0x6a, 0x58, 0xac, 0xfb, 0x78, 0xab, 0x88, 0xb6, 0x34, 0x57, 0xaf,
+		0xd6, 0xae, 0x6e, 0xac, 0x37, 0x6b, 0x6b, 0xcb, 0x2f, 0x08, 0x4d, 0xae, 0xca, 0xe6, 0x10, 0x58,
+		0x88, 0xe1, 0xf7, 0xbe, 0x39, 0x36, 0x9d, 0x1b, 0x20, 0x0f, 0xf7, 0x06, 0x15, 0x7a, 0x44, 0x7f,
+		0xe4, 0x43, 0x64, 0x0e, 0x8a, 0x6b, 0xeb, 0x5a, 0xa3, 0x5e, 0xad, 0x69, 0xb5, 0x4b, 0x97, 0x6a,
+		0xcb, 0xcd, 0x06, 0x3b, 0x68, 0xf0, 0xa0, 0x9b, 0x72, 0x2c, 0x38, 0x36, 0x3f, 0x18, 0x87, 0xb9,
+		0x88, 0x96, 0x90, 0x32, 0x77, 0x11, 0x32, 0xaf, 0xe5, 0x23, 0xd3, 0xb4, 0x7e, 0x11, 0x77, 0xf7,
+		0x1b, 0x7a, 0xdf, 0xe5, 0x1e, 0xc5, 0x87, 0x00, 0xd9, 0x6b, 0xb9, 0x68, 0xde, 0xf7, 0xf9, 0x01,
+		0x0e, 0x33, 0x41, 0x8a, 0x7e, 0x3e, 0x3b, 0xc3, 0x79, 0x0b, 0x90, 0x9e, 0xed, 0x98, 0xae, 0x79,
+		0x03, 0x03, 0x21, 0xc4, 0x69, 0x0f, 0x4e, 0xdc, 0x84, 0x2a, 0x8b, 0x92, 0xba, 0xe5, 0x7a, 0xd0,
+		0x96, 0xb1, 0xa3, 0x0f, 0x41, 0xe3, 0xf6, 0x23, 0xae, 0xca, 0xa2, 0xc4, 0x83, 0xbe, 0x07, 0x72,
+		0x6d, 0x7b, 0x80, 0x5e, 0x19, 0x06, 0x87, 0x2a, 0x59, 0x52, 0xb3, 0x2c, 0xcf, 0x03, 0xe1, 0x6e,
+		0x33, 0xff, 0x98, 0x29, 0xa7, 0x66, 0x59, 0x1e, 0x03, 0x79, 0x10, 0x8a, 0xfa, 0xce, 0x4e, 0x1f,
+		0x89, 0x0b, 0x42, 0xcc, 0x11, 0x58, 0xf0, 0xb2, 0x29, 0xe0, 0xc2, 0x15, 0x48, 0x0b, 0x3e, 0xe0,
+		0xfe, 0x17, 0x39, 0xa1, 0xf5, 0x98, 0x77, 0x3b, 0x86, 0x27, 0x4f, 0x96, 0x28, 0xbc, 0x07, 0x72,
+		0xa6, 0xa3, 0xf9, 0x61, 0x10, 0xb1, 0x53, 0xb1, 0xd3, 0x69, 0x35, 0x6b, 0x3a, 0xde, 0xa9, 0xa8,
+		0xf2, 0xb3, 0x59, 0x00, 0x5f, 0xd8, 0xc8, 0xf7, 0x48, 0x50, 0x60, 0x0b, 0x4c, 0xaf, 0x6f, 0x38,
+		0x86, 0xd5, 0x12, 0xdb, 0xc2, 0x87, 0xf6, 0x11, 0x51, 0xa6, 0xe6, 0x36, 0x38, 0x42, 0xe5, 0x99,
+		0x0f, 0x48, 0xd2, 0x2b, 0x52, 0xe2, 0x15, 0x49, 0xfa, 0x51, 0x29, 0x4f, 0xd2, 0xb5, 0xe7, 0x37,
+		0x56, 0xeb, 0xcb, 0xf5, 0x66, 0xe9, 0x8f, 0x53, 0x34, 0x5d, 0xbf, 0xca, 0xd3, 0x9f, 0x4f, 0x85,
+		0xcb, 0x5f, 0x4b, 0xfd, 0xa2, 0x14, 0x4f, 0xbf, 0x96, 0x52, 0xf3, 0xdb, 0x41, 0x7a, 0xa4, 0x13,
+		0x8c, 0xa0, 0x88, 0x8d, 0xdb, 0x48, 0xfa, 0xad, 0xa9, 0xf1, 0xb8, 0x89, 0xca, 0x43, 0xb4, 0x21,
+		0x49, 0xda, 0x90, 0x2c, 0x49, 0x2e, 0xaf, 0xae, 0x37, 0x6a, 0x55, 0xda, 0x8c, 0x0c, 0x49, 0xac,
+		0x6f, 0xd4, 0xd6, 0x4a, 0x9f, 0x17, 0x55, 0xfa, 0xc1, 0x16, 0xaf, 0x48, 0x70, 0x54, 0x9c, 0xb2,
+		0xf2, 0xb5, 0xd6, 0xb0, 0x5a, 0x76, 0x5b, 0x58, 0xb7, 0x85, 0xf3, 0xe7, 0xf6, 0xab, 0x5c, 0xe5,
+		0xa8, 0x94, 0x25, 0x35, 0x8e, 0x58, 0x79, 0x64, 0x84, 0x25, 0xe5, 0xb5, 0x2a, 0x6f, 0x4b, 0x96,
+		0x24, 0x37, 0xca, 0xcb, 0xcf, 0xd6, 0xaa, 0x7e, 0x6b, 0x0e, 0xf7, 0xa3, 0xa8, 0x90, 0x6f, 0x81,
+		0x22, 0x7a, 0x5b, 0x51, 0x36, 0xcc, 0x36, 0x3b, 0xf6, 0x4e, 0x8c, 0x3b, 0x2f, 0xf5, 0x5b, 0x84,
+		0xee, 0xd7, 0x6b, 0x1e, 0x46, 0xe5, 0xa1, 0x40, 0x53, 0x32, 0x24, 0xb1, 0xb6, 0xbe, 0x56, 0x13,
+		0xcd, 0xa0, 0x47, 0xc4, 0x2f, 0xf8, 0xcd, 0x28, 0x0c, 0x42, 0xa8, 0xe4, 0x5b, 0x40, 0x16, 0xee,
+		0x21, 0x8f, 0x25, 0x33, 0xe3, 0x8e, 0x7c, 0xfd, 0x06, 0x70, 0x27, 0x93, 0xc7, 0x8c, 0x07, 0x02,
+		0x2d, 0x98, 0x27, 0xc5, 0xd5, 0xda, 0xda, 0x4a, 0xf3, 0xb2, 0xb6, 0xa1, 0xd6, 0xe8, 0xc9, 0x5d,
+		0xe9, 0x8f, 0x45, 0xf5, 0xc5, 0x6e, 0x18, 0x91, 0x7c, 0x9b, 0x04, 0x59, 0x66, 0x02, 0x31, 0x9f,
+		0x14, 0x73, 0x2a, 0x3c, 0xb0, 0x5f, 0xdd, 0xd4, 0x02, 0xa2, 0xd0, 0x95, 0x8b, 0xb4, 0xda, 0xb8,
+		0x10, 0x88, 0xa3, 0x84, 0xac, 0xd6, 0x56, 0xca, 0xcb, 0x2f, 0x68, 0x95, 0x5a, 0xa3, 0x89, 0x9a,
+		0x6c, 0x5d, 0x65, 0x32, 0x0a, 0x64, 0xa6, 0xbc, 0xba, 0xba, 0xfe, 0x9c, 0xcf, 0x08, 0x78, 0xc9,
+		0x23, 0xa3, 0x7c, 0x13, 0xe4, 0x43, 0xe2, 0x8e, 0x46, 0x31, 0x35, 0xa6, 0xb1, 0x07, 0x8d, 0xda,
+		0xda, 0x72, 0xd0, 0x88, 0xcf, 0x81, 0x27, 0xde, 0xb2, 0x84, 0x29, 0x21, 0xfc, 0x72, 0x0c, 0xd5,
+		0x28, 0x6f, 0x80, 0x77, 0x96, 0x18, 0x57, 0x9e, 0x84, 0xb4, 0x10, 0x5f, 0x34, 0xcd, 0xa9, 0x85,
+		0x3d, 0xb4, 0x31, 0x48, 0x03, 0x95, 0x5d, 0x59, 0xc2, 0x6d, 0x10, 0x93, 0x69, 0x39, 0xa6, 0x5c,
+		0x83, 0xc3, 0x91, 0xa2, 0x47, 0xee, 0x85, 0x93, 0xe2, 0xfc, 0x92, 0x19, 0xfd, 0x5a, 0x6d, 0x6d,
+		0x79, 0xbd, 0x8a, 0xdb, 0x24, 0x9f, 0x26, 0x00, 0x97, 0x41, 0xd6, 0x4a, 0x21, 0x9f, 0x72, 0x4c,
+		0xa9, 0x43, 0x21, 0x2c, 0x40, 0xe4, 0x38, 0x1c, 0xdd, 0x6c, 0x5e, 0x7a, 0x4a, 0xbb, 0x56, 0x5e,
+		0xad, 0x57, 0xcb, 0x43, 0x1b, 0x22, 0x00, 0x2e, 0x45, 0x72, 0x0c, 0x1b, 0x8a, 0xd2, 0x25, 0xc7,
+		0x95, 0x44, 0x5a, 0x92, 0x25, 0xa5, 0x01, 0xc5, 0x21, 0x51, 0x20, 0x77, 0x41, 0x89, 0xef, 0x50,
+		0xa2, 0x5a, 0x35, 0x07, 0xc3, 0xc2, 0xc1, 0xf6, 0x6a, 0xd5, 0xda, 0x6a, 0xfd, 0x6a, 0xbd, 0x49,
+		0xdb, 0x77, 0x19, 0xc0, 0x1f, 0x63, 0x5c, 0xb3, 0xae, 0x34, 0xd6, 0xd7, 0xb4, 0x4b, 0xb8, 0xd1,
+		0x6b, 0x06, 0x48, 0x65, 0x80, 0x8d, 0xa9, 0x2c, 0xe1, 0x7e, 0x64, 0x74, 0xe0, 0xe5, 0xd8, 0x99,
+		0x0f, 0x4a, 0xb8, 0x64, 0x7d, 0x70, 0x6d, 0xe1, 0xdb, 0x24, 0x72, 0x77, 0xfa, 0xb5, 0x14, 0x49,
+		0x2d, 0xf6, 0xb6, 0x16, 0x5b, 0xbd, 0xde, 0x42, 0x11, 0x7f, 0x2c, 0xf7, 0x7a, 0x97, 0xc4, 0x42,
+		0x7c, 0x32, 0xfd, 0x27, 0x29, 0x92, 0xc6, 0x5c, 0x3c, 0x04, 0x58, 0x90, 0xf1, 0xd7, 0x15, 0xfd,
+		0x86, 0xee, 0x01, 0x1c, 0x4f, 0x7f, 0x21, 0x45, 0x92, 0x98, 0xbd, 0x63, 0x2f, 0x14, 0xf0, 0xff,
+		0x8a, 0xed, 0x15, 0xde, 0x9b, 0xfe, 0x8e, 0x35, 0x02, 0x98, 0x49, 0x25, 0xf6, 0xdc, 0x02, 0xc1,
+		0xdf, 0xf4, 0xd8, 0xec, 0x9c, 0x00, 0x3a, 0x93, 0x4c, 0x7f, 0x70, 0x4d, 0xfe, 0xf0, 0xda, 0x99,
+		0x64, 0xfa, 0xc3, 0x6b, 0xf2, 0xf7, 0xac, 0x5d, 0x49, 0xa6, 0x3f, 0x9f, 0x92, 0x5f, 0x4b, 0x29,
+		0x7f, 0x11, 0x07, 0xe2, 0xcb, 0xb7, 0xe7, 0x79, 0x79, 0x1e, 0xd2, 0x9e, 0x2b, 0x87, 0xc5, 0x8a,
+		0xbe, 0x75, 0x9f, 0x69, 0x21, 0xd0, 0x02, 0x59, 0x43, 0xae, 0x1d, 0x8f, 0x1a, 0xee, 0xdb, 0xbb,
+		0xa6, 0x65, 0x76, 0x07, 0x5d, 0x4d, 0xf8, 0x37, 0x26, 0xee, 0xdb, 0x39, 0x02, 0x4f, 0x53, 0x12,
+		0xfa, 0xad, 0x10, 0x89, 0x99, 0x89, 0x24, 0x18, 0x02, 0x4f, 0x2f, 0x7c, 0x45, 0x82, 0xd2, 0xb8,
+		0xc6, 0xde, 0x91, 0xeb, 0x65, 0x0d, 0xe6, 0xed, 0x1b, 0x46, 0xbf, 0x6f, 0xb6, 0xe9, 0x69, 0x8a,
+		0x67, 0x90, 0x25, 0x26, 0x1b, 0x64, 0x73, 0x01, 0x44, 0x6f, 0x50, 0x2b, 0xb8, 0x6e, 0xde, 0xc2,
+		0x25, 0x43, 0x50, 0x9a, 0x99, 0x4c, 0x29, 0x4f, 0x51, 0x04, 0x8d, 0x2b, 0x38, 0x4d, 0x70, 0x0f,
+		0x14, 0x93, 0xe3, 0xbe, 0xd5, 0xa7, 0x7c, 0x22, 0x06, 0x85, 0x70, 0x70, 0x26, 0xa9, 0x42, 0xba,
+		0x63, 0xf3, 0xc0, 0x27, 0x36, 0xda, 0xa7, 0x27, 0xc4, 0x73, 0x2e, 0xae, 0x72, 0x78, 0xd5, 0xc3,
+		0x5c, 0xf8, 0x5d, 0x09, 0xd2, 0x22, 0x9b, 0x1c, 0x81, 0x44, 0x4f, 0x77, 0x77, 0x29, 0xb9, 0x99,
+		0x4a, 0x4c, 0x96, 0x54, 0x9a, 0xc6, 0x7c, 0xa7, 0xa7, 0xb3, 0xa0, 0x2f, 0x9e, 0x8f, 0x69, 0xb4,
+		0xbc, 0x3a, 0x86, 0xde, 0xa6, 0xe7, 0x80, 0x76, 0xb7, 0x6b, 0x58, 0xae, 0x23, 0x2c, 0x2f, 0x9e,
+		0xbf, 0xcc, 0xb3, 0x31, 0x46, 0xd8, 0xed, 0xeb, 0x66, 0x27, 0x04, 0x9b, 0xa0, 0xb0, 0xb2, 0x28,
+		0xf0, 0x80, 0x97, 0xe0, 0x98, 0xa0, 0xdb, 0x36, 0x5c, 0xbd, 0xb5, 0x6b, 0xb4, 0x7d, 0xa4, 0x24,
+		0x3d, 0xef, 0x3f, 0xca, 0x01, 0xaa, 0xbc, 0x5c, 0xe0, 0x2a, 0x9f, 0x89, 0xc1, 0xac, 0x38, 0xb9,
+		0x6c, 0x7b, 0xcc, 0xba, 0x0a, 0xa0, 0x5b, 0x96, 0xed, 0x06, 0xd9, 0x35, 0x6a, 0x6c, 0x8e, 0xe0,
+		0x2d, 0x96, 0x3d, 0x24, 0x35, 0x40, 0x60, 0xe1, 0x4f, 0x25, 0x00, 0xbf, 0x68, 0x2c, 0xdf, 0x4e,
+		0x42, 0x96, 0x87, 0xde, 0xd2, 0xf8, 0x6d, 0xe6, 0xe0, 0x03, 0x96, 0x85, 0x67, 0x9c, 0xe8, 0xfb,
+		0xdb, 0x32, 0x76, 0x4c, 0x8b, 0xc7, 0x52, 0xb1, 0x84, 0x08, 0x49, 0x48, 0xf8, 0xb1, 0x86, 0x2a,
+		0xa4, 0x1d, 0xa3, 0xab, 0x5b, 0xae, 0xd9, 0xe2, 0xb3, 0xe6, 0xc2, 0x81, 0x1a, 0xbf, 0xd8, 0xe0,
+		0xd8, 0xaa, 0x47, 0x47, 0x39, 0x0d, 0x69, 0x91, 0xeb, 0x69, 0xe9, 0x43, 0x24, 0x05, 0xf1, 0x46,
+		0x0d, 0xd7, 0x29, 0xaa, 0x2c, 0xeb, 0xe5, 0x86, 0x1c, 0x3b, 0xf3, 0x89, 0x18, 0xa4, 0xc4, 0x34,
+		0x9e, 0x83, 0x62, 0xad, 0x5a, 0x1f, 0x52, 0xf8, 0x73, 0x50, 0x10, 0x99, 0x4c, 0xab, 0xca, 0xef,
+		0x4b, 0x05, 0x33, 0x37, 0xd4, 0xf5, 0xe6, 0xfa, 0x79, 0xf9, 0x8f, 0x47, 0x33, 0x1f, 0x93, 0x3f,
+		0x9f, 0x22, 0xb3, 0x90, 0x13, 0x99, 0xe7, 0x1f, 0x3d, 0xff, 0x98, 0xfc, 0xda, 0x70, 0xd6, 0xe3,
+		0xf2, 0x9f, 0x50, 0xdf, 0x92, 0xc8, 0x3a, 0xa7, 0x35, 0x51, 0x6b, 0xaf, 0xaf, 0xad, 0xbe, 0x20,
+		0x4b, 0xc1, 0x82, 0xf3, 0x81, 0x82, 0x18, 0xb9, 0x1b, 0x8e, 0x8a, 0x82, 0x8b, 0x17, 0x2f, 0x5e,
+		0x7c, 0x32, 0x50, 0x78, 0xfb, 0x43, 0xc9, 0xe1, 0xe2, 0xa7, 0x02, 0xc5, 0x1f, 0x1b, 0x2d, 0xbe,
+		0x18, 0x28, 0xfe, 0xe1, 0x0f, 0x25, 0xc9, 0x1c, 0x64, 0x45, 0xf1, 0xd5, 0xf2, 0xf3, 0xf2, 0xd7,
+		0xbe, 0xf6, 0xb5, 0xaf, 0xa5, 0x2a, 0xdf, 0x02, 0x73, 0x2d, 0xbb, 0x3b, 0x3c, 0x34, 0x15, 0x79,
+		0x28, 0x30, 0xc2, 0xb9, 0x2c, 0xbd, 0xf8, 0x08, 0x07, 0xda, 0xb1, 0x3b, 0xba, 0xb5, 0xb3, 0x68,
+		0xf7, 0x77, 0xfc, 0x7b, 0x02, 0x68, 0xe4, 0x3a, 0x81, 0xdb, 0x02, 0xbd, 0xad, 0xaf, 0x48, 0xd2,
+		0x8f, 0xc6, 0xe2, 0x2b, 0x1b, 0x95, 0x9f, 0x8a, 0x2d, 0xac, 0x30, 0xc4, 0x0d, 0x31, 0xf0, 0xaa,
+		0xb1, 0xdd, 0x31, 0x5a, 0x38, 0x3a, 0xf0, 0xd1, 0x04, 0xcc, 0x62, 0x20, 0x82, 0x7d, 0x96, 0xfe,
+		0x65, 0x2d, 0x20, 0x33, 0x34, 0xb1, 0x30, 0xf1, 0x3a, 0xc2, 0xd2, 0x05, 0xb6, 0x19, 0x23, 0x93,
+		0x42, 0x77, 0x4b, 0x7f, 0xf5, 0x5d, 0x3f, 0x31, 0xe3, 0xc7, 0x74, 0x2c, 0x5d, 0x1d, 0xb5, 0x09,
+		0x27, 0xd3, 0xf8, 0x92, 0xa0, 0x31, 0x6c, 0xe2, 0x2d, 0xbd, 0x15, 0xd2, 0x1e, 0x99, 0xfd, 0xc3,
+		0xe4, 0x4a, 0xff, 0x5d, 0x10, 0xf1, 0x30, 0x96, 0x9e, 0x01, 0x60, 0x16, 0x3b, 0x0b, 0x37, 0xd9,
+		0x1f, 0xff, 0xcb, 0x02, 0x3f, 0x43, 0x71, 0x70, 0x47, 0xb5, 0xb4, 0x02, 0x85, 0xb6, 0x6d, 0xb9,
+		0x9a, 0xdd, 0x35, 0x5d, 0xa3, 0xdb, 0x73, 0xf7, 0x26, 0x11, 0xf9, 0x6b, 0x46, 0x24, 0xad, 0xe6,
+		0x11, 0x6f, 0x5d, 0xa0, 0x61, 0x4b, 0x58, 0xc4, 0xe0, 0x34, 0x2d, 0xf9, 0x1f, 0x5e, 0x4b, 0x28,
+		0x0e, 0xb6, 0xa4, 0x52, 0xfb, 0xcd, 0xcf, 0x9e, 0x90, 0x3e, 0xf3, 0xd9, 0x13, 0xd2, 0x7f, 0xfd,
+		0xec, 0x09, 0xe9, 0xc3, 0x9f, 0x3b, 0x71, 0xe8, 0x33, 0x9f, 0x3b, 0x71, 0xe8, 0x0f, 0x3e, 0x77,
+		0xe2, 0xd0, 0x8b, 0x0f, 0xef, 0x98, 0xee, 0xee, 0x60, 0x6b, 0xb1, 0x65, 0x77, 0xcf, 0xb6, 0x6c,
+		0xa7, 0x6b, 0x3b, 0xfc, 0xdf, 0x23, 0x4e, 0xfb, 0x3a, 0x97, 0x1f, 0xf7, 0x16, 0x93, 0x82, 0xad,
+		0x24, 0x8b, 0x14, 0x84, 0x3f, 0x7b, 0x18, 0xe6, 0x77, 0xec, 0x1d, 0x9b, 0x26, 0xcf, 0xe2, 0x2f,
+		0x2e, 0x20, 0x19, 0x2f, 0x77, 0x0a, 0x21, 0x59, 0x83, 0x39, 0x0e, 0xac, 0xd1, 0x1d, 0x19, 0x3b,
+		0xd9, 0x27, 0xfb, 0x46, 0x87, 0x95, 0x7e, 0xe1, 0x8f, 0xa8, 0x2b, 0x4d, 0x9d, 0xe5, 0xa8, 0x58,
+		0xc6, 0x0e, 0xff, 0x97, 0x54, 0x38, 0x1c, 0xa2, 0xc7, 0x76, 0xc3, 0x46, 0x7f, 0x02, 0xc5, 0x7f,
+		0xcd, 0x29, 0xce, 0x05, 0x28, 0x36, 0x38, 0xea, 0xd2, 0x32, 0xe4, 0x0f, 0x42, 0xeb, 0x37, 0x38,
+		0xad, 0x9c, 0x11, 0x24, 0xb2, 0x02, 0x45, 0x4a, 0xa4, 0x35, 0x70, 0x5c, 0xbb, 0x4b, 0xc7, 0x70,
+		0x7f, 0x32, 0xff, 0xe6, 0x8f, 0xd8, 0xe2, 0x57, 0x40, 0xb4, 0x65, 0x0f, 0x6b, 0x69, 0x09, 0xe8,
+		0xce, 0x12, 0x43, 0x9e, 0x27, 0x50, 0xf8, 0x4d, 0xde, 0x10, 0x0f, 0x7e, 0xe9, 0x1a, 0xcc, 0xe3,
+		0x6f, 0xea, 0x09, 0x08, 0xb6, 0x64, 0x72, 0x28, 0x59, 0xe9, 0xf7, 0xbe, 0x9d, 0xad, 0xaf, 0x73,
+		0x1e, 0x81, 0x40, 0x9b, 0x02, 0xa3, 0xb8, 0x63, 0xb8, 0xae, 0xd1, 0x77, 0x34, 0xbd, 0x13, 0xd5,
+		0xbc, 0x40, 0x2c, 0x4e, 0xe9, 0x07, 0xbe, 0x18, 0x1e, 0xc5, 0x15, 0x86, 0x59, 0xee, 0x74, 0x96,
+		0x36, 0xe1, 0x68, 0x84, 0x54, 0x4c, 0x41, 0xf3, 0x07, 0x39, 0xcd, 0xf9, 0x11, 0xc9, 0x40, 0xb2,
+		0x1b, 0x20, 0xf2, 0xbd, 0xb1, 0x9c, 0x82, 0xe6, 0x47, 0x39, 0x4d, 0xc2, 0x71, 0xc5, 0x90, 0x22,
+		0xc5, 0x2b, 0x30, 0x7b, 0xc3, 0xe8, 0x6f, 0xd9, 0x0e, 0x8f, 0x7f, 0x9a, 0x82, 0xdc, 0x0f, 0x71,
+		0x72, 0x45, 0x8e, 0x48, 0x03, 0xa2, 0x90, 0xd6, 0x45, 0x48, 0x6f, 0xeb, 0x2d, 0x63, 0x0a, 0x12,
+		0xb7, 0x39, 0x89, 0x14, 0xc2, 0x23, 0x6a, 0x19, 0x72, 0x3b, 0x36, 0x77, 0x06, 0x4d, 0x46, 0xff,
+		0x18, 0x47, 0xcf, 0x0a, 0x1c, 0x4e, 0xa2, 0x67, 0xf7, 0x06, 0x1d, 0xf4, 0x14, 0x4d, 0x26, 0xf1,
+		0xc3, 0x82, 0x84, 0xc0, 0xe1, 0x24, 0x0e, 0xc0, 0xd6, 0x8f, 0x0b, 0x12, 0x4e, 0x80, 0x9f, 0xcf,
+		0x60, 0x58, 0x74, 0x67, 0xcf, 0xb6, 0xa6, 0x69, 0xc4, 0x8f, 0x70, 0x0a, 0xc0, 0x51, 0x90, 0xc0,
+		0xd3, 0x90, 0x99, 0x76, 0x20, 0x7e, 0xfc, 0x8b, 0x62, 0x7a, 0x88, 0x11, 0x58, 0x81, 0xa2, 0x50,
+		0x50, 0x78, 0xa8, 0x38, 0x99, 0xc4, 0x4f, 0x70, 0x12, 0x85, 0x00, 0x1a, 0xef, 0x86, 0x6b, 0x38,
+		0xee, 0x8e, 0x31, 0x0d, 0x91, 0x4f, 0x88, 0x6e, 0x70, 0x14, 0xce, 0xca, 0x2d, 0xc3, 0x6a, 0xed,
+		0x4e, 0x47, 0xe1, 0x93, 0x82, 0x95, 0x02, 0x07, 0x49, 0x2c, 0x43, 0xbe, 0xab, 0xf7, 0x9d, 0x5d,
+		0xbd, 0x33, 0xd5, 0x70, 0xfc, 0x24, 0xa7, 0x91, 0xf3, 0x90, 0x38, 0x47, 0x06, 0xd6, 0x41, 0xc8,
+		0xfc, 0x94, 0xe0, 0xc8, 0xc0, 0x0a, 0x11, 0xda, 0x80, 0x79, 0xc7, 0xa5, 0xfb, 0xa2, 0x83, 0x50,
+		0xfb, 0x69, 0x31, 0xf5, 0x18, 0xee, 0xd5, 0x20, 0xc5, 0xa7, 0x21, 0xe3, 0x98, 0xef, 0x99, 0x8a,
+		0xcc, 0xcf, 0x88, 0x91, 0xa6, 0x08, 0x88, 0xfc, 0x02, 0x1c, 0x8b, 0x5c, 0x26, 0xa6, 0x20, 0xf6,
+		0xb3, 0x9c, 0xd8, 0x91, 0x88, 0xa5, 0x82, 0xab, 0x84, 0x83, 0x92, 0xfc, 0x39, 0xa1, 0x12, 0x8c,
+		0x21, 0x5a, 0x1b, 0xe8, 0x9e, 0x77, 0xf4, 0xed, 0x83, 0x71, 0xed, 0x1f, 0x09, 0xae, 0x31, 0xdc,
+		0x10, 0xd7, 0x9a, 0x70, 0x84, 0x53, 0x3c, 0xd8, 0xb8, 0xfe, 0xbc, 0x50, 0xac, 0x0c, 0x7b, 0x33,
+		0x3c, 0xba, 0xdf, 0x08, 0x0b, 0x1e, 0x3b, 0x85, 0x1f, 0xd8, 0xd1, 0x30, 0x8a, 0x6a, 0x32, 0xe5,
+		0x5f, 0xe0, 0x94, 0x85, 0xc6, 0xf7, 0x1c, 0xc9, 0xce, 0x55, 0xbd, 0x87, 0xc4, 0x9f, 0x87, 0x92,
+		0x20, 0x3e, 0xb0, 0xfa, 0x46, 0xcb, 0xde, 0xb1, 0xcc, 0xf7, 0x18, 0xed, 0x29, 0x48, 0xff, 0xe2,
+		0xd0, 0x50, 0x6d, 0x06, 0xd0, 0x91, 0x72, 0x1d, 0x64, 0xcf, 0x56, 0xd1, 0xcc, 0x2e, 0x3d, 0x33,
+		0xdb, 0x9f, 0xe2, 0x2f, 0x89, 0x91, 0xf2, 0xf0, 0xea, 0x14, 0x6d, 0xa9, 0x06, 0xec, 0x06, 0xc5,
+		0xb4, 0x22, 0xf9, 0x29, 0x4e, 0x28, 0xef, 0x63, 0x71, 0xc5, 0xd1, 0xb2, 0xbb, 0x3d, 0xbd, 0x3f,
+		0x8d, 0xfe, 0xfb, 0x65, 0xa1, 0x38, 0x38, 0x0a, 0x57, 0x1c, 0x68, 0xaf, 0xe1, 0x6a, 0x3f, 0x05,
+		0x85, 0x5f, 0x11, 0x8a, 0x43, 0xe0, 0x70, 0x12, 0xc2, 0x60, 0x98, 0x82, 0xc4, 0x3f, 0x16, 0x24,
+		0x04, 0x0e, 0x92, 0x78, 0x87, 0xbf, 0xd0, 0xf6, 0x8d, 0x1d, 0xd3, 0x71, 0xf9, 0x1d, 0xa7, 0xfd,
+		0x49, 0xfd, 0xea, 0x17, 0xc3, 0x46, 0x98, 0x1a, 0x40, 0x45, 0x4d, 0xc4, 0x2d, 0x7b, 0x7a, 0x38,
+		0x31, 0xb9, 0x61, 0x9f, 0x16, 0x9a, 0x28, 0x80, 0x86, 0x6d, 0x0b, 0x58, 0x88, 0xc8, 0xf6, 0x16,
+		0x6e, 0xf8, 0xa7, 0x20, 0xf7, 0x6b, 0x43, 0x8d, 0x6b, 0x08, 0x5c, 0xa4, 0x19, 0xb0, 0x7f, 0x06,
+		0xd6, 0x75, 0x63, 0x6f, 0x2a, 0xe9, 0xfc, 0x27, 0x43, 0xf6, 0xcf, 0x26, 0xc3, 0x64, 0x3a, 0xa4,
+		0x38, 0x64, 0x4f, 0x4d, 0xde, 0x01, 0x7d, 0xeb, 0x97, 0x79, 0x7f, 0xc3, 0xe6, 0xd4, 0xd2, 0x2a,
+		0xc8, 0x3c, 0xc7, 0x37, 0x60, 0x27, 0x12, 0xfb, 0xf6, 0x2f, 0x7b, 0x72, 0x1e, 0xb2, 0x79, 0x96,
+		0x2e, 0x41, 0x3e, 0x64, 0xf0, 0x4c, 0x26, 0xf5, 0x3e, 0x4e, 0x2a, 0x17, 0xb4, 0x77, 0x96, 0x9e,
+		0x80, 0x04, 0x1a, 0x2f, 0x93, 0xd1, 0xdf, 0xcf, 0xd1, 0x29, 0xf8, 0xd2, 0xdb, 0x20, 0x2d, 0x8c,
+		0x96, 0xc9, 0xa8, 0xdf, 0xc1, 0x51, 0x3d, 0x14, 0x44, 0x17, 0x06, 0xcb, 0x64, 0xf4, 0xff, 0x5f,
+		0xa0, 0x0b, 0x14, 0x44, 0x9f, 0x9e, 0x85, 0xff, 0xe2, 0x83, 0x09, 0x86, 0x2e, 0x50, 0x96, 0xf0,
+		0x06, 0x07, 0xb3, 0x54, 0x26, 0x63, 0x7f, 0x27, 0xaf, 0x5c, 0x60, 0x2c, 0x3d, 0x09, 0x33, 0x53,
+		0x32, 0xfc, 0x43, 0x1c, 0x95, 0xc1, 0x2f, 0x2d, 0x43, 0x36, 0x60, 0x9d, 0x4c, 0x46, 0xff, 0x6e,
+		0x8e, 0x1e, 0xc4, 0xc2, 0xa6, 0x73, 0xeb, 0x64, 0x32, 0x81, 0xbf, 0x27, 0x9a, 0xce, 0x31, 0x90,
+		0x6d, 0xc2, 0x30, 0x99, 0x8c, 0xfd, 0x61, 0xc1, 0x75, 0x81, 0xb2, 0xf4, 0x0c, 0x64, 0xbc, 0xc5,
+		0x66, 0x32, 0xfe, 0xf7, 0x70, 0x7c, 0x1f, 0x07, 0x39, 0x30, 0xb0, 0x0e, 0x40, 0xe2, 0xef, 0x0b,
+		0x0e, 0x04, 0xb0, 0x70, 0x1a, 0x0d, 0x1b, 0x30, 0x93, 0x29, 0x7d, 0xaf, 0x98, 0x46, 0x43, 0xf6,
+		0x0b, 0x8e, 0x26, 0xd5, 0xf9, 0x93, 0x49, 0x7c, 0x44, 0x8c, 0x26, 0x85, 0xc7, 0x66, 0x0c, 0x5b,
+		0x04, 0x93, 0x69, 0x7c, 0xbf, 0x68, 0xc6, 0x90, 0x41, 0xb0, 0xb4, 0x01, 0x64, 0xd4, 0x1a, 0x98,
+		0x4c, 0xef, 0x15, 0x4e, 0x6f, 0x76, 0xc4, 0x18, 0x58, 0x7a, 0x0e, 0x8e, 0x44, 0x5b, 0x02, 0x93,
+		0xa9, 0xfe, 0xc0, 0x97, 0x87, 0xf6, 0x6e, 0x41, 0x43, 0x60, 0xa9, 0x09, 0xf3, 0x51, 0x56, 0xc0,
+		0x64, 0xb2, 0x3f, 0xf8, 0xe5, 0xb0, 0xe2, 0x0e, 0x1a, 0x01, 0x4b, 0x65, 0x00, 0x7f, 0x01, 0x9e,
+		0x4c, 0xeb, 0x87, 0x38, 0xad, 0x00, 0x12, 0x4e, 0x0d, 0xbe, 0xfe, 0x4e, 0xc6, 0xbf, 0x2d, 0xa6,
+		0x06, 0xc7, 0xc0, 0xa9, 0x21, 0x96, 0xde, 0xc9, 0xd8, 0x1f, 0x13, 0x53, 0x43, 0xa0, 0xa0, 0x64,
+		0x07, 0x56, 0xb7, 0xc9, 0x14, 0x7e, 0x44, 0x48, 0x76, 0x00, 0x6b, 0x69, 0x0d, 0x66, 0x47, 0x16,
+		0xc4, 0xc9, 0xa4, 0x7e, 0x94, 0x93, 0x92, 0x87, 0xd7, 0xc3, 0xe0, 0xe2, 0xc5, 0x17, 0xc3, 0xc9,
+		0xd4, 0x7e, 0x6c, 0x68, 0xf1, 0xe2, 0x6b, 0xe1, 0xd2, 0xd3, 0x90, 0xb6, 0x06, 0x9d, 0x0e, 0x4e,
+		0x9e, 0x49, 0x2e, 0xaf, 0x3f, 0xf9, 0x2a, 0xe7, 0x8e, 0x40, 0x58, 0x7a, 0x02, 0x66, 0x8c, 0xee,
+		0x96, 0xd1, 0x9e, 0x84, 0xf9, 0x85, 0xaf, 0x0a, 0x85, 0x89, 0xd0, 0xe8, 0x68, 0x63, 0xae, 0x11,
+		0x1a, 0x27, 0x3e, 0x01, 0xf7, 0x4f, 0xbf, 0xca, 0x2f, 0x95, 0xf9, 0x28, 0x3e, 0x81, 0x69, 0x3c,
+		0x75, 0x5f, 0x0c, 0x13, 0xa0, 0x23, 0x72, 0x11, 0x52, 0x78, 0x3c, 0xec, 0xea, 0x13, 0x3d, 0x96,
+		0x7f, 0xc6, 0xb1, 0x05, 0x3c, 0x32, 0xac, 0x6b, 0xf7, 0x0d, 0x57, 0xdf, 0x71, 0x26, 0xe1, 0xfe,
+		0x39, 0xc7, 0xf5, 0x10, 0x10, 0xb9, 0xa5, 0x3b, 0xee, 0x34, 0xfd, 0xfe, 0x0b, 0x81, 0x2c, 0x10,
+		0xb0, 0xd1, 0xf8, 0xfb, 0xba, 0x31, 0xd1, 0xc3, 0xf9, 0x97, 0xa2, 0xd1, 0x1c, 0x7e, 0xe9, 0x6d,
+		0x90, 0xc1, 0x9f, 0xec, 0xa6, 0xe8, 0x04, 0xe4, 0xbf, 0xe2, 0xc8, 0x3e, 0x06, 0xd6, 0xec, 0xb8,
+		0x6d, 0xd7, 0x9c, 0xcc, 0xec, 0x2f, 0xf1, 0x91, 0x16, 0xf0, 0x4b, 0x65, 0xc8, 0x3a, 0x6e, 0xbb,
+		0x3d, 0xe0, 0xf6, 0xe9, 0x24, 0xff, 0xf0, 0x57, 0x3d, 0x97, 0x85, 0x87, 0x83, 0xa3, 0x7d, 0xf3,
+		0xba, 0xdb, 0xb3, 0x69, 0x64, 0xd1, 0x44, 0x0f, 0x31, 0xa7, 0x10, 0x40, 0x59, 0x5a, 0x86, 0x1c,
+		0xf6, 0x45, 0x04, 0x68, 0x4c, 0xf4, 0x0f, 0x73, 0x06, 0x84, 0x90, 0x2a, 0xef, 0x1a, 0xe7, 0xdc,
+		0x8d, 0x3e, 0x43, 0x80, 0x15, 0x7b, 0xc5, 0x66, 0xa7, 0x07, 0x2f, 0xde, 0x3f, 0xea, 0xfd, 0x0d,
+		0xfb, 0x75, 0xe9, 0x2f, 0xf8, 0xc9, 0x04, 0x9c, 0x68, 0xd9, 0x5d, 0xc3, 0xdd, 0xda, 0x76, 0xcf,
+		0xb6, 0xfa, 0x7b, 0x3d, 0xd7, 0x3e, 0x7b, 0xe3, 0x1c, 0xba, 0x76, 0xed, 0x6d, 0x46, 0x98, 0x10,
+		0x51, 0xbe, 0xc8, 0xca, 0x17, 0x6f, 0x9c, 0x5b, 0x88, 0xf4, 0x11, 0x2b, 0xdb, 0x30, 0xb3, 0x81,
+		0x88, 0x78, 0x66, 0xe5, 0xda, 0xae, 0xde, 0xa1, 0x31, 0x41, 0x71, 0x95, 0x25, 0x30, 0x97, 0xdd,
+		0x8b, 0x8f, 0xb1, 0x5c, 0x53, 0x5c, 0x89, 0xef, 0x18, 0xfa, 0x36, 0xbb, 0x5e, 0x18, 0xa7, 0xd1,
+		0x4e, 0x69, 0xcc, 0xa0, 0x37, 0x09, 0xe7, 0x61, 0x46, 0x1f, 0xb0, 0x63, 0xc0, 0xf8, 0xe9, 0x9c,
+		0xca, 0x12, 0xca, 0x2a, 0xa4, 0xb8, 0x17, 0x13, 0xcf, 0xc1, 0xae, 0x1b, 0x7b, 0xb4, 0x9e, 0x9c,
+		0x8a, 0x3f, 0xc9, 0x59, 0x98, 0xa1, 0xad, 0xe7, 0x51, 0x9d, 0xc7, 0x16, 0x47, 0x9b, 0xbf, 0x48,
+		0x5b, 0xa9, 0x32, 0x38, 0xe5, 0x0a, 0xa4, 0xab, 0x36, 0x3a, 0xc1, 0xc3, 0xe4, 0x32, 0x8c, 0x1c,
+		0x6d, 0x74, 0x6f, 0xe0, 0x8a, 0xd0, 0x7b, 0x9a, 0xc0, 0x8b, 0x2c, 0xec, 0xbe, 0x29, 0x3f, 0xcb,
+		0xe4, 0x29, 0x65, 0x19, 0x52, 0x94, 0xf6, 0x7a, 0xcf, 0x7b, 0xc4, 0x41, 0x0a, 0x3c, 0xe2, 0xc0,
+		0xc9, 0xc7, 0xfc, 0xd6, 0x12, 0x48, 0xe0, 0x65, 0x34, 0xde, 0x71, 0xfa, 0x5b, 0x79, 0x06, 0xd2,
+		0x9c, 0x88, 0x43, 0x1e, 0x83, 0xb8, 0xdd, 0x13, 0x47, 0xf5, 0xc7, 0xc7, 0xf6, 0x65, 0xbd, 0x57,
+		0x49, 0xfc, 0xe6, 0xab, 0x27, 0x0f, 0xa9, 0x08, 0x5d, 0xb9, 0x3a, 0xf6, 0x4c, 0xe0, 0xb1, 0x90,
+		0x54, 0x88, 0x61, 0x17, 0x3f, 0xf4, 0x9e, 0x79, 0x76, 0x54, 0x18, 0xbc, 0xb3, 0x81, 0xef, 0x8d,
+		0xc1, 0x89, 0x11, 0xd7, 0x3f, 0x9f, 0x30, 0xe3, 0x1e, 0xab, 0x5a, 0x82, 0x74, 0x55, 0xcc, 0xc3,
+		0x12, 0xbe, 0x92, 0xd4, 0xb2, 0xad, 0xb6, 0xc3, 0xe5, 0x42, 0x24, 0x91, 0xc9, 0x96, 0x6e, 0xd9,
+		0x0e, 0xbf, 0x1c, 0xcd, 0x12, 0x95, 0x8f, 0x4a, 0x07, 0x13, 0xff, 0xbc, 0xa8, 0x89, 0x4e, 0x81,
+		0x0d, 0xe9, 0xc5, 0x73, 0x13, 0xcf, 0xcf, 0xae, 0x5b, 0xf6, 0x4d, 0xcb, 0xeb, 0x44, 0xe8, 0x0c,
+		0xed, 0xc4, 0xf0, 0x19, 0xda, 0x73, 0x46, 0xa7, 0xf3, 0x2c, 0xc2, 0x63, 0xd0, 0x8d, 0xe3, 0x71,
+		0xe5, 0x63, 0x89, 0x51, 0xae, 0xdc, 0xec, 0xeb, 0xbd, 0x9e, 0xd1, 0x77, 0xc6, 0x71, 0xe5, 0x5e,
+		0xc8, 0x56, 0x03, 0x31, 0x7f, 0xde, 0xf5, 0x0e, 0x89, 0xc6, 0x03, 0xb2, 0x84, 0xa2, 0x00, 0x5c,
+		0xea, 0xd8, 0xba, 0x1b, 0x01, 0x13, 0x0b, 0xc0, 0xd4, 0x2d, 0xf7, 0xc2, 0xe3, 0x11, 0x30, 0x71,
+		0x01, 0x73, 0x2f, 0x64, 0x37, 0xc7, 0x01, 0x25, 0xc2, 0x84, 0x1e, 0x3b, 0x1f, 0x01, 0x33, 0x33,
+		0x44, 0x28, 0x12, 0x28, 0x2f, 0x80, 0xee, 0x81, 0x4c, 0xc5, 0xb6, 0x3b, 0x11, 0x20, 0xe9, 0x00,
+		0x9d, 0x46, 0x20, 0x9c, 0x31, 0x04, 0x94, 0x09, 0x34, 0xa8, 0xb2, 0xe7, 0x1a, 0x4e, 0x04, 0x4c,
+		0x8e, 0xc3, 0x1c, 0x5c, 0x40, 0x9e, 0xe3, 0xe3, 0x72, 0x50, 0x01, 0x11, 0xe3, 0x79, 0x47, 0x02,
+		0xf2, 0xfd, 0xb9, 0x80, 0x8a, 0x65, 0x54, 0x51, 0xc3, 0xea, 0x7d, 0xbd, 0x2b, 0x04, 0x64, 0xd6,
+		0x9b, 0xd7, 0xb4, 0x7c, 0xac, 0x86, 0x5d, 0x98, 0x30, 0xff, 0x16, 0x26, 0x48, 0xa2, 0xf2, 0xe9,
+		0x38, 0x14, 0x97, 0x6d, 0xcb, 0x31, 0x2c, 0x67, 0xe0, 0x6c, 0xd0, 0x26, 0x90, 0xc7, 0x61, 0x66,
+		0xab, 0x63, 0xb7, 0xae, 0xf3, 0x4b, 0x13, 0x27, 0x16, 0x47, 0x1a, 0xb3, 0x58, 0xc1, 0x72, 0x06,
+		0xae, 0x32, 0x60, 0xf2, 0x36, 0x48, 0x1b, 0x37, 0x30, 0x76, 0xb6, 0x65, 0x70, 0x4d, 0x7b, 0x4f,
+		0x04, 0x62, 0x8d, 0x83, 0x70, 0x5c, 0x0f, 0x85, 0x7c, 0x03, 0x64, 0x78, 0x74, 0xa2, 0xdd, 0xe7,
+		0xaf, 0x09, 0x28, 0x11, 0xf8, 0xd7, 0x04, 0x0c, 0x27, 0xe0, 0x23, 0x91, 0x25, 0x48, 0xdd, 0x30,
+		0xfa, 0x8e, 0x88, 0x33, 0xc2, 0xe7, 0xb6, 0x22, 0xf0, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe4, 0x09,
+		0x48, 0xe8, 0x5b, 0x2d, 0x93, 0x87, 0xde, 0xdc, 0x1d, 0x81, 0x58, 0xae, 0x2c, 0xd7, 0x19, 0x16,
+		0xbd, 0x31, 0x41, 0xc1, 0xb1, 0xd1, 0xce, 0x9e, 0xd5, 0xda, 0xed, 0xdb, 0xd6, 0x5e, 0x29, 0x39,
+		0xb6, 0xd1, 0x0d, 0x01, 0x23, 0x1a, 0xed, 0x21, 0x61, 0xa3, 0x79, 0xbc, 0x4e, 0x29, 0x35, 0xb6,
+		0xd1, 0x3c, 0xce, 0x47, 0x34, 0x9a, 0x23, 0x28, 0x75, 0xc8, 0x06, 0xc6, 0x81, 0x5d, 0x68, 0xbd,
+		0xa5, 0x6d, 0xe1, 0x24, 0xe1, 0x13, 0x3e, 0xdd, 0xd5, 0x6f, 0xd1, 0x49, 0x43, 0x8e, 0x42, 0x0a,
+		0x0b, 0x77, 0xf8, 0xcd, 0x92, 0xb8, 0x9a, 0xec, 0xea, 0xb7, 0x56, 0x74, 0x0c, 0x1d, 0x8a, 0xcb,
+		0x09, 0xe5, 0x27, 0x25, 0x28, 0x84, 0x87, 0x86, 0x3c, 0x0c, 0x04, 0x31, 0xf0, 0x7c, 0x1e, 0xfd,
+		0xdc, 0x74, 0x90, 0x05, 0x5d, 0x8c, 0xca, 0x2a, 0xef, 0x18, 0x6b, 0x83, 0x2e, 0x6d, 0x80, 0x43,
+		0xf0, 0x30, 0x9f, 0x03, 0x0b, 0x01, 0xf4, 0x96, 0xdb, 0x91, 0xf7, 0xb8, 0x38, 0x40, 0x25, 0x8d,
+		0x0b, 0xd4, 0x2b, 0xff, 0xe5, 0xa4, 0x44, 0x83, 0xb6, 0xca, 0x3b, 0x86, 0x28, 0x09, 0x77, 0x25,
+		0x1e, 0xee, 0x8a, 0xf2, 0x0c, 0x14, 0x87, 0xa4, 0x80, 0x28, 0x90, 0xef, 0x0d, 0xb6, 0xb4, 0xeb,
+		0xc6, 0x1e, 0x8d, 0xf5, 0x65, 0xcb, 0x63, 0x46, 0xcd, 0xf6, 0x06, 0x5b, 0xcf, 0x1a, 0x7b, 0x74,
+		0xf6, 0x2d, 0xa5, 0x7f, 0xf5, 0xe3, 0x27, 0xa5, 0xd7, 0x3e, 0x7e, 0x52, 0x52, 0x1e, 0x86, 0x7c,
+		0x48, 0x0c, 0x70, 0x15, 0xd6, 0x7b, 0x3d, 0xae, 0xff, 0xf0, 0x67, 0x00, 0xf8, 0x45, 0xc8, 0xa1,
+		0xe1, 0x61, 0xb4, 0x39, 0xec, 0x03, 0x50, 0xa4, 0xac, 0xd0, 0x86, 0x79, 0x9d, 0xa7, 0xd9, 0x57,
+		0x05, 0xc3, 0x15, 0xc8, 0xfb, 0x70, 0x3e, 0xdb, 0xb3, 0x02, 0x6a, 0x45, 0x77, 0x94, 0xef, 0x93,
+		0xa0, 0x38, 0x24, 0x1b, 0xe4, 0x6d, 0x90, 0xc1, 0x2b, 0x06, 0xa6, 0xc3, 0x62, 0x8e, 0x26, 0xb0,
+		0x30, 0x41, 0xd9, 0xe7, 0x63, 0x90, 0x2a, 0xe4, 0x45, 0x54, 0x45, 0xdb, 0xe8, 0xe8, 0x7b, 0xa5,
+		0xd8, 0x74, 0x24, 0xc4, 0xb3, 0x89, 0x55, 0x44, 0x52, 0x7e, 0x43, 0x82, 0x7c, 0x48, 0xe8, 0x48,
+		0x1b, 0xee, 0xbe, 0x61, 0xbb, 0x46, 0x70, 0xb7, 0xcf, 0x9f, 0x13, 0xd8, 0x35, 0xcc, 0x9d, 0x5d,
+		0x77, 0xec, 0x05, 0x2b, 0x7f, 0xa1, 0xa1, 0x06, 0x89, 0xa4, 0x2e, 0x20, 0x1d, 0x7f, 0xd3, 0xcf,
+		0xde, 0x1d, 0xb8, 0x4c, 0x89, 0x90, 0x75, 0x20, 0xbd, 0x2d, 0x77, 0x98, 0x74, 0x6c, 0x5a, 0xd2,
+		0x32, 0x22, 0x07, 0x09, 0x2a, 0x0d, 0x00, 0x7f, 0xe2, 0x92, 0xf2, 0x34, 0x9d, 0x88, 0xef, 0xd7,
+		0xc2, 0xa5, 0x58, 0x49, 0xaa, 0x6c, 0x7c, 0xf2, 0xb3, 0x27, 0xc6, 0x2e, 0x34, 0x2f, 0x9e, 0x9f,
+		0xde, 0xa2, 0x12, 0xba, 0xdf, 0x5b, 0x19, 0x5e, 0x89, 0xc1, 0xdd, 0x11, 0xc6, 0xf7, 0x75, 0x63,
+		0xcf, 0x39, 0xb0, 0xed, 0x7d, 0x03, 0x32, 0x1b, 0xf4, 0x1d, 0xcd, 0x67, 0x8d, 0x3d, 0xb2, 0x80,
+		0x81, 0x8e, 0xe7, 0x9f, 0x78, 0xe2, 0xdc, 0x45, 0xb6, 0x60, 0x5e, 0x3e, 0xa4, 0x8a, 0x0c, 0x72,
+		0x02, 0x32, 0x8e, 0xd1, 0xea, 0x9d, 0x7f, 0xe2, 0xc2, 0xf5, 0x73, 0xcc, 0x12, 0xbd, 0x7c, 0x48,
+		0xf5, 0xb3, 0xc8, 0x5d, 0x90, 0xde, 0xea, 0x38, 0xe7, 0xce, 0x3f, 0xf6, 0xd4, 0x39, 0x66, 0x95,
+		0x5e, 0x3e, 0xa4, 0x7a, 0x39, 0x4b, 0x69, 0x9c, 0x25, 0xaf, 0xfd, 0xc8, 0x49, 0xa9, 0x32, 0x03,
+		0x71, 0x67, 0xd0, 0xfd, 0x7a, 0xd9, 0x9a, 0xbf, 0x96, 0x82, 0x7b, 0x46, 0x17, 0x4d, 0x4f, 0xfb,
+		0x8f, 0x5f, 0x37, 0xf7, 0x67, 0xe8, 0x18, 0xe6, 0x7d, 0x4a, 0x82, 0x9c, 0xa7, 0x64, 0xf0, 0x7a,
+		0xc3, 0x5b, 0x01, 0xbc, 0xba, 0x84, 0xf5, 0x7d, 0xd7, 0x7e, 0xeb, 0x93, 0x1a, 0x80, 0x27, 0x4f,
+		0x41, 0xba, 0xd7, 0xb7, 0x7b, 0xb6, 0xc3, 0x9f, 0xfb, 0x99, 0x84, 0xeb, 0x41, 0xe3, 0x75, 0x12,
+		0xba, 0x57, 0xd2, 0x6e, 0xd8, 0x2e, 0x1e, 0xfb, 0xf7, 0xec, 0x9b, 0xfc, 0x15, 0xb5, 0xb8, 0x2a,
+		0xd3, 0x92, 0x6b, 0xb4, 0x60, 0x03, 0xf3, 0x95, 0xff, 0x29, 0x41, 0xc6, 0xa3, 0x82, 0xe6, 0xb5,
+		0xde, 0x6e, 0xf7, 0x0d, 0xc7, 0xe1, 0x56, 0x92, 0x48, 0xe2, 0xaa, 0xc3, 0xf5, 0xa5, 0xf7, 0x98,
+		0x54, 0xd4, 0x46, 0x42, 0x88, 0x0f, 0x5d, 0xf1, 0x92, 0x4c, 0x99, 0xe2, 0xd5, 0x90, 0x88, 0xb6,
+		0x64, 0x6f, 0xf8, 0xcd, 0xa0, 0x6f, 0xbb, 0xf2, 0x0e, 0x68, 0xbd, 0xbe, 0x69, 0xf7, 0x4d, 0x77,
+		0x8f, 0xae, 0xc9, 0x71, 0x55, 0x16, 0x05, 0x1b, 0x3c, 0x3f, 0xa8, 0xbb, 0x99, 0x3a, 0x9d, 0x61,
+		0x17, 0x5c, 0x58, 0x75, 0x4c, 0x99, 0x9e, 0x82, 0x5c, 0x50, 0xbf, 0xf3, 0x77, 0xda, 0xc0, 0x57,
+		0xef, 0x4a, 0x07, 0x8a, 0x0d, 0xb3, 0xdb, 0xeb, 0x18, 0x7e, 0xf7, 0x2f, 0xf8, 0x9d, 0x94, 0xa6,
+		0xe8, 0xe4, 0xd8, 0x0e, 0xc6, 0x46, 0x3a, 0x78, 0xe6, 0x3f, 0x49, 0x7c, 0xe9, 0xad, 0x57, 0x2f,
+		0x75, 0xf4, 0x1d, 0x72, 0x0e, 0x0e, 0x57, 0x56, 0xd7, 0x97, 0x9f, 0xd5, 0xea, 0x55, 0xed, 0xd2,
+		0x6a, 0x39, 0x10, 0x8a, 0xbe, 0x70, 0xe4, 0xe5, 0xdb, 0xa7, 0x48, 0x00, 0x76, 0xd3, 0xa2, 0x16,
+		0x24, 0x39, 0x0b, 0xf3, 0x61, 0x94, 0x72, 0xa5, 0x81, 0x17, 0xa0, 0xa4, 0x85, 0xc3, 0x2f, 0xdf,
+		0x3e, 0x35, 0x1b, 0xc0, 0x28, 0x6f, 0x39, 0x86, 0xe5, 0x8e, 0x22, 0x2c, 0xaf, 0x5f, 0xbd, 0x8a,
+		0xf7, 0x01, 0x46, 0x10, 0x30, 0xac, 0xd5, 0x74, 0xc9, 0x43, 0x30, 0x1b, 0x46, 0x58, 0xab, 0xaf,
+		0xca, 0xf1, 0x05, 0xf2, 0xf2, 0xed, 0x53, 0x85, 0x00, 0xf4, 0x9a, 0xd9, 0x59, 0x48, 0x7f, 0xe0,
+		0xc7, 0x4e, 0x1c, 0xfa, 0xe4, 0x3f, 0x3c, 0x21, 0x55, 0x56, 0xbf, 0x2e, 0x7a, 0xed, 0xfb, 0x63,
+		0x70, 0x72, 0xd8, 0x10, 0x45, 0xc7, 0x8c, 0xe3, 0xea, 0xdd, 0xde, 0xb8, 0x3d, 0xd1, 0xd3, 0x90,
+		0x69, 0x0a, 0x98, 0x03, 0x6f, 0x15, 0x6f, 0x1f, 0x70, 0x27, 0x50, 0xf0, 0xaa, 0x12, 0x5b, 0x81,
+		0xf3, 0x53, 0x6e, 0x05, 0xbc, 0x7e, 0xdc, 0xd1, 0x5e, 0xe0, 0x2b, 0x0d, 0xb8, 0xcb, 0x67, 0xe2,
+		0x56, 0xcb, 0x44, 0xf5, 0xc4, 0x34, 0x02, 0x63, 0x8b, 0x2c, 0x4a, 0x17, 0xb1, 0x14, 0x15, 0xda,
+		0x04, 0xf7, 0xcc, 0xc2, 0x84, 0xbd, 0xc5, 0xc2, 0x64, 0x35, 0x3a, 0x66, 0xaf, 0x31, 0x69, 0x08,
+		0x95, 0x7f, 0x9f, 0x81, 0x94, 0x6a, 0xbc, 0x7b, 0x60, 0x38, 0x2e, 0x79, 0x0c, 0x12, 0x46, 0x6b,
+		0xd7, 0x1e, 0x9d, 0x7a, 0xbc, 0x1b, 0x8b, 0xb5, 0xd6, 0xae, 0xcd, 0x81, 0x2f, 0x1f, 0x52, 0x29,
+		0x30, 0xb9, 0x00, 0x33, 0xdb, 0x9d, 0x81, 0xb3, 0x5b, 0x8a, 0x0d, 0xef, 0x3c, 0x04, 0xd6, 0x25,
+		0x2c, 0xf6, 0xd1, 0x18, 0x38, 0x56, 0x46, 0x9f, 0x28, 0x8e, 0x8f, 0xab, 0x8c, 0xbe, 0x4c, 0xec,
+		0x57, 0x86, 0xc0, 0x64, 0x19, 0xc0, 0xb4, 0x4c, 0x57, 0x6b, 0xed, 0xea, 0xa6, 0x55, 0x9a, 0x19,
+		0xb6, 0xde, 0x7d, 0x54, 0xd3, 0x5d, 0x46, 0x10, 0x1f, 0x3f, 0x63, 0x8a, 0x3c, 0x6c, 0xf1, 0xbb,
+		0x07, 0x46, 0x5f, 0x58, 0xff, 0x11, 0x2d, 0x7e, 0x07, 0x16, 0x07, 0x5a, 0x4c, 0xc1, 0x71, 0xb7,
+		0xc4, 0x5e, 0xc1, 0x72, 0x6f, 0x95, 0xd2, 0xc3, 0x86, 0xbf, 0x40, 0xa5, 0x8f, 0x60, 0x35, 0x6f,
+		0xf9, 0xc8, 0xa9, 0x16, 0xcb, 0x21, 0x17, 0x21, 0xd9, 0xa2, 0xb3, 0xbc, 0x94, 0xe5, 0x0f, 0xaf,
+		0x8d, 0x22, 0xd3, 0x72, 0x1f, 0x97, 0x23, 0x90, 0x75, 0x28, 0x74, 0x4c, 0xc7, 0xd5, 0x1c, 0x4b,
+		0xef, 0x39, 0xbb, 0xb6, 0x2b, 0x1e, 0xa2, 0x79, 0x60, 0x94, 0xc4, 0xaa, 0xe9, 0xb8, 0x0d, 0x01,
+		0xe6, 0x53, 0xca, 0x77, 0x82, 0xf9, 0x48, 0xd0, 0xde, 0xde, 0x36, 0xfa, 0x1e, 0xc5, 0x52, 0x7e,
+		0x1c, 0xc1, 0x75, 0x84, 0x13, 0x98, 0x01, 0x82, 0x76, 0x30, 0x9f, 0x7c, 0x13, 0xcc, 0x75, 0x6c,
+		0xbd, 0xed, 0xd1, 0xd3, 0x5a, 0xbb, 0x03, 0xeb, 0x3a, 0x7d, 0x25, 0x3a, 0x7b, 0xfe, 0x4c, 0x44,
+		0x33, 0x6d, 0xbd, 0x2d, 0x90, 0x97, 0x11, 0xd4, 0xa7, 0x3c, 0xdb, 0x19, 0x2e, 0x23, 0x1a, 0xcc,
+		0xeb, 0xbd, 0x5e, 0x67, 0x6f, 0x98, 0x7c, 0x91, 0x92, 0x7f, 0x78, 0x94, 0x7c, 0x19, 0xa1, 0xc7,
+		0xd0, 0x27, 0xfa, 0x48, 0x21, 0xd9, 0x04, 0xb9, 0xd7, 0x37, 0x68, 0x70, 0x07, 0x5b, 0xec, 0xf4,
+		0x0e, 0x7d, 0xe9, 0x05, 0xef, 0x5a, 0x8c, 0x10, 0xdf, 0x60, 0x90, 0x1b, 0x1c, 0xd0, 0xa7, 0x5c,
+		0xec, 0x85, 0x4b, 0x18, 0x59, 0xbb, 0x65, 0xd0, 0x77, 0xb1, 0x38, 0xd9, 0xd9, 0xf1, 0x64, 0x29,
+		0x64, 0x24, 0xd9, 0x50, 0x09, 0xb9, 0x04, 0x59, 0xf6, 0xa4, 0x29, 0x5a, 0x18, 0x06, 0x7d, 0x70,
+		0x2c, 0x7b, 0xfe, 0xde, 0x88, 0xe9, 0x4a, 0x81, 0xae, 0xd9, 0xae, 0xe1, 0x13, 0x03, 0xc3, 0xcb,
+		0x24, 0x5b, 0x70, 0x98, 0xbe, 0x96, 0xb3, 0xa7, 0x85, 0x4d, 0xec, 0xd2, 0x1c, 0xbf, 0xe1, 0x3e,
+		0x42, 0x91, 0xbe, 0x3f, 0xbb, 0x77, 0x2d, 0x68, 0x6b, 0xfb, 0xa4, 0xe7, 0x6e, 0x8c, 0x96, 0xa2,
+		0xa4, 0x6d, 0x9b, 0x96, 0xde, 0xc1, 0xa7, 0xe0, 0x98, 0x87, 0x62, 0x7e, 0x9c, 0xa4, 0x5d, 0xe2,
+		0x70, 0x74, 0xa1, 0x0b, 0x48, 0xda, 0x76, 0x30, 0xbf, 0x92, 0xe2, 0x5e, 0x24, 0xef, 0x1d, 0x89,
+		0x94, 0x9c, 0x66, 0xaf, 0x1d, 0x5d, 0x49, 0xa4, 0x41, 0xce, 0x2a, 0x0f, 0x42, 0x36, 0xa0, 0xa7,
+		0x70, 0x15, 0xe2, 0xfb, 0x24, 0xee, 0x9b, 0x12, 0x49, 0xa5, 0x00, 0xb9, 0xa0, 0x6a, 0x52, 0x3e,
+		0x2c, 0x41, 0x36, 0xa0, 0x74, 0x10, 0x53, 0x38, 0x27, 0x38, 0x26, 0x4f, 0xe2, 0x3b, 0x66, 0x6c,
+		0xa3, 0x28, 0xca, 0x63, 0x74, 0x1b, 0x9a, 0xa3, 0x99, 0x7c, 0x9f, 0x8a, 0x97, 0x42, 0x7a, 0xe7,
+		0x7b, 0x1e, 0x48, 0x9c, 0x82, 0x40, 0xef, 0x7c, 0x4f, 0x00, 0xdc, 0x03, 0x39, 0xec, 0xba, 0x16,
+		0xf4, 0x80, 0x64, 0xd4, 0x2c, 0xe6, 0x71, 0x10, 0xe5, 0x77, 0x63, 0x20, 0x0f, 0x2b, 0x33, 0xfc,
+		0x4c, 0x00, 0x6a, 0x71, 0xae, 0xa6, 0x17, 0x46, 0xf6, 0x58, 0xde, 0xb2, 0xc8, 0x76, 0xeb, 0x1f,
+		0xc6, 0xbd, 0x22, 0xc5, 0x20, 0xc7, 0x50, 0x83, 0xe9, 0xa6, 0xa5, 0x99, 0x6d, 0xf1, 0xf6, 0x3f,
+		0x4d, 0xd7, 0xdb, 0xe8, 0x0d, 0x68, 0x09, 0x9f, 0x92, 0xc6, 0xd6, 0x9e, 0x7d, 0x5c, 0x3a, 0x43,
+		0xee, 0x27, 0xb5, 0xd8, 0x0a, 0x67, 0x90, 0x95, 0x90, 0xed, 0xcd, 0x1e, 0x06, 0xbd, 0x27, 0x42,
+		0x9e, 0x04, 0xcc, 0x66, 0xaf, 0xad, 0xbb, 0x06, 0xf7, 0x7f, 0x07, 0x50, 0x71, 0xef, 0xae, 0xf7,
+		0x7a, 0x9a, 0xe3, 0xea, 0xae, 0x11, 0x32, 0x36, 0xf3, 0x7a, 0xaf, 0x47, 0x9f, 0x40, 0x66, 0xe6,
+		0xe6, 0xfd, 0x50, 0x40, 0x0d, 0x6f, 0xea, 0x1d, 0xb1, 0x31, 0x4c, 0xb2, 0x2d, 0x3e, 0xcf, 0xe5,
+		0x9b, 0xcb, 0x36, 0xe4, 0x82, 0xca, 0xdd, 0x73, 0xdd, 0x4b, 0xbe, 0xeb, 0x1e, 0xf3, 0xe8, 0x2d,
+		0x1f, 0xc6, 0x21, 0x71, 0x33, 0x2a, 0xc9, 0xc9, 0x32, 0xdb, 0x99, 0xa7, 0xd0, 0x92, 0xe9, 0xf5,
+		0xed, 0x1b, 0xec, 0x6e, 0x79, 0x5a, 0x65, 0x09, 0xe5, 0x05, 0x28, 0x84, 0xd7, 0x01, 0x52, 0x80,
+		0x98, 0x7b, 0x8b, 0xd7, 0x12, 0x73, 0x6f, 0x91, 0x73, 0x81, 0xd7, 0xa1, 0x0b, 0x51, 0xab, 0x1f,
+		0xc7, 0xf7, 0x5f, 0x3d, 0x66, 0x57, 0xc5, 0x94, 0x22, 0xe4, 0x43, 0xab, 0x84, 0x72, 0x04, 0xe6,
+		0xa3, 0x74, 0xbe, 0x62, 0xc2, 0x7c, 0x94, 0xea, 0x26, 0x17, 0x20, 0xed, 0x29, 0x7d, 0x21, 0x41,
+		0x23, 0xb5, 0x7b, 0x48, 0x1e, 0x2c, 0xca, 0x0e, 0x0e, 0x04, 0x3d, 0xe1, 0x89, 0xf1, 0xad, 0x49,
+		0xaf, 0x87, 0x7e, 0x16, 0xe5, 0x5d, 0x50, 0x1a, 0xa7, 0xcf, 0x03, 0x8c, 0x63, 0xae, 0x1a, 0xc1,
+		0xb8, 0x23, 0x90, 0xe4, 0x17, 0x7b, 0x63, 0xd4, 0xf3, 0xcc, 0x53, 0xc8, 0x50, 0xa6, 0xdb, 0xe3,
+		0x34, 0x9b, 0x25, 0x14, 0x0d, 0x8e, 0x8d, 0x55, 0xe9, 0xfe, 0x91, 0x14, 0xf7, 0x61, 0xd3, 0x84,
+		0x4f, 0x88, 0x35, 0x96, 0x25, 0xb0, 0x5a, 0xc7, 0xb0, 0xda, 0x7c, 0x0f, 0x94, 0x51, 0x79, 0x0a,
+		0x9f, 0x2d, 0x38, 0x12, 0xad, 0xd7, 0x71, 0x23, 0x83, 0xfe, 0x20, 0x37, 0xec, 0x3a, 0x82, 0xae,
+		0x7e, 0xab, 0xc9, 0xfd, 0x46, 0x32, 0xc4, 0xdd, 0x5b, 0x0e, 0xbd, 0x35, 0x97, 0x53, 0xf1, 0x27,
+		0xb9, 0x06, 0xb3, 0x78, 0xf3, 0xae, 0xa3, 0x75, 0x74, 0xc7, 0xd5, 0xf8, 0xb2, 0xcf, 0xa6, 0xd3,
+		0x7d, 0xe3, 0xf4, 0x34, 0xbb, 0xdb, 0x66, 0xba, 0xa8, 0x82, 0xf8, 0x44, 0x28, 0x52, 0x22, 0xab,
+		0xba, 0xe3, 0xb2, 0x22, 0x52, 0x83, 0x6c, 0xd7, 0x74, 0xb6, 0x8c, 0x5d, 0xfd, 0x86, 0x69, 0xf7,
+		0xf9, 0xbc, 0x8a, 0x90, 0x9e, 0xab, 0x3e, 0x10, 0x27, 0x15, 0xc4, 0x0b, 0x0c, 0xca, 0x4c, 0x48,
+		0x9a, 0x85, 0x66, 0x49, 0x1e, 0x58, 0xb3, 0x3c, 0x0a, 0xf3, 0x96, 0x71, 0xcb, 0xd5, 0xfc, 0x99,
+		0xcb, 0x24, 0x85, 0xbd, 0x7c, 0x40, 0xb0, 0xcc, 0x9b, 0xeb, 0x0e, 0x3d, 0x15, 0x7c, 0x08, 0xbc,
+		0x7d, 0xa5, 0x26, 0xb6, 0xbc, 0x69, 0x0a, 0x5d, 0x14, 0xf9, 0x65, 0x96, 0xad, 0xbc, 0x4c, 0x07,
+		0x27, 0x6a, 0x75, 0x14, 0xac, 0x97, 0x7c, 0xd6, 0x37, 0x61, 0x9e, 0xe3, 0xb7, 0x43, 0xdc, 0x1f,
+		0xd9, 0xc3, 0x87, 0x8d, 0xae, 0x00, 0xd7, 0x89, 0xc0, 0x1f, 0xcf, 0xf8, 0xf8, 0x1d, 0x32, 0x9e,
+		0x40, 0x82, 0xb2, 0x25, 0xc1, 0xd4, 0x0d, 0xfe, 0xfe, 0xbf, 0x6d, 0x30, 0xde, 0x1f, 0x87, 0xd9,
+		0x11, 0xc3, 0xc2, 0xeb, 0x98, 0x14, 0xd9, 0xb1, 0x58, 0x64, 0xc7, 0xe2, 0x07, 0xee, 0x18, 0x1f,
+		0xed, 0xc4, 0xe4, 0xd1, 0x9e, 0x79, 0x23, 0x47, 0x3b, 0x79, 0x87, 0xa3, 0xfd, 0x75, 0x1d, 0x87,
+		0x1f, 0x92, 0x60, 0x61, 0xbc, 0x39, 0x16, 0x39, 0x20, 0x0f, 0xc3, 0xac, 0xd7, 0x14, 0x8f, 0x3c,
+		0x53, 0x8f, 0xb2, 0x57, 0xc0, 0xe9, 0x8f, 0x5d, 0xf1, 0xee, 0x87, 0xc2, 0x90, 0xb5, 0xc8, 0x84,
+		0x39, 0x1f, 0xf2, 0xc0, 0x2a, 0xbf, 0x18, 0xc7, 0x6f, 0x0d, 0x8c, 0x1a, 0x74, 0x11, 0x33, 0x56,
+		0xc5, 0xd7, 0xe5, 0x5a, 0x66, 0xfb, 0x8e, 0x27, 0xec, 0x2c, 0x47, 0xff, 0x7f, 0xf3, 0x75, 0x54,
+		0x4e, 0xf0, 0x5d, 0x60, 0x3c, 0xba, 0x42, 0xdf, 0x98, 0x6b, 0x0b, 0xdb, 0x28, 0xc3, 0x8e, 0x84,
+		0x78, 0x41, 0xd3, 0xe6, 0xd6, 0xd1, 0x8f, 0x03, 0xa4, 0x55, 0xc3, 0xe9, 0xa1, 0x31, 0xc7, 0xbe,
+		0xa9, 0xd3, 0x32, 0x7a, 0xae, 0x7f, 0xaa, 0x11, 0xb9, 0xc7, 0xe0, 0x20, 0x02, 0x0f, 0xf7, 0xda,
+		0x1e, 0x1e, 0x79, 0x9c, 0xbb, 0x14, 0xc6, 0x3a, 0x07, 0x98, 0xa9, 0xee, 0xa1, 0x52, 0x68, 0xf2,
+		0xa4, 0xf0, 0x29, 0xc4, 0xc7, 0xed, 0x94, 0xb9, 0xe1, 0xee, 0xe1, 0x31, 0x78, 0xac, 0x8e, 0x3a,
+		0x15, 0x12, 0xe3, 0xaa, 0x63, 0xf6, 0xbd, 0x5f, 0x9d, 0xc9, 0x6e, 0xd8, 0x07, 0xbd, 0x0a, 0xc9,
+		0x71, 0x5d, 0x0d, 0x18, 0xe2, 0x7e, 0x57, 0x7d, 0xb7, 0xc2, 0x93, 0xc2, 0xad, 0x90, 0x1a, 0xd7,
+		0x68, 0x6e, 0x79, 0xfa, 0x8d, 0xa6, 0xf0, 0xe4, 0xed, 0x01, 0xbf, 0x42, 0x66, 0xf8, 0x14, 0x76,
+		0xc4, 0xaf, 0xe0, 0x61, 0x7b, 0x8e, 0x85, 0x25, 0xcf, 0xb1, 0x90, 0x1b, 0xeb, 0x95, 0xe0, 0x26,
+		0xa3, 0x87, 0xcc, 0x31, 0xc8, 0xc6, 0x88, 0x67, 0x81, 0x39, 0x02, 0x1e, 0x9c, 0xe8, 0x59, 0xf0,
+		0x48, 0x0d, 0xb9, 0x16, 0x36, 0x46, 0x5c, 0x0b, 0x85, 0x71, 0x14, 0x87, 0xec, 0x53, 0x9f, 0x62,
+		0xd8, 0xb7, 0xf0, 0xcd, 0xd1, 0xbe, 0x85, 0xb1, 0x9b, 0xff, 0x08, 0x5b, 0xd4, 0x23, 0x1d, 0xe1,
+		0x5c, 0x78, 0xd7, 0x18, 0xe7, 0x82, 0x3c, 0x6e, 0x13, 0x1c, 0x65, 0x89, 0x7a, 0x15, 0x44, 0x79,
+		0x17, 0xae, 0x45, 0x78, 0x17, 0x98, 0x1b, 0xe0, 0xa1, 0x29, 0xbc, 0x0b, 0x1e, 0xe9, 0x11, 0xf7,
+		0xc2, 0xb5, 0x08, 0xf7, 0x02, 0x19, 0x4f, 0x77, 0xc8, 0x80, 0x0a, 0xd2, 0x0d, 0x15, 0x91, 0x95,
+		0xb0, 0x7f, 0x61, 0x6e, 0x7f, 0xbb, 0x95, 0x99, 0x01, 0x1e, 0xb5, 0xa0, 0x83, 0xa1, 0x35, 0xce,
+		0xc1, 0xc0, 0x7c, 0x00, 0x8f, 0x4c, 0xe9, 0x60, 0xf0, 0x68, 0x47, 0x7a, 0x18, 0x36, 0x46, 0x3c,
+		0x0c, 0x87, 0xc7, 0x09, 0xdc, 0xd0, 0x82, 0xe4, 0x0b, 0xdc, 0x58, 0x17, 0x03, 0x7b, 0x56, 0x99,
+		0x3d, 0xa8, 0x0c, 0x72, 0xf6, 0x4a, 0x22, 0x9d, 0x95, 0x73, 0xca, 0x43, 0x68, 0x02, 0x0d, 0xe9,
+		0x3d, 0xdc, 0x70, 0x18, 0xfd, 0xbe, 0xdd, 0x17, 0x21, 0x30, 0x34, 0xa1, 0x9c, 0x86, 0x5c, 0x50,
+		0xc5, 0xed, 0xe3, 0x8e, 0x28, 0x42, 0x3e, 0xa4, 0xd5, 0x94, 0x5f, 0x95, 0x20, 0x17, 0xd4, 0x57,
+		0xa1, 0xcd, 0x6a, 0x86, 0x6f, 0x56, 0x03, 0x4e, 0x8a, 0x58, 0xd8, 0x49, 0x81, 0x8f, 0x53, 0xf6,
+		0x46, 0xfc, 0x0f, 0x7a, 0xcf, 0xf3, 0x3f, 0x9c, 0x81, 0x59, 0xba, 0xde, 0x32, 0x57, 0x06, 0x5f,
+		0x19, 0xd8, 0x91, 0x4f, 0x11, 0x0b, 0x28, 0x33, 0xf8, 0x29, 0xef, 0x23, 0x30, 0x17, 0x80, 0xf5,
+		0x36, 0x82, 0x6c, 0x2b, 0x2e, 0x7b, 0xd0, 0x65, 0xbe, 0x23, 0xfc, 0x0d, 0x09, 0x66, 0x47, 0xd4,
+		0x65, 0xa4, 0x8f, 0x41, 0x7a, 0xa3, 0x7c, 0x0c, 0xb1, 0x3b, 0xf7, 0x31, 0x04, 0xb7, 0xb6, 0xf1,
+		0xf0, 0xd6, 0xf6, 0x2b, 0x12, 0xe4, 0x43, 0x6a, 0x1b, 0x07, 0xa1, 0x65, 0xb7, 0x45, 0xc0, 0x14,
+		0xfd, 0x8d, 0x36, 0x4d, 0xc7, 0x16, 0xef, 0xe1, 0xe2, 0x4f, 0x84, 0xf2, 0x16, 0xa2, 0x0c, 0x5f,
+		0x66, 0xbc, 0x7d, 0xea, 0x4c, 0x30, 0x74, 0x92, 0x87, 0x13, 0x26, 0xfd, 0x70, 0x42, 0x2f, 0x4e,
+		0x2a, 0x15, 0x88, 0x93, 0x22, 0x17, 0x31, 0xc8, 0xc0, 0xb6, 0xb7, 0x35, 0xbb, 0xc7, 0xd6, 0xfa,
+		0x90, 0x6d, 0x34, 0x12, 0x4a, 0xe8, 0xd0, 0x03, 0x49, 0xfa, 0x2b, 0x60, 0xb1, 0x64, 0x42, 0x16,
+		0xcb, 0x5d, 0x90, 0xc1, 0xe6, 0xb3, 0xc7, 0xf5, 0x81, 0xc7, 0xee, 0x8a, 0x0c, 0xe5, 0xa7, 0x63,
+		0x50, 0x1c, 0x5a, 0x75, 0x22, 0x3b, 0x2f, 0xa4, 0x32, 0x16, 0x70, 0xa1, 0x4c, 0xc7, 0x90, 0x13,
+		0x00, 0x3b, 0xba, 0xa3, 0xdd, 0xd4, 0x2d, 0x97, 0x7f, 0x10, 0x2b, 0xae, 0x06, 0x72, 0xf0, 0x53,
+		0x4e, 0x98, 0x1a, 0x38, 0xfc, 0x93, 0x58, 0x71, 0xd5, 0x4b, 0x93, 0x3a, 0x24, 0x8d, 0x1b, 0xf4,
+		0x9d, 0x18, 0xf6, 0x1e, 0xe2, 0xd1, 0x08, 0xf5, 0x84, 0xe5, 0x95, 0x12, 0x0e, 0xf7, 0x17, 0x5e,
+		0x3d, 0x29, 0x33, 0xf0, 0xb7, 0x78, 0xaf, 0x3d, 0xa8, 0x9c, 0x40, 0x98, 0x0d, 0xe9, 0x21, 0x36,
+		0x50, 0xd7, 0x62, 0x4e, 0xf8, 0x09, 0x90, 0xa9, 0xec, 0x0c, 0x54, 0xcd, 0x77, 0x8d, 0x6e, 0xcf,
+		0xb6, 0x3b, 0x1a, 0x9b, 0xe7, 0x65, 0x28, 0x84, 0x17, 0x59, 0xf6, 0x01, 0x1b, 0x17, 0xbd, 0x6d,
+		0x21, 0x3b, 0x3a, 0xc7, 0x32, 0xd9, 0xbc, 0x0a, 0xbe, 0x02, 0xa4, 0xbc, 0x03, 0x0e, 0x47, 0xae,
+		0xb1, 0xe4, 0x29, 0xc8, 0xf8, 0xeb, 0x33, 0x3b, 0xc7, 0xde, 0xcf, 0x67, 0xe3, 0x03, 0xe3, 0x43,
+		0x61, 0x91, 0x8b, 0x2c, 0x79, 0x1b, 0x24, 0xfb, 0x86, 0x33, 0xe8, 0xb8, 0xfc, 0xc5, 0xbf, 0xfb,
+		0x27, 0xaf, 0xce, 0xf8, 0x52, 0x14, 0x47, 0x52, 0xce, 0xc1, 0xb1, 0xb1, 0xab, 0xac, 0xef, 0x79,
+		0x91, 0x02, 0x9e, 0x17, 0xe5, 0xe7, 0x24, 0x58, 0x18, 0xbf, 0x72, 0x92, 0xca, 0x50, 0x83, 0xce,
+		0x4c, 0xb9, 0xee, 0x06, 0x5a, 0x85, 0x5b, 0x93, 0xbe, 0xb1, 0x6d, 0xb8, 0xad, 0x5d, 0xb6, 0x84,
+		0x33, 0xa5, 0x90, 0x57, 0xf3, 0x3c, 0x97, 0xe2, 0x38, 0x0c, 0xec, 0x25, 0xa3, 0xe5, 0x6a, 0x6c,
+		0x50, 0x1d, 0xfe, 0xa9, 0xd3, 0x3c, 0xcb, 0x6d, 0xb0, 0x4c, 0xe5, 0x61, 0x38, 0x3a, 0x66, 0x2d,
+		0x1e, 0xdd, 0xc3, 0x28, 0x2f, 0x22, 0x70, 0xe4, 0x02, 0x4b, 0x9e, 0x81, 0xa4, 0xe3, 0xea, 0xee,
+		0xc0, 0xe1, 0x3d, 0x7b, 0x70, 0xe2, 0xda, 0xdc, 0xa0, 0xe0, 0x2a, 0x47, 0x53, 0x9e, 0x06, 0x32,
+		0xba, 0xd2, 0x46, 0xec, 0xc3, 0xa4, 0xa8, 0x7d, 0xd8, 0x16, 0x1c, 0xdf, 0x67, 0x4d, 0x25, 0xcb,
+		0x43, 0x8d, 0x7b, 0x78, 0xaa, 0x25, 0x79, 0xa8, 0x81, 0x7f, 0x1e, 0x83, 0xc3, 0x91, 0x4b, 0x6b,
+		0x60, 0x96, 0x4a, 0xaf, 0x77, 0x96, 0xbe, 0x0d, 0xc0, 0xbd, 0xa5, 0xb1, 0x91, 0x16, 0xda, 0x3e,
+		0x6a, 0x3f, 0x71, 0xcb, 0x68, 0x35, 0x6f, 0x71, 0xc1, 0xc8, 0xb8, 0xfc, 0x17, 0x3a, 0x0a, 0x02,
+		0x7b, 0xdf, 0x01, 0x5d, 0x09, 0x9c, 0x52, 0xfc, 0x60, 0x6b, 0x86, 0x7c, 0x23, 0x9c, 0xed, 0x90,
+		0x17, 0xe1, 0xe8, 0xd0, 0x8a, 0xe6, 0xd1, 0x4e, 0x4c, 0xbd, 0xb0, 0x1d, 0x0e, 0x2f, 0x6c, 0x82,
+		0x76, 0x70, 0x55, 0x9a, 0x09, 0xaf, 0x4a, 0x2f, 0x02, 0xf8, 0x9b, 0x60, 0x9c, 0x6f, 0x7d, 0x7b,
+		0x60, 0xb5, 0x45, 0xa0, 0x2f, 0x4d, 0xe0, 0x29, 0x27, 0x4a, 0x82, 0x60, 0x55, 0x84, 0xc2, 0xc0,
+		0x21, 0x0d, 0xec, 0xa2, 0x19, 0xb8, 0xf2, 0x12, 0x90, 0x51, 0x7f, 0xe4, 0x98, 0x3a, 0xde, 0x1e,
+		0xae, 0x43, 0x19, 0xef, 0xda, 0x8c, 0xae, 0xeb, 0xff, 0x83, 0x19, 0x3a, 0xfc, 0x91, 0x71, 0xf6,
+		0xef, 0x04, 0xd0, 0x5d, 0xb7, 0x6f, 0x6e, 0x0d, 0xfc, 0x1a, 0x4e, 0x8d, 0x91, 0x9f, 0xb2, 0x00,
+		0xac, 0xdc, 0xc5, 0x05, 0x69, 0xde, 0xc7, 0x0d, 0x08, 0x53, 0x80, 0xa2, 0xb2, 0x06, 0x85, 0x30,
+		0x6e, 0xf4, 0xc5, 0x81, 0xd1, 0x37, 0xfb, 0xfd, 0x85, 0x9c, 0x5f, 0x4e, 0xa2, 0x09, 0xe5, 0x5b,
+		0x63, 0x90, 0x0b, 0x4a, 0xdf, 0xdf, 0xc1, 0xc5, 0x52, 0xf9, 0x0e, 0x09, 0xd2, 0x5e, 0xff, 0xc3,
+		0xae, 0xff, 0xd0, 0x99, 0x89, 0x7f, 0x85, 0xc4, 0xf3, 0xd7, 0xb3, 0x13, 0x92, 0xb8, 0x77, 0x42,
+		0xf2, 0x56, 0x6f, 0x41, 0x18, 0xbb, 0x99, 0x0f, 0x72, 0x9b, 0x0b, 0x96, 0x58, 0xa0, 0x9e, 0x9e,
+		0x2e, 0xa8, 0x0a, 0x8f, 0x6f, 0x02, 0x11, 0x51, 0x2c, 0xa1, 0x38, 0x81, 0x68, 0x55, 0x36, 0x1b,
+		0x7d, 0xc0, 0x58, 0x00, 0x70, 0x34, 0x0e, 0x2a, 0x3e, 0x39, 0x0e, 0x2a, 0x31, 0x1c, 0x07, 0xc5,
+		0x6c, 0x00, 0xe5, 0x1f, 0x48, 0x90, 0x16, 0xb3, 0x84, 0x3c, 0x13, 0x8c, 0xac, 0x16, 0x61, 0x9a,
+		0xe3, 0xb5, 0x14, 0xef, 0xbc, 0x8f, 0x43, 0x2a, 0xe2, 0x84, 0xd2, 0x6c, 0x6b, 0xdb, 0x1d, 0x5d,
+		0xbc, 0x66, 0x3b, 0x36, 0x2e, 0x9c, 0xc5, 0x15, 0xf1, 0x50, 0xd7, 0x7a, 0x1b, 0x13, 0xdc, 0x2a,
+		0xf9, 0x6b, 0x09, 0xe4, 0xe1, 0x59, 0xfc, 0xfa, 0xdb, 0x37, 0xba, 0x7a, 0xc5, 0x23, 0x56, 0x2f,
+		0xfc, 0xf0, 0x84, 0x07, 0xa1, 0x39, 0xe6, 0x8e, 0xc5, 0xc2, 0xae, 0x99, 0x3b, 0x8e, 0x78, 0x45,
+		0x0d, 0x51, 0x32, 0xda, 0xef, 0x99, 0x3b, 0xed, 0xf7, 0xfb, 0x63, 0x90, 0x0d, 0x78, 0x07, 0x31,
+		0xdc, 0x3c, 0xf0, 0xe1, 0x9c, 0x7b, 0xf6, 0x75, 0x25, 0x06, 0xbe, 0x55, 0x1a, 0xe2, 0x54, 0xec,
+		0x0e, 0x38, 0x35, 0xce, 0x0f, 0x2b, 0xdc, 0x8d, 0x89, 0x03, 0xbb, 0x1b, 0xa3, 0xe3, 0x13, 0x67,
+		0xc6, 0xc4, 0x27, 0x7e, 0x9b, 0x04, 0x69, 0xcf, 0x15, 0x73, 0xd0, 0xd3, 0xbc, 0x23, 0x90, 0xe4,
+		0x96, 0x18, 0x3b, 0xce, 0xe3, 0xa9, 0x48, 0xbf, 0xea, 0x02, 0xa4, 0xc5, 0x37, 0xbf, 0xf8, 0x7a,
+		0xe7, 0xa5, 0xcf, 0x6c, 0x41, 0x36, 0x70, 0x20, 0x4a, 0x8e, 0xc1, 0xe1, 0xe5, 0xcb, 0xb5, 0xe5,
+		0x67, 0xb5, 0xe6, 0xf3, 0xc3, 0x0f, 0xe6, 0x8e, 0x14, 0xa9, 0x35, 0x9a, 0x66, 0xef, 0x07, 0x86,
+		0x8b, 0x58, 0x41, 0x6c, 0x21, 0x81, 0xa1, 0x74, 0x67, 0xfe, 0x5a, 0x82, 0xb9, 0x08, 0x9b, 0x97,
+		0xdc, 0x03, 0x77, 0xaf, 0x5f, 0xba, 0x54, 0x53, 0xb5, 0xc6, 0x5a, 0x79, 0xa3, 0x71, 0x79, 0xbd,
+		0xa9, 0xa9, 0xb5, 0xc6, 0xe6, 0x6a, 0xf0, 0xc1, 0xd9, 0x53, 0x70, 0x57, 0x34, 0x48, 0x79, 0x79,
+		0xb9, 0xb6, 0xd1, 0x64, 0x9f, 0xf2, 0x18, 0x03, 0x51, 0xa1, 0x0f, 0xd2, 0x8e, 0x27, 0xa1, 0xd6,
+		0xae, 0xd4, 0x96, 0x9b, 0x72, 0x9c, 0x3c, 0x08, 0xf7, 0xee, 0x07, 0xc1, 0x9f, 0xc1, 0x95, 0x13,
+		0x13, 0x01, 0x1b, 0xb5, 0xb5, 0x6a, 0x4d, 0x95, 0x67, 0x78, 0xbf, 0x3f, 0x1e, 0x83, 0xd2, 0x38,
+		0xd3, 0x1a, 0x69, 0x95, 0x37, 0x36, 0x56, 0x5f, 0xf0, 0x69, 0x2d, 0x5f, 0xde, 0x5c, 0x7b, 0x76,
+		0x94, 0x05, 0x0f, 0x80, 0xb2, 0x1f, 0xa0, 0xc7, 0x88, 0xfb, 0xe1, 0x9e, 0x7d, 0xe1, 0x38, 0x3b,
+		0x26, 0x80, 0xa9, 0x35, 0xfc, 0x0e, 0x49, 0x9c, 0x2c, 0xc2, 0x99, 0x89, 0x60, 0x5e, 0x99, 0x9c,
+		0x20, 0x67, 0xe1, 0xe1, 0xfd, 0xe1, 0x19, 0x83, 0x04, 0x82, 0x60, 0xd1, 0xcb, 0x12, 0x1c, 0x8e,
+		0xb4, 0xd1, 0xf1, 0xc1, 0xe5, 0x0d, 0x75, 0x7d, 0xb9, 0xd6, 0x68, 0xe0, 0x3b, 0x97, 0x1b, 0xeb,
+		0x8d, 0xf2, 0xaa, 0xd6, 0x68, 0x96, 0x9b, 0x9b, 0x8d, 0x00, 0x6f, 0x14, 0x38, 0x31, 0x0e, 0xc8,
+		0xe3, 0xcb, 0x3e, 0x30, 0x5c, 0x02, 0x84, 0x9c, 0xde, 0x96, 0xe0, 0xd8, 0x58, 0x9b, 0x9c, 0x9c,
+		0x86, 0xfb, 0xd8, 0x9b, 0xcc, 0xda, 0xb5, 0xf5, 0x66, 0xf0, 0x9b, 0x31, 0x23, 0xad, 0x7a, 0x10,
+		0xee, 0xdd, 0x17, 0xd2, 0x6b, 0xda, 0x24, 0xc0, 0xa1, 0xf6, 0xbd, 0x4f, 0x82, 0xe2, 0x90, 0x2e,
+		0xa4, 0x4f, 0x3f, 0xd7, 0x1b, 0x95, 0xda, 0xe5, 0xf2, 0xb5, 0xfa, 0xba, 0x3a, 0x3c, 0x67, 0xef,
+		0x85, 0x93, 0x23, 0xa5, 0xd5, 0x4d, 0x7c, 0x3b, 0xbb, 0xdc, 0xac, 0xd1, 0x4a, 0x65, 0x09, 0x3b,
+		0x36, 0x02, 0xb4, 0x5a, 0x5f, 0xb9, 0xdc, 0xd4, 0x96, 0x57, 0xeb, 0xb5, 0xb5, 0xa6, 0x56, 0x6e,
+		0x36, 0xcb, 0xfe, 0x74, 0xae, 0x3c, 0x3b, 0x36, 0x2a, 0xf6, 0xdc, 0xf4, 0x51, 0xb1, 0x3c, 0xba,
+		0xd3, 0xbf, 0x27, 0x18, 0x83, 0x93, 0x5e, 0x21, 0xf7, 0xac, 0x0d, 0x47, 0x7f, 0xce, 0x09, 0x80,
+		0x45, 0x0e, 0x30, 0x3e, 0xde, 0xff, 0x6d, 0x10, 0x2f, 0xf7, 0x7a, 0xa8, 0xf9, 0x68, 0xba, 0x65,
+		0x77, 0xb8, 0x5e, 0xf5, 0xd2, 0x58, 0xe6, 0xd8, 0xdb, 0xee, 0x4d, 0xbd, 0xef, 0x7d, 0xce, 0x5a,
+		0xa4, 0x95, 0x8b, 0x90, 0xf1, 0xf6, 0x12, 0xf4, 0x89, 0x59, 0xef, 0x06, 0x58, 0x42, 0xdc, 0xf0,
+		0xe2, 0xd7, 0x64, 0x62, 0xfe, 0x35, 0x99, 0x04, 0x06, 0xff, 0x57, 0xd6, 0xc6, 0x72, 0xe7, 0xf1,
+		0xe9, 0xb9, 0xe3, 0x33, 0xc0, 0x63, 0xd0, 0x47, 0xee, 0x0e, 0xdc, 0x86, 0xf0, 0x62, 0x55, 0x83,
+		0xec, 0x89, 0x08, 0xf7, 0x9f, 0x14, 0x1d, 0x3b, 0x45, 0xf4, 0xeb, 0xa4, 0x51, 0xb9, 0xd3, 0xf0,
+		0xd8, 0x8b, 0x90, 0xc7, 0x8f, 0x2b, 0x34, 0x0c, 0xf7, 0xb2, 0xa1, 0xb7, 0x8d, 0x7e, 0xf8, 0x56,
+		0x74, 0x5e, 0xdc, 0x8a, 0x16, 0xeb, 0x59, 0xcc, 0x5f, 0xcf, 0x14, 0x13, 0x12, 0x88, 0x3a, 0x3e,
+		0x3c, 0x85, 0x99, 0x8c, 0x0c, 0x85, 0x25, 0xc8, 0x13, 0xe2, 0xde, 0x73, 0x7c, 0xc2, 0xbd, 0x67,
+		0xb1, 0x8f, 0xa2, 0xd0, 0x4a, 0x17, 0x52, 0xdc, 0x9a, 0x89, 0x3c, 0xf7, 0x5d, 0x83, 0x62, 0x4f,
+		0xef, 0xbb, 0xf4, 0x33, 0x8f, 0xbb, 0xb4, 0x1b, 0xdc, 0x12, 0x89, 0xba, 0xb8, 0x16, 0xea, 0x2e,
+		0xaf, 0x26, 0xdf, 0x0b, 0x66, 0x2a, 0xaf, 0x25, 0x20, 0xc9, 0xd9, 0xf1, 0xf6, 0x70, 0x8c, 0x5c,
+		0xc8, 0x4c, 0xf7, 0xc5, 0xdf, 0xdf, 0xf2, 0x72, 0x82, 0x02, 0x89, 0x3c, 0x30, 0x1c, 0x91, 0x56,
+		0xc9, 0x7e, 0xf6, 0xd5, 0x93, 0x29, 0xea, 0x37, 0xae, 0x57, 0xfd, 0xf0, 0xb4, 0x37, 0xde, 0x0a,
+		0xaa, 0x42, 0x3e, 0xe0, 0xd1, 0x36, 0xdb, 0x3c, 0x64, 0x60, 0x61, 0xbc, 0xa5, 0x28, 0x0e, 0x88,
+		0x3d, 0x6f, 0x77, 0xbd, 0x8d, 0xdf, 0x3e, 0x0d, 0x9c, 0x59, 0xb3, 0xcd, 0x3a, 0x73, 0xe5, 0x16,
+		0x3a, 0xde, 0x69, 0x34, 0x3d, 0xb2, 0x3d, 0x0e, 0x19, 0xfa, 0x39, 0xd3, 0xc0, 0xc9, 0x6e, 0x1a,
+		0x33, 0x68, 0xe1, 0x83, 0x50, 0x1c, 0x3e, 0xfc, 0x65, 0xc7, 0xb9, 0x85, 0x1b, 0xe1, 0x83, 0xdf,
+		0x71, 0x47, 0xc5, 0x99, 0xb1, 0x47, 0xc5, 0xf7, 0x43, 0xc1, 0x77, 0x51, 0x50, 0x58, 0x60, 0x96,
+		0xb6, 0x97, 0x4b, 0xc1, 0x82, 0xde, 0x86, 0x6c, 0xc8, 0xdb, 0xe0, 0x9d, 0x13, 0x70, 0xdf, 0x0b,
+		0x83, 0xc9, 0xb1, 0xd3, 0x66, 0x2c, 0xe0, 0x2e, 0x16, 0x0a, 0x7b, 0x2f, 0xe4, 0xc5, 0xf5, 0x50,
+		0x06, 0x97, 0xa7, 0x70, 0x39, 0x91, 0x39, 0xf6, 0xf4, 0xba, 0x10, 0x1d, 0xe5, 0x50, 0x82, 0x44,
+		0x95, 0xef, 0x91, 0x87, 0x3c, 0x6e, 0x9f, 0x8e, 0x43, 0x82, 0x1e, 0x32, 0x3d, 0x1e, 0x32, 0xcc,
+		0xa3, 0x44, 0x1a, 0xb7, 0x07, 0x46, 0xfb, 0xaa, 0xb3, 0x13, 0xb0, 0xcb, 0xc7, 0x05, 0xa7, 0x78,
+		0x8e, 0x8e, 0x78, 0xd0, 0xd1, 0x71, 0x09, 0xd2, 0x9e, 0x9c, 0x24, 0x26, 0xca, 0x49, 0x11, 0xe5,
+		0x04, 0xc5, 0x98, 0x67, 0xa8, 0x29, 0xbe, 0xbd, 0x20, 0x15, 0xc8, 0x78, 0x1a, 0xa6, 0x34, 0x73,
+		0x00, 0x99, 0xf5, 0xd1, 0xa2, 0xa3, 0x38, 0x92, 0x63, 0xa2, 0x38, 0x82, 0x82, 0xa5, 0x31, 0x35,
+		0x94, 0xa2, 0x1d, 0xf3, 0x05, 0xab, 0x8e, 0xb9, 0xb8, 0xaf, 0xf7, 0xf7, 0x57, 0x4c, 0xf6, 0xfc,
+		0x0c, 0x2c, 0xf5, 0x77, 0x6a, 0x4c, 0xd6, 0x32, 0xc6, 0xa4, 0x5d, 0x1a, 0x8c, 0xdb, 0xa5, 0x29,
+		0xff, 0x52, 0x82, 0x24, 0x0f, 0xd4, 0xd8, 0xc7, 0x49, 0xc0, 0xc6, 0x21, 0x36, 0x6e, 0x1c, 0xe2,
+		0xaf, 0x6b, 0x1c, 0xc0, 0x6b, 0xa7, 0x08, 0x4f, 0xbd, 0x2b, 0xd2, 0x55, 0x87, 0x8d, 0x6c, 0x98,
+		0x3b, 0xe2, 0xd4, 0xc8, 0xc7, 0x52, 0x5e, 0x95, 0x20, 0xe3, 0x95, 0x8f, 0x6e, 0x3c, 0xa5, 0x03,
+		0x6f, 0x3c, 0x0f, 0x16, 0x9f, 0x13, 0x12, 0xa5, 0xf8, 0x9d, 0x89, 0x52, 0x68, 0xd0, 0x13, 0x43,
+		0x83, 0xae, 0x7c, 0x0e, 0x2f, 0x18, 0x87, 0x5c, 0x81, 0xff, 0x87, 0x46, 0xeb, 0x1b, 0xb9, 0x7c,
+		0x61, 0x84, 0xd0, 0xc8, 0xb0, 0xdd, 0x17, 0x41, 0x32, 0xdc, 0x6a, 0x7f, 0xf8, 0x88, 0x20, 0xd3,
+		0xf0, 0x87, 0xf1, 0x97, 0x63, 0x30, 0x3b, 0x02, 0xff, 0xb7, 0x70, 0x38, 0xc3, 0x73, 0x78, 0x66,
+		0xca, 0x39, 0x9c, 0x1c, 0x3b, 0x87, 0x7f, 0x39, 0x06, 0x69, 0xb1, 0x19, 0xfa, 0x1b, 0xd1, 0xc1,
+		0xc7, 0x21, 0xd3, 0xb3, 0x3b, 0x1a, 0x2b, 0x61, 0xdf, 0x4c, 0x48, 0xf7, 0xec, 0x8e, 0x3a, 0x22,
+		0x6a, 0x33, 0x6f, 0x94, 0x82, 0x4e, 0xbe, 0x01, 0xc3, 0x90, 0x1a, 0x9e, 0x55, 0x2e, 0xe4, 0x18,
+		0x2f, 0xb8, 0x05, 0x75, 0x0e, 0x99, 0x80, 0xbf, 0xbc, 0x9b, 0xe3, 0xa3, 0xed, 0x66, 0xa0, 0x6a,
+		0x72, 0xd7, 0x43, 0x09, 0xc5, 0xc8, 0x1d, 0x1b, 0xab, 0xb9, 0x44, 0x9c, 0x8f, 0xf2, 0x8a, 0x04,
+		0xb0, 0x8a, 0xcc, 0xa5, 0x3d, 0x46, 0xe3, 0xc7, 0xa1, 0x8d, 0xd0, 0x42, 0x75, 0x9f, 0x1c, 0x3b,
+		0x70, 0xbc, 0x05, 0x39, 0x27, 0xd8, 0xf4, 0x2a, 0xe4, 0x7d, 0x01, 0x77, 0x0c, 0xd1, 0x9c, 0x93,
+		0xfb, 0xdd, 0x93, 0xc5, 0x2f, 0xa1, 0xe4, 0x6e, 0x04, 0x52, 0xca, 0xbf, 0x92, 0x20, 0x43, 0x5b,
+		0x85, 0x1f, 0x75, 0x0f, 0x0d, 0xa4, 0xf4, 0x3a, 0x06, 0xf2, 0x6e, 0x00, 0x46, 0x07, 0x1f, 0x4e,
+		0xe3, 0xf2, 0x95, 0xa1, 0x39, 0xf8, 0x1c, 0x1a, 0x79, 0xd2, 0xe3, 0x7a, 0x7c, 0x02, 0xd7, 0x85,
+		0x63, 0x99, 0xf3, 0xfe, 0x28, 0xa4, 0xe8, 0xe7, 0xc9, 0x6e, 0x39, 0x3c, 0x54, 0x22, 0x89, 0x5f,
+		0x70, 0xba, 0xe5, 0x28, 0xd7, 0x21, 0xd5, 0xbc, 0xc5, 0x5e, 0x4e, 0x3a, 0x0e, 0x99, 0xbe, 0x6d,
+		0x73, 0x6b, 0x90, 0x19, 0xe2, 0x69, 0xcc, 0xa0, 0xc6, 0x4f, 0xd4, 0x09, 0xc0, 0xd9, 0x69, 0xcd,
+		0x7e, 0x6e, 0xf0, 0xe3, 0x65, 0xd6, 0x7c, 0x68, 0x46, 0x91, 0xb7, 0xc0, 0xd1, 0x46, 0x7d, 0x65,
+		0xad, 0x56, 0xd5, 0xae, 0x36, 0x56, 0x86, 0x36, 0xd8, 0x0b, 0xc5, 0x97, 0x6f, 0x9f, 0xca, 0xf2,
+		0x5b, 0xac, 0xe3, 0xa0, 0x37, 0xd4, 0x1a, 0xdb, 0x69, 0x33, 0xe8, 0x8d, 0xbe, 0x81, 0xae, 0x58,
+		0x0a, 0xfd, 0x28, 0x1c, 0x8b, 0x80, 0xf6, 0xee, 0xb2, 0xce, 0xbe, 0x7c, 0xfb, 0x54, 0x7e, 0xa3,
+		0x6f, 0x30, 0x51, 0xa3, 0x18, 0x8b, 0x50, 0x1a, 0xc5, 0x60, 0x5e, 0x0d, 0xf9, 0xd4, 0x82, 0xfc,
+		0xf2, 0xed, 0x53, 0x39, 0xa1, 0x3b, 0x10, 0xfe, 0xeb, 0x7e, 0x99, 0xf5, 0xa3, 0x19, 0x38, 0xc6,
+		0x1e, 0xd0, 0xd2, 0xd8, 0x1e, 0x90, 0x25, 0xf8, 0x96, 0x34, 0x17, 0x2c, 0x9a, 0xfc, 0x65, 0x04,
+		0xe5, 0x59, 0x98, 0xab, 0x5b, 0xae, 0xd1, 0xc7, 0x77, 0x4f, 0xfd, 0x2f, 0x7e, 0xe0, 0xc8, 0xd2,
+		0x4f, 0x1c, 0x4a, 0xfe, 0x17, 0x33, 0xc8, 0xa9, 0xd0, 0x13, 0x9f, 0x7c, 0x07, 0x1f, 0xcc, 0x52,
+		0xbe, 0x55, 0x02, 0xb9, 0xd1, 0xd2, 0x3b, 0x7a, 0xff, 0xf5, 0x92, 0x22, 0x4f, 0x8a, 0x2f, 0x62,
+		0xf0, 0xab, 0x25, 0x71, 0xfa, 0x75, 0xa4, 0x60, 0xe7, 0x16, 0x59, 0x4d, 0x54, 0x07, 0xb3, 0x2f,
+		0x61, 0xe0, 0xcf, 0x33, 0xcf, 0x03, 0xf8, 0x05, 0xf8, 0xcd, 0xaf, 0xc6, 0x72, 0x79, 0xb5, 0xec,
+		0xf9, 0x69, 0x1a, 0x1b, 0xb5, 0xe5, 0xfa, 0xa5, 0x7a, 0xad, 0x2a, 0x1f, 0xc2, 0x0f, 0x6a, 0x05,
+		0x0b, 0xbd, 0xaf, 0x2a, 0x1f, 0x86, 0xd9, 0x60, 0x7e, 0xe5, 0x85, 0x66, 0xad, 0x21, 0xc7, 0x96,
+		0x2e, 0xe3, 0xdb, 0xc0, 0xf8, 0x1d, 0x4d, 0x5c, 0x00, 0x8d, 0xb6, 0x66, 0x5a, 0xe4, 0xc4, 0xfe,
+		0x5f, 0xda, 0x2c, 0xfd, 0xd6, 0x7f, 0x64, 0xdf, 0xc7, 0xc8, 0x33, 0xc4, 0x32, 0xe2, 0xd5, 0x2d,
+		0x7c, 0x65, 0x92, 0x5e, 0x16, 0xa7, 0x5f, 0xff, 0xd1, 0x4c, 0xc1, 0xff, 0xc9, 0x0f, 0x18, 0x22,
+		0x3d, 0x3c, 0xde, 0x9f, 0xf3, 0xd1, 0xbd, 0xd1, 0x5b, 0x7a, 0xd6, 0xff, 0xa2, 0x89, 0xd7, 0xc0,
+		0x89, 0x14, 0x7f, 0x9b, 0xb7, 0x50, 0xbc, 0x5f, 0x2c, 0x9a, 0xb8, 0x0a, 0xb3, 0x7a, 0xab, 0x65,
+		0xf4, 0x42, 0xed, 0x9b, 0xf0, 0x66, 0x9c, 0xe8, 0xad, 0xcc, 0x31, 0xfd, 0xa6, 0x3d, 0x09, 0x49,
+		0x87, 0x0e, 0xca, 0x24, 0x12, 0xa2, 0x39, 0x1c, 0x1c, 0x1f, 0xbc, 0x66, 0x62, 0xe0, 0xf5, 0x68,
+		0x02, 0x81, 0x7f, 0xcb, 0x09, 0xe4, 0x28, 0x9a, 0xe8, 0x8d, 0x05, 0x18, 0x57, 0xdd, 0xc1, 0xa8,
+		0x45, 0xbf, 0x37, 0xfb, 0xbf, 0x9b, 0xfc, 0x4f, 0x7f, 0xe9, 0x51, 0xef, 0x44, 0x3d, 0x20, 0x74,
+		0x11, 0x93, 0x45, 0x95, 0x39, 0x6d, 0xbf, 0xbf, 0x06, 0x14, 0x44, 0x7d, 0xbc, 0xdf, 0xfb, 0x57,
+		0xf6, 0xcf, 0x78, 0x65, 0x27, 0xa2, 0x24, 0x3c, 0x50, 0x53, 0x9e, 0x53, 0x65, 0x05, 0x4b, 0x15,
+		0xc8, 0x6f, 0x9b, 0x9d, 0xc0, 0x70, 0xef, 0x5f, 0xcb, 0x3f, 0xff, 0xa5, 0x47, 0xd9, 0x44, 0x43,
+		0x24, 0xce, 0x9a, 0x3b, 0xfa, 0x5e, 0x0b, 0xa5, 0xfe, 0x74, 0xb0, 0xa9, 0x9e, 0x76, 0xfa, 0x44,
+		0x1c, 0x4e, 0xb0, 0x82, 0xb3, 0x5b, 0xba, 0x63, 0xa0, 0xe2, 0x32, 0x5c, 0xfd, 0xdc, 0xd9, 0x96,
+		0x6d, 0x5a, 0xbe, 0x53, 0x11, 0xcb, 0x17, 0xb1, 0x7c, 0x91, 0x97, 0x8f, 0xf1, 0x69, 0x8d, 0x57,
+		0x74, 0x0b, 0xa3, 0xdf, 0x0c, 0x52, 0x3a, 0x90, 0x58, 0xb6, 0x4d, 0xfa, 0x75, 0xfa, 0xb6, 0x61,
+		0xd9, 0x5d, 0x11, 0xbd, 0x48, 0x13, 0xe4, 0x32, 0x24, 0xf5, 0xae, 0x3d, 0xb0, 0xf8, 0xcb, 0x79,
+		0x95, 0x47, 0x71, 0x2d, 0xfc, 0xc3, 0x57, 0x4f, 0x1e, 0x66, 0x64, 0x9d, 0xf6, 0xf5, 0x45, 0xd3,
+		0x3e, 0xdb, 0xd5, 0xdd, 0x5d, 0x1c, 0xe4, 0xdf, 0xff, 0xd4, 0x23, 0xc0, 0xeb, 0xab, 0x5b, 0xee,
+		0x27, 0x3f, 0xff, 0xf3, 0x67, 0x24, 0x95, 0xe3, 0x33, 0xb7, 0xa3, 0xd2, 0x83, 0x54, 0xd5, 0x68,
+		0xed, 0x53, 0x61, 0x7d, 0xa8, 0xc2, 0x73, 0xbc, 0xc2, 0xe3, 0xa3, 0x15, 0xb2, 0xaf, 0x5e, 0x57,
+		0x8d, 0x56, 0xa0, 0xda, 0xaa, 0xd1, 0x0a, 0xd7, 0x58, 0xa9, 0xfe, 0xc1, 0x7f, 0x3b, 0x71, 0xe8,
+		0xbd, 0x9f, 0x3d, 0x71, 0x68, 0xec, 0x90, 0x29, 0x93, 0x3f, 0xb1, 0xe3, 0x8d, 0xd4, 0xff, 0x92,
+		0xe0, 0x18, 0x93, 0x16, 0x7f, 0x79, 0xd0, 0xad, 0xbd, 0x71, 0xcf, 0x21, 0x5c, 0x80, 0x78, 0xd9,
+		0xda, 0x43, 0xdf, 0x0a, 0x92, 0xd1, 0x06, 0xfd, 0x8e, 0x08, 0xfa, 0xc4, 0xf4, 0x66, 0xbf, 0x13,
+		0x0e, 0x30, 0xf0, 0xde, 0x44, 0xfb, 0xee, 0x03, 0xbe, 0x84, 0x90, 0x2e, 0x5b, 0x7b, 0xe2, 0x0d,
+		0x84, 0xb7, 0x4c, 0xf9, 0x06, 0x82, 0x6e, 0xed, 0xf5, 0xb6, 0x0e, 0xfa, 0xf4, 0xc1, 0xfb, 0xdf,
+		0x0e, 0xf7, 0x71, 0x1e, 0x39, 0xae, 0x7e, 0xdd, 0xb4, 0x76, 0x3c, 0x61, 0xe5, 0x69, 0xce, 0x8a,
+		0x23, 0x7c, 0x40, 0x44, 0xae, 0x10, 0xd9, 0x51, 0x09, 0x5c, 0xd8, 0xf7, 0x2d, 0x85, 0x85, 0xfd,
+		0xbd, 0xc9, 0x0b, 0x13, 0xe6, 0xcd, 0x7e, 0x93, 0x61, 0xcc, 0xec, 0x19, 0x3b, 0xbc, 0x13, 0xdf,
+		0x6d, 0x9b, 0xe8, 0x4c, 0x7e, 0x45, 0x82, 0xc2, 0x65, 0xd3, 0x71, 0xed, 0xbe, 0xd9, 0xd2, 0x3b,
+		0xf4, 0x20, 0xfd, 0xad, 0x53, 0x5b, 0xff, 0x95, 0x0c, 0x4e, 0x05, 0x3e, 0xa9, 0x76, 0x85, 0x01,
+		0x9e, 0xbc, 0xa1, 0x77, 0x98, 0xe5, 0x1d, 0xd4, 0xbb, 0xc3, 0x6c, 0x0f, 0x9c, 0x2f, 0x07, 0xa9,
+		0x30, 0x5c, 0x7c, 0x1f, 0x49, 0xf9, 0x3e, 0x0c, 0xf6, 0x44, 0xfb, 0xcd, 0xa1, 0xe1, 0x61, 0x34,
+		0x00, 0xe9, 0x0a, 0x24, 0xfa, 0xba, 0xcb, 0x8d, 0x90, 0xca, 0x85, 0x03, 0xcf, 0x44, 0x56, 0x0b,
+		0xa5, 0x41, 0xde, 0x01, 0xf8, 0x18, 0x98, 0x46, 0xe9, 0xc5, 0x5e, 0x17, 0x3d, 0x7c, 0x13, 0x0d,
+		0xdb, 0x47, 0xde, 0x49, 0x3f, 0x34, 0x89, 0x97, 0x36, 0xac, 0x1d, 0x83, 0x51, 0x8e, 0xbf, 0x2e,
+		0xca, 0xf9, 0xae, 0x7e, 0x6b, 0x99, 0x52, 0x43, 0xfa, 0x5c, 0x63, 0xfd, 0xba, 0x04, 0xe0, 0x33,
+		0x86, 0xe8, 0x18, 0xc2, 0x2c, 0x52, 0xb4, 0x52, 0x11, 0xc2, 0xfc, 0xe0, 0x38, 0xde, 0x0f, 0xb1,
+		0xb5, 0x92, 0xc7, 0xe6, 0x7d, 0xe6, 0xd5, 0x93, 0x12, 0xab, 0xb5, 0xd8, 0x1a, 0x61, 0x7b, 0x96,
+		0xc5, 0x90, 0x69, 0xd4, 0xb3, 0x1d, 0x9b, 0xb8, 0x09, 0xcd, 0x8b, 0x4d, 0x28, 0x23, 0x08, 0x0c,
+		0x1b, 0xcb, 0x79, 0x1f, 0xfe, 0x4a, 0x82, 0x6c, 0x35, 0x60, 0x27, 0x62, 0x0c, 0xba, 0x6d, 0x99,
+		0xd7, 0x8d, 0xbe, 0x17, 0x83, 0xce, 0x92, 0x78, 0xda, 0xc4, 0x3e, 0xa5, 0xed, 0xee, 0x89, 0xd3,
+		0x26, 0x91, 0x46, 0xac, 0x9b, 0xc6, 0x96, 0x63, 0x0a, 0x3e, 0xab, 0x22, 0x89, 0xfe, 0x5c, 0xc7,
+		0x68, 0x0d, 0x30, 0x2c, 0x56, 0x6b, 0xd9, 0x96, 0x8b, 0x5f, 0xd4, 0x67, 0x61, 0x2e, 0x45, 0x91,
+		0xbf, 0xcc, 0xb2, 0x91, 0x48, 0xdb, 0x70, 0x75, 0xb3, 0xc3, 0xae, 0x71, 0x67, 0x54, 0x91, 0x24,
+		0x2b, 0x81, 0xe3, 0xff, 0xa4, 0x77, 0x3a, 0x11, 0xc9, 0xd1, 0xab, 0x1c, 0x2e, 0x28, 0xcc, 0x1e,
+		0x32, 0xef, 0xf3, 0x36, 0xa4, 0x05, 0x18, 0xde, 0x21, 0xef, 0xf5, 0x6d, 0xba, 0xea, 0xf7, 0xcc,
+		0x96, 0x36, 0xe8, 0x9b, 0xbc, 0xdf, 0x79, 0x9e, 0xbd, 0x61, 0xb6, 0x36, 0xfb, 0x26, 0x06, 0x46,
+		0x38, 0x76, 0x8b, 0x5e, 0x21, 0xd7, 0xad, 0x76, 0x07, 0x15, 0xb6, 0xc9, 0x22, 0xcf, 0x32, 0xaa,
+		0xcc, 0x4a, 0x2e, 0xd3, 0x82, 0xcd, 0xbe, 0xe9, 0xf0, 0x7a, 0x6e, 0xa7, 0x82, 0x91, 0x46, 0xcb,
+		0x20, 0xdb, 0x3d, 0xa3, 0x1f, 0xf2, 0xf8, 0xb0, 0xe9, 0x53, 0xfa, 0xfd, 0x4f, 0x3d, 0x32, 0xcf,
+		0xfb, 0xc3, 0x7d, 0x3e, 0xec, 0xfd, 0x4c, 0xb5, 0x28, 0x30, 0x78, 0x36, 0x79, 0x21, 0x14, 0x26,
+		0x3f, 0xd8, 0xf2, 0x9f, 0x7c, 0x9a, 0x1f, 0x91, 0x82, 0xb2, 0xb5, 0x57, 0x29, 0xfd, 0xb6, 0x4f,
+		0x9a, 0xef, 0x17, 0x37, 0x68, 0x74, 0x51, 0x30, 0x64, 0x9e, 0x92, 0x41, 0x8f, 0xcb, 0x4b, 0xba,
+		0xd9, 0x31, 0xda, 0x3c, 0x74, 0x8d, 0xa7, 0xf0, 0x0a, 0x11, 0x0f, 0x03, 0x65, 0x9f, 0x7b, 0x55,
+		0xc6, 0xb1, 0xbe, 0x62, 0x5b, 0xed, 0x70, 0xf4, 0x27, 0x86, 0x90, 0xba, 0xf6, 0x75, 0xc3, 0xe2,
+		0x23, 0x5a, 0x79, 0xf8, 0x00, 0x36, 0x82, 0xca, 0x51, 0xc9, 0x37, 0x81, 0xdc, 0x36, 0x3a, 0xc6,
+		0x0e, 0x73, 0x25, 0xec, 0xea, 0xe8, 0xdf, 0x4b, 0xde, 0xa9, 0x05, 0x50, 0xf4, 0x48, 0x35, 0x28,
+		0x25, 0xb2, 0x11, 0xde, 0x3a, 0xa5, 0xbc, 0x1b, 0x5e, 0x91, 0x7d, 0x0c, 0x4c, 0x95, 0xa0, 0x84,
+		0x85, 0xb6, 0x5a, 0x0f, 0xe1, 0x63, 0xff, 0x5b, 0xb6, 0x45, 0xbf, 0x5d, 0xca, 0x9d, 0x58, 0x69,
+		0x76, 0x75, 0xc2, 0xcb, 0xe7, 0x57, 0x27, 0x36, 0xa0, 0xe0, 0x83, 0xd2, 0x29, 0x9d, 0x39, 0xe8,
+		0x94, 0xce, 0x7b, 0x04, 0x10, 0x04, 0x3f, 0x72, 0xea, 0x2b, 0x0d, 0xea, 0x66, 0xcf, 0x9e, 0x57,
+		0x26, 0xab, 0x9f, 0x60, 0x67, 0x02, 0x04, 0x88, 0x05, 0x73, 0x5d, 0xd3, 0xd2, 0x1c, 0xa3, 0xb3,
+		0xad, 0x71, 0xce, 0x21, 0xdd, 0x2c, 0x65, 0xff, 0xdb, 0x0f, 0x30, 0x9a, 0x7f, 0xf8, 0xa9, 0x47,
+		0x8a, 0xbe, 0xe9, 0x74, 0xea, 0xd1, 0xc5, 0xc7, 0x9f, 0x54, 0x67, 0xbb, 0xa6, 0xd5, 0x30, 0x3a,
+		0xdb, 0x55, 0x8f, 0x30, 0x79, 0x2b, 0x1c, 0xf7, 0x19, 0x62, 0x5b, 0xda, 0xae, 0xdd, 0x69, 0x6b,
+		0x7d, 0x63, 0x5b, 0x6b, 0x51, 0xc3, 0x2f, 0x47, 0xd9, 0x78, 0xd4, 0x03, 0x59, 0xb7, 0x2e, 0xdb,
+		0x9d, 0xb6, 0x6a, 0x6c, 0x2f, 0x63, 0x31, 0x9e, 0x30, 0xf9, 0xd8, 0x66, 0x1b, 0x2f, 0xac, 0xc5,
+		0xf1, 0xed, 0x0d, 0x2f, 0xb3, 0xde, 0x76, 0x96, 0xd2, 0x1f, 0xf8, 0xf8, 0xc9, 0x43, 0xaf, 0x7d,
+		0xfc, 0xe4, 0x21, 0xe5, 0x12, 0x7d, 0x14, 0x8e, 0x4f, 0x2d, 0xc3, 0x21, 0x17, 0x20, 0xa3, 0x8b,
+		0x04, 0x7b, 0x72, 0x72, 0x9f, 0xa9, 0xe9, 0x83, 0xe2, 0x6b, 0x9b, 0xc9, 0xea, 0xb5, 0x0d, 0xdd,
+		0xec, 0x93, 0x1a, 0x6e, 0x8c, 0x84, 0xac, 0x4e, 0x3b, 0xcb, 0x7d, 0xf1, 0x16, 0xd3, 0x7c, 0x6d,
+		0x9c, 0x7b, 0x38, 0x53, 0xb9, 0xe7, 0xf7, 0x3f, 0xf5, 0xc8, 0xdd, 0x9c, 0xcc, 0xb5, 0x21, 0x4f,
+		0xb1, 0xa0, 0x37, 0xec, 0x41, 0x0e, 0xf4, 0xf9, 0x0a, 0xa4, 0x58, 0x53, 0x1d, 0xf2, 0x0c, 0xcc,
+		0xf4, 0xf0, 0x47, 0x49, 0x0a, 0xed, 0x95, 0x46, 0x65, 0x9e, 0xc2, 0x07, 0x25, 0x84, 0xe1, 0x29,
+		0x1f, 0x8c, 0x01, 0x54, 0xaf, 0x5d, 0x6b, 0xf6, 0xcd, 0x5e, 0xc7, 0x70, 0xdf, 0xa8, 0xbe, 0x6f,
+		0xc2, 0x61, 0xbf, 0xef, 0x4e, 0xbf, 0x75, 0xf0, 0xfe, 0xcf, 0xf9, 0x3e, 0xc4, 0x7e, 0x2b, 0x92,
+		0x6c, 0xdb, 0x71, 0x3d, 0xb2, 0xf1, 0x83, 0x93, 0xad, 0x3a, 0xee, 0x28, 0x67, 0x9f, 0x87, 0xac,
+		0xcf, 0x0c, 0x87, 0xd4, 0x21, 0xed, 0xf2, 0xdf, 0x9c, 0xc1, 0xca, 0x78, 0x06, 0x0b, 0xb4, 0xd0,
+		0xaa, 0x25, 0xd0, 0xf1, 0xa2, 0x11, 0x04, 0xe6, 0xc8, 0x9b, 0x53, 0xc6, 0x70, 0x7b, 0xc6, 0x95,
+		0x73, 0xfc, 0x8e, 0xb7, 0x67, 0x8c, 0x40, 0x80, 0xa9, 0x1f, 0x8a, 0xc1, 0xdc, 0xa6, 0x98, 0xbd,
+		0x6f, 0x7e, 0x1e, 0x6c, 0x42, 0xca, 0xb0, 0xdc, 0xbe, 0xe9, 0xdd, 0x1f, 0x78, 0x74, 0xdc, 0x98,
+		0x47, 0x74, 0xaa, 0x66, 0xb9, 0xfd, 0xbd, 0xa0, 0x04, 0x08, 0x5a, 0x01, 0x7e, 0x7c, 0x34, 0x0e,
+		0xa5, 0x71, 0xa8, 0x78, 0xa0, 0xdb, 0xea, 0x1b, 0x34, 0x23, 0xfc, 0x02, 0x6a, 0x41, 0x64, 0xf3,
+		0x65, 0x47, 0x05, 0xb4, 0x2c, 0x51, 0xb8, 0x10, 0xf4, 0xce, 0x4c, 0xc9, 0x82, 0x4f, 0x81, 0x2e,
+		0x3c, 0x4d, 0x28, 0x8a, 0x47, 0x76, 0xb6, 0xf4, 0x8e, 0x8e, 0xcf, 0x46, 0xc7, 0x0f, 0xbe, 0xe6,
+		0x8b, 0x87, 0x7a, 0x2a, 0x8c, 0x04, 0xa9, 0x41, 0x4a, 0x50, 0x4b, 0x1c, 0x9c, 0x9a, 0xc0, 0xc5,
+		0xe7, 0x94, 0x82, 0x0b, 0x03, 0xb5, 0x46, 0x12, 0x6a, 0xd6, 0xcb, 0xab, 0xb7, 0x27, 0xad, 0x3c,
+		0xc9, 0x7d, 0x57, 0x1e, 0x6e, 0xf0, 0xfd, 0x70, 0x1c, 0x66, 0x55, 0xa3, 0xfd, 0xb7, 0x7f, 0x58,
+		0x36, 0x00, 0xd8, 0x54, 0x45, 0x4d, 0x5a, 0x4a, 0xdc, 0xe9, 0x7c, 0xcf, 0x30, 0x22, 0x55, 0xc7,
+		0xfd, 0x9b, 0x1a, 0xa1, 0xff, 0x1c, 0x83, 0x5c, 0x70, 0x84, 0xfe, 0x4e, 0x2e, 0x5a, 0x64, 0xcd,
+		0x57, 0x53, 0xec, 0xa0, 0xfc, 0xa1, 0x71, 0x6a, 0x6a, 0x44, 0x9a, 0x27, 0xe8, 0xa7, 0xdf, 0x49,
+		0x41, 0x92, 0x5f, 0xc1, 0x5d, 0x1f, 0xb1, 0x6d, 0x27, 0x3e, 0x7f, 0x9d, 0x17, 0x2f, 0x88, 0x47,
+		0x9a, 0xb6, 0xf7, 0x03, 0xbe, 0x2b, 0xae, 0x85, 0xee, 0xf5, 0x62, 0xd4, 0x1c, 0xee, 0xcd, 0xbd,
+		0xde, 0x3b, 0x78, 0xb7, 0x19, 0xc1, 0x7c, 0x3d, 0x8c, 0x30, 0xf8, 0x24, 0x53, 0x8d, 0xe5, 0x90,
+		0x73, 0x40, 0x76, 0x3d, 0x4f, 0x8b, 0xe6, 0x33, 0x42, 0x3a, 0x9d, 0xa7, 0x2f, 0xe3, 0xce, 0xfa,
+		0xa5, 0x02, 0x05, 0x4f, 0x0c, 0x6d, 0xab, 0xad, 0x31, 0xaf, 0x24, 0xff, 0x46, 0x37, 0xe6, 0x54,
+		0x31, 0x83, 0xbc, 0x4f, 0x62, 0x66, 0xf2, 0xd0, 0xf6, 0x9f, 0xef, 0x52, 0x9a, 0x53, 0x4c, 0x8c,
+		0x2f, 0xbd, 0x7a, 0x72, 0x61, 0x4f, 0xef, 0x76, 0x96, 0x94, 0x08, 0x3a, 0x4a, 0x94, 0x47, 0x02,
+		0x8d, 0xe7, 0xb0, 0xfb, 0x80, 0xd4, 0x41, 0xc6, 0xab, 0x24, 0x7d, 0xfe, 0xc1, 0x7f, 0x6d, 0xdb,
+		0x10, 0xaf, 0xd0, 0x1f, 0x5b, 0x8c, 0xf0, 0x11, 0x2f, 0xa2, 0xaf, 0x95, 0x1f, 0x61, 0x16, 0xae,
+		0x1b, 0x7b, 0x2a, 0xc7, 0xbb, 0x64, 0x18, 0xe4, 0xdd, 0x70, 0x6c, 0xa7, 0x63, 0x6f, 0xe1, 0x2b,
+		0x55, 0xe6, 0xbb, 0x07, 0x66, 0x5b, 0xe3, 0x82, 0xa1, 0xb5, 0xf4, 0x5e, 0x29, 0xfd, 0xba, 0x5c,
+		0x29, 0x47, 0x18, 0xe1, 0x55, 0x4a, 0xb7, 0xc1, 0xc8, 0x2e, 0xeb, 0x3d, 0x72, 0x13, 0xee, 0xf2,
+		0x65, 0x3d, 0xa2, 0xd6, 0xcc, 0xeb, 0xaa, 0xf5, 0x98, 0x47, 0x7b, 0xa4, 0xe2, 0x1a, 0x8d, 0xdb,
+		0x15, 0x0c, 0xe7, 0x3e, 0xa3, 0x9e, 0xd1, 0x37, 0xed, 0xb6, 0xd6, 0x31, 0xac, 0x1d, 0x97, 0x85,
+		0xc5, 0x25, 0xd4, 0xbb, 0x7c, 0x30, 0xe6, 0x0b, 0xda, 0xa0, 0x40, 0xab, 0x14, 0x26, 0x9a, 0x0c,
+		0x7d, 0xb4, 0x9d, 0x13, 0x73, 0xe8, 0xb6, 0x29, 0x3f, 0x4a, 0x86, 0x3e, 0xd2, 0xce, 0x68, 0x39,
+		0xc4, 0x08, 0xec, 0xb8, 0xa8, 0xcc, 0xd1, 0xb9, 0x41, 0x77, 0x3e, 0x77, 0xde, 0x7b, 0x99, 0xef,
+		0xb4, 0x70, 0x8b, 0xae, 0x22, 0xbd, 0xa5, 0xfb, 0x50, 0x1d, 0xbe, 0xfc, 0xf9, 0x9f, 0x3f, 0x73,
+		0x3c, 0xe0, 0xd0, 0xbe, 0xe5, 0x79, 0x6e, 0xd9, 0x1c, 0x56, 0xde, 0x17, 0x03, 0x79, 0x79, 0xa8,
+		0xb5, 0xd1, 0x46, 0x93, 0x74, 0xe7, 0x46, 0x93, 0xf0, 0x25, 0xc6, 0xde, 0x00, 0x5f, 0x22, 0xba,
+		0x9b, 0x5a, 0xbb, 0x46, 0x7b, 0xd0, 0xa1, 0xf1, 0x0b, 0x81, 0xa8, 0xd0, 0xa2, 0x97, 0xcf, 0x57,
+		0xdb, 0x87, 0x40, 0x36, 0xb6, 0xb7, 0x8d, 0x96, 0x6b, 0xde, 0x30, 0x86, 0x5e, 0x38, 0xf0, 0xf2,
+		0x19, 0xa8, 0xf2, 0x91, 0x38, 0x14, 0x99, 0xd8, 0xf8, 0xee, 0x9c, 0xd5, 0xb1, 0xee, 0x9c, 0x29,
+		0x98, 0x30, 0xe2, 0xd7, 0x39, 0x09, 0x59, 0xba, 0x16, 0x72, 0x0d, 0xc3, 0xfc, 0x6b, 0x6c, 0x8d,
+		0x65, 0x2a, 0xe6, 0x1b, 0x21, 0x2f, 0xe6, 0x44, 0xd0, 0xc8, 0xbe, 0x53, 0x6e, 0xe5, 0x18, 0x31,
+		0xee, 0x03, 0xd9, 0xf4, 0x88, 0x73, 0x6f, 0x4d, 0xe2, 0x0e, 0x4f, 0x74, 0x38, 0xd9, 0x26, 0xa5,
+		0x42, 0xde, 0x09, 0x84, 0x75, 0x8a, 0x52, 0xd5, 0x9c, 0x01, 0xde, 0x24, 0x29, 0xcd, 0xdc, 0x21,
+		0x6d, 0x99, 0xd2, 0xa2, 0xa4, 0x1b, 0x94, 0x12, 0xee, 0xbb, 0x89, 0x6f, 0x03, 0x07, 0x9e, 0x92,
+		0x80, 0x80, 0xab, 0x42, 0xda, 0xdf, 0x05, 0xe2, 0xe3, 0x87, 0x5c, 0x20, 0x3e, 0x01, 0x0c, 0x63,
+		0x16, 0x96, 0x53, 0x6c, 0x92, 0x32, 0x0d, 0x2e, 0x8e, 0x1c, 0x89, 0x1a, 0x1e, 0x87, 0x94, 0x7f,
+		0x27, 0xc1, 0xb1, 0x91, 0xc5, 0xd4, 0x6b, 0x72, 0x0b, 0x48, 0x3f, 0x50, 0x48, 0x17, 0x25, 0xf1,
+		0xcc, 0xf9, 0x9d, 0xad, 0xcd, 0xb3, 0xfd, 0xe1, 0xd2, 0x37, 0xc8, 0x96, 0xe6, 0x86, 0xd4, 0x6f,
+		0x49, 0x30, 0x1f, 0x6c, 0x80, 0xd7, 0x95, 0x06, 0xe4, 0x82, 0x55, 0xf3, 0x4e, 0xdc, 0x37, 0x4d,
+		0x27, 0x82, 0xed, 0x0f, 0x11, 0x21, 0xd7, 0x7c, 0x83, 0x85, 0x9d, 0x66, 0x9c, 0x9b, 0x9a, 0x29,
+		0xa2, 0x61, 0x91, 0x86, 0x0b, 0x1b, 0x9b, 0x3f, 0x95, 0x20, 0xb1, 0x61, 0xdb, 0x1d, 0xf2, 0x6e,
+		0x98, 0xb5, 0x6c, 0x97, 0xaa, 0x5d, 0xc3, 0x9b, 0x0b, 0x6c, 0x52, 0xd7, 0xf6, 0xe5, 0xd5, 0x17,
+		0x5e, 0x3d, 0x39, 0x8a, 0x19, 0x25, 0xc4, 0x45, 0xcb, 0x76, 0x2b, 0x14, 0x88, 0xcf, 0x91, 0x6d,
+		0xc8, 0x87, 0xab, 0x63, 0x5a, 0xb0, 0x3c, 0xa9, 0xba, 0xfc, 0xc4, 0xaa, 0x72, 0x5b, 0x81, 0x7a,
+		0xd8, 0x77, 0x1d, 0xfe, 0x12, 0x47, 0xee, 0x9d, 0x20, 0x5f, 0x1b, 0xbe, 0xab, 0x7e, 0x09, 0x52,
+		0xe2, 0x6e, 0xba, 0x34, 0xed, 0xbd, 0xf7, 0x20, 0x3f, 0x39, 0x32, 0x3d, 0x2e, 0xfa, 0x4c, 0x0c,
+		0x8e, 0x61, 0x4c, 0x3f, 0xf7, 0x33, 0x73, 0xa3, 0x82, 0x9d, 0x6d, 0xed, 0xa1, 0xd6, 0x8d, 0x54,
+		0x9b, 0xb9, 0x51, 0x9d, 0x78, 0x0d, 0x8a, 0x68, 0xe1, 0xa3, 0x9f, 0xfa, 0xf5, 0xb9, 0xba, 0xf3,
+		0x76, 0xa7, 0xcd, 0x5b, 0x84, 0x8e, 0xee, 0x6b, 0x50, 0xb4, 0x8c, 0x9b, 0x21, 0xba, 0xf1, 0x3b,
+		0xa3, 0x6b, 0x19, 0x37, 0x03, 0x74, 0xfd, 0x90, 0xc5, 0x44, 0xe8, 0x02, 0xe4, 0x05, 0x88, 0xa3,
+		0x25, 0x36, 0x73, 0x00, 0xe5, 0x81, 0x08, 0x01, 0xab, 0xba, 0x01, 0xc7, 0xb8, 0xa3, 0xd2, 0x59,
+		0xdf, 0xa6, 0x1c, 0x35, 0x68, 0x87, 0x9e, 0x35, 0xf6, 0x22, 0xbc, 0x96, 0xb9, 0xa9, 0xbc, 0x96,
+		0x67, 0x7e, 0x45, 0x02, 0xf0, 0x5d, 0xf6, 0x18, 0xf6, 0x55, 0x59, 0x5f, 0xab, 0xfa, 0x77, 0xc1,
+		0x02, 0x91, 0x3d, 0x22, 0x48, 0xcc, 0xe9, 0x19, 0x2d, 0x73, 0xdb, 0x34, 0xda, 0xe4, 0x01, 0x98,
+		0x0f, 0x43, 0x63, 0xaa, 0x56, 0x95, 0xa5, 0x85, 0xdc, 0xcb, 0xb7, 0x4f, 0xa5, 0x99, 0x8b, 0xc2,
+		0xc0, 0x3b, 0x10, 0x87, 0x47, 0xe1, 0x30, 0x2a, 0x28, 0xb6, 0x90, 0x7f, 0xf9, 0xf6, 0xa9, 0x8c,
+		0xe7, 0xcb, 0x20, 0x0a, 0x90, 0x20, 0x24, 0xa7, 0x17, 0x5f, 0x80, 0x97, 0x6f, 0x9f, 0x4a, 0xb2,
+		0x29, 0xc3, 0x2f, 0x91, 0x7d, 0x33, 0x7e, 0xca, 0x6c, 0xbb, 0xaf, 0xb7, 0xa8, 0x6a, 0x58, 0x80,
+		0x23, 0xf5, 0xb5, 0x4b, 0x6a, 0x79, 0xb9, 0x89, 0xd7, 0xce, 0x42, 0xcd, 0x1e, 0x2a, 0xab, 0xae,
+		0x6f, 0x56, 0x56, 0x6b, 0x1a, 0x06, 0x9f, 0xb1, 0x1b, 0x9f, 0xa1, 0xb2, 0xe7, 0xd6, 0x9a, 0xf5,
+		0xab, 0x35, 0x39, 0x56, 0xb9, 0x30, 0x36, 0x1a, 0xe0, 0xae, 0xd0, 0x64, 0xf4, 0x8d, 0xa5, 0x50,
+		0x1c, 0xc0, 0xff, 0x1e, 0x00, 0xbc, 0xff, 0x89, 0x97, 0xf5, 0xb9, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2598,6 +2855,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.CommissionChangePeriodLength != that1.CommissionChangePeriodLength {
+		return false
+	}
+	if this.CommissionChangeDelayPeriods != that1.CommissionChangeDelayPeriods {
+		return false
+	}
+	if !this.MinSelfBondRatio.Equal(that1.MinSelfBondRatio) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3540,6 +3812,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinSelfBondRatio.Size()
+		i -= size
+		if _, err := m.MinSelfBondRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.CommissionChangeDelayPeriods != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CommissionChangeDelayPeriods))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CommissionChangePeriodLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CommissionChangePeriodLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3593,6 +3905,123 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareTokenSupply.Size()
+		i -= size
+		if _, err := m.ShareTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidTokens.Size()
+		i -= size
+		if _, err := m.LiquidTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4286,45 +4715,101 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CommissionChangePeriodLength != 0 {
+		n += 1 + sovStaking(uint64(m.CommissionChangePeriodLength))
+	}
+	if m.CommissionChangeDelayPeriods != 0 {
+		n += 1 + sovStaking(uint64(m.CommissionChangeDelayPeriods))
+	}
+	l = m.MinSelfBondRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *DelegationResponse) Size() (n int) {
+func (m *CommissionChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ScheduledHeight))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovStaking(uint64(m.EffectiveHeight))
+	}
 	return n
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *LiquidValidator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.LiquidShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.LiquidTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ShareTokenSupply.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *RedelegationResponse) Size() (n int) {
+func (m *DelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
+	l = m.Delegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -7241,6 +7726,516 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangePeriodLength", wireType)
+			}
+			m.CommissionChangePeriodLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChangePeriodLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeDelayPeriods", wireType)
+			}
+			m.CommissionChangeDelayPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChangeDelayPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBondRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfBondRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareTokenSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -49,12 +49,22 @@ func (h *HandlerMap) DefaultMode() signingv1beta1.SignMode {
 	return h.defaultMode
 }
 
-// GetSignBytes returns the sign bytes for the transaction for the requested mode.
-func (h *HandlerMap) GetSignBytes(ctx context.Context, signMode signingv1beta1.SignMode, signerData SignerData, txData TxData) ([]byte, error) {
+// GetHandler returns the sign mode handler of the requested mode.
+func (h *HandlerMap) GetHandler(signMode signingv1beta1.SignMode) (SignModeHandler, error) {
 	handler, ok := h.signModeHandlers[signMode]
 	if !ok {
 		return nil, fmt.Errorf("unsupported sign mode %s", signMode)
 	}
 
+	return handler, nil
+}
+
+// GetSignBytes returns the sign bytes for the transaction for the requested mode.
+func (h *HandlerMap) GetSignBytes(ctx context.Context, signMode signingv1beta1.SignMode, signerData SignerData, txData TxData) ([]byte, error) {
+	handler, err := h.GetHandler(signMode)
+	if err != nil {
+		return nil, err
+	}
+
 	return handler.GetSignBytes(ctx, signerData, txData)
 }
@@ -48,3 +48,15 @@ func TestNewHandlerMap(t *testing.T) {
 	require.Equal(t, dh.Mode(), handlerMap.DefaultMode())
 	require.NotEqual(t, ah.Mode(), handlerMap.DefaultMode())
 }
+
+func TestHandlerMapGetHandler(t *testing.T) {
+	dh := directHandler{}
+	handlerMap := signing.NewHandlerMap(dh)
+
+	handler, err := handlerMap.GetHandler(signingv1beta1.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, dh, handler)
+
+	_, err = handlerMap.GetHandler(signingv1beta1.SignMode_SIGN_MODE_TEXTUAL)
+	require.ErrorContains(t, err, "unsupported sign mode")
+}
@@ -47,6 +47,16 @@ type SignModeOptions struct {
 	// TypeResolver are the protobuf type resolvers to use for resolving message
 	// types. If it is nil, then a dynamicpb will be used on top of FileResolver.
 	TypeResolver protoregistry.MessageTypeResolver
+
+	// MessageRenderers are custom value renderers for messages, keyed by the
+	// message full name. They take precedence over the default renderers.
+	MessageRenderers map[protoreflect.FullName]ValueRenderer
+
+	// FieldRenderers are custom value renderers for message fields, keyed by
+	// the field full name (e.g. "cosmos.bank.v1beta1.MsgSend.amount"). They take
+	// precedence over the message and scalar renderers. For repeated fields,
+	// the renderer formats each element unless it is a RepeatedValueRenderer.
+	FieldRenderers map[protoreflect.FullName]ValueRenderer
 }
 
 // SignModeHandler holds the configuration for dispatching
@@ -64,6 +74,8 @@ type SignModeHandler struct {
 	// - Protobuf timestamp
 	// - Protobuf duration
 	messages map[protoreflect.FullName]ValueRenderer
+	// fields defines a registry for custom field renderers.
+	fields map[protoreflect.FullName]ValueRenderer
 }
 
 // NewSignModeHandler returns a new SignModeHandler which generates sign bytes and provides  value renderers.
@@ -85,6 +97,13 @@ func NewSignModeHandler(o SignModeOptions) (*SignModeHandler, error) {
 	}
 	t.init()
 
+	for name, vr := range o.MessageRenderers {
+		t.DefineMessageRenderer(name, vr)
+	}
+	for name, vr := range o.FieldRenderers {
+		t.DefineFieldRenderer(name, vr)
+	}
+
 	return t, nil
 }
 
@@ -96,6 +115,10 @@ func (r *SignModeHandler) SpecVersion() uint64 {
 
 // GetFieldValueRenderer returns the value renderer for the given FieldDescriptor.
 func (r *SignModeHandler) GetFieldValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
+	if vr, found := r.fields[fd.FullName()]; found {
+		return vr, nil
+	}
+
 	switch {
 	// Scalars, such as math.Int and math.Dec encoded as strings.
 	case fd.Kind() == protoreflect.StringKind:
@@ -177,6 +200,9 @@ func (r *SignModeHandler) init() {
 		r.messages[(&anypb.Any{}).ProtoReflect().Descriptor().FullName()] = NewAnyValueRenderer(r)
 		r.messages[(&textualpb.TextualData{}).ProtoReflect().Descriptor().FullName()] = NewTxValueRenderer(r)
 	}
+	if r.fields == nil {
+		r.fields = map[protoreflect.FullName]ValueRenderer{}
+	}
 }
 
 // DefineScalar adds a value renderer to the given Cosmos scalar.
@@ -191,9 +217,33 @@ func (r *SignModeHandler) DefineMessageRenderer(name protoreflect.FullName, vr V
 	r.messages[name] = vr
 }
 
+// DefineFieldRenderer adds a new custom field renderer, overriding the renderer
+// of the field type for this field only.
+func (r *SignModeHandler) DefineFieldRenderer(name protoreflect.FullName, vr ValueRenderer) {
+	r.init()
+	r.fields[name] = vr
+}
+
 // GetSignBytes returns the transaction sign bytes which is the CBOR representation
 // of a list of screens created from the TX data.
 func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	screens, err := r.GetScreens(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = encode(screens, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetScreens returns the screens created from the TX data, as they are displayed
+// to the signer before being encoded into the sign bytes.
+func (r *SignModeHandler) GetScreens(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]Screen, error) {
 	data := &textualpb.TextualData{
 		BodyBytes:     txData.BodyBytes,
 		AuthInfoBytes: txData.AuthInfoBytes,
@@ -206,18 +256,7 @@ func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.S
 		},
 	}
 
-	screens, err := NewTxValueRenderer(r).Format(ctx, protoreflect.ValueOf(data.ProtoReflect()))
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-	err = encode(screens, &buf)
-	if err != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
+	return NewTxValueRenderer(r).Format(ctx, protoreflect.ValueOf(data.ProtoReflect()))
 }
 
 func (r *SignModeHandler) Mode() signingv1beta1.SignMode {
@@ -47,6 +47,38 @@ func TestDispatcher(t *testing.T) {
 	}
 }
 
+func TestCustomRenderers(t *testing.T) {
+	fieldRenderer := textual.NewStringValueRenderer()
+	messageRenderer := textual.NewBytesValueRenderer()
+	uint32Fd, uint64Fd := fieldDescriptorFromName("UINT32"), fieldDescriptorFromName("UINT64")
+	timestampFd := fieldDescriptorFromName("TIMESTAMP")
+
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		FieldRenderers:      map[protoreflect.FullName]textual.ValueRenderer{uint32Fd.FullName(): fieldRenderer},
+		MessageRenderers:    map[protoreflect.FullName]textual.ValueRenderer{timestampFd.Message().FullName(): messageRenderer},
+	})
+	require.NoError(t, err)
+
+	// the field renderer only applies to its field
+	rend, err := tr.GetFieldValueRenderer(uint32Fd)
+	require.NoError(t, err)
+	require.Equal(t, fieldRenderer, rend)
+	rend, err = tr.GetFieldValueRenderer(uint64Fd)
+	require.NoError(t, err)
+	require.IsType(t, textual.NewIntValueRenderer(uint64Fd), rend)
+
+	// the message renderer overrides the default one
+	rend, err = tr.GetFieldValueRenderer(timestampFd)
+	require.NoError(t, err)
+	require.Equal(t, messageRenderer, rend)
+
+	tr.DefineFieldRenderer(uint64Fd.FullName(), fieldRenderer)
+	rend, err = tr.GetFieldValueRenderer(uint64Fd)
+	require.NoError(t, err)
+	require.Equal(t, fieldRenderer, rend)
+}
+
 // fieldDescriptorFromName is like GetADR050ValueRenderer, but taking a Go type
 // as input instead of a protoreflect.FieldDescriptor.
 func fieldDescriptorFromName(name string) protoreflect.FieldDescriptor {
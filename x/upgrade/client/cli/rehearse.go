@@ -0,0 +1,351 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagForkDir   = "fork-dir"
+	FlagBlockTime = "block-time"
+)
+
+// RehearsalReport is the outcome of an upgrade rehearsal.
+type RehearsalReport struct {
+	UpgradeName    string                `json:"upgrade_name"`
+	Height         int64                 `json:"height"`
+	Duration       string                `json:"duration"`
+	AppHashBefore  string                `json:"app_hash_before"`
+	AppHashAfter   string                `json:"app_hash_after"`
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	StoreHashes    []StoreHashChange     `json:"store_hashes"`
+}
+
+// ModuleVersionChange describes the consensus version of a module before and after an upgrade.
+// A zero version means the module was not registered.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// StoreHashChange describes the hash of a module store before and after an upgrade.
+// An empty hash means the store was not mounted.
+type StoreHashChange struct {
+	Store  string `json:"store"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// NewRehearseUpgradeCmd returns a command rehearsing a software upgrade against a fork of the node state.
+// It must be run with the new binary, which registers the upgrade handler and store upgrades.
+func NewRehearseUpgradeCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rehearse-upgrade <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Dry-run a software upgrade against a copy of the node state",
+		Long: `Dry-run a software upgrade against a copy of the node state.
+
+The application database of the node is copied to a fork directory, and the named upgrade is
+scheduled at the height following the latest committed height, as if a proposal had passed.
+The upgrade-info.json file is written in the fork, so that the store loader registered by the
+application (types.UpgradeStoreLoader) applies the store upgrades, and a single empty block is
+executed, running the upgrade handler and module migrations.
+
+The node home and data are never modified. The node must be stopped while its data is copied.
+The report contains the migration runtime, the module version changes and the app hash differences.`,
+		Example: fmt.Sprintf("$ %s rehearse-upgrade v2 --home ~/.simd", getDefaultDaemonName()),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			forkDir, err := cmd.Flags().GetString(FlagForkDir)
+			if err != nil {
+				return err
+			}
+
+			if forkDir == "" {
+				if forkDir, err = os.MkdirTemp("", "upgrade-rehearsal"); err != nil {
+					return fmt.Errorf("could not create fork directory: %w", err)
+				}
+				defer os.RemoveAll(forkDir)
+			}
+
+			blockTimeStr, err := cmd.Flags().GetString(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+
+			blockTime := time.Now().UTC()
+			if blockTimeStr != "" {
+				if blockTime, err = time.Parse(time.RFC3339, blockTimeStr); err != nil {
+					return fmt.Errorf("invalid block time: %w", err)
+				}
+			}
+
+			info, err := cmd.Flags().GetString(FlagUpgradeInfo)
+			if err != nil {
+				return err
+			}
+
+			srcDB := filepath.Join(serverCtx.Config.RootDir, "data", "application.db")
+			if err := copyDir(srcDB, filepath.Join(forkDir, "data", "application.db")); err != nil {
+				return fmt.Errorf("could not fork application database: %w", err)
+			}
+
+			db, err := server.OpenDB(forkDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			height := rootmulti.GetLatestVersion(db) + 1
+			p := types.Plan{Name: args[0], Height: height, Info: info}
+
+			// write the upgrade info in the fork, for the app store loader to apply the store upgrades
+			upgradeInfo, err := json.Marshal(p)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(forkDir, "data", types.UpgradeInfoFilename), upgradeInfo, 0o600); err != nil {
+				return err
+			}
+
+			serverCtx.Viper.Set(flags.FlagHome, forkDir)
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			defer app.Close()
+
+			report, err := RehearseUpgrade(app, p, blockTime)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagForkDir, "", "Directory in which the node state is forked, it is kept after the rehearsal. Defaults to a temporary directory")
+	cmd.Flags().String(FlagBlockTime, "", "Block time (RFC3339) of the upgrade block. Defaults to the current time")
+	cmd.Flags().String(FlagUpgradeInfo, "", "Info of the rehearsed upgrade plan")
+
+	return cmd
+}
+
+// RehearsalApp is the subset of the application used to rehearse an upgrade.
+type RehearsalApp interface {
+	FinalizeBlock(*abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error)
+	CommitMultiStore() storetypes.CommitMultiStore
+}
+
+// RehearseUpgrade schedules the given plan in the application state and executes the block at the plan height.
+// The application must have been loaded at the height preceding the plan height, and is left uncommitted.
+func RehearseUpgrade(app RehearsalApp, p types.Plan, blockTime time.Time) (report RehearsalReport, err error) {
+	cms := app.CommitMultiStore()
+
+	keysGetter, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return report, errors.New("commit multistore does not expose its store keys")
+	}
+	storeKeys := keysGetter.StoreKeysByName()
+
+	upgradeKey, ok := storeKeys[types.StoreKey]
+	if !ok {
+		return report, fmt.Errorf("%s store is not mounted", types.StoreKey)
+	}
+
+	if latest := cms.LatestVersion(); latest+1 != p.Height {
+		return report, fmt.Errorf("plan height %d must follow the latest height %d", p.Height, latest)
+	}
+
+	appHashBefore := cms.LastCommitID().Hash
+	storeHashesBefore := storeHashes(cms, storeKeys)
+	versionsBefore, err := moduleVersions(cms.GetCommitKVStore(upgradeKey))
+	if err != nil {
+		return report, err
+	}
+
+	// schedule the plan, as done by the upgrade keeper when the upgrade proposal passes
+	bz, err := p.Marshal()
+	if err != nil {
+		return report, err
+	}
+	cms.GetCommitKVStore(upgradeKey).Set(types.PlanKey(), bz)
+
+	start := time.Now()
+	res, err := finalizeBlock(app, &abci.FinalizeBlockRequest{Height: p.Height, Time: blockTime})
+	if err != nil {
+		return report, fmt.Errorf("upgrade %s failed: %w", p.Name, err)
+	}
+	duration := time.Since(start)
+
+	versionsAfter, err := moduleVersions(cms.GetCommitKVStore(upgradeKey))
+	if err != nil {
+		return report, err
+	}
+
+	return RehearsalReport{
+		UpgradeName:    p.Name,
+		Height:         p.Height,
+		Duration:       duration.String(),
+		AppHashBefore:  hex.EncodeToString(appHashBefore),
+		AppHashAfter:   hex.EncodeToString(res.AppHash),
+		ModuleVersions: diffModuleVersions(versionsBefore, versionsAfter),
+		StoreHashes:    diffStoreHashes(storeHashesBefore, storeHashes(cms, storeKeys)),
+	}, nil
+}
+
+// finalizeBlock executes the block, converting panics (e.g. a missing upgrade handler) into errors.
+func finalizeBlock(app RehearsalApp, req *abci.FinalizeBlockRequest) (res *abci.FinalizeBlockResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return app.FinalizeBlock(req)
+}
+
+// moduleVersions reads the module version map from the upgrade store.
+func moduleVersions(store storetypes.KVStore) (map[string]uint64, error) {
+	prefix := []byte{types.VersionMapByte}
+	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer it.Close()
+
+	versions := make(map[string]uint64)
+	for ; it.Valid(); it.Next() {
+		if len(it.Value()) != 8 {
+			return nil, fmt.Errorf("invalid version of module %s", it.Key()[1:])
+		}
+		versions[string(it.Key()[1:])] = binary.BigEndian.Uint64(it.Value())
+	}
+
+	return versions, nil
+}
+
+// storeHashes returns the working hash of every IAVL store, by store name.
+func storeHashes(cms storetypes.CommitMultiStore, storeKeys map[string]storetypes.StoreKey) map[string][]byte {
+	hashes := make(map[string][]byte, len(storeKeys))
+	for name, key := range storeKeys {
+		store := cms.GetCommitKVStore(key)
+		if store == nil || store.GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+		hashes[name] = store.WorkingHash()
+	}
+
+	return hashes
+}
+
+func diffModuleVersions(before, after map[string]uint64) []ModuleVersionChange {
+	var changes []ModuleVersionChange
+	for _, module := range sortedKeys(before, after) {
+		if before[module] != after[module] {
+			changes = append(changes, ModuleVersionChange{Module: module, From: before[module], To: after[module]})
+		}
+	}
+
+	return changes
+}
+
+func diffStoreHashes(before, after map[string][]byte) []StoreHashChange {
+	var changes []StoreHashChange
+	for _, name := range sortedKeys(before, after) {
+		if !bytes.Equal(before[name], after[name]) {
+			changes = append(changes, StoreHashChange{
+				Store:  name,
+				Before: hex.EncodeToString(before[name]),
+				After:  hex.EncodeToString(after[name]),
+			})
+		}
+	}
+
+	return changes
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// copyDir recursively copies the src directory into dst, which must not exist.
+func copyDir(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%s already exists", dst)
+	}
+
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func versionBytes(v uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return bz
+}
+
+// newRehearsalApp returns an app committed at height 1, whose pre-blocker applies the scheduled
+// plan by bumping the bank module version and writing to the foo store, or panics if fail is set.
+func newRehearsalApp(t *testing.T, fail bool) *baseapp.BaseApp {
+	t.Helper()
+
+	upgradeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	fooKey := storetypes.NewKVStoreKey("foo")
+	barKey := storetypes.NewKVStoreKey("bar")
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), coretesting.NewMemDB(), nil,
+		baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)))
+	app.MountStores(upgradeKey, fooKey, barKey)
+	app.SetPreBlocker(func(ctx sdk.Context, _ *abci.FinalizeBlockRequest) error {
+		store := ctx.KVStore(upgradeKey)
+		bz := store.Get(types.PlanKey())
+		if bz == nil {
+			return nil
+		}
+
+		if fail {
+			panic("UPGRADE NEEDED")
+		}
+
+		var plan types.Plan
+		if err := plan.Unmarshal(bz); err != nil {
+			return err
+		}
+
+		store.Set(append([]byte{types.VersionMapByte}, "bank"...), versionBytes(2))
+		store.Set(append([]byte{types.VersionMapByte}, "foo"...), versionBytes(1))
+		store.Delete(types.PlanKey())
+		ctx.KVStore(fooKey).Set([]byte("upgraded"), []byte(plan.Name))
+		return nil
+	})
+	require.NoError(t, app.LoadLatestVersion())
+
+	// commit an initial state at height 1
+	app.CommitMultiStore().GetCommitKVStore(upgradeKey).Set(append([]byte{types.VersionMapByte}, "bank"...), versionBytes(1))
+	app.CommitMultiStore().GetCommitKVStore(barKey).Set([]byte("k"), []byte("v"))
+	_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return app
+}
+
+func TestRehearseUpgrade(t *testing.T) {
+	app := newRehearsalApp(t, false)
+	lastCommitID := app.CommitMultiStore().LastCommitID()
+
+	_, err := RehearseUpgrade(app, types.Plan{Name: "v2", Height: 5}, time.Now())
+	require.ErrorContains(t, err, "plan height 5 must follow the latest height 1")
+
+	report, err := RehearseUpgrade(app, types.Plan{Name: "v2", Height: 2}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "v2", report.UpgradeName)
+	require.Equal(t, int64(2), report.Height)
+	require.NotEmpty(t, report.Duration)
+	require.NotEqual(t, report.AppHashBefore, report.AppHashAfter)
+	require.Equal(t, []ModuleVersionChange{
+		{Module: "bank", From: 1, To: 2},
+		{Module: "foo", From: 0, To: 1},
+	}, report.ModuleVersions)
+
+	changedStores := make([]string, 0, len(report.StoreHashes))
+	for _, change := range report.StoreHashes {
+		changedStores = append(changedStores, change.Store)
+	}
+	require.Equal(t, []string{"foo", "upgrade"}, changedStores)
+
+	// the rehearsal is never committed
+	require.Equal(t, lastCommitID, app.CommitMultiStore().LastCommitID())
+}
+
+func TestRehearseUpgradePanic(t *testing.T) {
+	app := newRehearsalApp(t, true)
+
+	_, err := RehearseUpgrade(app, types.Plan{Name: "v2", Height: 2}, time.Now())
+	require.ErrorContains(t, err, "upgrade v2 failed: panic: UPGRADE NEEDED")
+}
+
+func TestCopyDir(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b"), []byte("b"), 0o600))
+
+	dst := filepath.Join(t.TempDir(), "fork", "application.db")
+	require.NoError(t, copyDir(src, dst))
+
+	bz, err := os.ReadFile(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(bz))
+	bz, err = os.ReadFile(filepath.Join(dst, "sub", "b"))
+	require.NoError(t, err)
+	require.Equal(t, "b", string(bz))
+
+	require.ErrorContains(t, copyDir(src, dst), "already exists")
+}
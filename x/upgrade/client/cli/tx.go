@@ -24,6 +24,7 @@ const (
 	FlagNoValidate         = "no-validate"
 	FlagNoChecksumRequired = "no-checksum-required"
 	FlagDaemonName         = "daemon-name"
+	FlagTrustedKeysFile    = "trusted-keys-file"
 	FlagAuthority          = "authority"
 )
 
@@ -84,8 +85,23 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 					return err
 				}
 
+				parseOpts := []plan.ParseOption{plan.ParseOptionEnforceChecksum(!noChecksum)}
+
+				trustedKeysFile, err := cmd.Flags().GetString(FlagTrustedKeysFile)
+				if err != nil {
+					return err
+				}
+
+				if trustedKeysFile != "" {
+					trustedKeys, err := plan.LoadTrustedKeysFile(trustedKeysFile)
+					if err != nil {
+						return err
+					}
+					parseOpts = append(parseOpts, plan.ParseOptionTrustedKeys(trustedKeys...))
+				}
+
 				var planInfo *plan.Info
-				if planInfo, err = plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(!noChecksum)); err != nil {
+				if planInfo, err = plan.ParseInfo(p.Info, parseOpts...); err != nil {
 					return err
 				}
 
@@ -123,6 +139,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().Bool(FlagNoValidate, false, "Skip validation of the upgrade info (dangerous!)")
 	cmd.Flags().Bool(FlagNoChecksumRequired, false, "Skip requirement of checksums for binaries in the upgrade info")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
+	cmd.Flags().String(FlagTrustedKeysFile, "", "Path to a file of base64 ed25519 release keys, one per line. When set, the upgrade info binaries must be signed by one of them")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
 
 	// add common proposal flags
@@ -1,6 +1,7 @@
 package plan
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,8 @@ type Info struct {
 	parseConfig ParseConfig
 
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+	// Signatures are the release key signatures of the binaries, see SignBinaries.
+	Signatures []Signature `json:"signatures,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture strings to a URL where the binary can be downloaded.
@@ -28,6 +31,9 @@ type ParseConfig struct {
 	// EnforceChecksum, if true, will cause all downloaded files to be checked against their checksums.
 	// When false, checksums are not enforced to be present in the url.
 	EnforceChecksum bool
+	// TrustedKeys, if not empty, requires the binaries to be signed by at least one of these release keys.
+	// Checksums are always enforced when trusted keys are set, as the signature only covers the binaries URLs.
+	TrustedKeys []ed25519.PublicKey
 }
 
 // ParseOption is used to configure the parsing of a Plan.Info string.
@@ -40,8 +46,21 @@ func ParseOptionEnforceChecksum(enforce bool) ParseOption {
 	}
 }
 
+// ParseOptionTrustedKeys returns a ParseOption that sets the TrustedKeys field of the ParseConfig.
+func ParseOptionTrustedKeys(keys ...ed25519.PublicKey) ParseOption {
+	return func(c *ParseConfig) {
+		c.TrustedKeys = keys
+	}
+}
+
+// enforceChecksum returns true if the binaries URLs must contain a checksum.
+func (c ParseConfig) enforceChecksum() bool {
+	return c.EnforceChecksum || len(c.TrustedKeys) > 0
+}
+
 // ParseInfo parses an info string into a map of os/arch strings to URL string.
 // If the infoStr is a url, an GET request will be made to it, and its response will be parsed instead.
+// If trusted keys are configured, the binaries must be signed by at least one of them.
 func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 	parseConfig := &ParseConfig{}
 	for _, opt := range opts {
@@ -55,7 +74,7 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 
 	// If it's a url, download it and treat the result as the real info.
 	if _, err := neturl.ParseRequestURI(infoStr); err == nil {
-		if err := ValidateURL(infoStr, parseConfig.EnforceChecksum); err != nil {
+		if err := ValidateURL(infoStr, parseConfig.enforceChecksum()); err != nil {
 			return nil, err
 		}
 
@@ -73,21 +92,33 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 
 	planInfo.parseConfig = *parseConfig
 
+	if len(parseConfig.TrustedKeys) > 0 {
+		if err := planInfo.VerifySignatures(parseConfig.TrustedKeys); err != nil {
+			return nil, fmt.Errorf("could not verify plan info signatures: %w", err)
+		}
+	}
+
 	return &planInfo, nil
 }
 
 // ValidateFull does all possible validation of this Info.
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
+//   - The binaries are signed by a trusted key, when trusted keys are configured.
 //   - Binaries.ValidateBasic() doesn't return an error
 //   - Binaries.CheckURLs(daemonName) doesn't return an error.
 //
 // Warning: This is an expensive process. See BinaryDownloadURLMap.CheckURLs for more info.
 func (m Info) ValidateFull(daemonName string) error {
-	if err := m.Binaries.ValidateBasic(m.parseConfig.EnforceChecksum); err != nil {
+	if len(m.parseConfig.TrustedKeys) > 0 {
+		if err := m.VerifySignatures(m.parseConfig.TrustedKeys); err != nil {
+			return err
+		}
+	}
+	if err := m.Binaries.ValidateBasic(m.parseConfig.enforceChecksum()); err != nil {
 		return err
 	}
-	if err := m.Binaries.CheckURLs(daemonName, m.parseConfig.EnforceChecksum); err != nil {
+	if err := m.Binaries.CheckURLs(daemonName, m.parseConfig.enforceChecksum()); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,159 @@
+package plan
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Signature is an ed25519 signature of the binaries of an upgrade Info, made by a release key.
+// Both fields are standard base64 encoded.
+type Signature struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// SignBytes returns the bytes signed by release keys for this BinaryDownloadURLMap.
+// It is the JSON encoding of the map, which has its keys sorted and is thus deterministic.
+// Since the binary URLs are expected to contain a checksum, the signature covers the binaries content.
+func (m BinaryDownloadURLMap) SignBytes() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// SignBinaries signs the provided binaries with the given ed25519 private key.
+// The returned signature can be appended to the signatures of an upgrade Info.
+func SignBinaries(binaries BinaryDownloadURLMap, key ed25519.PrivateKey) (Signature, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return Signature{}, fmt.Errorf("invalid ed25519 private key length %d", len(key))
+	}
+
+	signBytes, err := binaries.SignBytes()
+	if err != nil {
+		return Signature{}, err
+	}
+
+	pubKey, ok := key.Public().(ed25519.PublicKey)
+	if !ok {
+		return Signature{}, errors.New("invalid ed25519 private key")
+	}
+
+	return Signature{
+		PublicKey: base64.StdEncoding.EncodeToString(pubKey),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, signBytes)),
+	}, nil
+}
+
+// VerifySignatures checks that the binaries of this Info are signed by at least one of the trusted keys.
+// Signatures made by keys which are not trusted are ignored, but an invalid signature made by a trusted
+// key is always an error.
+func (m Info) VerifySignatures(trustedKeys []ed25519.PublicKey) error {
+	if len(trustedKeys) == 0 {
+		return errors.New("no trusted keys provided")
+	}
+
+	if len(m.Signatures) == 0 {
+		return errors.New("upgrade info is not signed")
+	}
+
+	signBytes, err := m.Binaries.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	verified := false
+	for i, sig := range m.Signatures {
+		pubKey, err := decodePublicKey(sig.PublicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key in signatures[%d]: %w", i, err)
+		}
+
+		if !isTrustedKey(pubKey, trustedKeys) {
+			continue
+		}
+
+		sigBz, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature encoding in signatures[%d]: %w", i, err)
+		}
+
+		if !ed25519.Verify(pubKey, signBytes, sigBz) {
+			return fmt.Errorf("invalid signature in signatures[%d] for trusted key %s", i, sig.PublicKey)
+		}
+
+		verified = true
+	}
+
+	if !verified {
+		return errors.New("upgrade info is not signed by any trusted key")
+	}
+
+	return nil
+}
+
+// ParseTrustedKeys parses a list of trusted release keys.
+// Each non-empty line must contain a standard base64 encoded ed25519 public key.
+// Lines starting with '#' are treated as comments.
+func ParseTrustedKeys(content string) ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, err := decodePublicKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key on line %d: %w", lineNum, err)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no trusted keys found")
+	}
+
+	return keys, nil
+}
+
+// LoadTrustedKeysFile reads the trusted release keys from the given file.
+// See ParseTrustedKeys for the expected format.
+func LoadTrustedKeysFile(path string) ([]ed25519.PublicKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read trusted keys file: %w", err)
+	}
+
+	return ParseTrustedKeys(string(bz))
+}
+
+func decodePublicKey(s string) (ed25519.PublicKey, error) {
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length %d", len(bz))
+	}
+
+	return bz, nil
+}
+
+func isTrustedKey(key ed25519.PublicKey, trustedKeys []ed25519.PublicKey) bool {
+	for _, trusted := range trustedKeys {
+		if key.Equal(trusted) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package plan
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, seed byte) ed25519.PrivateKey {
+	t.Helper()
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+}
+
+func makeSignedInfo(t *testing.T, binaries BinaryDownloadURLMap, keys ...ed25519.PrivateKey) string {
+	t.Helper()
+	info := Info{Binaries: binaries}
+	for _, key := range keys {
+		sig, err := SignBinaries(binaries, key)
+		require.NoError(t, err)
+		info.Signatures = append(info.Signatures, sig)
+	}
+	bz, err := json.Marshal(info)
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestParseInfoTrustedKeys(t *testing.T) {
+	releaseKey := newTestKey(t, 1)
+	otherKey := newTestKey(t, 2)
+	releasePubKey := releaseKey.Public().(ed25519.PublicKey)
+
+	binaries := BinaryDownloadURLMap{
+		"linux/amd64": "https://example.com/simd-linux-amd64?checksum=sha256:aaaa",
+		"any":         "https://example.com/simd?checksum=sha256:bbbb",
+	}
+
+	// signature made by the release key over different binaries
+	sig, err := SignBinaries(BinaryDownloadURLMap{"any": "https://evil.com/simd?checksum=sha256:cccc"}, releaseKey)
+	require.NoError(t, err)
+	wronglySigned := Info{Binaries: binaries, Signatures: []Signature{sig}}
+	wronglySignedBz, err := json.Marshal(wronglySigned)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		infoStr   string
+		expErrMsg string
+	}{
+		{
+			name:    "signed by trusted key",
+			infoStr: makeSignedInfo(t, binaries, releaseKey),
+		},
+		{
+			name:    "signed by trusted and untrusted keys",
+			infoStr: makeSignedInfo(t, binaries, otherKey, releaseKey),
+		},
+		{
+			name:      "unsigned",
+			infoStr:   makeSignedInfo(t, binaries),
+			expErrMsg: "upgrade info is not signed",
+		},
+		{
+			name:      "signed by untrusted key",
+			infoStr:   makeSignedInfo(t, binaries, otherKey),
+			expErrMsg: "not signed by any trusted key",
+		},
+		{
+			name:      "wrongly signed",
+			infoStr:   string(wronglySignedBz),
+			expErrMsg: "invalid signature in signatures[0]",
+		},
+		{
+			name:      "invalid public key",
+			infoStr:   `{"binaries":{"any":"https://example.com/simd?checksum=sha256:bbbb"},"signatures":[{"public_key":"Zm9v","signature":""}]}`,
+			expErrMsg: "invalid public key in signatures[0]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := ParseInfo(tc.infoStr, ParseOptionTrustedKeys(releasePubKey))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, binaries, info.Binaries)
+			require.NoError(t, info.Binaries.ValidateBasic(info.parseConfig.enforceChecksum()))
+		})
+	}
+}
+
+func TestTrustedKeysEnforceChecksum(t *testing.T) {
+	releaseKey := newTestKey(t, 1)
+	binaries := BinaryDownloadURLMap{"any": "https://example.com/simd"}
+
+	info, err := ParseInfo(makeSignedInfo(t, binaries, releaseKey), ParseOptionTrustedKeys(releaseKey.Public().(ed25519.PublicKey)))
+	require.NoError(t, err)
+
+	err = info.ValidateFull("simd")
+	require.ErrorContains(t, err, "missing checksum query parameter")
+}
+
+func TestParseTrustedKeys(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString(newTestKey(t, 1).Public().(ed25519.PublicKey))
+	key2 := base64.StdEncoding.EncodeToString(newTestKey(t, 2).Public().(ed25519.PublicKey))
+
+	keys, err := ParseTrustedKeys("# release keys\n" + key1 + "\n\n  " + key2 + "  \n")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	_, err = ParseTrustedKeys("# only comments\n")
+	require.ErrorContains(t, err, "no trusted keys found")
+
+	_, err = ParseTrustedKeys(key1 + "\nnot-a-key\n")
+	require.ErrorContains(t, err, "invalid trusted key on line 2")
+
+	_, err = ParseTrustedKeys(base64.StdEncoding.EncodeToString([]byte("short")))
+	require.ErrorContains(t, err, "invalid ed25519 public key length")
+
+	path := filepath.Join(t.TempDir(), "trusted_keys")
+	require.NoError(t, os.WriteFile(path, []byte(key1+"\n"), 0o600))
+	keys, err = LoadTrustedKeysFile(path)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	_, err = LoadTrustedKeysFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "could not read trusted keys file")
+}
@@ -45,6 +45,8 @@ type ModuleInputs struct {
 	ExtraTxValidators        []appmodulev2.TxValidator[transaction.Tx] `optional:"true"`
 	UnorderedTxManager       *unorderedtx.Manager                      `optional:"true"`
 	TxFeeChecker             ante.TxFeeChecker                         `optional:"true"`
+	FeeDenomOracle           ante.FeeDenomOracle                       `optional:"true"`
+	FeeSwapHook              ante.FeeSwapHook                          `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -78,6 +80,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 		feeTxValidator = ante.NewDeductFeeDecorator(in.AccountKeeper, in.BankKeeper, in.FeeGrantKeeper, in.TxFeeChecker)
 		feeTxValidator.SetMinGasPrices(minGasPrices) // set min gas price in deduct fee decorator
+		feeTxValidator.SetFeeDenomOracle(in.FeeDenomOracle)
+		feeTxValidator.SetFeeSwapHook(in.FeeSwapHook)
+		feeTxValidator.SetBaseFeeEnabled(in.ModuleConfig.EnableBaseFee)
 	}
 
 	if in.UnorderedTxManager != nil {
@@ -135,6 +140,9 @@ func newAnteHandler(in ModuleInputs) (sdk.AnteHandler, error) {
 			FeegrantKeeper:     in.FeeGrantKeeper,
 			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
 			UnorderedTxManager: in.UnorderedTxManager,
+			FeeDenomOracle:     in.FeeDenomOracle,
+			FeeSwapHook:        in.FeeSwapHook,
+			EnableBaseFee:      in.ModuleConfig.EnableBaseFee,
 		},
 	)
 	if err != nil {